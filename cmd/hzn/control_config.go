@@ -0,0 +1,698 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v3/certcrypto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/horizon/pkg/control"
+	grpcgzip "github.com/hashicorp/horizon/pkg/grpc/gzip"
+	"github.com/hashicorp/horizon/pkg/tlsmanage"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/pkg/errors"
+)
+
+// ControlConfig is the control server's configuration, as read from the
+// environment. The fields are named after the variables they're read from.
+// Durations and counts left at zero use the default of whatever consumes
+// them.
+type ControlConfig struct {
+	DatabaseURL        string
+	DatabaseReplicaURL string
+	DBStatementTimeout time.Duration
+
+	EgressCAFile string
+
+	// Where token signing keys and TLS material are kept: vault, the
+	// default, or file, which keeps them under SECRETS_DIR. Tokens are
+	// signed by the backend itself, so with file the control server never
+	// talks to vault.
+	SecretsBackend string
+	SecretsDir     string
+
+	S3Bucket       string
+	S3CreateBucket bool
+
+	// From S3_REGION_BUCKETS, eg. us-east-1=hzn-use1,eu-west-1=hzn-euw1.
+	// The bucket of ControlRegion is used in place of S3Bucket.
+	S3RegionBuckets map[string]string
+
+	// CONTROL_REGION, defaulting to the region of the AWS session.
+	ControlRegion string
+
+	HubDomain string
+	ZoneID    string
+
+	LetsEncryptStaging bool
+
+	// Refuse to serve TLS material clients won't trust, rather than have
+	// them connect while ignoring certificate errors.
+	RequireRealTLS bool
+
+	ACMEAccountKeyFile      string
+	ACMEAccountURL          string
+	ACMEEmail               string
+	ACMEAcceptTOS           bool
+	ACMEFallbackDNSProvider string
+
+	CertRenewBefore time.Duration
+	HubCertKeyType  certcrypto.KeyType
+
+	// Issue each hub a cert naming its own subdomain, for environments
+	// that forbid wildcard certs. HUB_DOMAIN must then not be a wildcard.
+	HubSubdomainCerts bool
+
+	// How long a gone hub's subdomain keeps its cert, from
+	// HUB_SUBDOMAIN_CERT_GRACE.
+	HubSubdomainCertGrace time.Duration
+
+	// From CONTROL_DOMAIN, CONTROL_CERT_KEY_TYPE and
+	// CONTROL_CERT_RENEW_BEFORE.
+	ControlCert tlsmanage.ControlCertConfig
+
+	DisableTLSReadiness bool
+	ALPNProtocols       []string
+
+	RegisterToken string
+	OpsToken      string
+
+	// TOKEN_KEY_ID, defaulting to k1.
+	TokenKeyID         string
+	TokenVerifyKeyIDs  []string
+	MaxTokenTTL        time.Duration
+	RejectLongTokenTTL bool
+
+	// Tokens per minute per account, negative removes the limit.
+	TokenIssueRate  int
+	TokenIssueBurst int
+
+	// TOKEN_SIGNING_ALGORITHM, ed25519 or ecdsa-p256, defaulting to ed25519.
+	TokenSigningAlgorithm token.Algorithm
+
+	ASNDBPath   string
+	ASNCacheTTL time.Duration
+
+	HubAccessKey string
+	HubSecretKey string
+	HubImageTag  string
+
+	// LISTEN_ADDR, defaulting to PORT on all interfaces. A unix:// path
+	// serves plaintext on a unix socket with ListenSocketMode instead.
+	ListenAddr       string
+	ListenSocketMode os.FileMode
+
+	MaxLabelLinksPerAccount int
+	MaxFlowsPerHub          int64
+	MaxStreamsPerPeer       int
+	StreamIdleTimeout       time.Duration
+	FlowIdleTimeout         time.Duration
+	SlowRPCThreshold        time.Duration
+
+	AccountServiceQuota int64
+
+	// Fractions, read as percentages, eg. 80,95
+	QuotaWarningThresholds []float64
+
+	GzipCompression bool
+	GzipMinSize     int
+
+	MaxRequestBodySize int64
+
+	// Negative removes the limit.
+	MaxConns int
+
+	// The LOAD_MAX_ thresholds past which the server is overloaded. Only
+	// LOAD_MAX_CONNS makes it report not ready, the others are shared by
+	// every server and so require LOAD_SHED_RPCS, which rejects
+	// non-critical RPCs while past any.
+	LoadMaxJobBacklog int
+	LoadMaxDBLatency  time.Duration
+	LoadMaxConns      int
+	LoadShedRPCs      bool
+
+	HubReconnectInitialBackoff time.Duration
+	HubReconnectMaxBackoff     time.Duration
+	HubReconnectJitter         time.Duration
+
+	DrainWindow time.Duration
+
+	FlowRollups         bool
+	FlowRollupRetention time.Duration
+
+	// ACTIVITY_SUMMARY, hour or day, rolls the activity log up into
+	// summaries as it's pruned, counting entries by their "type" field.
+	// ACTIVITY_SUMMARY_KEY_EVENTS lists the types kept whole.
+	ActivitySummary          string
+	ActivitySummaryKeyEvents []string
+	ActivitySummaryRetention time.Duration
+
+	MgmtAllowCIDRs        []*net.IPNet
+	MgmtDenyCIDRs         []*net.IPNet
+	MgmtTrustedProxyCIDRs []*net.IPNet
+	ProxyProtocol         bool
+
+	UnknownFields   control.UnknownFieldMode
+	LabelLinkCycles control.LabelLinkCycleMode
+
+	EventSinkURL               string
+	FlowAssignmentStrategy     string
+	FlowAssignmentRetries      int
+	FlowAssignmentRetryBackoff time.Duration
+	HubAffinityAccounts        []string
+
+	DogStatsDAddr     string
+	StatsDAddr        string
+	DisablePrometheus bool
+
+	EnablePprof bool
+	PprofAddr   string
+
+	WebhookURL            string
+	RequireHubCredentials bool
+	RequestIDHeader       string
+
+	MinHubProtocolVersion int32
+
+	// The default TLS policy for agent connections to hubs. The minimum
+	// version is read as eg. 1.2.
+	AgentTLSMinVersion     uint16
+	AgentRequireClientCert bool
+	AgentClientCAFile      string
+
+	MaintenanceMode   bool
+	MaintenanceReason string
+
+	ConfigFile string
+
+	OrphanCleanupDryRun   bool
+	OrphanGracePeriod     time.Duration
+	OrphanCleanupInterval time.Duration
+
+	HubSRVName string
+	HubSRVPort int
+
+	WorkqWorkers       int
+	WorkqBatchSize     int
+	WorkqPollInterval  time.Duration
+	WorkqDisableListen bool
+
+	// Serve a queue per account, fairly, with at most WorkqMaxPerAccount
+	// of one account's jobs running at once on each worker.
+	WorkqAccountQueues bool
+	WorkqMaxPerAccount int
+}
+
+// FromEnv reads the configuration from the environment, filling in the
+// defaults. It fails on values that can't be parsed, leaving the checks
+// that involve more than one setting to Validate.
+func (c *ControlConfig) FromEnv() error {
+	return c.fromLookup(os.Getenv)
+}
+
+func (c *ControlConfig) fromLookup(getenv func(string) string) error {
+	e := &envReader{getenv: getenv}
+
+	c.DatabaseURL = getenv("DATABASE_URL")
+	c.DatabaseReplicaURL = getenv("DATABASE_REPLICA_URL")
+	c.DBStatementTimeout = e.duration("DB_STATEMENT_TIMEOUT", 0)
+
+	c.EgressCAFile = getenv("EGRESS_CA_FILE")
+
+	c.SecretsBackend = getenv("SECRETS_BACKEND")
+	c.SecretsDir = getenv("SECRETS_DIR")
+
+	c.S3Bucket = getenv("S3_BUCKET")
+	c.S3CreateBucket = getenv("S3_CREATE_BUCKET") == "1"
+
+	var err error
+
+	c.S3RegionBuckets, err = control.ParseRegionBuckets(getenv("S3_REGION_BUCKETS"))
+	if err != nil {
+		e.fail("S3_REGION_BUCKETS", err.Error())
+	}
+
+	c.ControlRegion = getenv("CONTROL_REGION")
+
+	c.HubDomain = getenv("HUB_DOMAIN")
+	c.ZoneID = getenv("ZONE_ID")
+
+	c.LetsEncryptStaging = e.flag("LETSENCRYPT_STAGING")
+	c.RequireRealTLS = e.flag("REQUIRE_REAL_TLS")
+
+	c.ACMEAccountKeyFile = getenv("ACME_ACCOUNT_KEY_FILE")
+	c.ACMEAccountURL = getenv("ACME_ACCOUNT_URL")
+	c.ACMEEmail = getenv("ACME_EMAIL")
+	c.ACMEAcceptTOS = e.flag("ACME_ACCEPT_TOS")
+	c.ACMEFallbackDNSProvider = getenv("ACME_FALLBACK_DNS_PROVIDER")
+
+	c.CertRenewBefore = e.duration("CERT_RENEW_BEFORE", 1)
+	c.HubCertKeyType = e.keyType("HUB_CERT_KEY_TYPE")
+	c.HubSubdomainCerts = e.flag("HUB_SUBDOMAIN_CERTS")
+	c.HubSubdomainCertGrace = e.duration("HUB_SUBDOMAIN_CERT_GRACE", 1)
+
+	c.ControlCert.Domain = getenv("CONTROL_DOMAIN")
+	c.ControlCert.KeyType = e.keyType("CONTROL_CERT_KEY_TYPE")
+	c.ControlCert.RenewBefore = e.duration("CONTROL_CERT_RENEW_BEFORE", 1)
+
+	c.DisableTLSReadiness = e.flag("DISABLE_TLS_READINESS")
+	c.ALPNProtocols = e.list("ALPN_PROTOCOLS")
+
+	c.RegisterToken = getenv("REGISTER_TOKEN")
+	c.OpsToken = getenv("OPS_TOKEN")
+
+	c.TokenKeyID = getenv("TOKEN_KEY_ID")
+	if c.TokenKeyID == "" {
+		c.TokenKeyID = "k1"
+	}
+
+	c.TokenVerifyKeyIDs = e.list("TOKEN_VERIFY_KEY_IDS")
+	c.MaxTokenTTL = e.duration("MAX_TOKEN_TTL", 0)
+	c.RejectLongTokenTTL = e.flag("REJECT_LONG_TOKEN_TTL")
+
+	if str := getenv("TOKEN_ISSUE_RATE"); str != "" {
+		n, err := strconv.Atoi(str)
+		if err != nil || n == 0 {
+			e.fail("TOKEN_ISSUE_RATE", str)
+		}
+
+		c.TokenIssueRate = n
+	}
+
+	c.TokenIssueBurst = e.integer("TOKEN_ISSUE_BURST", 1)
+
+	c.TokenSigningAlgorithm, err = token.ParseAlgorithm(getenv("TOKEN_SIGNING_ALGORITHM"))
+	if err != nil {
+		e.fail("TOKEN_SIGNING_ALGORITHM", getenv("TOKEN_SIGNING_ALGORITHM"))
+	}
+
+	c.ASNDBPath = getenv("ASN_DB_PATH")
+	c.ASNCacheTTL = e.duration("ASN_CACHE_TTL", 1)
+
+	c.HubAccessKey = getenv("HUB_ACCESS_KEY")
+	c.HubSecretKey = getenv("HUB_SECRET_KEY")
+	c.HubImageTag = getenv("HUB_IMAGE_TAG")
+
+	c.ListenAddr = getenv("LISTEN_ADDR")
+	if c.ListenAddr == "" {
+		c.ListenAddr = ":" + getenv("PORT")
+	}
+
+	c.ListenSocketMode = defaultListenSocketMode
+	if str := getenv("LISTEN_SOCKET_MODE"); str != "" {
+		mode, err := strconv.ParseUint(str, 8, 32)
+		if err != nil || mode > 0777 {
+			e.fail("LISTEN_SOCKET_MODE", str)
+		}
+
+		c.ListenSocketMode = os.FileMode(mode)
+	}
+
+	c.MaxLabelLinksPerAccount = e.integer("MAX_LABEL_LINKS_PER_ACCOUNT", 0)
+	c.MaxFlowsPerHub = e.int64("MAX_FLOWS_PER_HUB", 0)
+	c.MaxStreamsPerPeer = e.integer("MAX_STREAMS_PER_PEER", 0)
+	c.StreamIdleTimeout = e.duration("STREAM_IDLE_TIMEOUT", 0)
+	c.FlowIdleTimeout = e.duration("FLOW_IDLE_TIMEOUT", 0)
+	if c.FlowIdleTimeout > 0 && c.FlowIdleTimeout < control.MinFlowIdleTimeout {
+		e.fail("FLOW_IDLE_TIMEOUT", getenv("FLOW_IDLE_TIMEOUT"))
+	}
+	c.SlowRPCThreshold = e.duration("SLOW_RPC_THRESHOLD", 0)
+
+	c.AccountServiceQuota = e.int64("ACCOUNT_SERVICE_QUOTA", 0)
+
+	if str := getenv("QUOTA_WARNING_THRESHOLDS"); str != "" {
+		for _, part := range strings.Split(str, ",") {
+			pct, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+			if err != nil || pct <= 0 || pct > 100 {
+				e.fail("QUOTA_WARNING_THRESHOLDS", str)
+				break
+			}
+
+			c.QuotaWarningThresholds = append(c.QuotaWarningThresholds, pct/100)
+		}
+
+		sort.Float64s(c.QuotaWarningThresholds)
+	}
+
+	// Compression is only used for clients that ask for it, so enabling it
+	// doesn't affect hubs, which use lz4.
+	c.GzipCompression = e.flag("GZIP_COMPRESSION")
+
+	c.GzipMinSize = grpcgzip.DefaultMinSize
+	if getenv("GZIP_MIN_SIZE") != "" {
+		c.GzipMinSize = e.integer("GZIP_MIN_SIZE", 0)
+	}
+
+	c.MaxRequestBodySize = e.int64("MAX_REQUEST_BODY_SIZE", 1)
+
+	if str := getenv("MAX_CONNS"); str != "" {
+		n, err := strconv.Atoi(str)
+		if err != nil || n == 0 {
+			e.fail("MAX_CONNS", str)
+		}
+
+		c.MaxConns = n
+	}
+
+	c.LoadMaxJobBacklog = e.integer("LOAD_MAX_JOB_BACKLOG", 1)
+	c.LoadMaxDBLatency = e.duration("LOAD_MAX_DB_LATENCY", 1)
+	c.LoadMaxConns = e.integer("LOAD_MAX_CONNS", 1)
+	c.LoadShedRPCs = e.flag("LOAD_SHED_RPCS")
+
+	c.HubReconnectInitialBackoff = e.duration("HUB_RECONNECT_INITIAL_BACKOFF", 0)
+	c.HubReconnectMaxBackoff = e.duration("HUB_RECONNECT_MAX_BACKOFF", 0)
+	c.HubReconnectJitter = e.duration("HUB_RECONNECT_JITTER", 0)
+
+	c.DrainWindow = e.duration("DRAIN_WINDOW", 0)
+
+	c.FlowRollups = e.flag("FLOW_ROLLUPS")
+	c.FlowRollupRetention = e.duration("FLOW_ROLLUP_RETENTION", 1)
+
+	c.ActivitySummary, err = control.ParseSummaryGranularity(getenv("ACTIVITY_SUMMARY"))
+	if err != nil {
+		e.fail("ACTIVITY_SUMMARY", getenv("ACTIVITY_SUMMARY"))
+	}
+
+	c.ActivitySummaryKeyEvents = e.list("ACTIVITY_SUMMARY_KEY_EVENTS")
+	c.ActivitySummaryRetention = e.duration("ACTIVITY_SUMMARY_RETENTION", 1)
+
+	c.MgmtAllowCIDRs, err = control.ParseCIDRList(getenv("MGMT_ALLOW_CIDRS"))
+	if err != nil {
+		e.fail("MGMT_ALLOW_CIDRS", err.Error())
+	}
+
+	c.MgmtDenyCIDRs, err = control.ParseCIDRList(getenv("MGMT_DENY_CIDRS"))
+	if err != nil {
+		e.fail("MGMT_DENY_CIDRS", err.Error())
+	}
+
+	c.MgmtTrustedProxyCIDRs, err = control.ParseCIDRList(getenv("MGMT_TRUSTED_PROXY_CIDRS"))
+	if err != nil {
+		e.fail("MGMT_TRUSTED_PROXY_CIDRS", err.Error())
+	}
+
+	c.ProxyProtocol = e.flag("PROXY_PROTOCOL")
+
+	if str := getenv("UNKNOWN_FIELDS"); str != "" {
+		c.UnknownFields, err = control.ParseUnknownFieldMode(str)
+		if err != nil {
+			e.fail("UNKNOWN_FIELDS", str)
+		}
+	}
+
+	if str := getenv("LABEL_LINK_CYCLES"); str != "" {
+		c.LabelLinkCycles, err = control.ParseLabelLinkCycleMode(str)
+		if err != nil {
+			e.fail("LABEL_LINK_CYCLES", str)
+		}
+	}
+
+	c.EventSinkURL = getenv("EVENT_SINK_URL")
+	c.FlowAssignmentStrategy = getenv("FLOW_ASSIGNMENT_STRATEGY")
+	c.FlowAssignmentRetries = e.integer("FLOW_ASSIGNMENT_RETRIES", 0)
+	c.FlowAssignmentRetryBackoff = e.duration("FLOW_ASSIGNMENT_RETRY_BACKOFF", 1)
+	c.HubAffinityAccounts = e.list("HUB_AFFINITY_ACCOUNTS")
+
+	c.DogStatsDAddr = getenv("DOGSTATSD_ADDR")
+	c.StatsDAddr = getenv("STATSD_ADDR")
+	c.DisablePrometheus = e.flag("DISABLE_PROMETHEUS")
+
+	c.EnablePprof = e.flag("ENABLE_PPROF")
+	c.PprofAddr = getenv("PPROF_ADDR")
+
+	c.WebhookURL = getenv("WEBHOOK_URL")
+	c.RequireHubCredentials = e.flag("REQUIRE_HUB_CREDENTIALS")
+	c.RequestIDHeader = getenv("REQUEST_ID_HEADER")
+
+	c.MinHubProtocolVersion = int32(e.integer("MIN_HUB_PROTOCOL_VERSION", 0))
+
+	if str := getenv("AGENT_TLS_MIN_VERSION"); str != "" {
+		c.AgentTLSMinVersion, err = control.ParseTLSVersion(str)
+		if err != nil {
+			e.fail("AGENT_TLS_MIN_VERSION", str)
+		}
+	}
+
+	c.AgentRequireClientCert = e.flag("AGENT_REQUIRE_CLIENT_CERT")
+	c.AgentClientCAFile = getenv("AGENT_CLIENT_CA_FILE")
+
+	c.MaintenanceMode = e.flag("MAINTENANCE_MODE")
+	c.MaintenanceReason = getenv("MAINTENANCE_REASON")
+
+	c.ConfigFile = getenv("CONFIG_FILE")
+
+	c.OrphanCleanupDryRun = e.flag("ORPHAN_CLEANUP_DRY_RUN")
+	c.OrphanGracePeriod = e.duration("ORPHAN_GRACE_PERIOD", 1)
+
+	c.OrphanCleanupInterval = 24 * time.Hour
+	if getenv("ORPHAN_CLEANUP_INTERVAL") != "" {
+		c.OrphanCleanupInterval = e.duration("ORPHAN_CLEANUP_INTERVAL", 1)
+	}
+
+	c.HubSRVName = getenv("HUB_SRV_NAME")
+
+	if str := getenv("HUB_SRV_PORT"); str != "" {
+		c.HubSRVPort, err = strconv.Atoi(str)
+		if err != nil || c.HubSRVPort < 1 || c.HubSRVPort > 65535 {
+			e.fail("HUB_SRV_PORT", str)
+		}
+	}
+
+	c.WorkqWorkers = e.integer("WORKQ_WORKERS", 1)
+	c.WorkqBatchSize = e.integer("WORKQ_BATCH_SIZE", 1)
+	c.WorkqPollInterval = e.duration("WORKQ_POLL_INTERVAL", 1)
+	c.WorkqDisableListen = e.flag("WORKQ_DISABLE_LISTEN")
+	c.WorkqAccountQueues = e.flag("WORKQ_ACCOUNT_QUEUES")
+	c.WorkqMaxPerAccount = e.integer("WORKQ_MAX_PER_ACCOUNT", 0)
+
+	return e.err
+}
+
+// Validate checks that the settings the control server can't run without
+// are present and that they're consistent with each other. All of the
+// problems found are returned, not just the first.
+func (c *ControlConfig) Validate() error {
+	var result error
+
+	fail := func(format string, args ...interface{}) {
+		result = multierror.Append(result, fmt.Errorf(format, args...))
+	}
+
+	required := []struct {
+		name, value string
+	}{
+		{"DATABASE_URL", c.DatabaseURL},
+		{"HUB_DOMAIN", c.HubDomain},
+		{"ZONE_ID", c.ZoneID},
+		{"REGISTER_TOKEN", c.RegisterToken},
+		{"OPS_TOKEN", c.OpsToken},
+	}
+
+	for _, r := range required {
+		if r.value == "" {
+			fail("missing %s", r.name)
+		}
+	}
+
+	// Regions without a bucket of their own fall back to S3_BUCKET, so it
+	// can only be left out when the buckets are given by region.
+	if c.S3Bucket == "" && len(c.S3RegionBuckets) == 0 {
+		fail("missing S3_BUCKET")
+	}
+
+	if c.HubSubdomainCerts && strings.HasPrefix(c.HubDomain, "*.") {
+		fail("HUB_SUBDOMAIN_CERTS is set, but HUB_DOMAIN is a wildcard: %s", c.HubDomain)
+	}
+
+	if c.RequireRealTLS && c.LetsEncryptStaging {
+		fail("REQUIRE_REAL_TLS is set, but LETSENCRYPT_STAGING issues untrusted certificates")
+	}
+
+	// The contact for the ACME account, so that the CA's expiry notices
+	// reach someone.
+	if c.ACMEEmail != "" && !strings.Contains(c.ACMEEmail, "@") {
+		fail("invalid ACME_EMAIL: %s", c.ACMEEmail)
+	}
+
+	if c.ControlCert.Domain == "" && (c.ControlCert.KeyType != "" || c.ControlCert.RenewBefore != 0) {
+		fail("CONTROL_CERT_KEY_TYPE and CONTROL_CERT_RENEW_BEFORE require CONTROL_DOMAIN")
+	}
+
+	// The hubs are handed both halves of the AWS credentials, or neither.
+	if (c.HubAccessKey == "") != (c.HubSecretKey == "") {
+		fail("HUB_ACCESS_KEY and HUB_SECRET_KEY must be set together")
+	}
+
+	if c.HubSRVPort != 0 && c.HubSRVName == "" {
+		fail("HUB_SRV_PORT requires HUB_SRV_NAME")
+	}
+
+	if c.HubReconnectMaxBackoff != 0 && c.HubReconnectInitialBackoff > c.HubReconnectMaxBackoff {
+		fail("HUB_RECONNECT_INITIAL_BACKOFF must not exceed HUB_RECONNECT_MAX_BACKOFF")
+	}
+
+	// Callers on a unix socket have no address to check.
+	if _, ok := unixSocketPath(c.ListenAddr); ok && (len(c.MgmtAllowCIDRs) > 0 || len(c.MgmtDenyCIDRs) > 0) {
+		fail("MGMT_ALLOW_CIDRS and MGMT_DENY_CIDRS can't be used with a unix:// LISTEN_ADDR")
+	}
+
+	// PROXY headers are only read from the trusted proxies.
+	if c.ProxyProtocol && len(c.MgmtTrustedProxyCIDRs) == 0 {
+		fail("PROXY_PROTOCOL requires MGMT_TRUSTED_PROXY_CIDRS")
+	}
+
+	// Otherwise no agent could present a certificate the hubs accept.
+	if c.AgentRequireClientCert && c.AgentClientCAFile == "" {
+		fail("AGENT_REQUIRE_CLIENT_CERT requires AGENT_CLIENT_CA_FILE")
+	}
+
+	if c.LoadShedRPCs && c.LoadMaxJobBacklog == 0 && c.LoadMaxDBLatency == 0 && c.LoadMaxConns == 0 {
+		fail("LOAD_SHED_RPCS requires LOAD_MAX_JOB_BACKLOG, LOAD_MAX_DB_LATENCY or LOAD_MAX_CONNS")
+	}
+
+	// The shared signals no longer affect readiness, so they'd only be
+	// sampled.
+	if !c.LoadShedRPCs && (c.LoadMaxJobBacklog > 0 || c.LoadMaxDBLatency > 0) {
+		fail("LOAD_MAX_JOB_BACKLOG and LOAD_MAX_DB_LATENCY only take effect with LOAD_SHED_RPCS")
+	}
+
+	if c.WorkqMaxPerAccount > 0 && !c.WorkqAccountQueues {
+		fail("WORKQ_MAX_PER_ACCOUNT is set, but only applies with WORKQ_ACCOUNT_QUEUES")
+	}
+
+	if _, err := control.NewAssignmentStrategy(c.FlowAssignmentStrategy); err != nil {
+		fail("invalid FLOW_ASSIGNMENT_STRATEGY: %s", c.FlowAssignmentStrategy)
+	}
+
+	if err := c.validateListenAddr(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result
+}
+
+func (c *ControlConfig) validateListenAddr() error {
+	if path, ok := unixSocketPath(c.ListenAddr); ok {
+		return validateUnixSocketPath(path)
+	}
+
+	host, port, err := net.SplitHostPort(c.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("invalid LISTEN_ADDR %s: %s", c.ListenAddr, err)
+	}
+
+	if port == "" {
+		return fmt.Errorf("invalid LISTEN_ADDR %s: missing port (set PORT or LISTEN_ADDR)", c.ListenAddr)
+	}
+
+	if host != "" && net.ParseIP(host) == nil {
+		if _, err := net.LookupHost(host); err != nil {
+			return fmt.Errorf("invalid LISTEN_ADDR %s: %s", c.ListenAddr, err)
+		}
+	}
+
+	return nil
+}
+
+// The names vault keeps the token signing keys under, by key id.
+func (c *ControlConfig) verifyKeys() map[string]string {
+	keys := map[string]string{}
+
+	for _, id := range c.TokenVerifyKeyIDs {
+		keys[id] = "hzn-" + id
+	}
+
+	return keys
+}
+
+// Reads typed values from the environment, keeping the first error so that
+// a whole config can be read before checking it.
+type envReader struct {
+	getenv func(string) string
+	err    error
+}
+
+func (e *envReader) fail(name, value string) {
+	if e.err == nil {
+		e.err = errors.Errorf("invalid %s: %s", name, value)
+	}
+}
+
+func (e *envReader) flag(name string) bool {
+	return e.getenv(name) != ""
+}
+
+// The comma separated values of name, without surrounding whitespace or
+// empty entries.
+func (e *envReader) list(name string) []string {
+	var out []string
+
+	for _, part := range strings.Split(e.getenv(name), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+
+	return out
+}
+
+func (e *envReader) duration(name string, min time.Duration) time.Duration {
+	str := e.getenv(name)
+	if str == "" {
+		return 0
+	}
+
+	dur, err := time.ParseDuration(str)
+	if err != nil || dur < min {
+		e.fail(name, str)
+	}
+
+	return dur
+}
+
+func (e *envReader) integer(name string, min int) int {
+	str := e.getenv(name)
+	if str == "" {
+		return 0
+	}
+
+	n, err := strconv.Atoi(str)
+	if err != nil || n < min {
+		e.fail(name, str)
+	}
+
+	return n
+}
+
+func (e *envReader) int64(name string, min int64) int64 {
+	str := e.getenv(name)
+	if str == "" {
+		return 0
+	}
+
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil || n < min {
+		e.fail(name, str)
+	}
+
+	return n
+}
+
+func (e *envReader) keyType(name string) certcrypto.KeyType {
+	str := e.getenv(name)
+	if str == "" {
+		return ""
+	}
+
+	kt, err := tlsmanage.ParseKeyType(str)
+	if err != nil {
+		e.fail(name, str)
+	}
+
+	return kt
+}
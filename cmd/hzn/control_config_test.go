@@ -0,0 +1,261 @@
+package main
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/horizon/pkg/control"
+	grpcgzip "github.com/hashicorp/horizon/pkg/grpc/gzip"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControlConfig(t *testing.T) {
+	base := map[string]string{
+		"DATABASE_URL":   "postgres://localhost/hzn",
+		"S3_BUCKET":      "hzn",
+		"HUB_DOMAIN":     "*.hub.test",
+		"ZONE_ID":        "Z1",
+		"REGISTER_TOKEN": "reg",
+		"OPS_TOKEN":      "ops",
+		"PORT":           "24402",
+	}
+
+	read := func(t *testing.T, extra map[string]string) (*ControlConfig, error) {
+		env := make(map[string]string)
+
+		for k, v := range base {
+			env[k] = v
+		}
+
+		for k, v := range extra {
+			env[k] = v
+		}
+
+		var cfg ControlConfig
+
+		err := cfg.fromLookup(func(name string) string { return env[name] })
+
+		return &cfg, err
+	}
+
+	t.Run("fills in defaults", func(t *testing.T) {
+		cfg, err := read(t, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Validate())
+
+		assert.Equal(t, ":24402", cfg.ListenAddr)
+		assert.Equal(t, "k1", cfg.TokenKeyID)
+		assert.Equal(t, grpcgzip.DefaultMinSize, cfg.GzipMinSize)
+		assert.Equal(t, 24*time.Hour, cfg.OrphanCleanupInterval)
+		assert.Empty(t, cfg.verifyKeys())
+		assert.Equal(t, token.AlgorithmEd25519, cfg.TokenSigningAlgorithm)
+	})
+
+	t.Run("parses typed values", func(t *testing.T) {
+		cfg, err := read(t, map[string]string{
+			"LISTEN_ADDR":                   "127.0.0.1:8080",
+			"FLOW_IDLE_TIMEOUT":             "5m",
+			"SLOW_RPC_THRESHOLD":            "250ms",
+			"MAX_CONNS":                     "-1",
+			"QUOTA_WARNING_THRESHOLDS":      "95, 80",
+			"TOKEN_VERIFY_KEY_IDS":          "k0, ,k2",
+			"MGMT_ALLOW_CIDRS":              "10.0.0.0/8",
+			"AGENT_TLS_MIN_VERSION":         "1.3",
+			"TOKEN_ISSUE_RATE":              "-1",
+			"TOKEN_SIGNING_ALGORITHM":       "ecdsa-p256",
+			"LOAD_MAX_DB_LATENCY":           "500ms",
+			"LOAD_SHED_RPCS":                "1",
+			"ACTIVITY_SUMMARY":              "day",
+			"DATABASE_REPLICA_URL":          "postgres://replica/hzn",
+			"WORKQ_ACCOUNT_QUEUES":          "1",
+			"WORKQ_MAX_PER_ACCOUNT":         "2",
+			"FLOW_ASSIGNMENT_RETRIES":       "3",
+			"FLOW_ASSIGNMENT_RETRY_BACKOFF": "50ms",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Validate())
+
+		assert.Equal(t, "127.0.0.1:8080", cfg.ListenAddr)
+		assert.Equal(t, 5*time.Minute, cfg.FlowIdleTimeout)
+		assert.Equal(t, 250*time.Millisecond, cfg.SlowRPCThreshold)
+		assert.Equal(t, -1, cfg.MaxConns)
+		assert.Equal(t, []float64{0.8, 0.95}, cfg.QuotaWarningThresholds)
+		assert.Equal(t, map[string]string{"k0": "hzn-k0", "k2": "hzn-k2"}, cfg.verifyKeys())
+		assert.Len(t, cfg.MgmtAllowCIDRs, 1)
+		assert.Equal(t, uint16(tls.VersionTLS13), cfg.AgentTLSMinVersion)
+		assert.Equal(t, -1, cfg.TokenIssueRate)
+		assert.Equal(t, token.AlgorithmECDSAP256, cfg.TokenSigningAlgorithm)
+		assert.Equal(t, 500*time.Millisecond, cfg.LoadMaxDBLatency)
+		assert.True(t, cfg.LoadShedRPCs)
+		assert.Equal(t, control.SummarizeDaily, cfg.ActivitySummary)
+		assert.Equal(t, "postgres://replica/hzn", cfg.DatabaseReplicaURL)
+		assert.True(t, cfg.WorkqAccountQueues)
+		assert.Equal(t, 2, cfg.WorkqMaxPerAccount)
+		assert.Equal(t, 3, cfg.FlowAssignmentRetries)
+		assert.Equal(t, 50*time.Millisecond, cfg.FlowAssignmentRetryBackoff)
+	})
+
+	t.Run("rejects values that don't parse", func(t *testing.T) {
+		cases := map[string]string{
+			"MAX_CONNS":                     "0",
+			"STREAM_IDLE_TIMEOUT":           "-1s",
+			"FLOW_IDLE_TIMEOUT":             "3ns",
+			"CERT_RENEW_BEFORE":             "0s",
+			"HUB_CERT_KEY_TYPE":             "dsa",
+			"QUOTA_WARNING_THRESHOLDS":      "120",
+			"HUB_SRV_PORT":                  "70000",
+			"WORKQ_WORKERS":                 "0",
+			"AGENT_TLS_MIN_VERSION":         "1.4",
+			"LABEL_LINK_CYCLES":             "ignore",
+			"TOKEN_ISSUE_RATE":              "0",
+			"TOKEN_ISSUE_BURST":             "0",
+			"TOKEN_SIGNING_ALGORITHM":       "rsa",
+			"LOAD_MAX_JOB_BACKLOG":          "0",
+			"ACTIVITY_SUMMARY":              "week",
+			"FLOW_ASSIGNMENT_RETRIES":       "-1",
+			"FLOW_ASSIGNMENT_RETRY_BACKOFF": "0s",
+			"WORKQ_MAX_PER_ACCOUNT":         "-1",
+		}
+
+		for name, value := range cases {
+			_, err := read(t, map[string]string{name: value})
+			require.Error(t, err, name)
+
+			assert.Equal(t, "invalid "+name+": "+value, err.Error())
+		}
+	})
+
+	t.Run("reports every inconsistency", func(t *testing.T) {
+		cfg, err := read(t, map[string]string{
+			"OPS_TOKEN":                 "",
+			"REQUIRE_REAL_TLS":          "1",
+			"LETSENCRYPT_STAGING":       "1",
+			"HUB_ACCESS_KEY":            "AKIA",
+			"CONTROL_CERT_KEY_TYPE":     "P256",
+			"FLOW_ASSIGNMENT_STRATEGY":  "random",
+			"AGENT_REQUIRE_CLIENT_CERT": "1",
+			"LOAD_SHED_RPCS":            "1",
+			"HUB_SUBDOMAIN_CERTS":       "1",
+			"WORKQ_MAX_PER_ACCOUNT":     "3",
+		})
+		require.NoError(t, err)
+
+		err = cfg.Validate()
+		require.Error(t, err)
+
+		merr, ok := err.(*multierror.Error)
+		require.True(t, ok)
+
+		assert.Len(t, merr.Errors, 9)
+	})
+
+	t.Run("requires LOAD_SHED_RPCS for the shared load thresholds", func(t *testing.T) {
+		for _, name := range []string{"LOAD_MAX_JOB_BACKLOG", "LOAD_MAX_DB_LATENCY"} {
+			value := "100"
+			if name == "LOAD_MAX_DB_LATENCY" {
+				value = "1s"
+			}
+
+			cfg, err := read(t, map[string]string{name: value})
+			require.NoError(t, err)
+
+			assert.Error(t, cfg.Validate(), name)
+
+			cfg, err = read(t, map[string]string{name: value, "LOAD_SHED_RPCS": "1"})
+			require.NoError(t, err)
+
+			assert.NoError(t, cfg.Validate(), name)
+		}
+
+		cfg, err := read(t, map[string]string{"LOAD_MAX_CONNS": "100"})
+		require.NoError(t, err)
+
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("requires trusted proxies for PROXY_PROTOCOL", func(t *testing.T) {
+		cfg, err := read(t, map[string]string{"PROXY_PROTOCOL": "1"})
+		require.NoError(t, err)
+
+		assert.Error(t, cfg.Validate())
+
+		cfg, err = read(t, map[string]string{
+			"PROXY_PROTOCOL":           "1",
+			"MGMT_TRUSTED_PROXY_CIDRS": "10.0.0.0/8",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Validate())
+
+		assert.True(t, cfg.ProxyProtocol)
+	})
+
+	t.Run("requires a port to listen on", func(t *testing.T) {
+		cfg, err := read(t, map[string]string{"PORT": ""})
+		require.NoError(t, err)
+
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("accepts regional buckets in place of a default", func(t *testing.T) {
+		cfg, err := read(t, map[string]string{
+			"S3_BUCKET":         "",
+			"S3_REGION_BUCKETS": "us-east-1=hzn-use1,eu-west-1=hzn-euw1",
+			"CONTROL_REGION":    "eu-west-1",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Validate())
+
+		assert.Equal(t, "hzn-euw1", cfg.S3RegionBuckets[cfg.ControlRegion])
+
+		cfg.S3RegionBuckets = nil
+
+		assert.Error(t, cfg.Validate())
+
+		_, err = read(t, map[string]string{"S3_REGION_BUCKETS": "us-east-1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("accepts unix socket listen addresses", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		cfg, err := read(t, map[string]string{
+			"LISTEN_ADDR":        "unix://" + filepath.Join(dir, "control.sock"),
+			"LISTEN_SOCKET_MODE": "0600",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Validate())
+
+		assert.Equal(t, os.FileMode(0600), cfg.ListenSocketMode)
+
+		cfg, err = read(t, map[string]string{"LISTEN_ADDR": "unix://control.sock"})
+		require.NoError(t, err)
+
+		assert.Error(t, cfg.Validate())
+
+		cfg, err = read(t, map[string]string{
+			"LISTEN_ADDR":      "unix://" + filepath.Join(dir, "control.sock"),
+			"MGMT_ALLOW_CIDRS": "10.0.0.0/8",
+		})
+		require.NoError(t, err)
+
+		assert.Error(t, cfg.Validate())
+
+		_, err = read(t, map[string]string{"LISTEN_SOCKET_MODE": "999"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const unixListenPrefix = "unix://"
+
+// The default permissions of the control server's unix socket, letting
+// processes in the socket's group connect as well as its owner.
+const defaultListenSocketMode os.FileMode = 0660
+
+// The path of the socket addr names if it's a unix:// address.
+func unixSocketPath(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, unixListenPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(addr, unixListenPrefix), true
+}
+
+// Listen on addr, which is either a TCP address or a unix:// socket path.
+// A socket left behind by an earlier run is replaced, but any other file at
+// the path is left alone. The socket is given mode and is removed again
+// when the listener is closed.
+//
+// The socket is created in a private directory next to path and only moved
+// into place once it has mode, so there's no window in which it can be
+// connected to with the permissions the umask happened to give it.
+func listenControl(addr string, mode os.FileMode) (net.Listener, error) {
+	path, ok := unixSocketPath(addr)
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+
+		err = os.Remove(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dir, err := ioutil.TempDir(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	tmp := filepath.Join(dir, "sock")
+
+	ln, err := net.Listen("unix", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	ul := ln.(*net.UnixListener)
+
+	// Closing would otherwise unlink tmp rather than path.
+	ul.SetUnlinkOnClose(false)
+
+	err = os.Chmod(tmp, mode)
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+
+	if err != nil {
+		ul.Close()
+		return nil, err
+	}
+
+	return &unixListener{UnixListener: ul, path: path}, nil
+}
+
+// A unix socket listener that removes its socket once it's closed.
+type unixListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l *unixListener) Close() error {
+	err := l.UnixListener.Close()
+	os.Remove(l.path)
+	return err
+}
+
+func validateUnixSocketPath(path string) error {
+	if path == "" || !filepath.IsAbs(path) {
+		return fmt.Errorf("invalid LISTEN_ADDR %s%s: socket path must be absolute", unixListenPrefix, path)
+	}
+
+	fi, err := os.Stat(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("invalid LISTEN_ADDR %s%s: %s", unixListenPrefix, path, err)
+	}
+
+	if !fi.IsDir() {
+		return fmt.Errorf("invalid LISTEN_ADDR %s%s: %s is not a directory", unixListenPrefix, path, filepath.Dir(path))
+	}
+
+	return nil
+}
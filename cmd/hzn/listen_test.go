@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenControl(t *testing.T) {
+	t.Run("creates and removes a unix socket", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "control.sock")
+
+		// Left behind by a previous run.
+		stale, err := net.Listen("unix", path)
+		require.NoError(t, err)
+
+		stale.(*net.UnixListener).SetUnlinkOnClose(false)
+		stale.Close()
+
+		ln, err := listenControl("unix://"+path, 0600)
+		require.NoError(t, err)
+
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+
+		conn.Close()
+
+		ln.Close()
+
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+
+		// Nothing is left behind from creating the socket.
+		entries, err := ioutil.ReadDir(dir)
+		require.NoError(t, err)
+
+		assert.Empty(t, entries)
+	})
+
+	t.Run("leaves other files alone", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "control.sock")
+
+		err = ioutil.WriteFile(path, []byte("data"), 0644)
+		require.NoError(t, err)
+
+		_, err = listenControl("unix://"+path, 0600)
+		require.Error(t, err)
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, "data", string(data))
+	})
+}
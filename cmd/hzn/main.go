@@ -4,12 +4,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
-	"net/http/pprof"
 	"os"
 	"os/signal"
 	"strings"
@@ -19,7 +19,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	legodns "github.com/go-acme/lego/v3/providers/dns"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -27,20 +29,26 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/pkg/config"
 	"github.com/hashicorp/horizon/pkg/control"
+	"github.com/hashicorp/horizon/pkg/dbx"
 	"github.com/hashicorp/horizon/pkg/discovery"
+	grpcgzip "github.com/hashicorp/horizon/pkg/grpc/gzip"
 	"github.com/hashicorp/horizon/pkg/grpc/lz4"
 	grpctoken "github.com/hashicorp/horizon/pkg/grpc/token"
 	"github.com/hashicorp/horizon/pkg/hub"
 	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/hashicorp/horizon/pkg/periodic"
+	"github.com/hashicorp/horizon/pkg/secrets"
 	"github.com/hashicorp/horizon/pkg/tlsmanage"
 	"github.com/hashicorp/horizon/pkg/utils"
 	"github.com/hashicorp/horizon/pkg/workq"
 	"github.com/hashicorp/vault/api"
 	"github.com/jinzhu/gorm"
 	"github.com/mitchellh/cli"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -69,6 +77,36 @@ func main() {
 		"migrate": func() (cli.Command, error) {
 			return &migrateRunner{}, nil
 		},
+		"migrate down": func() (cli.Command, error) {
+			return &migrateDown{}, nil
+		},
+		"workq": func() (cli.Command, error) {
+			return &workqCommand{}, nil
+		},
+		"workq list": func() (cli.Command, error) {
+			return &workqList{}, nil
+		},
+		"workq list-dead": func() (cli.Command, error) {
+			return &workqList{dead: true}, nil
+		},
+		"workq show": func() (cli.Command, error) {
+			return &workqShow{}, nil
+		},
+		"workq retry": func() (cli.Command, error) {
+			return &workqRetry{}, nil
+		},
+		"workq delete": func() (cli.Command, error) {
+			return &workqDelete{}, nil
+		},
+		"workq cancel": func() (cli.Command, error) {
+			return &workqCancel{}, nil
+		},
+		"workq depths": func() (cli.Command, error) {
+			return &workqDepths{}, nil
+		},
+		"workq run": func() (cli.Command, error) {
+			return &workqRun{}, nil
+		},
 	}
 
 	fmt.Printf("hzn: %s\n", ver)
@@ -145,12 +183,8 @@ func StartHealthz(L hclog.Logger) {
 		w.WriteHeader(200)
 	})
 
-	mux.HandleFunc("/debug/pprof/", pprof.Index)
-	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
-	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
-	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
-
+	// No profiling here, this port is unauthenticated. The control server
+	// serves it behind the ops token, see ENABLE_PPROF and PPROF_ADDR.
 	http.ListenAndServe(":"+healthzPort, mux)
 }
 
@@ -179,160 +213,474 @@ func (c *controlServer) Run(args []string) int {
 	})
 
 	L.Info("log level configured", "level", level)
+
+	err := c.run(L)
+	if err != nil {
+		L.Error("error running control server", "error", err)
+		return 1
+	}
+
+	return 0
+}
+
+// Run the control server until it's been drained. Failures are returned
+// rather than exiting, so that the deferred cleanup always runs.
+func (c *controlServer) run(L hclog.Logger) error {
 	L.Trace("starting server")
 
-	vcfg := api.DefaultConfig()
+	// Each phase is logged and recorded as it ends, so that a slow start
+	// can be attributed to whichever dependency held it up.
+	st := control.NewStartupTimer(L)
+	st.Phase("config")
 
-	vc, err := api.NewClient(vcfg)
+	var cfg ControlConfig
+
+	err := cfg.FromEnv()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	// If we have token AND this is kubernetes, then let's try to get a token
-	if vc.Token() == "" {
-		f, err := os.Open("/var/run/secrets/kubernetes.io/serviceaccount/token")
-		if err == nil {
-			L.Info("attempting to login to vault via kubernetes auth")
+	err = cfg.Validate()
+	if err != nil {
+		return err
+	}
 
-			data, err := ioutil.ReadAll(f)
-			if err != nil {
-				log.Fatal(err)
-			}
+	// Outbound connections to vault and AWS may go through a proxy that
+	// presents certificates signed by its own CA.
+	var egressPool *x509.CertPool
 
-			f.Close()
-
-			sec, err := vc.Logical().Write("auth/kubernetes/login", map[string]interface{}{
-				"role": "horizon",
-				"jwt":  string(bytes.TrimSpace(data)),
-			})
-			if err != nil {
-				log.Fatal(err)
-			}
+	if cfg.EgressCAFile != "" {
+		pool, err := utils.LoadCertPool(cfg.EgressCAFile)
+		if err != nil {
+			return fmt.Errorf("invalid EGRESS_CA_FILE: %s", err)
+		}
 
-			if sec == nil {
-				log.Fatal("unable to login to get token")
-			}
+		egressPool = pool
+	}
 
-			vc.SetToken(sec.Auth.ClientToken)
+	// Only the vault secrets backend talks to vault. With the file backend
+	// signing keys and TLS material are kept locally, so no vault client is
+	// built and no kubernetes login is attempted.
+	var vc *api.Client
 
-			L.Info("retrieved token from vault", "accessor", sec.Auth.Accessor)
+	if cfg.SecretsBackend == "" || cfg.SecretsBackend == secrets.BackendVault {
+		st.Phase("vault")
 
-			go func() {
-				tic := time.NewTicker(time.Hour)
-				for {
-					<-tic.C
-					vc.Auth().Token().RenewSelf(86400)
-				}
-			}()
+		vc, err = newVaultClient(L, &cfg)
+		if err != nil {
+			return err
 		}
 	}
 
-	url := os.Getenv("DATABASE_URL")
-	if url == "" {
-		log.Fatal("no DATABASE_URL provided")
+	// Signing keys and TLS material live in vault unless SECRETS_BACKEND
+	// selects a local directory instead. Token signing goes through the
+	// backend's Sign, so the file backend needs no vault at all.
+	secretStore, err := secrets.New(cfg.SecretsBackend, vc, cfg.SecretsDir)
+	if err != nil {
+		return fmt.Errorf("invalid SECRETS_BACKEND: %s", err)
+	}
+
+	st.Phase("database")
+
+	url := cfg.DatabaseURL
+
+	if cfg.DBStatementTimeout != 0 {
+		url, err = dbx.WithStatementTimeout(url, cfg.DBStatementTimeout)
+		if err != nil {
+			return err
+		}
 	}
 
 	db, err := gorm.Open("postgres", url)
 	if err != nil {
-		log.Fatal(err)
+		return errors.Wrapf(err, "opening database")
 	}
 
-	sess := session.New()
+	defer db.Close()
 
-	bucket := os.Getenv("S3_BUCKET")
-	if bucket == "" {
-		log.Fatal("S3_BUCKET not set")
+	control.InstrumentDB(db)
+
+	// Read-only RPCs are served from the replica when one is configured,
+	// everything else, including the workq worker, stays on db.
+	var readDB *gorm.DB
+
+	if cfg.DatabaseReplicaURL != "" {
+		replicaURL := cfg.DatabaseReplicaURL
+
+		if cfg.DBStatementTimeout != 0 {
+			replicaURL, err = dbx.WithStatementTimeout(replicaURL, cfg.DBStatementTimeout)
+			if err != nil {
+				return err
+			}
+		}
+
+		readDB, err = gorm.Open("postgres", replicaURL)
+		if err != nil {
+			return errors.Wrapf(err, "opening replica database")
+		}
+
+		defer readDB.Close()
+
+		control.InstrumentDB(readDB)
 	}
 
-	domain := os.Getenv("HUB_DOMAIN")
-	if domain == "" {
-		log.Fatal("missing HUB_DOMAIN")
+	st.Phase("s3")
+
+	sess := session.New(aws.NewConfig().
+		WithHTTPClient(&http.Client{Transport: utils.EgressTransport(egressPool)}))
+
+	region := cfg.ControlRegion
+	if region == "" {
+		region = aws.StringValue(sess.Config.Region)
+	}
+
+	bucket, err := control.SelectBucket(cfg.S3RegionBuckets, region, cfg.S3Bucket)
+	if err != nil {
+		return err
+	}
+
+	// A regional bucket is in the control server's region, which needn't be
+	// the region the session was configured with. The default bucket is in
+	// the session's region.
+	if _, ok := cfg.S3RegionBuckets[region]; ok {
+		sess = sess.Copy(aws.NewConfig().WithRegion(region))
+	}
+
+	control.InstrumentAWSSession(sess)
+
+	L.Info("using s3 bucket", "bucket", bucket, "region", aws.StringValue(sess.Config.Region))
+
+	err = control.EnsureBucket(
+		hclog.WithContext(context.Background(), L),
+		sess, bucket, cfg.S3CreateBucket,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to use S3_BUCKET: %s", err)
+	}
+
+	st.Phase("acme-account")
+
+	domain := cfg.HubDomain
+
+	var acmeAccountKey []byte
+
+	if cfg.ACMEAccountKeyFile != "" {
+		acmeAccountKey, err = ioutil.ReadFile(cfg.ACMEAccountKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to read ACME_ACCOUNT_KEY_FILE: %s", err)
+		}
 	}
 
-	staging := os.Getenv("LETSENCRYPT_STAGING") != ""
+	// CONTROL_DOMAIN gives the control endpoint its own certificate, on a
+	// name outside of the wildcard hub domain.
+	controlCert := cfg.ControlCert
 
 	tlsmgr, err := tlsmanage.NewManager(tlsmanage.ManagerConfig{
 		L:           L,
 		Domain:      domain,
 		VaultClient: vc,
-		Staging:     staging,
+		Secrets:     secretStore,
+		Staging:     cfg.LetsEncryptStaging,
+		AccountKey:  acmeAccountKey,
+		AccountURL:  cfg.ACMEAccountURL,
+		Email:       cfg.ACMEEmail,
+		AcceptTOS:   cfg.ACMEAcceptTOS,
+		RenewBefore: cfg.CertRenewBefore,
+		KeyType:     cfg.HubCertKeyType,
+		Control:     controlCert,
 	})
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	zoneId := os.Getenv("ZONE_ID")
-	if zoneId == "" {
-		log.Fatal("missing ZONE_ID")
+	err = tlsmgr.ValidateAccount(hclog.WithContext(context.Background(), L))
+	if err != nil {
+		return err
 	}
 
+	zoneId := cfg.ZoneID
+
 	err = tlsmgr.SetupRoute53(sess, zoneId)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	regTok := os.Getenv("REGISTER_TOKEN")
-	if regTok == "" {
-		log.Fatal("missing REGISTER_TOKEN")
+	// A second provider, configured by lego's own environment variables,
+	// that's used if Route53 is unable to present the challenge.
+	if name := cfg.ACMEFallbackDNSProvider; name != "" {
+		prov, err := legodns.NewDNSChallengeProviderByName(name)
+		if err != nil {
+			return fmt.Errorf("invalid ACME_FALLBACK_DNS_PROVIDER: %s", err)
+		}
+
+		tlsmgr.SetFallbackDNSProvider(prov)
 	}
 
-	opsTok := os.Getenv("OPS_TOKEN")
-	if opsTok == "" {
-		log.Fatal("missing OPS_TOKEN")
+	if cfg.GzipCompression {
+		grpcgzip.Register(cfg.GzipMinSize)
 	}
 
-	asnDB := os.Getenv("ASN_DB_PATH")
+	eventSink, err := control.NewEventSink(cfg.EventSinkURL)
+	if err != nil {
+		return fmt.Errorf("invalid EVENT_SINK_URL: %s", err)
+	}
 
-	hubAccess := os.Getenv("HUB_ACCESS_KEY")
-	hubSecret := os.Getenv("HUB_SECRET_KEY")
-	hubTag := os.Getenv("HUB_IMAGE_TAG")
+	assignment, err := control.NewAssignmentStrategy(cfg.FlowAssignmentStrategy)
+	if err != nil {
+		return fmt.Errorf("invalid FLOW_ASSIGNMENT_STRATEGY: %s", cfg.FlowAssignmentStrategy)
+	}
 
-	port := os.Getenv("PORT")
+	var agentClientCA []byte
+
+	if cfg.AgentClientCAFile != "" {
+		agentClientCA, err = ioutil.ReadFile(cfg.AgentClientCAFile)
+		if err != nil {
+			return errors.Wrapf(err, "reading AGENT_CLIENT_CA_FILE")
+		}
+	}
+
+	listenAddr := cfg.ListenAddr
+	opsTok := cfg.OpsToken
 
 	go StartHealthz(L)
 
-	ctx := hclog.WithContext(context.Background(), L)
+	// Canceled on the way out, stopping the worker and the periodic tasks
+	// whether the server was drained or failed to start.
+	ctx, cancel := context.WithCancel(hclog.WithContext(context.Background(), L))
+	defer cancel()
+
+	// Issues the hub cert if there's none stored yet.
+	st.Phase("hub-tls")
 
 	cert, key, err := tlsmgr.HubMaterial(ctx)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
+	if cfg.RequireRealTLS {
+		err = tlsmanage.CheckRealCertificate(cert)
+		if err != nil {
+			return fmt.Errorf("REQUIRE_REAL_TLS is set, refusing to serve hub certificate: %s", err)
+		}
+	}
+
+	st.Phase("lock-manager")
+
 	lm, err := control.NewConsulLockManager(ctx)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
+	st.Phase("server")
+
 	s, err := control.NewServer(control.ServerConfig{
 		Logger: L,
 		DB:     db,
+		ReadDB: readDB,
 
-		RegisterToken: regTok,
-		OpsToken:      opsTok,
+		RegisterToken: cfg.RegisterToken,
+		OpsToken:      cfg.OpsToken,
 
 		VaultClient: vc,
-		VaultPath:   "hzn-k1",
-		KeyId:       "k1",
+		VaultPath:   "hzn-" + cfg.TokenKeyID,
+		KeyId:       cfg.TokenKeyID,
+		VerifyKeys:  cfg.verifyKeys(),
 
 		AwsSession: sess,
 		Bucket:     bucket,
 
-		ASNDB: asnDB,
+		ASNDB:       cfg.ASNDBPath,
+		ASNCacheTTL: cfg.ASNCacheTTL,
 
-		HubAccessKey: hubAccess,
-		HubSecretKey: hubSecret,
-		HubImageTag:  hubTag,
+		HubAccessKey: cfg.HubAccessKey,
+		HubSecretKey: cfg.HubSecretKey,
+		HubImageTag:  cfg.HubImageTag,
 		LockManager:  lm,
+
+		DataDogAddr:       cfg.DogStatsDAddr,
+		StatsDAddr:        cfg.StatsDAddr,
+		DisablePrometheus: cfg.DisablePrometheus,
+
+		MaxFlowsPerHub:    cfg.MaxFlowsPerHub,
+		HTTPGzip:          cfg.GzipCompression,
+		HTTPGzipMinSize:   cfg.GzipMinSize,
+		EnablePprof:       cfg.EnablePprof,
+		MaxStreamsPerPeer: cfg.MaxStreamsPerPeer,
+		StreamIdleTimeout: cfg.StreamIdleTimeout,
+		FlowIdleTimeout:   cfg.FlowIdleTimeout,
+		SlowRPCThreshold:  cfg.SlowRPCThreshold,
+
+		MaxLabelLinksPerAccount: cfg.MaxLabelLinksPerAccount,
+
+		WebhookURL:             cfg.WebhookURL,
+		AccountServiceQuota:    cfg.AccountServiceQuota,
+		QuotaWarningThresholds: cfg.QuotaWarningThresholds,
+		MaxRequestBodySize:     cfg.MaxRequestBodySize,
+		MaxConns:               cfg.MaxConns,
+
+		LoadMaxJobBacklog: cfg.LoadMaxJobBacklog,
+		LoadMaxDBLatency:  cfg.LoadMaxDBLatency,
+		LoadMaxConns:      cfg.LoadMaxConns,
+		LoadShedRPCs:      cfg.LoadShedRPCs,
+
+		HubSubdomainCerts:     cfg.HubSubdomainCerts,
+		HubSubdomainCertGrace: cfg.HubSubdomainCertGrace,
+
+		AccountJobQueues: cfg.WorkqAccountQueues,
+
+		ReconnectInitialBackoff: cfg.HubReconnectInitialBackoff,
+		ReconnectMaxBackoff:     cfg.HubReconnectMaxBackoff,
+		ReconnectJitter:         cfg.HubReconnectJitter,
+
+		RequireHubCredentials: cfg.RequireHubCredentials,
+		MinHubProtocolVersion: cfg.MinHubProtocolVersion,
+		AssignmentStrategy:    assignment,
+		HubAffinityAccounts:   cfg.HubAffinityAccounts,
+
+		AssignmentRetries:      cfg.FlowAssignmentRetries,
+		AssignmentRetryBackoff: cfg.FlowAssignmentRetryBackoff,
+
+		AgentTLSMinVersion:     cfg.AgentTLSMinVersion,
+		AgentRequireClientCert: cfg.AgentRequireClientCert,
+		AgentClientCA:          agentClientCA,
+
+		MaxTokenTTL:        cfg.MaxTokenTTL,
+		RejectLongTokenTTL: cfg.RejectLongTokenTTL,
+		TokenIssueRate:     cfg.TokenIssueRate,
+		TokenIssueBurst:    cfg.TokenIssueBurst,
+
+		TokenSigningAlgorithm: cfg.TokenSigningAlgorithm,
+
+		EventSink:   eventSink,
+		DrainWindow: cfg.DrainWindow,
+
+		MgmtAllowCIDRs:        cfg.MgmtAllowCIDRs,
+		MgmtDenyCIDRs:         cfg.MgmtDenyCIDRs,
+		MgmtTrustedProxyCIDRs: cfg.MgmtTrustedProxyCIDRs,
+		ProxyProtocol:         cfg.ProxyProtocol,
+
+		FlowRollups:       cfg.FlowRollups,
+		OrphanGracePeriod: cfg.OrphanGracePeriod,
+
+		Secrets: secretStore,
+
+		RequestIDHeader: cfg.RequestIDHeader,
+		UnknownFields:   cfg.UnknownFields,
+		LabelLinkCycles: cfg.LabelLinkCycles,
+
+		MaintenanceMode:   cfg.MaintenanceMode,
+		MaintenanceReason: cfg.MaintenanceReason,
 	})
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+
+	// Settings in the config file override the environment, and are re-read
+	// on SIGHUP so they can be tuned without a restart.
+	if path := cfg.ConfigFile; path != "" {
+		cf, err := control.LoadConfigFile(path)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.Reload(cf)
+		if err != nil {
+			return fmt.Errorf("invalid CONFIG_FILE: %s", err)
+		}
+
+		hups := make(chan os.Signal, 1)
+		signal.Notify(hups, syscall.SIGHUP)
+
+		go func() {
+			for range hups {
+				L.Info("reloading configuration", "path", path)
+
+				cf, err := control.LoadConfigFile(path)
+				if err != nil {
+					L.Error("error reading config file, keeping current configuration", "error", err)
+					continue
+				}
+
+				changed, err := s.Reload(cf)
+				if err != nil {
+					L.Error("error applying config file, keeping current configuration", "error", err)
+					continue
+				}
+
+				L.Info("configuration reloaded", "changed", len(changed))
+			}
+		}()
+	}
+
+	// Cert refresh and token signing both need vault, so report not ready
+	// when it's unusable.
+	if cfg.SecretsBackend == "" || cfg.SecretsBackend == secrets.BackendVault {
+		s.AddReadinessCheck("vault", control.VaultReadinessCheck(vc, 0))
+	}
+
+	// Surfaces a broken ACME, DNS provider or material store before the
+	// next renewal fails. As it makes every control server unready at once
+	// during an ACME outage, it can be turned off.
+	if !cfg.DisableTLSReadiness {
+		s.AddReadinessCheck("tls", tlsmgr.HealthCheck(0))
+	}
+
+	// Sheds traffic while overloaded, rather than letting a slow database
+	// or a deep job backlog drag every request down with it.
+	if s.LoadSheddingEnabled() {
+		go s.MonitorLoad(ctx)
+		s.AddReadinessCheck("load", s.LoadReadinessCheck())
+	}
+
+	// Profiling can also be served on a separate, presumably private, address.
+	if pprofAddr := cfg.PprofAddr; pprofAddr != "" {
+		L.Info("starting pprof server", "addr", pprofAddr)
+
+		go func() {
+			err := http.ListenAndServe(pprofAddr, control.PprofHandler(opsTok))
+			if err != nil {
+				L.Error("error running pprof server", "error", err)
+			}
+		}()
 	}
 
 	// Setup cleanup activities
-	lc := &control.LogCleaner{DB: config.DB()}
+	lc := &control.LogCleaner{
+		DB:               config.DB(),
+		Summarize:        cfg.ActivitySummary,
+		KeyEvents:        cfg.ActivitySummaryKeyEvents,
+		SummaryRetention: cfg.ActivitySummaryRetention,
+	}
+
 	workq.RegisterHandler("cleanup-activity-log", lc.CleanupActivityLog)
 	workq.RegisterPeriodicJob("cleanup-activity-log", "default", "cleanup-activity-log", nil, time.Hour)
 
+	rc := &control.FlowRollupCleaner{
+		DB:        config.DB(),
+		Retention: cfg.FlowRollupRetention,
+	}
+
+	workq.RegisterHandler("cleanup-flow-rollups", rc.CleanupFlowRollups)
+	workq.RegisterPeriodicJob("cleanup-flow-rollups", "default", "cleanup-flow-rollups", nil, time.Hour)
+
+	if cfg.FlowRollups {
+		go s.RunFlowRollups(ctx)
+	}
+
+	oc := &control.OrphanCleaner{
+		DB:      config.DB(),
+		Session: sess,
+		Bucket:  bucket,
+		DryRun:  cfg.OrphanCleanupDryRun,
+
+		GracePeriod: cfg.OrphanGracePeriod,
+	}
+
+	workq.RegisterHandler("cleanup-orphaned-objects", oc.CleanupOrphanedObjects)
+	workq.RegisterPeriodicJob("cleanup-orphaned-objects", "default", "cleanup-orphaned-objects", nil, cfg.OrphanCleanupInterval)
+
+	workq.RegisterHandler(control.PublishEventJob, s.PublishEvent)
+
 	hubDomain := domain
 	if strings.HasPrefix(hubDomain, "*.") {
 		hubDomain = hubDomain[2:]
@@ -340,38 +688,152 @@ func (c *controlServer) Run(args []string) int {
 
 	s.SetHubTLS(cert, key, hubDomain)
 
+	st.Phase("control-tls")
+
+	if controlCert.Domain != "" {
+		ccert, ckey, err := tlsmgr.ControlMaterial(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cfg.RequireRealTLS {
+			err = tlsmanage.CheckRealCertificate(ccert)
+			if err != nil {
+				return fmt.Errorf("REQUIRE_REAL_TLS is set, refusing to serve control certificate: %s", err)
+			}
+		}
+
+		s.SetHubTLS(ccert, ckey, controlCert.Domain)
+	}
+
+	// Where wildcard certs are forbidden, each hub is given a cert naming its
+	// own subdomain. They're issued by the reconcile job as hubs register and
+	// removed once they're gone, and every instance picks up the stored set.
+	if cfg.HubSubdomainCerts {
+		tlsmgr.SetSubdomainSource(s.HubSubdomains)
+
+		refreshSubdomains := func() {
+			stored, err := tlsmgr.FetchSubdomainsFromVault()
+			if err != nil {
+				L.Error("error refreshing subdomain certs from vault", "error", err)
+				return
+			}
+
+			material := make(map[string]control.TLSMaterial, len(stored))
+
+			for _, sm := range stored {
+				if cfg.RequireRealTLS {
+					if err := tlsmanage.CheckRealCertificate(sm.Certificate); err != nil {
+						L.Error("REQUIRE_REAL_TLS is set, ignoring subdomain certificate", "name", sm.Name, "error", err)
+						continue
+					}
+				}
+
+				material[sm.Name] = control.TLSMaterial{Cert: sm.Certificate, Key: sm.Key}
+			}
+
+			s.SetSubdomainTLS(material)
+		}
+
+		refreshSubdomains()
+
+		go periodic.Run(ctx, tlsmanage.SubdomainCertPeriod, refreshSubdomains)
+	}
+
+	// Optionally advertise the hubs via an SRV record so clients can balance
+	// across them.
+	if cfg.HubSRVName != "" {
+		srv := &control.SRVPublisher{
+			Server:  s,
+			Route53: route53.New(sess),
+			ZoneID:  zoneId,
+			Name:    cfg.HubSRVName,
+			Port:    cfg.HubSRVPort,
+		}
+
+		workq.RegisterHandler("reconcile-hub-srv", srv.ReconcileHubSRV)
+		workq.RegisterPeriodicJob("reconcile-hub-srv", "default", "reconcile-hub-srv", nil, time.Minute)
+	}
+
 	// So that when they are refreshed by the background job, we eventually pick
 	// them up. Hubs are also refreshing their config on an hourly basis so they'll
 	// end up picking up the new TLS material that way too.
 	go periodic.Run(ctx, time.Hour, func() {
-		cert, key, err := tlsmgr.RefreshFromVault()
+		cert, key, err := tlsmgr.RefreshFromVaultWithBackoff(ctx)
 		if err != nil {
-			L.Error("error refreshing hub certs from vault")
-		} else {
-			s.SetHubTLS(cert, key, hubDomain)
+			return
 		}
+
+		if cfg.RequireRealTLS {
+			if err := tlsmanage.CheckRealCertificate(cert); err != nil {
+				L.Error("REQUIRE_REAL_TLS is set, ignoring refreshed hub certificate", "error", err)
+				return
+			}
+		}
+
+		s.SetHubTLS(cert, key, hubDomain)
 	})
 
-	gs := grpc.NewServer()
+	if controlCert.Domain != "" {
+		go periodic.Run(ctx, time.Hour, func() {
+			cert, key, err := tlsmgr.RefreshControlFromVault()
+			if err != nil {
+				L.Error("error refreshing control cert from vault", "error", err)
+				return
+			}
+
+			if cfg.RequireRealTLS {
+				if err := tlsmanage.CheckRealCertificate(cert); err != nil {
+					L.Error("REQUIRE_REAL_TLS is set, ignoring refreshed control certificate", "error", err)
+					return
+				}
+			}
+
+			s.SetHubTLS(cert, key, controlCert.Domain)
+		})
+	}
+
+	gs := grpc.NewServer(
+		grpc.StatsHandler(s.UnknownFieldsStatsHandler()),
+		grpc.ChainUnaryInterceptor(
+			s.UnaryRequestIDInterceptor,
+			s.UnarySlowRPCInterceptor,
+			s.UnaryUnknownFieldsInterceptor,
+			s.UnaryMgmtACLInterceptor,
+			s.UnaryAuthInterceptor,
+			s.UnaryMaintenanceModeInterceptor,
+			s.UnaryLoadSheddingInterceptor,
+			control.UnaryDBErrorInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			s.StreamRequestIDInterceptor,
+			s.StreamUnknownFieldsInterceptor,
+			s.StreamMgmtACLInterceptor,
+			s.StreamAuthInterceptor,
+			s.StreamLimitInterceptor,
+			control.StreamDBErrorInterceptor,
+		),
+	)
 	pb.RegisterControlServicesServer(gs, s)
 	pb.RegisterControlManagementServer(gs, s)
 	pb.RegisterFlowTopReporterServer(gs, s)
 
-	tlsCert, err := tlsmgr.Certificate()
-	if err != nil {
-		log.Fatal(err)
-	}
-
+	// Certificates are selected by SNI from the material registered via
+	// SetHubTLS, so refreshed certs are picked up without a restart.
 	var lcfg tls.Config
-	lcfg.Certificates = []tls.Certificate{tlsCert}
+	lcfg.GetCertificate = s.GetCertificate
+	lcfg.NextProtos = control.NextProtos
+
+	if len(cfg.ALPNProtocols) > 0 {
+		lcfg.NextProtos = cfg.ALPNProtocols
+	}
 
 	hs := &http.Server{
 		TLSConfig:   &lcfg,
-		Addr:        ":" + port,
+		Addr:        listenAddr,
 		IdleTimeout: 2 * time.Minute,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.ProtoMajor == 2 &&
-				strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			if control.IsGRPCRequest(r) {
 				gs.ServeHTTP(w, r)
 			} else {
 				s.ServeHTTP(w, r)
@@ -382,7 +844,9 @@ func (c *controlServer) Run(args []string) int {
 		}),
 	}
 
-	tlsmgr.RegisterRenewHandler(L, workq.GlobalRegistry)
+	st.Phase("worker")
+
+	tlsmgr.RegisterRenewHandler(workq.GlobalRegistry)
 
 	L.Info("starting background worker")
 
@@ -391,9 +855,26 @@ func (c *controlServer) Run(args []string) int {
 	wl := L.Named("workq")
 
 	worker := workq.NewWorker(wl, db, []string{"default"})
+	worker.AccountQueues = cfg.WorkqAccountQueues
+	worker.MaxPerAccount = cfg.WorkqMaxPerAccount
+
+	workerDone := make(chan struct{})
+
+	// Let the worker stop before the database is closed.
+	defer func() {
+		cancel()
+		<-workerDone
+	}()
+
 	go func() {
+		defer close(workerDone)
+
 		err := worker.Run(ctx, workq.RunConfig{
-			ConnInfo: url,
+			ConnInfo:      url,
+			Concurrency:   cfg.WorkqWorkers,
+			BatchSize:     cfg.WorkqBatchSize,
+			PopInterval:   cfg.WorkqPollInterval,
+			DisableListen: cfg.WorkqDisableListen,
 		})
 		if err != nil {
 			if err != context.Canceled {
@@ -402,12 +883,123 @@ func (c *controlServer) Run(args []string) int {
 		}
 	}()
 
-	err = hs.ListenAndServeTLS("", "")
+	// On shutdown, give the hubs the drain window to move their activity
+	// streams to another control server before the connections are cut.
+	drained := make(chan struct{})
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-sigs
+		L.Info("signal received, draining", "signal", sig)
+
+		err := s.Drain(ctx, hs)
+		if err != nil {
+			L.Error("error draining server", "error", err)
+		}
+
+		close(drained)
+	}()
+
+	st.Phase("listen")
+
+	ln, err := listenControl(listenAddr, cfg.ListenSocketMode)
 	if err != nil {
-		log.Fatal(err)
+		return errors.Wrapf(err, "listening on %s", listenAddr)
 	}
 
-	return 0
+	st.Ready()
+
+	// Access to a unix socket is controlled by its permissions and it
+	// doesn't leave the host, so it's served without TLS. gRPC then needs
+	// HTTP/2 without TLS.
+	if _, ok := unixSocketPath(listenAddr); ok {
+		hs.Handler = h2c.NewHandler(hs.Handler, &http2.Server{})
+
+		L.Info("serving on unix socket without tls", "addr", listenAddr)
+
+		err = hs.Serve(s.LimitListener(ln))
+	} else {
+		err = hs.ServeTLS(s.LimitListener(s.ProxyProtocolListener(ln)), "", "")
+	}
+
+	if err != nil && err != http.ErrServerClosed {
+		return errors.Wrapf(err, "serving on %s", listenAddr)
+	}
+
+	<-drained
+
+	return nil
+}
+
+// Build the vault client used by the vault secrets backend, logging in
+// with the pod's service account token when running in kubernetes without
+// a vault token.
+func newVaultClient(L hclog.Logger, cfg *ControlConfig) (*api.Client, error) {
+	vcfg := api.DefaultConfig()
+
+	// Added to the roots vault's own environment configures, if any,
+	// rather than replacing them.
+	if cfg.EgressCAFile != "" {
+		tr, ok := vcfg.HttpClient.Transport.(*http.Transport)
+		if !ok || tr.TLSClientConfig == nil {
+			return nil, errors.New("unable to configure EGRESS_CA_FILE for the vault client")
+		}
+
+		err := utils.TrustCAFile(tr.TLSClientConfig, cfg.EgressCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EGRESS_CA_FILE: %s", err)
+		}
+	}
+
+	vcfg.HttpClient.Transport = control.InstrumentTransport("vault", vcfg.HttpClient.Transport)
+
+	vc, err := api.NewClient(vcfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating vault client")
+	}
+
+	// If we have token AND this is kubernetes, then let's try to get a token
+	if vc.Token() == "" {
+		f, err := os.Open("/var/run/secrets/kubernetes.io/serviceaccount/token")
+		if err == nil {
+			L.Info("attempting to login to vault via kubernetes auth")
+
+			data, err := ioutil.ReadAll(f)
+			if err != nil {
+				return nil, err
+			}
+
+			f.Close()
+
+			sec, err := vc.Logical().Write("auth/kubernetes/login", map[string]interface{}{
+				"role": "horizon",
+				"jwt":  string(bytes.TrimSpace(data)),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if sec == nil {
+				return nil, errors.New("unable to login to get token")
+			}
+
+			vc.SetToken(sec.Auth.ClientToken)
+
+			L.Info("retrieved token from vault", "accessor", sec.Auth.Accessor)
+
+			go func() {
+				tic := time.NewTicker(time.Hour)
+				for {
+					<-tic.C
+					vc.Auth().Token().RenewSelf(86400)
+				}
+			}()
+		}
+	}
+
+	return vc, nil
 }
 
 type hubRunner struct{}
@@ -504,6 +1096,20 @@ func (h *hubRunner) Run(args []string) int {
 
 	var filter func(serv *pb.ServiceRoute) bool
 
+	// Operators enable features on a hub by advertising them, so that
+	// control only sends agents needing them to upgraded hubs.
+	var capabilities []string
+
+	for _, c := range strings.Split(os.Getenv("HUB_CAPABILITIES"), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			capabilities = append(capabilities, c)
+		}
+	}
+
+	// Resolving a link's target through further links is opt-in, since
+	// hostnames linked directly to a service are otherwise resolved as is.
+	followLinks := os.Getenv("HUB_FOLLOW_LABEL_LINKS") != ""
+
 	status := cc.Status()
 	leader, err := status.Leader()
 	if err == nil {
@@ -534,6 +1140,9 @@ func (h *hubRunner) Run(args []string) int {
 		WorkDir:      tmpdir,
 		K8Deployment: deployment,
 		FilterRoute:  filter,
+		Capabilities: capabilities,
+
+		FollowLabelLinks: followLinks,
 	})
 
 	if deployment != "" {
@@ -575,6 +1184,13 @@ func (h *hubRunner) Run(args []string) int {
 
 	go func() {
 		err := client.Run(ctx)
+
+		// Reconnecting won't change control's mind, the hub has to be
+		// upgraded.
+		if errors.Cause(err) == control.ErrProtocolVersionRefused {
+			log.Fatal(err)
+		}
+
 		if err != nil {
 			L.Error("error running control client background tasks", "error", err)
 		}
@@ -722,6 +1338,10 @@ func (c *devServer) Run(args []string) int {
 		hubDomain = hubDomain[2:]
 	}
 
+	if os.Getenv("REQUIRE_REAL_TLS") != "" {
+		log.Fatal("REQUIRE_REAL_TLS is set, but the dev server only serves a self-signed certificate")
+	}
+
 	cert, key, err := utils.SelfSignedCert()
 	if err != nil {
 		log.Fatal(err)
@@ -746,8 +1366,7 @@ func (c *devServer) Run(args []string) int {
 	hs := &http.Server{
 		Addr: ":24402",
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.ProtoMajor == 2 &&
-				strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			if control.IsGRPCRequest(r) {
 				gs.ServeHTTP(w, r)
 			} else {
 				if r.URL.Path == discovery.HTTPPath {
@@ -917,6 +1536,13 @@ func (h *devServer) RunHub(ctx context.Context, token, addr string, sess *sessio
 
 	go func() {
 		err := client.Run(ctx)
+
+		// Reconnecting won't change control's mind, the hub has to be
+		// upgraded.
+		if errors.Cause(err) == control.ErrProtocolVersionRefused {
+			log.Fatal(err)
+		}
+
 		if err != nil {
 			L.Error("error running control client background tasks", "error", err)
 		}
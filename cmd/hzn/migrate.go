@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/spf13/pflag"
+)
+
+type migrateDown struct{}
+
+func (m *migrateDown) Help() string {
+	return `Roll back the last N migrations
+
+Usage: hzn migrate down [--force] <N>
+
+Rollbacks that drop tables, columns or types, or delete rows, lose data
+and are refused unless --force is given. Run the migrations again with
+hzn migrate to redeploy.`
+}
+
+func (m *migrateDown) Synopsis() string {
+	return "Roll back the last N migrations"
+}
+
+func (m *migrateDown) Run(args []string) int {
+	fs := pflag.NewFlagSet("migrate", pflag.ExitOnError)
+
+	force := fs.Bool("force", false, "run rollbacks that lose data")
+
+	err := fs.Parse(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if fs.NArg() != 1 {
+		log.Fatal("the number of migrations to roll back must be provided")
+	}
+
+	n, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || n < 1 {
+		log.Fatalf("invalid number of migrations: %s", fs.Arg(0))
+	}
+
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		log.Fatal("no DATABASE_URL provided")
+	}
+
+	migPath := os.Getenv("MIGRATIONS_PATH")
+	if migPath == "" {
+		migPath = "/migrations"
+	}
+
+	mig, err := migrate.New("file://"+migPath, url)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	current, dirty, err := mig.Version()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if dirty {
+		log.Fatalf("database is dirty at version %d, fix it before rolling back", current)
+	}
+
+	downs, err := dbx.DownMigrations(migPath, current, n)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var destructive bool
+
+	for _, d := range downs {
+		stmts, err := d.Destructive()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, stmt := range stmts {
+			fmt.Printf("version %d: %s\n", d.Version, stmt)
+			destructive = true
+		}
+	}
+
+	if destructive && !*force {
+		fmt.Println("refusing to run rollbacks that lose data without --force")
+		return 1
+	}
+
+	err = mig.Steps(-n)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("rolled back %d migrations from version %d\n", n, current)
+	return 0
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/control"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/jinzhu/gorm"
+	"github.com/mitchellh/cli"
+	"github.com/spf13/pflag"
+)
+
+func workqDB() *gorm.DB {
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		log.Fatal("no DATABASE_URL provided")
+	}
+
+	db, err := gorm.Open("postgres", url)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return db
+}
+
+func parseJobId(args []string) []byte {
+	if len(args) != 1 {
+		log.Fatal("a single job id must be provided")
+	}
+
+	id, err := pb.ParseULID(args[0])
+	if err != nil {
+		log.Fatalf("invalid job id %s: %s", args[0], err)
+	}
+
+	return id.Bytes()
+}
+
+func printJobs(jobs []*workq.Job) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintln(tw, "ID\tQUEUE\tTYPE\tSTATUS\tATTEMPTS\tCREATED\tREASON")
+
+	for _, j := range jobs {
+		// Why a dead job is dead.
+		var reason string
+
+		switch {
+		case j.AbandonReason != nil:
+			reason = *j.AbandonReason
+		case j.CancelReason != nil:
+			reason = *j.CancelReason
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			pb.ULIDFromBytes(j.Id).SpecString(),
+			j.Queue, j.JobType, j.Status, j.Attempts,
+			j.CreatedAt.Format(time.RFC3339),
+			reason,
+		)
+	}
+}
+
+type workqCommand struct{}
+
+func (w *workqCommand) Help() string {
+	return "Inspect and manage the jobs in the work queue"
+}
+
+func (w *workqCommand) Synopsis() string {
+	return "Inspect and manage the jobs in the work queue"
+}
+
+func (w *workqCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+type workqList struct {
+	// Only list dead jobs, those that exhausted their attempts.
+	dead bool
+}
+
+func (w *workqList) Help() string {
+	if w.dead {
+		return "List the jobs that have exhausted their attempts"
+	}
+
+	return "List the jobs in the work queue"
+}
+
+func (w *workqList) Synopsis() string {
+	return w.Help()
+}
+
+func (w *workqList) Run(args []string) int {
+	fs := pflag.NewFlagSet("workq", pflag.ExitOnError)
+
+	queue := fs.String("queue", "", "only list jobs in this queue")
+	jobType := fs.String("handler", "", "only list jobs of this job type")
+	limit := fs.Int("limit", workq.DefaultListLimit, "maximum number of jobs to list")
+
+	var state *string
+	if !w.dead {
+		state = fs.String("state", "", "only list jobs in this state (queued, finished, dead)")
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	filter := workq.JobFilter{
+		Queue:   *queue,
+		JobType: *jobType,
+		Limit:   *limit,
+	}
+
+	if w.dead {
+		filter.Status = "dead"
+	} else {
+		filter.Status = *state
+	}
+
+	db := workqDB()
+	defer db.Close()
+
+	jobs, err := workq.ListJobs(db, filter)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	printJobs(jobs)
+	return 0
+}
+
+type workqShow struct{}
+
+func (w *workqShow) Help() string {
+	return "Show the details of a job, including its payload"
+}
+
+func (w *workqShow) Synopsis() string {
+	return "Show the details of a job"
+}
+
+func (w *workqShow) Run(args []string) int {
+	id := parseJobId(args)
+
+	db := workqDB()
+	defer db.Close()
+
+	job, err := workq.GetJob(db, id)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("id:         %s\n", pb.ULIDFromBytes(job.Id).SpecString())
+	fmt.Printf("queue:      %s\n", job.Queue)
+	fmt.Printf("job-type:   %s\n", job.JobType)
+	fmt.Printf("status:     %s\n", job.Status)
+	fmt.Printf("attempts:   %d\n", job.Attempts)
+	fmt.Printf("created-at: %s\n", job.CreatedAt.Format(time.RFC3339))
+
+	if job.CoolOffUntil != nil {
+		fmt.Printf("cool-off:   %s\n", job.CoolOffUntil.Format(time.RFC3339))
+	}
+
+	fmt.Printf("payload:    %s\n", job.Payload)
+	return 0
+}
+
+type workqRetry struct{}
+
+func (w *workqRetry) Help() string {
+	return "Requeue a job, resetting its attempts and cool off"
+}
+
+func (w *workqRetry) Synopsis() string {
+	return "Requeue a job"
+}
+
+func (w *workqRetry) Run(args []string) int {
+	id := parseJobId(args)
+
+	db := workqDB()
+	defer db.Close()
+
+	err := workq.RetryJob(db, id)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("requeued job %s\n", args[0])
+	return 0
+}
+
+type workqDelete struct{}
+
+func (w *workqDelete) Help() string {
+	return "Delete a job from the work queue"
+}
+
+func (w *workqDelete) Synopsis() string {
+	return "Delete a job"
+}
+
+func (w *workqDelete) Run(args []string) int {
+	id := parseJobId(args)
+
+	db := workqDB()
+	defer db.Close()
+
+	err := workq.DeleteJob(db, id)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("deleted job %s\n", args[0])
+	return 0
+}
+
+type workqCancel struct{}
+
+func (w *workqCancel) Help() string {
+	return "Cancel a job that is still waiting to run"
+}
+
+func (w *workqCancel) Synopsis() string {
+	return "Cancel a pending job"
+}
+
+func (w *workqCancel) Run(args []string) int {
+	id := parseJobId(args)
+
+	db := workqDB()
+	defer db.Close()
+
+	ok, err := workq.CancelJob(db, id)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !ok {
+		fmt.Printf("job %s is not pending, unable to cancel\n", args[0])
+		return 1
+	}
+
+	fmt.Printf("canceled job %s\n", args[0])
+	return 0
+}
+
+type workqDepths struct{}
+
+func (w *workqDepths) Help() string {
+	return "List the number of jobs ready to run in each queue, including every per-account queue"
+}
+
+func (w *workqDepths) Synopsis() string {
+	return "List the depth of each queue"
+}
+
+func (w *workqDepths) Run(args []string) int {
+	db := workqDB()
+	defer db.Close()
+
+	depths, err := workq.QueueDepths(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var queues []string
+	for queue := range depths {
+		queues = append(queues, queue)
+	}
+
+	sort.Strings(queues)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintln(tw, "QUEUE\tREADY")
+
+	for _, queue := range queues {
+		fmt.Fprintf(tw, "%s\t%d\n", queue, depths[queue])
+	}
+
+	return 0
+}
+
+type workqRun struct{}
+
+func (w *workqRun) Help() string {
+	return `Run a job handler once, waiting for it to finish
+
+Usage: hzn workq run <job-type> [json-payload]
+
+Only handlers that can be set up from the environment are available:
+cleanup-activity-log and cleanup-flow-rollups need DATABASE_URL, and
+cleanup-orphaned-objects additionally needs S3_BUCKET and AWS credentials.
+cleanup-activity-log summarizes the entries it prunes according to
+ACTIVITY_SUMMARY, ACTIVITY_SUMMARY_KEY_EVENTS and ACTIVITY_SUMMARY_RETENTION,
+which are read and checked as for the control server.`
+}
+
+func (w *workqRun) Synopsis() string {
+	return "Run a job handler once"
+}
+
+func (w *workqRun) Run(args []string) int {
+	fs := pflag.NewFlagSet("workq", pflag.ExitOnError)
+
+	dryRun := fs.Bool("dry-run", false, "for cleanup-orphaned-objects, only log what would be deleted")
+
+	err := fs.Parse(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	args = fs.Args()
+
+	if len(args) < 1 || len(args) > 2 {
+		log.Fatal("a job type and optional json payload must be provided")
+	}
+
+	var payload interface{}
+
+	if len(args) == 2 {
+		err = json.Unmarshal([]byte(args[1]), &payload)
+		if err != nil {
+			log.Fatalf("invalid payload: %s", err)
+		}
+	}
+
+	// Read the settings the way the control server does, so a job run by
+	// hand behaves as it would there.
+	var cfg ControlConfig
+
+	err = cfg.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db := workqDB()
+	defer db.Close()
+
+	var r workq.Registry
+
+	lc := &control.LogCleaner{
+		DB:               db,
+		Summarize:        cfg.ActivitySummary,
+		KeyEvents:        cfg.ActivitySummaryKeyEvents,
+		SummaryRetention: cfg.ActivitySummaryRetention,
+	}
+
+	r.Register("cleanup-activity-log", lc.CleanupActivityLog)
+
+	rc := &control.FlowRollupCleaner{DB: db}
+	r.Register("cleanup-flow-rollups", rc.CleanupFlowRollups)
+
+	if cfg.S3Bucket != "" {
+		oc := &control.OrphanCleaner{
+			DB:      db,
+			Session: session.New(),
+			Bucket:  cfg.S3Bucket,
+			DryRun:  *dryRun,
+		}
+
+		r.Register("cleanup-orphaned-objects", oc.CleanupOrphanedObjects)
+	}
+
+	L := hclog.New(&hclog.LoggerOptions{
+		Name:  "workq",
+		Level: hclog.Info,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	start := time.Now()
+
+	err = r.RunOnce(hclog.WithContext(ctx, L), db, args[0], payload)
+	if err != nil {
+		log.Fatalf("error running %s: %s", args[0], err)
+	}
+
+	fmt.Printf("ran %s in %s\n", args[0], time.Since(start))
+	return 0
+}
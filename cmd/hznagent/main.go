@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/horizon/pkg/agent"
 	"github.com/hashicorp/horizon/pkg/discovery"
 	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
 	"github.com/mitchellh/cli"
 	"github.com/spf13/pflag"
 )
@@ -101,6 +102,29 @@ func Token(flag *string) string {
 	return token
 }
 
+// The discovery client for control, asking for hubs on behalf of the
+// account tok is for. The capabilities the hubs must advertise are taken,
+// comma separated, from HORIZON_HUB_CAPABILITIES.
+func discoveryClient(control, tok string) (*discovery.Client, error) {
+	dc, err := discovery.NewClient(control)
+	if err != nil {
+		return nil, err
+	}
+
+	// Discovery works without it, just without account affinity.
+	if account, err := token.UnverifiedAccount(tok); err == nil {
+		dc.Account = account.SpecString()
+	}
+
+	for _, c := range strings.Split(os.Getenv("HORIZON_HUB_CAPABILITIES"), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			dc.Capabilities = append(dc.Capabilities, c)
+		}
+	}
+
+	return dc, nil
+}
+
 type proxyRunner struct {
 	flags    *pflag.FlagSet
 	fControl *string
@@ -159,7 +183,7 @@ func (c *proxyRunner) Run(args []string) int {
 
 	L.Debug("discovering hubs")
 
-	dc, err := discovery.NewClient(*c.fControl)
+	dc, err := discoveryClient(*c.fControl, Token(c.fToken))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -392,7 +416,7 @@ func (a *agentRunner) Run(args []string) int {
 
 	L.Debug("discovering hubs")
 
-	dc, err := discovery.NewClient(*a.fControl)
+	dc, err := discoveryClient(*a.fControl, Token(a.fToken))
 	if err != nil {
 		log.Fatal(err)
 	}
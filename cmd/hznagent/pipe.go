@@ -9,7 +9,6 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/pkg/agent"
-	"github.com/hashicorp/horizon/pkg/discovery"
 	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/spf13/pflag"
 )
@@ -83,7 +82,7 @@ func (c *pipeRunner) Run(args []string) int {
 
 	L.Debug("discovering hubs")
 
-	dc, err := discovery.NewClient(*c.fControl)
+	dc, err := discoveryClient(*c.fControl, Token(c.fToken))
 	if err != nil {
 		log.Fatal(err)
 	}
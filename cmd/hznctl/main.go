@@ -71,6 +71,8 @@ func (h *hubTokenCreate) Run(args []string) int {
 	addr := fs.String("control-addr", "127.0.0.1:24001", "Address of control server")
 	insecure := fs.Bool("insecure", false, "Whether or not to secure the grpc connection")
 	token := fs.String("token", "", "Token to authenticate with control server")
+	credName := fs.String("credential-name", "", "issue a revocable hub credential with this name instead of a token")
+	credHub := fs.String("credential-hub-id", "", "stable id of the hub the credential is for (default: the first hub to use it)")
 
 	err := fs.Parse(args)
 	if err != nil {
@@ -102,6 +104,27 @@ func (h *hubTokenCreate) Run(args []string) int {
 
 	s := pb.NewControlManagementClient(gcc)
 
+	if *credName != "" {
+		req := &pb.IssueHubCredentialRequest{
+			Name: *credName,
+		}
+
+		if *credHub != "" {
+			req.HubId, err = pb.ParseULID(*credHub)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		resp, err := s.IssueHubCredential(ctx, req)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(resp.Secret)
+		return 0
+	}
+
 	ctr, err := s.IssueHubToken(ctx, &pb.Noop{})
 	if err != nil {
 		log.Fatal(err)
@@ -199,6 +222,8 @@ func (h *llCreate) Run(args []string) int {
 	acc := fs.String("account", "", "account for the label")
 	namespace := fs.String("namespace", "/waypoint", "namespace to assign to this managament client")
 	tLabel := fs.String("target", "", "target label")
+	update := fs.Bool("update", false, "replace the target of an existing label link for the same label")
+	weight := fs.Int("weight", -1, "add a weighted link, splitting traffic for the label between targets by weight (0 disables the link)")
 
 	err := fs.Parse(args)
 	if err != nil {
@@ -242,14 +267,27 @@ func (h *llCreate) Run(args []string) int {
 
 	tls := pb.ParseLabelSet(*tLabel)
 
-	_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+	onConflict := pb.LINK_CONFLICT_ERROR
+	if *update {
+		onConflict = pb.LINK_CONFLICT_UPDATE
+	}
+
+	req := &pb.AddLabelLinkRequest{
 		Labels: gls,
 		Account: &pb.Account{
 			AccountId: accId,
 			Namespace: *namespace,
 		},
-		Target: tls,
-	})
+		Target:     tls,
+		OnConflict: onConflict,
+	}
+
+	if *weight >= 0 {
+		req.Weighted = true
+		req.Weight = uint32(*weight)
+	}
+
+	_, err = s.AddLabelLink(ctx, req)
 
 	if err != nil {
 		log.Fatal(err)
@@ -123,6 +123,10 @@ type Agent struct {
 	// is not set, the defaults are used.
 	RootCAs *x509.CertPool
 
+	// ClientCertificate is presented to hubs, for accounts whose TLS policy
+	// requires agents to authenticate with one as well as their token.
+	ClientCertificate *tls.Certificate
+
 	mu         sync.RWMutex
 	services   map[string]*Service
 	sessions   []*yamux.Session
@@ -279,6 +283,10 @@ func (a *Agent) connectToHub(ctx context.Context, hub discovery.HubConfig, statu
 		clientTlsConfig.RootCAs = a.RootCAs
 	}
 
+	if a.ClientCertificate != nil {
+		clientTlsConfig.Certificates = []tls.Certificate{*a.ClientCertificate}
+	}
+
 	if hub.PinnedCert != nil {
 		clientTlsConfig.RootCAs = x509.NewCertPool()
 		clientTlsConfig.RootCAs.AddCert(hub.PinnedCert)
@@ -0,0 +1,289 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// An AccountAlias makes the account key AliasID refer to the account
+// AccountID, so that accounts can be renamed, moved between namespaces or
+// merged without every caller updating its references at once.
+//
+// Aliases are resolved by the management RPCs that take an account, before
+// the caller's access is checked against the account resolved to, and by
+// those that select flows by account. An alias always refers to an account
+// rather than another alias, so resolving takes a single lookup.
+//
+// Only management is affected. Tokens already issued keep naming the
+// account they were issued for, and agents and hubs keep using it: the
+// services of an aliased account are registered, routed and listed under
+// its own id.
+type AccountAlias struct {
+	AliasID   []byte `gorm:"primary_key"`
+	AccountID []byte
+
+	CreatedAt time.Time
+}
+
+// The advisory lock serializing writes to the account aliases. Adding one
+// also moves the aliases of the aliased account, so a single lock covers
+// them all, keeping every alias one lookup from its account.
+const accountAliasesLockKey = "account-aliases"
+
+// Replace account, in place, with the account it's an alias of, if it is
+// one. An unset namespace on account is looked up as namespace, but only
+// filled in if account turns out to be an alias.
+func (s *Server) resolveAlias(account *pb.Account, namespace string) error {
+	if account == nil || account.AccountId == nil {
+		return nil
+	}
+
+	key := account
+	if key.Namespace == "" {
+		key = &pb.Account{Namespace: namespace, AccountId: account.AccountId}
+	}
+
+	var alias AccountAlias
+
+	err := dbx.Check(s.db.Where("alias_id = ?", key.Key()).First(&alias))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	target, err := pb.AccountFromKey(alias.AccountID)
+	if err != nil {
+		return err
+	}
+
+	account.Namespace = target.Namespace
+	account.AccountId = target.AccountId
+
+	return nil
+}
+
+// The accounts that those of accounts that are aliases refer to, keyed by
+// the alias's key, read with a single lookup. Used where resolving each
+// account in turn would take one per entry, as for the flows hubs report.
+func (s *Server) resolveAliases(accounts []*pb.Account) (map[string]*pb.Account, error) {
+	var keys pq.ByteaArray
+
+	for _, account := range accounts {
+		if account != nil && account.AccountId != nil {
+			keys = append(keys, account.Key())
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var aliases []*AccountAlias
+
+	err := dbx.Check(s.db.Where("alias_id = ANY(?)", keys).Find(&aliases))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]*pb.Account, len(aliases))
+
+	for _, alias := range aliases {
+		target, err := pb.AccountFromKey(alias.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		targets[string(alias.AliasID)] = target
+	}
+
+	return targets, nil
+}
+
+// AddAccountAlias makes req.Alias refer to req.Account. The caller must be
+// allowed both namespaces, and req.Account must exist; if it's an alias
+// itself, the new alias refers to the account it resolves to.
+//
+// req.Alias may name an existing account, which is how accounts are merged:
+// references to the alias then reach req.Account, and the aliased account's
+// own data is left in place but can no longer be reached through its id
+// until the alias is removed. Aliases of the aliased account are moved to
+// req.Account along with it, so they keep resolving in a single lookup.
+//
+// Adding an alias that already refers to req.Account does nothing. An
+// alias that refers to another account must be removed first, and an
+// account can't be made an alias of itself.
+func (s *Server) AddAccountAlias(ctx context.Context, req *pb.AddAccountAliasRequest) (*pb.Noop, error) {
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Alias == nil || req.Alias.AccountId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing alias")
+	}
+
+	if req.Alias.Namespace == "" {
+		req.Alias.Namespace = caller.Account().Namespace
+	}
+
+	if !caller.AllowAccount(req.Alias.Namespace) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	}
+
+	// Resolves req.Account, in case it's an alias itself.
+	err = s.checkFeatureAccount(&accountCaller{mgmt: caller}, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Alias.Equal(req.Account) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "an account can't be an alias of itself")
+	}
+
+	aliasKey := req.Alias.Key()
+	accountKey := req.Account.Key()
+
+	tx := s.db.Begin()
+
+	err = lockXact(tx, accountAliasesLockKey)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// req.Account was resolved before the lock was taken, so it may have
+	// been made an alias since.
+	var target AccountAlias
+
+	err = dbx.Check(tx.Where("alias_id = ?", accountKey).First(&target))
+	switch err {
+	case nil:
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrInvalidRequest, "%s has been made an alias of another account", req.Account.SpecString())
+	case gorm.ErrRecordNotFound:
+		// ok
+	default:
+		tx.Rollback()
+		return nil, err
+	}
+
+	var existing AccountAlias
+
+	err = dbx.Check(tx.Where("alias_id = ?", aliasKey).First(&existing))
+	switch err {
+	case nil:
+		tx.Rollback()
+
+		if string(existing.AccountID) == string(accountKey) {
+			return &pb.Noop{}, nil
+		}
+
+		return nil, errors.Wrapf(ErrInvalidRequest, "%s is already an alias of another account", req.Alias.SpecString())
+	case gorm.ErrRecordNotFound:
+		// ok
+	default:
+		tx.Rollback()
+		return nil, err
+	}
+
+	res := tx.Model(&AccountAlias{}).Where("account_id = ?", aliasKey).Update("account_id", accountKey)
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	moved := res.RowsAffected
+
+	err = dbx.Check(tx.Create(&AccountAlias{
+		AliasID:   aliasKey,
+		AccountID: accountKey,
+	}))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = dbx.Check(tx.Commit())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("account alias added",
+		"alias", req.Alias.SpecString(),
+		"account", req.Account.SpecString(),
+		"moved-aliases", moved,
+	)
+
+	s.audit(ctx, caller, "add-account-alias", req.Account.SpecString(), map[string]interface{}{
+		"alias":         req.Alias.SpecString(),
+		"moved-aliases": moved,
+	})
+
+	return &pb.Noop{}, nil
+}
+
+// RemoveAccountAlias stops req.Alias referring to another account. If it
+// names an account that was merged by aliasing it, that account's own data
+// can be reached through its id again. Aliases that were moved along with
+// it when it was merged stay with the account they were moved to.
+func (s *Server) RemoveAccountAlias(ctx context.Context, req *pb.RemoveAccountAliasRequest) (*pb.Noop, error) {
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Alias == nil || req.Alias.AccountId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing alias")
+	}
+
+	if req.Alias.Namespace == "" {
+		req.Alias.Namespace = caller.Account().Namespace
+	}
+
+	if !caller.AllowAccount(req.Alias.Namespace) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	}
+
+	tx := s.db.Begin()
+
+	err = lockXact(tx, accountAliasesLockKey)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	res := tx.Where("alias_id = ?", req.Alias.Key()).Delete(AccountAlias{})
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, errors.Wrapf(ErrInvalidRequest, "%s is not an alias", req.Alias.SpecString())
+	}
+
+	err = dbx.Check(tx.Commit())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("account alias removed", "alias", req.Alias.SpecString())
+
+	s.audit(ctx, caller, "remove-account-alias", req.Alias.SpecString(), nil)
+
+	return &pb.Noop{}, nil
+}
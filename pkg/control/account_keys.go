@@ -0,0 +1,373 @@
+package control
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// An AccountKey is an API key that authorizes management of a single
+// account. Only a hash of the secret is stored.
+type AccountKey struct {
+	ID         []byte `gorm:"primary_key"`
+	AccountID  []byte
+	Name       string
+	SecretHash []byte
+
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+
+	CreatedAt time.Time
+}
+
+// Account keys are presented as accountKeyPrefix, the key id and the
+// secret, separated by dots. The prefix distinguishes them from signed
+// tokens.
+const accountKeyPrefix = "hzn-ak"
+
+const secretKeySize = 32
+
+const maxAccountKeyNameLength = 128
+
+func hashSecretKey(secret []byte) []byte {
+	sum := sha256.Sum256(secret)
+	return sum[:]
+}
+
+func isAccountKey(auth string) bool {
+	return strings.HasPrefix(auth, accountKeyPrefix+".")
+}
+
+// Split a presented account key into its id and secret.
+func parseAccountKey(auth string) (*pb.ULID, []byte, error) {
+	return parseSecretKey(accountKeyPrefix, auth)
+}
+
+// Split a presented key of the form prefix.id.secret, as used by account
+// keys and hub credentials, into its id and secret.
+func parseSecretKey(prefix, auth string) (*pb.ULID, []byte, error) {
+	parts := strings.Split(auth, ".")
+	if len(parts) != 3 || parts[0] != prefix {
+		return nil, nil, ErrBadAuthentication
+	}
+
+	id, err := pb.ParseULID(parts[1])
+	if err != nil {
+		return nil, nil, ErrBadAuthentication
+	}
+
+	secret, err := hex.DecodeString(parts[2])
+	if err != nil || len(secret) != secretKeySize {
+		return nil, nil, ErrBadAuthentication
+	}
+
+	return id, secret, nil
+}
+
+// Validate a presented account key, returning the account it authorizes
+// and the key's id. Revoked and unknown keys are rejected. On success the
+// key's last used time is updated.
+func (s *Server) checkAccountKey(auth string) (*pb.Account, *pb.ULID, error) {
+	id, secret, err := parseAccountKey(auth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var key AccountKey
+
+	err = dbx.Check(s.db.Where("id = ?", id.Bytes()).First(&key))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, ErrBadAuthentication
+		}
+
+		return nil, nil, err
+	}
+
+	if subtle.ConstantTimeCompare(hashSecretKey(secret), key.SecretHash) != 1 {
+		return nil, nil, ErrBadAuthentication
+	}
+
+	if key.RevokedAt != nil {
+		return nil, nil, errors.Wrapf(ErrBadAuthentication, "account key has been revoked")
+	}
+
+	err = dbx.Check(s.db.Model(&key).Update("last_used_at", time.Now()))
+	if err != nil {
+		s.L.Error("error updating account key last used time", "error", err)
+	}
+
+	account, err := pb.AccountFromKey(key.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, id, nil
+}
+
+// The caller of an account scoped RPC. Either mgmt is set, when a
+// management token was presented, or account and keyID are, when an
+// account key was.
+type accountCaller struct {
+	mgmt *token.ValidToken
+
+	account *pb.Account
+	keyID   *pb.ULID
+}
+
+// The namespace of accounts the caller names without one.
+func (c *accountCaller) namespace() string {
+	if c.mgmt != nil {
+		return c.mgmt.Account().Namespace
+	}
+
+	return c.account.Namespace
+}
+
+// Check that the caller may act on account, once it's been resolved.
+// Management tokens may act on any account in their namespace, account
+// keys only on their own account.
+func (c *accountCaller) allowed(account *pb.Account) error {
+	if c.mgmt != nil {
+		if !c.mgmt.AllowAccount(account.Namespace) {
+			return errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+		}
+
+		return nil
+	}
+
+	if !c.account.Equal(account) {
+		return errors.Wrapf(ErrBadAuthentication, "account key not valid for account")
+	}
+
+	return nil
+}
+
+// Identify the caller of an account scoped RPC from the management token or
+// account key in ctx. The key's account is resolved in case it's an alias,
+// so the keys of an account that was merged into another act for the
+// account it was merged into.
+func (s *Server) accountCallerFrom(ctx context.Context) (*accountCaller, error) {
+	auth, ok := contextAuthorization(ctx)
+	if !ok {
+		return nil, ErrBadAuthentication
+	}
+
+	if !isAccountKey(auth) {
+		caller, err := s.checkMgmtAllowed(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &accountCaller{mgmt: caller}, nil
+	}
+
+	var (
+		account *pb.Account
+		id      *pb.ULID
+		err     error
+	)
+
+	// Already validated by UnaryAuthInterceptor.
+	if ac := authorizedFrom(ctx); ac != nil && ac.keyID != nil {
+		account, id = ac.keyAccount, ac.keyID
+	} else {
+		account, id, err = s.checkAccountKey(auth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.resolveAlias(account, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountCaller{account: account, keyID: id}, nil
+}
+
+// Check that the request may act on account, either with a management
+// token for the account's namespace or an account key for the account
+// itself. account is resolved in place, see resolveAccount.
+func (s *Server) checkAccountAllowed(ctx context.Context, account *pb.Account) (*accountCaller, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.resolveAccount(caller, account, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return caller, nil
+}
+
+func accountKeyToPB(key *AccountKey) (*pb.AccountKey, error) {
+	account, err := pb.AccountFromKey(key.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.AccountKey{
+		Id:        pb.ULIDFromBytes(key.ID),
+		Account:   account,
+		Name:      key.Name,
+		CreatedAt: pb.NewTimestamp(key.CreatedAt),
+	}
+
+	if key.LastUsedAt != nil {
+		out.LastUsedAt = pb.NewTimestamp(*key.LastUsedAt)
+	}
+
+	if key.RevokedAt != nil {
+		out.RevokedAt = pb.NewTimestamp(*key.RevokedAt)
+	}
+
+	return out, nil
+}
+
+func (s *Server) CreateAccountKey(ctx context.Context, req *pb.CreateAccountKeyRequest) (*pb.CreateAccountKeyResponse, error) {
+	caller, err := s.checkAccountAllowed(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name == "" || len(req.Name) > maxAccountKeyNameLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid account key name")
+	}
+
+	var ao Account
+
+	err = dbx.Check(s.db.First(&ao, req.Account.Key()))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(ErrInvalidRequest, "unknown account")
+		}
+
+		return nil, err
+	}
+
+	secret := make([]byte, secretKeySize)
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	id := pb.NewULID()
+
+	key := AccountKey{
+		ID:         id.Bytes(),
+		AccountID:  req.Account.Key(),
+		Name:       req.Name,
+		SecretHash: hashSecretKey(secret),
+	}
+
+	err = dbx.Check(s.db.Create(&key))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("created account key", "account", req.Account.SpecString(), "key-id", id.SpecString())
+
+	s.auditAccount(ctx, caller, "create-account-key", req.Account.SpecString(), map[string]interface{}{
+		"key-id": id.SpecString(),
+		"name":   req.Name,
+	})
+
+	info, err := accountKeyToPB(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAccountKeyResponse{
+		Key:    info,
+		Secret: strings.Join([]string{accountKeyPrefix, id.SpecString(), hex.EncodeToString(secret)}, "."),
+	}, nil
+}
+
+func (s *Server) ListAccountKeys(ctx context.Context, req *pb.ListAccountKeysRequest) (*pb.ListAccountKeysResponse, error) {
+	_, err := s.checkAccountAllowed(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*AccountKey
+
+	err = dbx.Check(
+		s.listDB(ctx, req.ConsistentRead).Where("account_id = ?", req.Account.Key()).
+			Order("created_at ASC, id ASC").
+			Find(&keys),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.ListAccountKeysResponse
+
+	ie := itemErrors{bestEffort: req.BestEffort}
+
+	for _, key := range keys {
+		info, err := accountKeyToPB(key)
+		if err != nil {
+			if err := ie.add(pb.ULIDFromBytes(key.ID).SpecString(), err); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		resp.Keys = append(resp.Keys, info)
+	}
+
+	resp.Errors = ie.errs
+
+	return &resp, nil
+}
+
+func (s *Server) RevokeAccountKey(ctx context.Context, req *pb.RevokeAccountKeyRequest) (*pb.Noop, error) {
+	caller, err := s.checkAccountAllowed(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.KeyId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing key id")
+	}
+
+	// Scoping the update by account keeps callers from revoking keys of
+	// other accounts by id.
+	res := s.db.Model(&AccountKey{}).
+		Where("id = ?", req.KeyId.Bytes()).
+		Where("account_id = ?", req.Account.Key()).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", time.Now())
+
+	err = dbx.Check(res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, errors.Wrapf(ErrInvalidRequest, "no active account key with that id")
+	}
+
+	s.logger(ctx).Info("revoked account key", "account", req.Account.SpecString(), "key-id", req.KeyId.SpecString())
+
+	s.auditAccount(ctx, caller, "revoke-account-key", req.Account.SpecString(), map[string]interface{}{
+		"key-id": req.KeyId.SpecString(),
+	})
+
+	return &pb.Noop{}, nil
+}
@@ -0,0 +1,210 @@
+package control
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type activeFlow struct {
+	stream *pb.FlowStream
+	hub    *connectedHub
+
+	// The messages and bytes of all the flow's reports so far. Hubs only
+	// report what's been sent since their previous report.
+	totals flowTotals
+}
+
+type flowTotals struct {
+	messages, bytes int64
+}
+
+// Track the flows hubs report as running, so that they can be listed and
+// killed. Flows show up with their first stats update from the hub.
+// Returns whether fs is the first report of the flow, and the flow's
+// totals including fs. The totals only cover the reports this server has
+// seen, so they're short for flows whose hub reported to another one first.
+func (s *Server) trackActiveFlow(ch *connectedHub, fs *pb.FlowStream) (bool, flowTotals) {
+	if fs.FlowId == nil {
+		return false, flowTotals{messages: fs.NumMessages, bytes: fs.NumBytes}
+	}
+
+	key := fs.FlowId.SpecString()
+
+	s.flowsMu.Lock()
+	defer s.flowsMu.Unlock()
+
+	prev, known := s.activeFlows[key]
+
+	var totals flowTotals
+	if known {
+		totals = prev.totals
+	}
+
+	totals.messages += fs.NumMessages
+	totals.bytes += fs.NumBytes
+
+	if fs.EndedAt != nil {
+		delete(s.activeFlows, key)
+		return false, totals
+	}
+
+	// Copy it, the flow top aggregates updates into the record it was
+	// first given.
+	cp := *fs
+
+	s.activeFlows[key] = &activeFlow{stream: &cp, hub: ch, totals: totals}
+
+	return !known, totals
+}
+
+// Forget the flows of a hub that has disconnected.
+func (s *Server) removeHubFlows(ch *connectedHub) {
+	s.flowsMu.Lock()
+	defer s.flowsMu.Unlock()
+
+	for key, af := range s.activeFlows {
+		if af.hub == ch {
+			delete(s.activeFlows, key)
+		}
+	}
+}
+
+// ListActiveFlows answers from the flows tracked in memory as the hubs
+// connected to this server report them. Unlike the other list RPCs it
+// doesn't go through listDB, there being no database copy to lag behind.
+func (s *Server) ListActiveFlows(ctx context.Context, req *pb.ListActiveFlowsRequest) (*pb.ListActiveFlowsResponse, error) {
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Account != nil {
+		_, err = s.resolveAccount(&accountCaller{mgmt: caller}, req.Account, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var flows []*pb.FlowStream
+
+	s.flowsMu.RLock()
+
+	for _, af := range s.activeFlows {
+		if af.stream.Account != nil {
+			flows = append(flows, af.stream)
+		}
+	}
+
+	s.flowsMu.RUnlock()
+
+	accounts := make([]*pb.Account, len(flows))
+	for i, fs := range flows {
+		accounts[i] = fs.Account
+	}
+
+	// Flows are reported under the account the agent's token names, which
+	// may since have been made an alias.
+	targets, err := s.resolveAliases(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.ListActiveFlowsResponse
+
+	for _, fs := range flows {
+		account := fs.Account
+		if target, ok := targets[string(account.Key())]; ok {
+			account = target
+		}
+
+		if !caller.AllowAccount(account.Namespace) {
+			continue
+		}
+
+		if req.Account != nil && !req.Account.Equal(account) {
+			continue
+		}
+
+		if req.HubId != nil && !req.HubId.Equal(fs.HubId) {
+			continue
+		}
+
+		resp.Flows = append(resp.Flows, fs)
+	}
+
+	sort.Slice(resp.Flows, func(i, j int) bool {
+		return resp.Flows[i].FlowId.SpecString() < resp.Flows[j].FlowId.SpecString()
+	})
+
+	return &resp, nil
+}
+
+func (s *Server) KillFlow(ctx context.Context, req *pb.KillFlowRequest) (*pb.Noop, error) {
+	L := s.L.Named("kill-flow")
+
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FlowId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing flow id")
+	}
+
+	key := req.FlowId.SpecString()
+
+	s.flowsMu.RLock()
+	af, ok := s.activeFlows[key]
+	s.flowsMu.RUnlock()
+
+	if !ok || af.stream.Account == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown flow: %s", key)
+	}
+
+	// Access is checked against the account the flow's account is an
+	// alias of, if it's become one, as for ListActiveFlows.
+	account := &pb.Account{
+		Namespace: af.stream.Account.Namespace,
+		AccountId: af.stream.Account.AccountId,
+	}
+
+	err = s.resolveAlias(account, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if !caller.AllowAccount(account.Namespace) {
+		return nil, status.Errorf(codes.NotFound, "unknown flow: %s", key)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case af.hub.xmit <- &pb.CentralActivity{KillFlows: []*pb.ULID{req.FlowId}}:
+		// ok
+	case <-time.After(5 * time.Second):
+		return nil, errors.Errorf("timed out instructing hub to kill flow: %s", key)
+	}
+
+	L.Info("instructed hub to kill flow",
+		"flow", key,
+		"hub", af.stream.HubId.SpecString(),
+		"account", af.stream.Account.SpecString(),
+		"caller-namespace", caller.Account().Namespace,
+	)
+
+	s.m.IncrCounter([]string{"flow", "killed"}, 1)
+
+	s.audit(ctx, caller, "kill-flow", key, map[string]interface{}{
+		"hub":     af.stream.HubId.SpecString(),
+		"account": af.stream.Account.SpecString(),
+	})
+
+	return &pb.Noop{}, nil
+}
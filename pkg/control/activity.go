@@ -109,7 +109,7 @@ func (ar *ActivityReader) checkLog(ctx context.Context, L hclog.Logger) {
 	for {
 		var entries []*ActivityLog
 
-		err := dbx.Check(ar.db.Where("id > ?", ar.lastEntry).Limit(100).Find(&entries))
+		err := dbx.Check(ar.db.Where("id > ?", ar.lastEntry).Order("id ASC").Limit(100).Find(&entries))
 		if err != nil {
 			if err != gorm.ErrRecordNotFound {
 				L.Error("error looking for new activity log entries", "error", err)
@@ -151,6 +151,11 @@ func NewActivityInjector(db *gorm.DB) (*ActivityInjector, error) {
 	return ai, nil
 }
 
+// Inject adds an entry to the activity log. v is stored as JSON; []byte is
+// taken to be JSON already. Entries should be JSON objects naming their event
+// type in a top level "type" string field: that is the field the activity log
+// cleanup summarizes entries by and matches LogCleaner.KeyEvents against, and
+// entries without it are all counted together under the empty type.
 func (ai *ActivityInjector) Inject(ctx context.Context, v interface{}) error {
 	var entry ActivityLog
 
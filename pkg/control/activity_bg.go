@@ -2,19 +2,174 @@ package control
 
 import (
 	context "context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/pkg/dbx"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 )
 
 var LogPruneInterval = "6 hours"
 
+// The granularities activity log entries can be summarized by.
+const (
+	SummarizeHourly = "hour"
+	SummarizeDaily  = "day"
+)
+
+// How long summaries are kept when LogCleaner.SummaryRetention is not set.
+const DefaultActivitySummaryRetention = 365 * 24 * time.Hour
+
+// An ActivitySummary counts the activity log entries of one event type,
+// taken from the entry's top level "type" string field (see
+// ActivityInjector.Inject), created in the bucket starting at BucketStart.
+// Entries without a type, including any that aren't JSON objects, are
+// counted under "".
+type ActivitySummary struct {
+	Granularity string    `gorm:"primary_key"`
+	BucketStart time.Time `gorm:"primary_key"`
+	EventType   string    `gorm:"primary_key"`
+
+	Count int64
+
+	// A JSON array of the entries of the types in LogCleaner.KeyEvents,
+	// kept whole.
+	KeyEvents []byte
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ParseSummaryGranularity validates the granularity of activity summaries.
+// An empty string turns summarization off.
+func ParseSummaryGranularity(str string) (string, error) {
+	switch str {
+	case "", SummarizeHourly, SummarizeDaily:
+		return str, nil
+	default:
+		return "", fmt.Errorf("unknown activity summary granularity: %s", str)
+	}
+}
+
 type LogCleaner struct {
 	DB *gorm.DB
+
+	// When set, to SummarizeHourly or SummarizeDaily, entries are rolled
+	// up into activity summaries as they're pruned, rather than only
+	// deleted.
+	Summarize string
+
+	// The event types whose entries are kept whole in the summaries,
+	// matched against the entries' "type" field.
+	KeyEvents []string
+
+	// How long summaries are kept. Defaults to
+	// DefaultActivitySummaryRetention.
+	SummaryRetention time.Duration
 }
 
 func (l *LogCleaner) CleanupActivityLog(ctx context.Context, jobType string, _ *struct{}) error {
-	return dbx.Check(
-		l.DB.Exec("DELETE FROM activity_logs WHERE created_at < now() - ?::interval", LogPruneInterval),
+	if l.Summarize != "" {
+		return l.summarizeActivityLog(ctx)
+	}
+
+	res := l.DB.Exec("DELETE FROM activity_logs WHERE created_at < now() - ?::interval", LogPruneInterval)
+
+	err := dbx.Check(res)
+	if err != nil {
+		return err
+	}
+
+	hclog.FromContext(ctx).Info("pruned activity log", "deleted", res.RowsAffected, "older-than", LogPruneInterval)
+
+	return nil
+}
+
+// Roll the entries older than LogPruneInterval up into summaries and delete
+// them, in one transaction so no entry is counted twice or lost. A bucket
+// spanning the cutoff is summarized across runs, so the counts of an
+// existing summary are added to rather than replaced.
+func (l *LogCleaner) summarizeActivityLog(ctx context.Context) error {
+	L := hclog.FromContext(ctx)
+
+	granularity, err := ParseSummaryGranularity(l.Summarize)
+	if err != nil {
+		return err
+	}
+
+	retention := l.SummaryRetention
+	if retention <= 0 {
+		retention = DefaultActivitySummaryRetention
+	}
+
+	keyEvents := l.KeyEvents
+	if keyEvents == nil {
+		keyEvents = []string{}
+	}
+
+	tx := l.DB.Begin()
+
+	var cutoff time.Time
+
+	err = tx.Raw("SELECT now() - ?::interval", LogPruneInterval).Row().Scan(&cutoff)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	res := tx.Exec(`
+INSERT INTO activity_summaries (granularity, bucket_start, event_type, count, key_events)
+SELECT ?, date_trunc(?, created_at), COALESCE(event->>'type', ''), count(*),
+       COALESCE(jsonb_agg(event ORDER BY id) FILTER (WHERE event->>'type' = ANY(?)), '[]')
+  FROM activity_logs
+ WHERE created_at < ?
+ GROUP BY 2, 3
+ON CONFLICT (granularity, bucket_start, event_type) DO UPDATE
+   SET count = activity_summaries.count + EXCLUDED.count,
+       key_events = activity_summaries.key_events || EXCLUDED.key_events,
+       updated_at = now()`,
+		granularity, granularity, pq.StringArray(keyEvents), cutoff)
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	summaries := res.RowsAffected
+
+	res = tx.Exec("DELETE FROM activity_logs WHERE created_at < ?", cutoff)
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleted := res.RowsAffected
+
+	res = tx.Exec("DELETE FROM activity_summaries WHERE bucket_start < ?", time.Now().Add(-retention))
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = dbx.Check(tx.Commit())
+	if err != nil {
+		return err
+	}
+
+	L.Info("summarized activity log",
+		"deleted", deleted,
+		"summaries", summaries,
+		"granularity", granularity,
+		"older-than", LogPruneInterval,
+		"expired-summaries", res.RowsAffected,
 	)
+
+	return nil
 }
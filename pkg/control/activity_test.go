@@ -73,4 +73,63 @@ func TestActivity(t *testing.T) {
 		err = dbx.Check(db.First(&ae2))
 		require.Error(t, err)
 	})
+
+	t.Run("summarizes old logs before pruning them", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, testDbName)
+		defer db.Close()
+
+		old := time.Now().Add(-7 * time.Hour).Truncate(time.Hour)
+
+		for _, ev := range []string{
+			`{"type": "flow-started"}`,
+			`{"type": "flow-started"}`,
+			`{"type": "account-created", "account": "a"}`,
+			`1`,
+		} {
+			ae := ActivityLog{CreatedAt: old.Add(time.Minute), Event: []byte(ev)}
+			require.NoError(t, dbx.Check(db.Create(&ae)))
+		}
+
+		recent := ActivityLog{CreatedAt: time.Now(), Event: []byte(`{"type": "flow-started"}`)}
+		require.NoError(t, dbx.Check(db.Create(&recent)))
+
+		lc := LogCleaner{
+			DB:        db,
+			Summarize: SummarizeHourly,
+			KeyEvents: []string{"account-created"},
+		}
+
+		require.NoError(t, lc.CleanupActivityLog(context.Background(), "cleanup-activity-log", nil))
+
+		var summaries []*ActivitySummary
+		require.NoError(t, dbx.Check(db.Order("event_type ASC").Find(&summaries)))
+		require.Len(t, summaries, 3)
+
+		assert.Equal(t, "", summaries[0].EventType)
+		assert.Equal(t, int64(1), summaries[0].Count)
+
+		assert.Equal(t, "account-created", summaries[1].EventType)
+		assert.Equal(t, int64(1), summaries[1].Count)
+		assert.JSONEq(t, `[{"type": "account-created", "account": "a"}]`, string(summaries[1].KeyEvents))
+
+		assert.Equal(t, "flow-started", summaries[2].EventType)
+		assert.Equal(t, int64(2), summaries[2].Count)
+		assert.JSONEq(t, `[]`, string(summaries[2].KeyEvents))
+		assert.True(t, old.Equal(summaries[2].BucketStart))
+
+		// Entries added to a summarized bucket later are added to its counts
+		late := ActivityLog{CreatedAt: old.Add(2 * time.Minute), Event: []byte(`{"type": "flow-started"}`)}
+		require.NoError(t, dbx.Check(db.Create(&late)))
+
+		require.NoError(t, lc.CleanupActivityLog(context.Background(), "cleanup-activity-log", nil))
+
+		var flows ActivitySummary
+		require.NoError(t, dbx.Check(db.Where("event_type = ?", "flow-started").First(&flows)))
+		assert.Equal(t, int64(3), flows.Count)
+
+		var remaining []*ActivityLog
+		require.NoError(t, dbx.Check(db.Find(&remaining)))
+		require.Len(t, remaining, 1)
+		assert.Equal(t, recent.Id, remaining[0].Id)
+	})
 }
@@ -0,0 +1,35 @@
+package control
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// The ALPN protocols the control server listener advertises. gRPC is only
+// possible over h2, so clients negotiating http/1.1 are always served by
+// the HTTP handler.
+var NextProtos = []string{"h2", "http/1.1"}
+
+// IsGRPCRequest indicates if req should be dispatched to the gRPC server
+// rather than the HTTP handler. The negotiated ALPN protocol is used when
+// available, with the content type then distinguishing gRPC from plain
+// HTTP/2 requests.
+func IsGRPCRequest(req *http.Request) bool {
+	if req.TLS != nil && req.TLS.NegotiatedProtocol != "" {
+		if req.TLS.NegotiatedProtocol != "h2" {
+			return false
+		}
+	} else if req.ProtoMajor != 2 {
+		return false
+	}
+
+	mt, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err == nil && (mt == "application/grpc" || strings.HasPrefix(mt, "application/grpc+")) {
+		return true
+	}
+
+	// gRPC clients always send TE: trailers, browsers never do, so use it to
+	// recognize calls that carry a non-standard content type.
+	return req.Method == "POST" && strings.EqualFold(req.Header.Get("TE"), "trailers")
+}
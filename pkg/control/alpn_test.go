@@ -0,0 +1,49 @@
+package control
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGRPCRequest(t *testing.T) {
+	req := func(proto string, major int, contentType string) *http.Request {
+		r := &http.Request{
+			Method:     "POST",
+			ProtoMajor: major,
+			Header:     make(http.Header),
+		}
+
+		if proto != "" {
+			r.TLS = &tls.ConnectionState{NegotiatedProtocol: proto}
+		}
+
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+
+		return r
+	}
+
+	t.Run("routes on the negotiated protocol", func(t *testing.T) {
+		assert.True(t, IsGRPCRequest(req("h2", 2, "application/grpc")))
+		assert.False(t, IsGRPCRequest(req("http/1.1", 1, "application/grpc")))
+		assert.False(t, IsGRPCRequest(req("h2", 2, "application/json")))
+	})
+
+	t.Run("falls back to the request protocol without ALPN", func(t *testing.T) {
+		assert.True(t, IsGRPCRequest(req("", 2, "application/grpc")))
+		assert.False(t, IsGRPCRequest(req("", 1, "application/grpc")))
+	})
+
+	t.Run("recognizes content type variants", func(t *testing.T) {
+		assert.True(t, IsGRPCRequest(req("h2", 2, "application/grpc+proto")))
+		assert.True(t, IsGRPCRequest(req("h2", 2, "Application/GRPC; charset=utf-8")))
+
+		r := req("h2", 2, "application/x-custom")
+		r.Header.Set("TE", "trailers")
+		assert.True(t, IsGRPCRequest(r))
+	})
+}
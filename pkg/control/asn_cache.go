@@ -0,0 +1,177 @@
+package control
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/oschwald/geoip2-golang"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long ASN lookups are cached for when ServerConfig.ASNCacheTTL is not
+// set.
+const DefaultASNCacheTTL = time.Hour
+
+// Once this many lookups are cached, expired ones are dropped, and if that
+// doesn't make room the cache starts over.
+const maxASNCacheEntries = 100000
+
+var errNoASNDB = errors.New("no ASN database configured")
+
+type asnEntry struct {
+	info    *geoip2.ASN
+	err     error
+	expires time.Time
+}
+
+// Caches ASN lookups by IP, including failed ones, since the same clients
+// connect over and over.
+type asnCache struct {
+	mu      sync.Mutex
+	entries map[string]asnEntry
+}
+
+func (c *asnCache) get(ip string, now time.Time) (asnEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ent, ok := c.entries[ip]
+	if !ok || now.After(ent.expires) {
+		return asnEntry{}, false
+	}
+
+	return ent, true
+}
+
+func (c *asnCache) put(ip string, ent asnEntry, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]asnEntry)
+	}
+
+	if len(c.entries) >= maxASNCacheEntries {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+
+		if len(c.entries) >= maxASNCacheEntries {
+			c.entries = make(map[string]asnEntry)
+		}
+	}
+
+	c.entries[ip] = ent
+}
+
+// Discard every cached lookup, returning how many there were.
+func (c *asnCache) flush() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := len(c.entries)
+	c.entries = nil
+
+	return n
+}
+
+func (s *Server) asnCacheTTL() time.Duration {
+	cfg := s.config()
+
+	if cfg.ASNCacheTTL > 0 {
+		return cfg.ASNCacheTTL
+	}
+
+	return DefaultASNCacheTTL
+}
+
+// Open the ASN database at ServerConfig.ASNDB, replacing the one in use.
+// The old reader is closed once no lookup is using it. On error the old
+// reader stays in use.
+func (s *Server) openASNDB() error {
+	path := s.config().ASNDB
+	if path == "" {
+		return nil
+	}
+
+	r, err := geoip2.Open(path)
+	if err != nil {
+		return err
+	}
+
+	s.asnMu.Lock()
+	old := s.asnDB
+	s.asnDB = r
+	s.asnMu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+
+	return nil
+}
+
+func (s *Server) hasASNDB() bool {
+	s.asnMu.RLock()
+	defer s.asnMu.RUnlock()
+
+	return s.asnDB != nil
+}
+
+// Look up the ASN of ip, using the cache when possible.
+func (s *Server) lookupASN(ip net.IP) (*geoip2.ASN, error) {
+	s.asnMu.RLock()
+	defer s.asnMu.RUnlock()
+
+	if s.asnDB == nil {
+		return nil, errNoASNDB
+	}
+
+	key := ip.String()
+	now := time.Now()
+
+	if ent, ok := s.asnCache.get(key, now); ok {
+		s.m.IncrCounter([]string{"asn", "cache", "hit"}, 1)
+		return ent.info, ent.err
+	}
+
+	s.m.IncrCounter([]string{"asn", "cache", "miss"}, 1)
+
+	info, err := s.asnDB.ASN(ip)
+
+	s.asnCache.put(key, asnEntry{
+		info:    info,
+		err:     err,
+		expires: now.Add(s.asnCacheTTL()),
+	}, now)
+
+	return info, err
+}
+
+// FlushASNCache reopens the ASN database and discards the cached lookups,
+// so that an updated database file is used without waiting for a restart
+// or for the entries to expire. It requires the ops token.
+func (s *Server) FlushASNCache(ctx context.Context, _ *pb.Noop) (*pb.FlushASNCacheResponse, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	err := s.openASNDB()
+	if err != nil {
+		s.logger(ctx).Error("error reopening ASN database", "error", err)
+		return nil, status.Errorf(codes.Internal, "unable to reopen the ASN database: %s", err)
+	}
+
+	n := s.asnCache.flush()
+
+	s.logger(ctx).Info("flushed ASN cache", "entries", n)
+
+	return &pb.FlushASNCacheResponse{Flushed: int64(n)}, nil
+}
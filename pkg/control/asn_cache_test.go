@@ -0,0 +1,96 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/oschwald/geoip2-golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestASNCache(t *testing.T) {
+	t.Run("returns lookups until they expire", func(t *testing.T) {
+		var c asnCache
+
+		now := time.Now()
+
+		c.put("1.1.1.1", asnEntry{
+			info:    &geoip2.ASN{AutonomousSystemNumber: 13335},
+			expires: now.Add(time.Minute),
+		}, now)
+
+		ent, ok := c.get("1.1.1.1", now.Add(time.Second))
+		require.True(t, ok)
+		assert.Equal(t, uint(13335), ent.info.AutonomousSystemNumber)
+
+		_, ok = c.get("8.8.8.8", now)
+		assert.False(t, ok)
+
+		_, ok = c.get("1.1.1.1", now.Add(2*time.Minute))
+		assert.False(t, ok)
+	})
+
+	t.Run("flushes every entry", func(t *testing.T) {
+		var c asnCache
+
+		now := time.Now()
+
+		c.put("1.1.1.1", asnEntry{expires: now.Add(time.Minute)}, now)
+		c.put("8.8.8.8", asnEntry{expires: now.Add(time.Minute)}, now)
+
+		assert.Equal(t, 2, c.flush())
+
+		_, ok := c.get("1.1.1.1", now)
+		assert.False(t, ok)
+
+		assert.Equal(t, 0, c.flush())
+	})
+
+	t.Run("flushing requires the ops token", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.opsToken = "ddeeff"
+
+		now := time.Now()
+		s.asnCache.put("1.1.1.1", asnEntry{expires: now.Add(time.Minute)}, now)
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		_, err := s.FlushASNCache(metadata.NewIncomingContext(context.Background(), md), &pb.Noop{})
+		assert.Equal(t, ErrBadAuthentication, err)
+
+		md.Set("authorization", "ddeeff")
+
+		resp, err := s.FlushASNCache(metadata.NewIncomingContext(context.Background(), md), &pb.Noop{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), resp.Flushed)
+	})
+
+	t.Run("keeps the cache when the database can't be reopened", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.opsToken = "ddeeff"
+		s.cfg.ASNDB = "/nonexistent/asn.mmdb"
+
+		now := time.Now()
+		s.asnCache.put("1.1.1.1", asnEntry{expires: now.Add(time.Minute)}, now)
+
+		md := make(metadata.MD)
+		md.Set("authorization", "ddeeff")
+
+		_, err := s.FlushASNCache(metadata.NewIncomingContext(context.Background(), md), &pb.Noop{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		_, ok := s.asnCache.get("1.1.1.1", now)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,306 @@
+package control
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// A HubCandidate is a hub with capacity that may be advertised to an agent.
+type HubCandidate struct {
+	StableID    *pb.ULID
+	Locations   []*pb.NetworkLocation
+	ActiveFlows int64
+
+	// Zero means the hub has no flow limit.
+	MaxFlows int64
+
+	// The autonomous systems of the hub's public addresses, when an ASN
+	// database is configured.
+	ASNs []uint
+
+	// As advertised by the hub. Hubs lacking a capability the agent
+	// requires are never candidates.
+	Capabilities []string
+}
+
+// An AssignmentRequest describes the agent asking for hubs.
+type AssignmentRequest struct {
+	ClientIP net.IP
+
+	// Zero when unknown.
+	ClientASN uint
+
+	// The account the agent will serve, as passed in the account query
+	// parameter of the discovery request. Empty when not given.
+	Account string
+
+	// The capabilities a hub must advertise to be assigned, as passed in
+	// capability query parameters of the discovery request.
+	Capabilities []string
+}
+
+// Reports whether the hub advertises every capability the agent requires.
+func (h *HubCandidate) Supports(required []string) bool {
+	for _, want := range required {
+		found := false
+
+		for _, have := range h.Capabilities {
+			if have == want {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// An AssignmentStrategy decides which hubs are advertised to an agent, and
+// in which order. Agents try the hubs they're given in that order, skipping
+// the ones they can't reach, except under AssignAll, where they measure the
+// latency to each and connect to the best ones.
+type AssignmentStrategy interface {
+	// The name the strategy is selected by, used in metrics.
+	Name() string
+
+	// Order returns the hubs to advertise, most preferred first. It may
+	// drop candidates but must not return an empty list when given a
+	// non-empty one.
+	Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate
+}
+
+// The strategies that NewAssignmentStrategy knows by name.
+const (
+	AssignAll              = "all"
+	AssignLeastConnections = "least-connections"
+	AssignRoundRobin       = "round-robin"
+	AssignASN              = "asn"
+	AssignAccountHash      = "account-hash"
+)
+
+// NewAssignmentStrategy returns the built in strategy called name. An
+// empty name returns the default, AssignAll.
+func NewAssignmentStrategy(name string) (AssignmentStrategy, error) {
+	switch name {
+	case "", AssignAll:
+		return allHubs{}, nil
+	case AssignLeastConnections:
+		return leastConnections{}, nil
+	case AssignRoundRobin:
+		return &roundRobin{}, nil
+	case AssignASN:
+		return asnAffinity{}, nil
+	case AssignAccountHash:
+		return accountHash{}, nil
+	default:
+		return nil, fmt.Errorf("unknown assignment strategy: %s", name)
+	}
+}
+
+// Advertises every hub in the order they're stored, leaving the choice
+// entirely to the agent's latency measurements.
+type allHubs struct{}
+
+func (allHubs) Name() string {
+	return AssignAll
+}
+
+func (allHubs) Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate {
+	return hubs
+}
+
+// Prefers the hubs carrying the smallest share of their flow limit, or the
+// fewest flows for hubs without one.
+type leastConnections struct{}
+
+func (leastConnections) Name() string {
+	return AssignLeastConnections
+}
+
+func (leastConnections) Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate {
+	load := func(h *HubCandidate) float64 {
+		if h.MaxFlows > 0 {
+			return float64(h.ActiveFlows) / float64(h.MaxFlows)
+		}
+
+		return float64(h.ActiveFlows)
+	}
+
+	out := append([]*HubCandidate(nil), hubs...)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return load(out[i]) < load(out[j])
+	})
+
+	return out
+}
+
+// Rotates which hub is preferred on every request.
+type roundRobin struct {
+	next uint64
+}
+
+func (*roundRobin) Name() string {
+	return AssignRoundRobin
+}
+
+func (r *roundRobin) Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate {
+	if len(hubs) == 0 {
+		return hubs
+	}
+
+	start := int(atomic.AddUint64(&r.next, 1) % uint64(len(hubs)))
+
+	out := make([]*HubCandidate, 0, len(hubs))
+	out = append(out, hubs[start:]...)
+	out = append(out, hubs[:start]...)
+
+	return out
+}
+
+// Prefers hubs in the same autonomous system as the agent, so their
+// traffic can stay within one network. Without an ASN for the agent the
+// stored order is kept.
+type asnAffinity struct{}
+
+func (asnAffinity) Name() string {
+	return AssignASN
+}
+
+func (asnAffinity) Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate {
+	if req.ClientASN == 0 {
+		return hubs
+	}
+
+	local := func(h *HubCandidate) bool {
+		for _, asn := range h.ASNs {
+			if asn == req.ClientASN {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	out := append([]*HubCandidate(nil), hubs...)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return local(out[i]) && !local(out[j])
+	})
+
+	return out
+}
+
+// Orders hubs by rendezvous hashing of the account and hub ids, so the
+// agents of an account prefer the same hubs and only the accounts of a
+// hub that leaves are moved. Without an account the stored order is kept.
+type accountHash struct{}
+
+func (accountHash) Name() string {
+	return AssignAccountHash
+}
+
+func (accountHash) Order(req *AssignmentRequest, hubs []*HubCandidate) []*HubCandidate {
+	if req.Account == "" {
+		return hubs
+	}
+
+	weight := func(h *HubCandidate) uint64 {
+		sum := sha256.Sum256([]byte(req.Account + "/" + h.StableID.SpecString()))
+		return binary.BigEndian.Uint64(sum[:8])
+	}
+
+	out := append([]*HubCandidate(nil), hubs...)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return weight(out[i]) > weight(out[j])
+	})
+
+	return out
+}
+
+// Counts the discovery responses ordered by each strategy. Hubs come and
+// go, so they aren't a label; how the agents spread across them shows in
+// the hubs' own flow and agent counts.
+var hubAssignments = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "control_hub_assignments_total",
+		Help: "The number of discovery responses whose hubs were ordered, by strategy.",
+	},
+	[]string{"strategy"},
+)
+
+func init() {
+	prometheus.MustRegister(hubAssignments)
+}
+
+// Build the assignment request for an agent's discovery request.
+func (s *Server) assignmentRequest(req *http.Request) *AssignmentRequest {
+	q := req.URL.Query()
+
+	ar := &AssignmentRequest{
+		Account: q.Get("account"),
+	}
+
+	for _, v := range q["capability"] {
+		for _, c := range strings.Split(v, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				ar.Capabilities = append(ar.Capabilities, c)
+			}
+		}
+	}
+
+	ip, err := ipFromRequest(req)
+	if err != nil {
+		return ar
+	}
+
+	ar.ClientIP = ip
+
+	if info, err := s.lookupASN(ip); err == nil {
+		ar.ClientASN = info.AutonomousSystemNumber
+	}
+
+	return ar
+}
+
+// The autonomous systems of the public addresses in locs.
+func (s *Server) locationASNs(locs []*pb.NetworkLocation) []uint {
+	if !s.hasASNDB() {
+		return nil
+	}
+
+	var out []uint
+
+	for _, loc := range locs {
+		if !loc.IsPublic() {
+			continue
+		}
+
+		for _, addr := range loc.Addresses {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				continue
+			}
+
+			if info, err := s.lookupASN(ip); err == nil && info.AutonomousSystemNumber != 0 {
+				out = append(out, info.AutonomousSystemNumber)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,91 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/discovery"
+)
+
+// The wait before the first retry of a failed assignment, when
+// ServerConfig.AssignmentRetryBackoff isn't set. It doubles with each
+// retry.
+const DefaultAssignmentRetryBackoff = 100 * time.Millisecond
+
+// The longest wait between retries, however many are configured, so that
+// an agent isn't kept waiting long before it's told to come back later.
+const maxAssignmentRetryWait = 2 * time.Second
+
+// The longest an assignment is retried for in all, however many retries
+// are configured. No retry is made whose wait would pass it.
+const maxAssignmentRetryTime = 10 * time.Second
+
+// Whether the outcome of assignHubs may clear up by itself shortly, as
+// when every hub is full or none are registered while they restart. Agents
+// needing capabilities no hub has won't get them by retrying.
+func retryableAssignment(candidates []*HubCandidate, err error) bool {
+	if err != nil {
+		return err == discovery.ErrNoCapacity
+	}
+
+	return len(candidates) == 0
+}
+
+// assignHubs, retried up to ServerConfig.AssignmentRetries times with
+// backoff while there's no hub to assign, rereading the hubs each time so
+// ones that have freed up or registered since are seen, and for no longer
+// than maxAssignmentRetryTime. The last outcome is returned once the
+// retries are exhausted or ctx is done. With retries configured, no hubs
+// registered is reported as discovery.ErrNoCapacity like every hub being
+// full; without them it's an empty list, as before retries existed.
+func (s *Server) assignHubsWithRetry(ctx context.Context, ar *AssignmentRequest) (AssignmentStrategy, []*HubCandidate, error) {
+	cfg := s.config()
+
+	backoff := cfg.AssignmentRetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultAssignmentRetryBackoff
+	}
+
+	deadline := time.Now().Add(maxAssignmentRetryTime)
+
+	for attempt := 0; ; attempt++ {
+		strategy, candidates, err := s.assignHubs(ar)
+
+		if !retryableAssignment(candidates, err) {
+			if attempt > 0 {
+				s.m.IncrCounter([]string{"assignment", "retry_succeeded"}, 1)
+			}
+
+			return strategy, candidates, err
+		}
+
+		if err == nil && cfg.AssignmentRetries > 0 {
+			err = discovery.ErrNoCapacity
+		}
+
+		wait := backoff << uint(attempt)
+		if wait <= 0 || wait > maxAssignmentRetryWait {
+			wait = maxAssignmentRetryWait
+		}
+
+		if attempt >= cfg.AssignmentRetries || time.Now().Add(wait).After(deadline) {
+			if attempt > 0 {
+				s.L.Warn("no hub to assign after retrying", "attempts", attempt+1, "error", err)
+				s.m.IncrCounter([]string{"assignment", "retries_exhausted"}, 1)
+			}
+
+			return strategy, candidates, err
+		}
+
+		s.m.IncrCounter([]string{"assignment", "retries"}, 1)
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return strategy, candidates, err
+		case <-timer.C:
+		}
+	}
+}
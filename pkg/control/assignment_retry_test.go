@@ -0,0 +1,125 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/discovery"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignmentRetry(t *testing.T) {
+	db := testsql.TestPostgresDB(t, "hzn")
+	defer db.Close()
+
+	var s Server
+	s.L = hclog.L()
+	s.db = db
+	s.hubDomain = "hub.test"
+	s.cfg.MaxFlowsPerHub = 10
+	s.cfg.AssignmentRetries = 3
+	s.cfg.AssignmentRetryBackoff = 20 * time.Millisecond
+	s.connectedHubs = make(map[string]*connectedHub)
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	locs, err := json.Marshal([]*pb.NetworkLocation{
+		{Addresses: []string{"1.1.1.1"}},
+	})
+	require.NoError(t, err)
+
+	instance := pb.NewULID()
+
+	err = dbx.Check(db.Create(&Hub{
+		StableID:       pb.NewULID().Bytes(),
+		InstanceID:     instance.Bytes(),
+		ConnectionInfo: locs,
+		LastCheckin:    time.Now(),
+	}))
+	require.NoError(t, err)
+
+	ch := &connectedHub{activeFlows: new(int64)}
+	s.connectedHubs[instance.SpecString()] = ch
+
+	t.Run("retries until a hub frees up", func(t *testing.T) {
+		atomic.StoreInt64(ch.activeFlows, 10)
+
+		time.AfterFunc(30*time.Millisecond, func() {
+			atomic.StoreInt64(ch.activeFlows, 5)
+		})
+
+		locs, err := s.assignNetworkLocations(context.Background(), &AssignmentRequest{})
+		require.NoError(t, err)
+		assert.Len(t, locs, 1)
+	})
+
+	t.Run("reports no capacity once the retries are exhausted", func(t *testing.T) {
+		atomic.StoreInt64(ch.activeFlows, 10)
+
+		start := time.Now()
+
+		_, err := s.assignNetworkLocations(context.Background(), &AssignmentRequest{})
+		assert.Equal(t, discovery.ErrNoCapacity, err)
+
+		// 20ms, 40ms then 80ms
+		assert.True(t, time.Since(start) >= 140*time.Millisecond)
+	})
+
+	t.Run("stops retrying when the request is canceled", func(t *testing.T) {
+		atomic.StoreInt64(ch.activeFlows, 10)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		start := time.Now()
+
+		_, err := s.assignNetworkLocations(ctx, &AssignmentRequest{})
+		assert.Equal(t, discovery.ErrNoCapacity, err)
+
+		assert.True(t, time.Since(start) < 100*time.Millisecond)
+	})
+
+	t.Run("reports no capacity when no hubs are registered only with retries", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var empty Server
+		empty.L = hclog.L()
+		empty.db = db
+		empty.cfg.AssignmentRetries = 1
+		empty.cfg.AssignmentRetryBackoff = 10 * time.Millisecond
+		empty.m = s.m
+
+		_, err := empty.assignNetworkLocations(context.Background(), &AssignmentRequest{})
+		assert.Equal(t, discovery.ErrNoCapacity, err)
+
+		locs, err := empty.GetAllNetworkLocations()
+		require.NoError(t, err)
+		assert.Empty(t, locs)
+
+		empty.cfg.AssignmentRetries = 0
+
+		locs, err = empty.assignNetworkLocations(context.Background(), &AssignmentRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, locs)
+	})
+
+	t.Run("doesn't retry for capabilities no hub has", func(t *testing.T) {
+		atomic.StoreInt64(ch.activeFlows, 0)
+
+		start := time.Now()
+
+		_, err := s.assignNetworkLocations(context.Background(), &AssignmentRequest{Capabilities: []string{"hzn/9"}})
+		assert.Equal(t, discovery.ErrNoCompatibleHub, err)
+
+		assert.True(t, time.Since(start) < 100*time.Millisecond)
+	})
+}
@@ -0,0 +1,176 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/discovery"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignmentStrategy(t *testing.T) {
+	a := &HubCandidate{StableID: pb.NewULID(), ActiveFlows: 50, MaxFlows: 100, ASNs: []uint{64500}}
+	b := &HubCandidate{StableID: pb.NewULID(), ActiveFlows: 10}
+	c := &HubCandidate{StableID: pb.NewULID(), ActiveFlows: 20, MaxFlows: 100, ASNs: []uint{64501}}
+
+	hubs := []*HubCandidate{a, b, c}
+
+	order := func(t *testing.T, name string, req *AssignmentRequest) []*HubCandidate {
+		strategy, err := NewAssignmentStrategy(name)
+		require.NoError(t, err)
+
+		assert.Equal(t, name, strategy.Name())
+
+		return strategy.Order(req, hubs)
+	}
+
+	t.Run("defaults to advertising every hub in order", func(t *testing.T) {
+		strategy, err := NewAssignmentStrategy("")
+		require.NoError(t, err)
+
+		assert.Equal(t, hubs, strategy.Order(&AssignmentRequest{}, hubs))
+	})
+
+	t.Run("prefers the least loaded hubs", func(t *testing.T) {
+		out := order(t, AssignLeastConnections, &AssignmentRequest{})
+
+		assert.Equal(t, []*HubCandidate{c, a, b}, out)
+	})
+
+	t.Run("rotates the preferred hub", func(t *testing.T) {
+		strategy, err := NewAssignmentStrategy(AssignRoundRobin)
+		require.NoError(t, err)
+
+		seen := map[*HubCandidate]bool{}
+
+		for i := 0; i < len(hubs); i++ {
+			out := strategy.Order(&AssignmentRequest{}, hubs)
+			require.Equal(t, len(hubs), len(out))
+
+			seen[out[0]] = true
+		}
+
+		assert.Equal(t, len(hubs), len(seen))
+	})
+
+	t.Run("prefers hubs in the agent's network", func(t *testing.T) {
+		out := order(t, AssignASN, &AssignmentRequest{ClientASN: 64501})
+		assert.Equal(t, []*HubCandidate{c, a, b}, out)
+
+		out = order(t, AssignASN, &AssignmentRequest{})
+		assert.Equal(t, hubs, out)
+	})
+
+	t.Run("keeps an account on the same hubs", func(t *testing.T) {
+		out := order(t, AssignAccountHash, &AssignmentRequest{Account: "acct-1"})
+		again := order(t, AssignAccountHash, &AssignmentRequest{Account: "acct-1"})
+
+		assert.Equal(t, out, again)
+
+		// Removing a hub the account doesn't prefer leaves its first
+		// choice alone.
+		var rest []*HubCandidate
+		for _, h := range hubs {
+			if h != out[len(out)-1] {
+				rest = append(rest, h)
+			}
+		}
+
+		strategy, err := NewAssignmentStrategy(AssignAccountHash)
+		require.NoError(t, err)
+
+		assert.Equal(t, out[0], strategy.Order(&AssignmentRequest{Account: "acct-1"}, rest)[0])
+	})
+
+	t.Run("rejects unknown strategies", func(t *testing.T) {
+		_, err := NewAssignmentStrategy("random")
+		require.Error(t, err)
+	})
+}
+
+func TestHubCapabilities(t *testing.T) {
+	t.Run("matches hubs advertising every required capability", func(t *testing.T) {
+		h := &HubCandidate{Capabilities: []string{"hzn/1", "hzn/2", "udp"}}
+
+		assert.True(t, h.Supports(nil))
+		assert.True(t, h.Supports([]string{"hzn/2", "udp"}))
+		assert.False(t, h.Supports([]string{"hzn/2", "quic"}))
+		assert.False(t, (&HubCandidate{}).Supports([]string{"hzn/2"}))
+	})
+
+	t.Run("reads required capabilities from the discovery request", func(t *testing.T) {
+		var s Server
+
+		req := httptest.NewRequest("GET", discovery.HTTPPath+"?capability=hzn/2,udp&capability=quic&account=test!1", nil)
+
+		ar := s.assignmentRequest(req)
+		assert.Equal(t, []string{"hzn/2", "udp", "quic"}, ar.Capabilities)
+		assert.Equal(t, "test!1", ar.Account)
+	})
+
+	t.Run("only advertises compatible hubs", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+		s.hubDomain = "hub.test"
+
+		addHub := func(caps ...string) *pb.ULID {
+			id := pb.NewULID()
+
+			locs, err := json.Marshal([]*pb.NetworkLocation{
+				{Addresses: []string{"1.1.1.1"}},
+			})
+			require.NoError(t, err)
+
+			err = dbx.Check(db.Create(&Hub{
+				StableID:       id.Bytes(),
+				InstanceID:     pb.NewULID().Bytes(),
+				ConnectionInfo: locs,
+				LastCheckin:    time.Now(),
+				Capabilities:   pq.StringArray(caps),
+			}))
+			require.NoError(t, err)
+
+			return id
+		}
+
+		addHub()
+		upgraded := addHub("hzn/2")
+
+		locs, err := s.assignNetworkLocations(context.Background(), &AssignmentRequest{})
+		require.NoError(t, err)
+		assert.Len(t, locs, 2)
+
+		locs, err = s.assignNetworkLocations(context.Background(), &AssignmentRequest{Capabilities: []string{"hzn/2"}})
+		require.NoError(t, err)
+		require.Len(t, locs, 1)
+		assert.Equal(t, upgraded.String()+".hub.test", locs[0].Name)
+
+		_, err = s.assignNetworkLocations(context.Background(), &AssignmentRequest{Capabilities: []string{"hzn/3"}})
+		assert.Equal(t, discovery.ErrNoCompatibleHub, err)
+
+		hubs, err := s.AllHubs(nil, &pb.Noop{})
+		require.NoError(t, err)
+		require.Len(t, hubs.Hubs, 2)
+
+		for _, h := range hubs.Hubs {
+			if h.StableId.Equal(upgraded) {
+				assert.Equal(t, []string{"hzn/2"}, h.Capabilities)
+			} else {
+				assert.Empty(t, h.Capabilities)
+			}
+		}
+	})
+}
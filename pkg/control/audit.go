@@ -0,0 +1,80 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/horizon/internal/sqljson"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/token"
+)
+
+// An AuditLog records an administrative action taken through the
+// management API, along with the management client that took it.
+type AuditLog struct {
+	ID        int64 `gorm:"primary_key"`
+	Action    string
+	Namespace string
+	ActorID   []byte
+	Target    string
+	Details   sqljson.Data
+	RequestID string
+
+	CreatedAt time.Time
+}
+
+// Record that caller performed action against target, as part of the
+// request ctx belongs to. Failures are logged rather than returned since
+// the action itself has already happened.
+func (s *Server) audit(ctx context.Context, caller *token.ValidToken, action, target string, details map[string]interface{}) {
+	rec := AuditLog{
+		Action:    action,
+		Namespace: caller.Account().Namespace,
+		Target:    target,
+		RequestID: requestID(ctx),
+	}
+
+	if caller.Body.Id != nil {
+		rec.ActorID = caller.Body.Id.Bytes()
+	}
+
+	s.writeAudit(ctx, &rec, details)
+}
+
+// Like audit, for the caller of an account scoped RPC. Actions taken with
+// an account key are recorded with the key's id as the actor.
+func (s *Server) auditAccount(ctx context.Context, caller *accountCaller, action, target string, details map[string]interface{}) {
+	if caller.mgmt != nil {
+		s.audit(ctx, caller.mgmt, action, target, details)
+		return
+	}
+
+	rec := AuditLog{
+		Action:    action,
+		Namespace: caller.account.Namespace,
+		ActorID:   caller.keyID.Bytes(),
+		Target:    target,
+		RequestID: requestID(ctx),
+	}
+
+	err := rec.Details.Set("account-key", caller.keyID.SpecString())
+	if err != nil {
+		s.logger(ctx).Error("error encoding audit log details", "action", action, "error", err)
+	}
+
+	s.writeAudit(ctx, &rec, details)
+}
+
+func (s *Server) writeAudit(ctx context.Context, rec *AuditLog, details map[string]interface{}) {
+	for k, v := range details {
+		err := rec.Details.Set(k, v)
+		if err != nil {
+			s.logger(ctx).Error("error encoding audit log details", "action", rec.Action, "error", err)
+		}
+	}
+
+	err := dbx.Check(s.db.Create(rec))
+	if err != nil {
+		s.logger(ctx).Error("error writing audit log", "action", rec.Action, "target", rec.Target, "error", err)
+	}
+}
@@ -0,0 +1,291 @@
+package control
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// What a caller must present to invoke an RPC.
+type authPolicy int
+
+const (
+	// No credentials required.
+	authPublic authPolicy = iota
+
+	// A hub credential, or a token signed by the server with the HUB role
+	// unless RequireHubCredentials is set.
+	authHub
+
+	// A token signed by the server with the MANAGE role.
+	authManage
+
+	// The static register token.
+	authRegister
+
+	// The static ops token.
+	authOps
+
+	// A MANAGE token, or an account key. Handlers check that the caller
+	// may act on the requested account with checkAccountAllowed.
+	authAccount
+)
+
+func (p authPolicy) String() string {
+	switch p {
+	case authPublic:
+		return "public"
+	case authHub:
+		return "hub"
+	case authManage:
+		return "manage"
+	case authRegister:
+		return "register"
+	case authOps:
+		return "ops"
+	case authAccount:
+		return "account"
+	default:
+		return "unknown"
+	}
+}
+
+// How the server treats an RPC.
+type methodPolicy struct {
+	// The authorization the caller needs.
+	auth authPolicy
+
+	// The RPC changes state, so it's rejected in maintenance mode.
+	write bool
+}
+
+// The policy of every RPC the server exposes, keyed by full method name.
+// Methods missing from this table are rejected, so a new RPC can't be
+// served until it's been given an entry here.
+var methodPolicies = map[string]methodPolicy{
+	"/pb.ControlServices/AddService":          {auth: authHub},
+	"/pb.ControlServices/RemoveService":       {auth: authHub},
+	"/pb.ControlServices/ListServices":        {auth: authHub},
+	"/pb.ControlServices/FetchConfig":         {auth: authHub},
+	"/pb.ControlServices/StreamActivity":      {auth: authHub},
+	"/pb.ControlServices/SyncHub":             {auth: authHub},
+	"/pb.ControlServices/HubDisconnect":       {auth: authHub},
+	"/pb.ControlServices/AllHubs":             {auth: authHub},
+	"/pb.ControlServices/RequestServiceToken": {auth: authHub},
+
+	"/pb.ControlManagement/Register":             {auth: authRegister, write: true},
+	"/pb.ControlManagement/AddAccount":           {auth: authManage, write: true},
+	"/pb.ControlManagement/AddLabelLink":         {auth: authAccount, write: true},
+	"/pb.ControlManagement/RemoveLabelLink":      {auth: authAccount, write: true},
+	"/pb.ControlManagement/CreateToken":          {auth: authAccount, write: true},
+	"/pb.ControlManagement/IssueHubToken":        {auth: authRegister, write: true},
+	"/pb.ControlManagement/GetTokenPublicKey":    {auth: authPublic},
+	"/pb.ControlManagement/ListAccounts":         {auth: authManage},
+	"/pb.ControlManagement/ListTokenKeys":        {auth: authManage},
+	"/pb.ControlManagement/SetHubMaxFlows":       {auth: authManage, write: true},
+	"/pb.ControlManagement/ListActiveFlows":      {auth: authManage},
+	"/pb.ControlManagement/KillFlow":             {auth: authManage, write: true},
+	"/pb.ControlManagement/LookupAccount":        {auth: authManage},
+	"/pb.ControlManagement/AddAccountAlias":      {auth: authManage, write: true},
+	"/pb.ControlManagement/RemoveAccountAlias":   {auth: authManage, write: true},
+	"/pb.ControlManagement/SetAccountFeature":    {auth: authManage, write: true},
+	"/pb.ControlManagement/GetAccountFeatures":   {auth: authAccount},
+	"/pb.ControlManagement/GetMaintenanceStatus": {auth: authOps},
+	"/pb.ControlManagement/CreateAccountKey":     {auth: authAccount, write: true},
+	"/pb.ControlManagement/ListAccountKeys":      {auth: authAccount},
+	"/pb.ControlManagement/RevokeAccountKey":     {auth: authAccount, write: true},
+	"/pb.ControlManagement/IssueHubCredential":   {auth: authRegister, write: true},
+	"/pb.ControlManagement/ListHubCredentials":   {auth: authOps},
+	"/pb.ControlManagement/RevokeHubCredential":  {auth: authOps, write: true},
+	"/pb.ControlManagement/EnqueueJob":           {auth: authOps, write: true},
+	"/pb.ControlManagement/FlushASNCache":        {auth: authOps},
+	"/pb.ControlManagement/RebuildRoutingState":  {auth: authOps, write: true},
+	"/pb.ControlManagement/SetMaintenanceMode":   {auth: authOps},
+
+	"/pb.ControlManagement/SetAccountDefaultLabels": {auth: authAccount, write: true},
+	"/pb.ControlManagement/GetAccountDefaultLabels": {auth: authAccount},
+	"/pb.ControlManagement/SetAccountTLSPolicy":     {auth: authAccount, write: true},
+	"/pb.ControlManagement/GetAccountTLSPolicy":     {auth: authAccount},
+	"/pb.ControlManagement/CleanupOrphanedObjects":  {auth: authOps, write: true},
+	"/pb.ControlManagement/WatchEvents":             {auth: authOps},
+
+	"/pb.FlowTopReporter/CurrentFlowTop": {auth: authOps},
+}
+
+func contextAuthorization(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	auth := md["authorization"]
+
+	if len(auth) < 1 || auth[0] == "" {
+		return "", false
+	}
+
+	return auth[0], true
+}
+
+func staticTokenMatches(presented, expected string) bool {
+	if expected == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(expected)) == 1
+}
+
+// What authorize validated, kept in the request's context so handlers
+// don't validate the same credentials again.
+type authorizedCaller struct {
+	// A token with the MANAGE role.
+	mgmt *token.ValidToken
+
+	// The account and id of an account key.
+	keyAccount *pb.Account
+	keyID      *pb.ULID
+
+	// The hub credential a hub authenticated with.
+	hubCred *HubCredential
+}
+
+type authorizedCallerKey struct{}
+
+func authorizedFrom(ctx context.Context) *authorizedCaller {
+	ac, _ := ctx.Value(authorizedCallerKey{}).(*authorizedCaller)
+	return ac
+}
+
+// Checks the credentials in ctx against the policy registered for method.
+// The handlers still perform their own checks to obtain the caller's token;
+// this guarantees that nothing reaches a handler without an explicit decision.
+func (s *Server) authorizeMethod(ctx context.Context, method string) error {
+	_, err := s.authorize(ctx, method)
+	return err
+}
+
+// Like authorizeMethod, returning ctx with the management token, account
+// key or hub credential that was validated attached, where checkMgmtAllowed,
+// checkAccountAllowed and checkFromHub find them.
+func (s *Server) authorize(ctx context.Context, method string) (context.Context, error) {
+	mp, ok := methodPolicies[method]
+	if !ok {
+		s.logger(ctx).Error("rejecting call to method without an authorization policy", "method", method)
+		return nil, status.Errorf(codes.PermissionDenied, "no authorization policy for %s", method)
+	}
+
+	policy := mp.auth
+
+	if policy == authPublic {
+		return ctx, nil
+	}
+
+	auth, ok := contextAuthorization(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+	}
+
+	switch policy {
+	case authRegister:
+		if staticTokenMatches(auth, s.registerToken) {
+			return ctx, nil
+		}
+	case authOps:
+		if staticTokenMatches(auth, s.opsToken) {
+			return ctx, nil
+		}
+	case authAccount:
+		if isAccountKey(auth) {
+			account, id, err := s.checkAccountKey(auth)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+			}
+
+			return context.WithValue(ctx, authorizedCallerKey{}, &authorizedCaller{
+				keyAccount: account,
+				keyID:      id,
+			}), nil
+		}
+
+		vt, err := token.CheckTokenKeys(auth, s.tokenKeys())
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+		}
+
+		if vt.Body.Role == pb.MANAGE {
+			return context.WithValue(ctx, authorizedCallerKey{}, &authorizedCaller{mgmt: vt}), nil
+		}
+	case authHub, authManage:
+		if policy == authHub && isHubCredential(auth) {
+			cred, err := s.checkHubCredential(auth)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+			}
+
+			return context.WithValue(ctx, authorizedCallerKey{}, &authorizedCaller{hubCred: cred}), nil
+		}
+
+		if policy == authHub && s.config().RequireHubCredentials {
+			break
+		}
+
+		vt, err := token.CheckTokenKeys(auth, s.tokenKeys())
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+		}
+
+		if policy == authHub && vt.Body.Role == pb.HUB {
+			return ctx, nil
+		}
+
+		if policy == authManage && vt.Body.Role == pb.MANAGE {
+			return context.WithValue(ctx, authorizedCallerKey{}, &authorizedCaller{mgmt: vt}), nil
+		}
+	}
+
+	s.m.IncrCounter([]string{"auth", "denied"}, 1)
+
+	return nil, status.Errorf(codes.PermissionDenied, "%s requires %s authorization", method, policy)
+}
+
+// UnaryAuthInterceptor rejects unary calls that don't satisfy the method's
+// entry in the authorization policy table.
+func (s *Server) UnaryAuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := s.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor rejects streams that don't satisfy the method's
+// entry in the authorization policy table.
+func (s *Server) StreamAuthInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := s.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	if ctx != ss.Context() {
+		ss = &contextStream{ServerStream: ss, ctx: ctx}
+	}
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,140 @@
+package control
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthPolicy(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	s := &Server{
+		L:             hclog.L(),
+		keyId:         "k1",
+		pubKey:        pub,
+		registerToken: "aabbcc",
+		opsToken:      "ddeeff",
+	}
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	roleToken := func(role pb.TokenRole) string {
+		var tc token.TokenCreator
+		tc.Role = role
+
+		stoken, err := tc.EncodeED25519(priv, "k1")
+		require.NoError(t, err)
+
+		return stoken
+	}
+
+	withAuth := func(auth string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", auth))
+	}
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &pb.Noop{}, nil
+	}
+
+	call := func(ctx context.Context, method string) error {
+		called = false
+		_, err := s.UnaryAuthInterceptor(ctx, &pb.Noop{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("every registered method has a policy", func(t *testing.T) {
+		gs := grpc.NewServer()
+		pb.RegisterControlServicesServer(gs, s)
+		pb.RegisterControlManagementServer(gs, s)
+		pb.RegisterFlowTopReporterServer(gs, s)
+
+		for name, info := range gs.GetServiceInfo() {
+			for _, m := range info.Methods {
+				method := "/" + name + "/" + m.Name
+				_, ok := methodPolicies[method]
+				assert.True(t, ok, "missing authorization policy for %s", method)
+			}
+		}
+	})
+
+	t.Run("rejects methods without a policy", func(t *testing.T) {
+		err := call(withAuth(s.registerToken), "/pb.ControlManagement/NotARealMethod")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, called)
+	})
+
+	t.Run("rejects calls without credentials", func(t *testing.T) {
+		err := call(context.Background(), "/pb.ControlServices/AddService")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, called)
+	})
+
+	t.Run("checks token roles", func(t *testing.T) {
+		err := call(withAuth(roleToken(pb.MANAGE)), "/pb.ControlServices/AddService")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, called)
+
+		err = call(withAuth(roleToken(pb.HUB)), "/pb.ControlServices/AddService")
+		require.NoError(t, err)
+		assert.True(t, called)
+
+		err = call(withAuth(roleToken(pb.HUB)), "/pb.ControlManagement/AddAccount")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = call(withAuth(roleToken(pb.MANAGE)), "/pb.ControlManagement/AddAccount")
+		require.NoError(t, err)
+	})
+
+	t.Run("checks static tokens", func(t *testing.T) {
+		err := call(withAuth(s.opsToken), "/pb.ControlManagement/Register")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = call(withAuth(s.registerToken), "/pb.ControlManagement/Register")
+		require.NoError(t, err)
+
+		err = call(withAuth(s.registerToken), "/pb.FlowTopReporter/CurrentFlowTop")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = call(withAuth(s.opsToken), "/pb.FlowTopReporter/CurrentFlowTop")
+		require.NoError(t, err)
+	})
+
+	t.Run("allows public methods without credentials", func(t *testing.T) {
+		err := call(context.Background(), "/pb.ControlManagement/GetTokenPublicKey")
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("applies to streams", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/pb.ControlServices/StreamActivity"}
+
+		streamCalled := false
+		streamHandler := func(srv interface{}, ss grpc.ServerStream) error {
+			streamCalled = true
+			return nil
+		}
+
+		err := s.StreamAuthInterceptor(nil, &peerStream{ctx: context.Background()}, info, streamHandler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, streamCalled)
+
+		err = s.StreamAuthInterceptor(nil, &peerStream{ctx: withAuth(roleToken(pb.HUB))}, info, streamHandler)
+		require.NoError(t, err)
+		assert.True(t, streamCalled)
+	})
+}
@@ -0,0 +1,121 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+)
+
+// EnsureBucket verifies that bucket exists and is accessible with the
+// credentials in sess. If the bucket does not exist and create is true,
+// it's created in the session's region. This is intended to be called at
+// startup so that a misconfigured bucket is detected immediately rather
+// than on the first write.
+func EnsureBucket(ctx context.Context, sess *session.Session, bucket string, create bool) error {
+	L := hclog.FromContext(ctx)
+
+	api := s3.New(sess)
+
+	_, err := api.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if err == nil {
+		L.Debug("verified s3 bucket is accessible", "bucket", bucket)
+		return nil
+	}
+
+	var status int
+
+	if rf, ok := err.(awserr.RequestFailure); ok {
+		status = rf.StatusCode()
+	}
+
+	switch status {
+	case http.StatusNotFound:
+		// handled below
+	case http.StatusForbidden:
+		return errors.Wrapf(err, "access denied to s3 bucket %s", bucket)
+	case http.StatusMovedPermanently:
+		return errors.Wrapf(err, "s3 bucket %s exists in a different region than %s",
+			bucket, aws.StringValue(sess.Config.Region))
+	default:
+		return errors.Wrapf(err, "unable to access s3 bucket %s", bucket)
+	}
+
+	if !create {
+		return errors.Errorf("s3 bucket %s does not exist", bucket)
+	}
+
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	// us-east-1 is the default location and S3 rejects it as an explicit
+	// location constraint.
+	if region := aws.StringValue(sess.Config.Region); region != "" && region != "us-east-1" {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(region),
+		}
+	}
+
+	_, err = api.CreateBucketWithContext(ctx, input)
+	if err != nil {
+		return errors.Wrapf(err, "creating s3 bucket %s", bucket)
+	}
+
+	L.Info("created s3 bucket", "bucket", bucket, "region", aws.StringValue(sess.Config.Region))
+
+	return nil
+}
+
+// ParseRegionBuckets parses a list of region=bucket pairs separated by
+// commas, eg. "us-east-1=hzn-use1,eu-west-1=hzn-euw1".
+func ParseRegionBuckets(str string) (map[string]string, error) {
+	out := make(map[string]string)
+
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		idx := strings.IndexByte(part, '=')
+		if idx <= 0 || idx == len(part)-1 {
+			return nil, fmt.Errorf("expected region=bucket: %s", part)
+		}
+
+		region := strings.TrimSpace(part[:idx])
+
+		if _, ok := out[region]; ok {
+			return nil, fmt.Errorf("duplicate region: %s", region)
+		}
+
+		out[region] = strings.TrimSpace(part[idx+1:])
+	}
+
+	return out, nil
+}
+
+// SelectBucket returns the bucket that a control server in region stores
+// account data in: the region's own bucket if it has one, or def. The
+// regional buckets are expected to replicate one another, as hubs read from
+// the bucket of the control server they're connected to.
+func SelectBucket(buckets map[string]string, region, def string) (string, error) {
+	if bucket, ok := buckets[region]; ok {
+		return bucket, nil
+	}
+
+	if def == "" {
+		return "", errors.Errorf("no S3 bucket for region %q and no default bucket", region)
+	}
+
+	return def, nil
+}
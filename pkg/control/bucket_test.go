@@ -0,0 +1,86 @@
+package control
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureBucket(t *testing.T) {
+	sess := testutils.AWSSession(t)
+
+	ctx := context.Background()
+
+	t.Run("accepts an existing bucket", func(t *testing.T) {
+		bucket := strings.ToLower("hzntest-" + pb.NewULID().SpecString())
+
+		_, err := s3.New(sess).CreateBucket(&s3.CreateBucketInput{
+			Bucket: aws.String(bucket),
+		})
+		require.NoError(t, err)
+
+		defer testutils.DeleteBucket(s3.New(sess), bucket)
+
+		err = EnsureBucket(ctx, sess, bucket, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("errors on a missing bucket", func(t *testing.T) {
+		bucket := strings.ToLower("hzntest-" + pb.NewULID().SpecString())
+
+		err := EnsureBucket(ctx, sess, bucket, false)
+		require.Error(t, err)
+	})
+
+	t.Run("creates a missing bucket when requested", func(t *testing.T) {
+		bucket := strings.ToLower("hzntest-" + pb.NewULID().SpecString())
+
+		defer testutils.DeleteBucket(s3.New(sess), bucket)
+
+		err := EnsureBucket(ctx, sess, bucket, true)
+		require.NoError(t, err)
+
+		_, err = s3.New(sess).HeadBucket(&s3.HeadBucketInput{
+			Bucket: aws.String(bucket),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestRegionBuckets(t *testing.T) {
+	t.Run("parses region to bucket pairs", func(t *testing.T) {
+		buckets, err := ParseRegionBuckets("us-east-1=hzn-use1, eu-west-1 = hzn-euw1,")
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]string{
+			"us-east-1": "hzn-use1",
+			"eu-west-1": "hzn-euw1",
+		}, buckets)
+
+		for _, str := range []string{"us-east-1", "=hzn", "us-east-1=", "a=b,a=c"} {
+			_, err := ParseRegionBuckets(str)
+			require.Error(t, err, str)
+		}
+	})
+
+	t.Run("selects the bucket of the region", func(t *testing.T) {
+		buckets := map[string]string{"eu-west-1": "hzn-euw1"}
+
+		bucket, err := SelectBucket(buckets, "eu-west-1", "hzn")
+		require.NoError(t, err)
+		require.Equal(t, "hzn-euw1", bucket)
+
+		bucket, err = SelectBucket(buckets, "us-west-2", "hzn")
+		require.NoError(t, err)
+		require.Equal(t, "hzn", bucket)
+
+		_, err = SelectBucket(buckets, "us-west-2", "")
+		require.Error(t, err)
+	})
+}
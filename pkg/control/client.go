@@ -4,6 +4,7 @@ import (
 	context "context"
 	"crypto/ed25519"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	io "io"
 	"io/ioutil"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/horizon/pkg/netloc"
 	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/hashicorp/horizon/pkg/periodic"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	gcreds "google.golang.org/grpc/credentials"
@@ -54,6 +57,11 @@ type accountInfo struct {
 
 	// Populated by pushes from the server
 	Recent []*pb.ServiceRoute
+
+	// Set once the account's data has been fetched, even if there turned
+	// out to be none, so a nil Services can be told apart from a failed
+	// fetch.
+	Fetched bool
 }
 
 type Client struct {
@@ -89,7 +97,14 @@ type Client struct {
 	rawtlsCert []byte
 	rawtlsKey  []byte
 	tlsCert    *tls.Certificate
-	tokenPub   ed25519.PublicKey
+
+	// Whether tlsCert is snake-oil, and how many handshakes it's been
+	// served in.
+	snakeOil           bool
+	snakeOilHandshakes int64
+
+	tokenPub  ed25519.PublicKey
+	tokenKeys map[string]token.PublicKey
 
 	hubActivity chan *pb.HubActivity
 
@@ -98,8 +113,32 @@ type Client struct {
 	clientset *client.Clientset
 
 	liveHubs *lru.ARCCache
+
+	flowKiller func(id *pb.ULID) bool
+
+	// Pacing for reconnecting the activity stream, as advertised by
+	// control in FetchConfig.
+	reconnect *pb.ReconnectPolicy
+
+	// How long flows may go without traffic before being torn down, as
+	// advertised by control in FetchConfig. Zero disables it.
+	flowIdleTimeout time.Duration
+
+	// The TLS policy for accounts that don't set their own and the CAs
+	// agent client certificates are verified against, as advertised by
+	// control in FetchConfig.
+	tlsPolicy      *pb.TLSPolicy
+	agentClientCAs *x509.CertPool
+
+	// Set once control refuses the hub's protocol version, which retrying
+	// won't change.
+	refused error
 }
 
+// ErrProtocolVersionRefused is returned by Run when control refuses the
+// hub's ProtocolVersion. The hub has to be upgraded before it can connect.
+var ErrProtocolVersionRefused = errors.New("control refused this hub's protocol version")
+
 type hubLiveness struct {
 	alive     bool
 	retiredAt time.Time
@@ -125,6 +164,15 @@ type ClientConfig struct {
 	NextProto map[string]func(hs *http.Server, tlsConn *tls.Conn, h http.Handler)
 
 	FilterRoute func(*pb.ServiceRoute) bool
+
+	// The capabilities advertised to control, which only assigns agents
+	// requiring one to hubs that have it.
+	Capabilities []string
+
+	// Have ResolveLabelLink follow a resolved target that is itself linked
+	// to further labels of the same account, up to MaxLabelLinkHops links.
+	// Only the first link is resolved otherwise.
+	FollowLabelLinks bool
 }
 
 func NewClient(ctx context.Context, cfg ClientConfig) (*Client, error) {
@@ -247,9 +295,10 @@ func (c *Client) LearnLocations(def *pb.LabelSet) ([]*pb.NetworkLocation, error)
 
 func (c *Client) BootstrapConfig(ctx context.Context) error {
 	resp, err := c.client.FetchConfig(ctx, &pb.ConfigRequest{
-		StableId:   c.StableId(),
-		InstanceId: c.instanceId,
-		Locations:  c.netloc,
+		StableId:     c.StableId(),
+		InstanceId:   c.instanceId,
+		Locations:    c.netloc,
+		Capabilities: c.cfg.Capabilities,
 	})
 	if err != nil {
 		return err
@@ -259,18 +308,57 @@ func (c *Client) BootstrapConfig(ctx context.Context) error {
 	c.rawtlsKey = resp.TlsKey
 	c.tokenPub = resp.TokenPub
 
+	c.mu.Lock()
+	c.reconnect = resp.Reconnect
+	c.flowIdleTimeout = time.Duration(resp.FlowIdleTimeout)
+	c.tlsPolicy = resp.TlsPolicy
+	c.agentClientCAs = nil
+	c.mu.Unlock()
+
+	if len(resp.AgentClientCa) > 0 {
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(resp.AgentClientCa) {
+			return errors.New("no certificates found in agent client ca")
+		}
+
+		c.mu.Lock()
+		c.agentClientCAs = pool
+		c.mu.Unlock()
+	}
+
+	if len(resp.TokenKeys) > 0 {
+		keys := make(map[string]token.PublicKey)
+
+		for _, k := range resp.TokenKeys {
+			alg, err := token.ParseAlgorithm(k.Algorithm)
+			if err != nil {
+				c.L.Warn("ignoring token key of unsupported algorithm", "key-id", k.KeyId, "algorithm", k.Algorithm)
+				continue
+			}
+
+			keys[k.KeyId] = token.PublicKey{Algorithm: alg, Key: k.PublicKey}
+		}
+
+		c.tokenKeys = keys
+	}
+
 	cert, err := tls.X509KeyPair(c.rawtlsCert, c.rawtlsKey)
 	if err != nil {
 		return err
 	}
 
+	c.mu.Lock()
 	c.tlsCert = &cert
+	c.trackSnakeOil(c.rawtlsCert)
+	c.mu.Unlock()
 
 	if resp.S3AccessKey != "" {
 		L := c.L
 
 		L.Info("reconfiguring s3 access to use server provided credentials",
 			"bucket", resp.S3Bucket,
+			"region", resp.S3Region,
 			"access-key", resp.S3AccessKey,
 			"token-pub", hex.EncodeToString(c.tokenPub),
 		)
@@ -279,6 +367,12 @@ func (c *Client) BootstrapConfig(ctx context.Context) error {
 
 		cfg.WithCredentials(credentials.NewStaticCredentials(resp.S3AccessKey, resp.S3SecretKey, ""))
 
+		// The control server's bucket may be in another region than the
+		// hub's own.
+		if resp.S3Region != "" {
+			cfg.WithRegion(resp.S3Region)
+		}
+
 		c.cfg.Session = session.New(&cfg)
 		c.s3api = s3.New(c.cfg.Session)
 
@@ -297,6 +391,12 @@ func (c *Client) TokenPub() ed25519.PublicKey {
 	return c.tokenPub
 }
 
+// The keys, by key id, that tokens should be validated against. Empty if
+// the control server did not advertise a key set.
+func (c *Client) TokenKeys() map[string]token.PublicKey {
+	return c.tokenKeys
+}
+
 type NPNHandler func(hs *http.Server, c *tls.Conn, h http.Handler)
 
 func (c *Client) RunIngress(ctx context.Context, li net.Listener, npn map[string]NPNHandler, h http.Handler) error {
@@ -304,19 +404,65 @@ func (c *Client) RunIngress(ctx context.Context, li net.Listener, npn map[string
 
 	var cfg tls.Config
 
+	c.mu.Lock()
 	if c.tlsCert == nil {
 		cert, err := tls.X509KeyPair(c.rawtlsCert, c.rawtlsKey)
 		if err != nil {
+			c.mu.Unlock()
 			return err
 		}
 
 		c.tlsCert = &cert
+		c.trackSnakeOil(c.rawtlsCert)
 	}
+	c.mu.Unlock()
 
+	// Read under c.mu, central can replace the cert while running.
 	cfg.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		c.noteHandshake(hello)
+
 		return c.tlsCert, nil
 	}
 
+	agentProtos := make(map[string]bool)
+
+	for proto := range c.cfg.NextProto {
+		agentProtos[proto] = true
+	}
+
+	for proto := range npn {
+		agentProtos[proto] = true
+	}
+
+	// Client certificates are only asked of agents, so that browsers
+	// using the same listener aren't prompted for one. They're verified
+	// if given and then checked against the account's TLS policy once the
+	// agent has identified itself.
+	cfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		c.mu.RLock()
+		pool := c.agentClientCAs
+		c.mu.RUnlock()
+
+		if pool == nil {
+			return nil, nil
+		}
+
+		for _, proto := range hello.SupportedProtos {
+			if agentProtos[proto] {
+				agentCfg := cfg.Clone()
+				agentCfg.ClientAuth = tls.VerifyClientCertIfGiven
+				agentCfg.ClientCAs = pool
+
+				return agentCfg, nil
+			}
+		}
+
+		return nil, nil
+	}
+
 	hs := &http.Server{
 		Handler:   h,
 		TLSConfig: &cfg,
@@ -459,6 +605,112 @@ func (c *RouteCalculation) Services() []*pb.ServiceRoute {
 	return c.shuffle(c.All)
 }
 
+// FlowIdleTimeout returns how long a flow for account may go without
+// traffic in either direction before the hub tears it down. The account's
+// override, if any, takes precedence over the control server's default.
+// Zero means the flow is never torn down for being idle.
+func (c *Client) FlowIdleTimeout(account *pb.Account) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if info, ok := c.accountServices[account.StringKey()]; ok {
+		info.Mu.RLock()
+		services := info.Services
+		info.Mu.RUnlock()
+
+		if services != nil {
+			switch {
+			case services.FlowIdleTimeout > 0:
+				return time.Duration(services.FlowIdleTimeout)
+			case services.FlowIdleTimeout < 0:
+				return 0
+			}
+		}
+	}
+
+	return c.flowIdleTimeout
+}
+
+// AccountTLSPolicy returns the TLS policy the account's agents must meet,
+// the control server's default tightened by the account's own policy. The
+// account's policy is part of its routing data, which is fetched here if it
+// hasn't been yet. When that fails, the copy left in the work directory by
+// an earlier fetch is used, and failing that, just the default, so that an
+// S3 outage doesn't lock out agents of accounts this hub hasn't seen yet.
+func (c *Client) AccountTLSPolicy(account *pb.Account) *pb.TLSPolicy {
+	accStr := account.StringKey()
+
+	c.mu.RLock()
+	def := c.tlsPolicy
+	info, ok := c.accountServices[accStr]
+	c.mu.RUnlock()
+
+	if !ok {
+		info = &accountInfo{
+			MapKey:   accStr,
+			S3Key:    "account_services/" + account.HashKey(),
+			LastUse:  time.Now(),
+			FileName: account.HashKey(),
+			Process:  make(chan struct{}),
+		}
+
+		c.mu.Lock()
+		if existing, ok := c.accountServices[accStr]; ok {
+			info = existing
+		} else {
+			c.accountServices[accStr] = info
+		}
+		c.mu.Unlock()
+	}
+
+	info.Mu.RLock()
+	fetched, services := info.Fetched, info.Services
+	info.Mu.RUnlock()
+
+	// Fetched outside of c.mu, so a slow S3 doesn't hold up every lookup.
+	if !fetched {
+		err := c.refreshAcconut(c.L, info)
+		if err == nil {
+			info.Mu.RLock()
+			services = info.Services
+			info.Mu.RUnlock()
+		} else {
+			services, err = c.readAccountFile(info)
+			if err != nil {
+				c.L.Warn("unable to read the account tls policy, applying the default",
+					"account", accStr, "error", err)
+			} else {
+				c.L.Warn("unable to fetch the account tls policy, applying the copy on disk",
+					"account", accStr)
+			}
+		}
+	}
+
+	return mergeTLSPolicy(def, services.GetTlsPolicy())
+}
+
+// Read the account's data as last downloaded by refreshAcconut, possibly by
+// an earlier run of the hub.
+func (c *Client) readAccountFile(info *accountInfo) (*pb.AccountServices, error) {
+	compressedData, err := ioutil.ReadFile(filepath.Join(c.workDir, info.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := zstdDecompress(compressedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var ac pb.AccountServices
+	err = ac.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ac, nil
+}
+
 func (c *Client) LookupService(ctx context.Context, account *pb.Account, labels *pb.LabelSet) (*RouteCalculation, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -585,11 +837,13 @@ func (c *Client) LookupService(ctx context.Context, account *pb.Account, labels
 	return ret, nil
 }
 
-func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) {
+// Fetch the account's data from S3, unless it hasn't changed since it was
+// last fetched.
+func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) error {
 	tmp, err := ioutil.TempFile(c.workDir, info.FileName)
 	if err != nil {
 		L.Error("error creating temp file for account data", "error", err)
-		return
+		return err
 	}
 
 	defer os.Remove(tmp.Name())
@@ -599,8 +853,12 @@ func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) {
 		Key:    &info.S3Key,
 	}
 
-	if info.LastMD5 != "" {
-		obj.IfNoneMatch = aws.String(info.LastMD5)
+	info.Mu.RLock()
+	lastMD5 := info.LastMD5
+	info.Mu.RUnlock()
+
+	if lastMD5 != "" {
+		obj.IfNoneMatch = aws.String(lastMD5)
 	}
 
 	resp, err := c.s3api.GetObject(obj)
@@ -608,16 +866,21 @@ func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) {
 		if rf, ok := err.(awserr.RequestFailure); ok {
 			if rf.StatusCode() == 304 {
 				L.Trace("account data not modified", "key", info.S3Key)
-				return
+				return nil
 			}
 
 			if rf.StatusCode() == 404 {
 				L.Trace("no account data available", "key", info.S3Key)
-				return
+
+				info.Mu.Lock()
+				info.Fetched = true
+				info.Mu.Unlock()
+
+				return nil
 			}
 		}
 		L.Error("error fetching account data", "error", err, "key", info.S3Key, "bucket", c.bucket)
-		return
+		return err
 	}
 
 	defer resp.Body.Close()
@@ -625,7 +888,7 @@ func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) {
 	n, err := io.Copy(tmp, resp.Body)
 	if err != nil {
 		L.Error("error copying s3 object to disk", "error", err)
-		return
+		return err
 	}
 
 	L.Debug("downloaded account data", "key", info.S3Key, "size", n)
@@ -633,40 +896,42 @@ func (c *Client) refreshAcconut(L hclog.Logger, info *accountInfo) {
 	err = os.Rename(tmp.Name(), filepath.Join(c.workDir, info.FileName))
 	if err != nil {
 		L.Error("error renaming account data", "tmpfile", tmp.Name(), "target", info.FileName)
-		return
+		return err
 	}
 
 	_, err = tmp.Seek(0, os.SEEK_SET)
 	if err != nil {
 		L.Error("error seeking account data to start of file", "error", err)
-		return
+		return err
 	}
 
 	compressedData, err := ioutil.ReadAll(tmp)
 	if err != nil {
 		L.Error("error reading account data", "error", err)
-		return
+		return err
 	}
 
 	data, err := zstdDecompress(compressedData)
 	if err != nil {
 		L.Error("error uncompressing data", "error", err)
-		return
+		return err
 	}
 
 	var ac pb.AccountServices
 	err = ac.Unmarshal(data)
 	if err != nil {
 		L.Error("error unmarshaling account services", "error", err)
-		return
+		return err
 	}
 
-	info.LastMD5 = *resp.ETag
-
 	info.Mu.Lock()
 	defer info.Mu.Unlock()
 
+	info.LastMD5 = *resp.ETag
 	info.Services = &ac
+	info.Fetched = true
+
+	return nil
 }
 
 func (c *Client) checkAccounts(L hclog.Logger) {
@@ -697,6 +962,8 @@ func (c *Client) streamActivity(
 			Hub:       c.instanceId,
 			StableHub: c.cfg.Id,
 			Locations: c.netloc,
+
+			ProtocolVersion: ProtocolVersion,
 		},
 		SentAt: pb.NewTimestamp(time.Now()),
 	})
@@ -713,8 +980,19 @@ func (c *Client) streamActivity(
 		for {
 			ca, err := activity.Recv()
 			if err != nil {
-				if status, ok := status.FromError(err); ok && status.Code() == codes.Canceled {
-					return
+				if status, ok := status.FromError(err); ok {
+					switch status.Code() {
+					case codes.Canceled:
+						return
+					case codes.FailedPrecondition:
+						L.Error("control refused this hub's protocol version", "version", ProtocolVersion, "error", status.Message())
+
+						c.mu.Lock()
+						c.refused = errors.Wrapf(ErrProtocolVersionRefused, "%s", status.Message())
+						c.mu.Unlock()
+
+						return
+					}
 				}
 
 				L.Error("error reading activity", "error", err)
@@ -747,9 +1025,20 @@ func (c *Client) Run(ctx context.Context) error {
 
 	activityChan := make(chan *pb.CentralActivity)
 
+	// Each activity stream gets its own context so it can be closed while
+	// a new one is opened, such as when moving to another control server.
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	defer func() {
+		cancelStream()
+	}()
+
+	// Fires when it's time to move the activity stream, as requested by a
+	// draining control server.
+	var migrate <-chan time.Time
+
 	if c.client != nil {
 		L.Debug("configuring activity stream")
-		activity, err = c.streamActivity(ctx, L, activityChan)
+		activity, err = c.streamActivity(streamCtx, L, activityChan)
 		if err != nil {
 			return err
 		}
@@ -780,22 +1069,61 @@ func (c *Client) Run(ctx context.Context) error {
 				default:
 				}
 
+				c.mu.RLock()
+				refused := c.refused
+				c.mu.RUnlock()
+
+				if refused != nil {
+					return refused
+				}
+
 				L.Error("detected activity stream closed, reconnecting...")
 				activityChan = make(chan *pb.CentralActivity)
-				for {
-					activity, err = c.streamActivity(ctx, L, activityChan)
-					if err == nil {
-						break
-					}
+
+				cancelStream()
+				streamCtx, cancelStream = context.WithCancel(ctx)
+
+				activity, err = c.reconnectActivity(streamCtx, L, activityChan)
+				if err != nil {
+					return err
 				}
+
 				L.Info("rebootstraping after activity stream reconnection")
 				err = c.BootstrapConfig(ctx)
 				if err != nil {
 					L.Error("error bootstraping new configuration", "error", err)
 				}
 			} else {
+				if ev.MigrateStream != nil {
+					delay := migrateDelay(ev.MigrateStream.Within)
+					L.Info("control server is draining, moving activity stream", "delay", delay)
+					migrate = time.After(delay)
+				}
+
 				c.processCentralActivity(ctx, L, ev)
 			}
+		case <-migrate:
+			migrate = nil
+
+			// The new stream is opened before the old one is closed so no
+			// activity is missed. The draining server has stopped accepting
+			// new streams, so it lands on another control server.
+			newChan := make(chan *pb.CentralActivity)
+			newCtx, cancelNew := context.WithCancel(ctx)
+
+			na, err := c.streamActivity(newCtx, L, newChan)
+			if err != nil {
+				cancelNew()
+				L.Error("error opening new activity stream, keeping the current one", "error", err)
+				continue
+			}
+
+			activity.CloseSend()
+			cancelStream()
+
+			activity, activityChan, cancelStream = na, newChan, cancelNew
+
+			L.Info("moved activity stream")
 		case act := <-c.hubActivity:
 			if activity != nil {
 				activity.Send(act)
@@ -804,6 +1132,50 @@ func (c *Client) Run(ctx context.Context) error {
 	}
 }
 
+// Reopen the activity stream, pacing attempts with the reconnect policy
+// advertised by control so that hubs don't all reconnect at once after
+// control restarts.
+func (c *Client) reconnectActivity(
+	ctx context.Context,
+	L hclog.Logger,
+	activityChan chan *pb.CentralActivity,
+) (pb.ControlServices_StreamActivityClient, error) {
+	c.mu.RLock()
+	policy := c.reconnect
+	c.mu.RUnlock()
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for attempt := 0; ; attempt++ {
+		delay := reconnectDelay(policy, attempt, rng)
+
+		L.Debug("waiting to reconnect activity stream", "attempt", attempt, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		activity, err := c.streamActivity(ctx, L, activityChan)
+		if err == nil {
+			return activity, nil
+		}
+
+		L.Warn("error reconnecting activity stream", "error", err, "attempt", attempt)
+	}
+}
+
+// A random point within the window a draining control server gives hubs
+// to move their activity streams, so they don't all move at once.
+func migrateDelay(within int64) time.Duration {
+	if within <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(within))
+}
+
 func (c *Client) processCentralActivity(ctx context.Context, L hclog.Logger, ev *pb.CentralActivity) {
 	L.Debug("processing activity from central")
 
@@ -827,7 +1199,38 @@ func (c *Client) processCentralActivity(ctx context.Context, L hclog.Logger, ev
 
 	if ev.NewLabelLinks != nil {
 		L.Debug("updating recent label links")
-		c.recentLabelLinks = append(c.recentLabelLinks, ev.NewLabelLinks.LabelLinks...)
+
+		c.labelMu.Lock()
+		c.recentLabelLinks = mergeRecentLabelLinks(c.recentLabelLinks, ev.NewLabelLinks.LabelLinks)
+		c.labelMu.Unlock()
+	}
+
+	if ev.HubTls != nil {
+		cert, err := tls.X509KeyPair(ev.HubTls.Cert, ev.HubTls.Key)
+		if err != nil {
+			L.Error("ignoring unparseable tls material from central", "error", err)
+		} else {
+			L.Info("replacing tls certificate with one from central")
+
+			c.mu.Lock()
+			c.rawtlsCert = ev.HubTls.Cert
+			c.rawtlsKey = ev.HubTls.Key
+			c.tlsCert = &cert
+			c.trackSnakeOil(ev.HubTls.Cert)
+			c.mu.Unlock()
+		}
+	}
+
+	if len(ev.KillFlows) > 0 {
+		c.mu.RLock()
+		kill := c.flowKiller
+		c.mu.RUnlock()
+
+		for _, id := range ev.KillFlows {
+			if kill == nil || !kill(id) {
+				L.Warn("requested to kill unknown flow", "flow", id)
+			}
+		}
 	}
 
 	if ev.HubChange != nil {
@@ -871,6 +1274,15 @@ func (c *Client) processCentralActivity(ctx context.Context, L hclog.Logger, ev
 	}
 }
 
+// SetFlowKiller sets the function used to tear down flows when the control
+// server asks for it.
+func (c *Client) SetFlowKiller(f func(id *pb.ULID) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.flowKiller = f
+}
+
 func (c *Client) SendFlow(rec *pb.FlowRecord) {
 	c.hubActivity <- &pb.HubActivity{
 		Flow: []*pb.FlowRecord{rec},
@@ -968,6 +1380,30 @@ func (c *Client) updateLabelLinks(ctx context.Context, L hclog.Logger) error {
 		return err
 	}
 
+	// Links stored before labels were validated on creation may differ in
+	// case, which normalizing takes care of, or still be malformed, and
+	// routing on those does more harm than dropping them.
+	valid := lls.LabelLinks[:0]
+
+	for _, ll := range lls.LabelLinks {
+		ll.Labels.Normalize()
+		ll.Target.Normalize()
+
+		err := ll.Labels.Validate()
+		if err == nil {
+			err = ll.Target.Validate()
+		}
+
+		if err != nil {
+			L.Warn("ignoring invalid label link", "account", ll.Account.SpecString(), "error", err)
+			continue
+		}
+
+		valid = append(valid, ll)
+	}
+
+	lls.LabelLinks = valid
+
 	c.lastLabelMD5 = *resp.ETag
 
 	c.labelMu.Lock()
@@ -980,12 +1416,47 @@ func (c *Client) updateLabelLinks(ctx context.Context, L hclog.Logger) error {
 	return err
 }
 
+// ResolveLabelLink returns the account and target labels that label is
+// linked to. With ClientConfig.FollowLabelLinks, a target that is itself
+// linked to further labels of the same account is followed, up to
+// MaxLabelLinkHops links.
 func (c *Client) ResolveLabelLink(label *pb.LabelSet) (*pb.Account, *pb.LabelSet, *pb.Account_Limits, error) {
 	c.labelMu.RLock()
 	defer c.labelMu.RUnlock()
 
 	label.Finalize()
 
+	account, target, limits, err := c.resolveLabelLink(label)
+	if err != nil || target == nil || !c.cfg.FollowLabelLinks {
+		return account, target, limits, err
+	}
+
+	for hops := 1; ; hops++ {
+		nextAccount, next, nextLimits, err := c.resolveLabelLink(target)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if next == nil || !nextAccount.Equal(account) {
+			return account, target, limits, nil
+		}
+
+		if hops >= MaxLabelLinkHops {
+			c.L.Warn("label link resolution exceeded the hop limit, the links likely form a cycle",
+				"account", account.SpecString(),
+				"labels", label.SpecString(),
+				"hops", hops,
+			)
+
+			return nil, nil, nil, errors.Wrapf(ErrLabelLinkHops, "resolving %s", label.SpecString())
+		}
+
+		target, limits = next, nextLimits
+	}
+}
+
+// Resolve a single link for label. labelMu must be held.
+func (c *Client) resolveLabelLink(label *pb.LabelSet) (*pb.Account, *pb.LabelSet, *pb.Account_Limits, error) {
 	mature := 0
 
 	if c.labelLinks != nil {
@@ -998,32 +1469,69 @@ func (c *Client) ResolveLabelLink(label *pb.LabelSet) (*pb.Account, *pb.LabelSet
 		"mature", mature,
 	)
 
-	for _, ll := range c.recentLabelLinks {
-		if ll.Labels.Equal(label) {
-			return ll.Account, ll.Target, ll.Limits, nil
-		}
+	if lls := matchingLabelLinks(c.recentLabelLinks, label); len(lls) > 0 {
+		return resolvedLabelLink(lls)
 	}
 
 	// We move the recent to lessRecent when we update all the label links.
 	// This 2 layer technique means we have no gaps where we might miss an
 	// immediate update.
-	for _, ll := range c.lessRecentLabelLinks {
-		if ll.Labels.Equal(label) {
-			return ll.Account, ll.Target, ll.Limits, nil
-		}
+	if lls := matchingLabelLinks(c.lessRecentLabelLinks, label); len(lls) > 0 {
+		return resolvedLabelLink(lls)
 	}
 
 	if c.labelLinks == nil {
 		return nil, nil, nil, nil
 	}
 
-	for _, ll := range c.labelLinks.LabelLinks {
+	return resolvedLabelLink(matchingLabelLinks(c.labelLinks.LabelLinks, label))
+}
+
+// The links in lls for label. Each set of links, whether recent or mature,
+// holds every link for a label, so the union of two sets would split traffic
+// over targets that no longer exist.
+func matchingLabelLinks(lls []*pb.LabelLink, label *pb.LabelSet) []*pb.LabelLink {
+	var out []*pb.LabelLink
+
+	for _, ll := range lls {
 		if ll.Labels.Equal(label) {
-			return ll.Account, ll.Target, ll.Limits, nil
+			out = append(out, ll)
+		}
+	}
+
+	return out
+}
+
+func resolvedLabelLink(lls []*pb.LabelLink) (*pb.Account, *pb.LabelSet, *pb.Account_Limits, error) {
+	ll := pickLabelLink(lls, rand.Int63n)
+	if ll == nil {
+		return nil, nil, nil, nil
+	}
+
+	return ll.Account, ll.Target, ll.Limits, nil
+}
+
+// Add the broadcast links to recent, replacing the links recent already had
+// for the same account and labels, as a broadcast carries all of them.
+func mergeRecentLabelLinks(recent, broadcast []*pb.LabelLink) []*pb.LabelLink {
+	out := recent[:0]
+
+	for _, ll := range recent {
+		replaced := false
+
+		for _, nl := range broadcast {
+			if ll.Account.Equal(nl.Account) && ll.Labels.Equal(nl.Labels) {
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			out = append(out, ll)
 		}
 	}
 
-	return nil, nil, nil, nil
+	return append(out, broadcast...)
 }
 
 func (c *Client) AllHubs(ctx context.Context) ([]*pb.HubInfo, error) {
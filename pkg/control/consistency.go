@@ -0,0 +1,31 @@
+package control
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/metadata"
+)
+
+// Callers that need a read to see writes they've just made, on any RPC
+// that reads from ServerConfig.ReadDB, set this metadata key to "true".
+const ReadPrimaryMetadataKey = "hzn-read-primary"
+
+// The database a read-only request reads from. Unless consistent is set, or
+// the caller set ReadPrimaryMetadataKey, this is ServerConfig.ReadDB when
+// configured, which may lag behind writes the caller has just made.
+func (s *Server) listDB(ctx context.Context, consistent bool) *gorm.DB {
+	cfg := s.config()
+
+	if consistent || cfg.ReadDB == nil {
+		return s.db
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(ReadPrimaryMetadataKey); len(vals) > 0 && vals[0] == "true" {
+			return s.db
+		}
+	}
+
+	return cfg.ReadDB
+}
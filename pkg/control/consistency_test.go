@@ -0,0 +1,85 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestConsistentRead(t *testing.T) {
+	db := testsql.TestPostgresDB(t, "hzn")
+	defer db.Close()
+
+	// Stands in for a replica that hasn't caught up yet.
+	lagging := testsql.TestPostgresDB(t, "hzn_lagging")
+	defer lagging.Close()
+
+	var s Server
+	s.L = hclog.L()
+	s.db = db
+	s.cfg.ReadDB = lagging
+
+	account := &pb.Account{
+		Namespace: "/",
+		AccountId: pb.NewULID(),
+	}
+
+	err := dbx.Check(db.Create(&Service{
+		ServiceId: pb.NewULID().Bytes(),
+		HubId:     pb.NewULID().Bytes(),
+		AccountId: account.Key(),
+		Type:      "test",
+		Labels:    pq.StringArray{"env=test"},
+	}))
+	require.NoError(t, err)
+
+	t.Run("reads lists from the read db by default", func(t *testing.T) {
+		resp, err := s.ListServices(context.Background(), &pb.ListServicesRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, resp.Services)
+	})
+
+	t.Run("reads from the primary when asked for a consistent read", func(t *testing.T) {
+		resp, err := s.ListServices(context.Background(), &pb.ListServicesRequest{
+			Account:        account,
+			ConsistentRead: true,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, resp.Services, 1)
+	})
+
+	t.Run("reads from the primary when the caller sets the metadata key", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(ReadPrimaryMetadataKey, "true"))
+
+		resp, err := s.ListServices(ctx, &pb.ListServicesRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, resp.Services, 1)
+	})
+
+	t.Run("uses the primary when no read db is configured", func(t *testing.T) {
+		s.cfg.ReadDB = nil
+
+		resp, err := s.ListServices(context.Background(), &pb.ListServicesRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, resp.Services, 1)
+	})
+}
@@ -0,0 +1,103 @@
+package control
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Query metrics labeled by operation and table. Tables come from the
+// models, so the label set stays small; the SQL itself is never a label.
+var (
+	dbQueryDurations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "control_db_query_duration_seconds",
+			Help:    "The latency of database queries, by operation and table.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 15),
+		},
+		[]string{"operation", "table"},
+	)
+
+	dbQueryRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "control_db_rows_total",
+			Help: "The number of rows returned or affected by database queries, by operation and table.",
+		},
+		[]string{"operation", "table"},
+	)
+
+	dbQueryErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "control_db_errors_total",
+			Help: "The number of failed database queries, by operation and table.",
+		},
+		[]string{"operation", "table"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(dbQueryDurations, dbQueryRows, dbQueryErrors)
+}
+
+const dbQueryStartKey = "hzn:query_start"
+
+// InstrumentDB registers callbacks on db that record every create, query,
+// update and delete in the query metrics. Statements run with Exec don't
+// pass through gorm's callbacks and aren't recorded. gorm shares callbacks
+// between connections, so instrumenting more than one db is a no-op.
+func InstrumentDB(db *gorm.DB) {
+	cb := db.Callback()
+
+	if cb.Query().Get("hzn:metrics_start") != nil {
+		return
+	}
+
+	cb.Create().Before("gorm:create").Register("hzn:metrics_start", startDBQuery)
+	cb.Create().After("gorm:create").Register("hzn:metrics_end", endDBQuery("create"))
+
+	cb.Query().Before("gorm:query").Register("hzn:metrics_start", startDBQuery)
+	cb.Query().After("gorm:query").Register("hzn:metrics_end", endDBQuery("query"))
+
+	cb.Update().Before("gorm:update").Register("hzn:metrics_start", startDBQuery)
+	cb.Update().After("gorm:update").Register("hzn:metrics_end", endDBQuery("update"))
+
+	cb.Delete().Before("gorm:delete").Register("hzn:metrics_start", startDBQuery)
+	cb.Delete().After("gorm:delete").Register("hzn:metrics_end", endDBQuery("delete"))
+
+	cb.RowQuery().Before("gorm:row_query").Register("hzn:metrics_start", startDBQuery)
+	cb.RowQuery().After("gorm:row_query").Register("hzn:metrics_end", endDBQuery("row_query"))
+}
+
+func startDBQuery(scope *gorm.Scope) {
+	scope.InstanceSet(dbQueryStartKey, time.Now())
+}
+
+func endDBQuery(operation string) func(scope *gorm.Scope) {
+	return func(scope *gorm.Scope) {
+		v, ok := scope.InstanceGet(dbQueryStartKey)
+		if !ok {
+			return
+		}
+
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := scope.TableName()
+		if table == "" {
+			table = "unknown"
+		}
+
+		dbQueryDurations.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+
+		// Not finding a record is an answer, not a failure.
+		if scope.HasError() && !gorm.IsRecordNotFoundError(scope.DB().Error) {
+			dbQueryErrors.WithLabelValues(operation, table).Inc()
+			return
+		}
+
+		dbQueryRows.WithLabelValues(operation, table).Add(float64(scope.DB().RowsAffected))
+	}
+}
@@ -0,0 +1,49 @@
+package control
+
+import (
+	"testing"
+
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBMetrics(t *testing.T) {
+	t.Run("records queries by operation and table", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		InstrumentDB(db)
+		InstrumentDB(db)
+
+		rows := func(op string) float64 {
+			return testutil.ToFloat64(dbQueryRows.WithLabelValues(op, "management_clients"))
+		}
+
+		errs := func(op string) float64 {
+			return testutil.ToFloat64(dbQueryErrors.WithLabelValues(op, "management_clients"))
+		}
+
+		creates, queries, queryErrors := rows("create"), rows("query"), errs("query")
+
+		rec := ManagementClient{
+			ID:        pb.NewULID().Bytes(),
+			Namespace: "/db-metrics",
+		}
+
+		require.NoError(t, dbx.Check(db.Create(&rec)))
+
+		var found []*ManagementClient
+		require.NoError(t, dbx.Check(db.Where("namespace = ?", "/db-metrics").Find(&found)))
+
+		var missing ManagementClient
+		db.Where("namespace = ?", "/not-there").First(&missing)
+
+		assert.Equal(t, creates+1, rows("create"))
+		assert.Equal(t, queries+1, rows("query"))
+		assert.Equal(t, queryErrors, errs("query"))
+	})
+}
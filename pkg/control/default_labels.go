@@ -0,0 +1,143 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+)
+
+// AccountDefaultLabels are merged into the labels of every service
+// registered for an account, so that conventions such as tagging services
+// with a tenant or environment are applied centrally.
+type AccountDefaultLabels struct {
+	AccountID []byte `gorm:"primary_key"`
+	Labels    pq.StringArray
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// The default labels of account, or nil when it has none.
+func accountDefaultLabels(db *gorm.DB, account *pb.Account) (*pb.LabelSet, error) {
+	var adl AccountDefaultLabels
+
+	err := dbx.Check(db.Where("account_id = ?", account.Key()).First(&adl))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var ls pb.LabelSet
+
+	err = ls.Scan(adl.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ls, nil
+}
+
+// Returns labels with the labels in defaults added, except for the ones
+// labels already has a label of the same name for.
+func mergeDefaultLabels(labels, defaults *pb.LabelSet) *pb.LabelSet {
+	if defaults == nil || len(defaults.Labels) == 0 {
+		return labels
+	}
+
+	var out pb.LabelSet
+
+	names := make(map[string]bool)
+
+	for _, lbl := range labels.Labels {
+		names[lbl.Name] = true
+		out.Labels = append(out.Labels, lbl)
+	}
+
+	for _, lbl := range defaults.Labels {
+		if !names[lbl.Name] {
+			out.Labels = append(out.Labels, lbl)
+		}
+	}
+
+	out.Finalize()
+
+	return &out
+}
+
+func (s *Server) SetAccountDefaultLabels(ctx context.Context, req *pb.SetAccountDefaultLabelsRequest) (*pb.Noop, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkFeatureAccount(caller, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Labels == nil || len(req.Labels.Labels) == 0 {
+		err = dbx.Check(s.db.Where("account_id = ?", req.Account.Key()).Delete(AccountDefaultLabels{}))
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger(ctx).Info("account default labels cleared", "account", req.Account.SpecString())
+
+		s.auditAccount(ctx, caller, "clear-account-default-labels", req.Account.SpecString(), nil)
+
+		return &pb.Noop{}, nil
+	}
+
+	err = validateLabels("labels", req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Labels.Finalize()
+
+	err = dbx.Check(s.db.Exec(
+		`INSERT INTO account_default_labels (account_id, labels) VALUES (?, ?)
+		 ON CONFLICT (account_id) DO UPDATE SET labels = EXCLUDED.labels, updated_at = now()`,
+		req.Account.Key(), req.Labels.AsStringArray(),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("account default labels set",
+		"account", req.Account.SpecString(),
+		"labels", req.Labels.SpecString(),
+	)
+
+	s.auditAccount(ctx, caller, "set-account-default-labels", req.Account.SpecString(), map[string]interface{}{
+		"labels": req.Labels.SpecString(),
+	})
+
+	return &pb.Noop{}, nil
+}
+
+func (s *Server) GetAccountDefaultLabels(ctx context.Context, req *pb.GetAccountDefaultLabelsRequest) (*pb.GetAccountDefaultLabelsResponse, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkFeatureAccount(caller, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := accountDefaultLabels(s.listDB(ctx, req.ConsistentRead), req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAccountDefaultLabelsResponse{Labels: labels}, nil
+}
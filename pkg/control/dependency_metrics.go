@@ -0,0 +1,105 @@
+package control
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Latency of calls made to external dependencies, such as vault and S3,
+// so their contribution to request latency can be measured.
+var (
+	dependencyDurations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "control_dependency_duration_seconds",
+			Help:    "The latency of calls to external dependencies, by dependency and operation.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+		},
+		[]string{"dependency", "operation"},
+	)
+
+	dependencyErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "control_dependency_errors_total",
+			Help: "The number of failed calls to external dependencies, by dependency and operation.",
+		},
+		[]string{"dependency", "operation"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(dependencyDurations, dependencyErrors)
+}
+
+func recordDependencyCall(dependency, operation string, dur time.Duration, failed bool) {
+	dependencyDurations.WithLabelValues(dependency, operation).Observe(dur.Seconds())
+
+	if failed {
+		dependencyErrors.WithLabelValues(dependency, operation).Inc()
+	}
+}
+
+type instrumentedTransport struct {
+	dependency string
+	next       http.RoundTripper
+}
+
+// InstrumentTransport wraps next so that each request is recorded in the
+// dependency latency metrics under dependency. Requests are grouped into
+// operations by method and the first two segments of the vault API path,
+// ie "PUT transit/sign", which keeps the label set small.
+func InstrumentTransport(dependency string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &instrumentedTransport{dependency: dependency, next: next}
+}
+
+func vaultOperation(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/")
+
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+
+	return req.Method + " " + strings.Join(parts, "/")
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := t.next.RoundTrip(req)
+
+	failed := err != nil || resp.StatusCode >= 500
+
+	recordDependencyCall(t.dependency, vaultOperation(req), time.Since(start), failed)
+
+	return resp, err
+}
+
+// InstrumentAWSSession records the latency of every call made by clients
+// created from sess, including retries, labeled with the service and
+// operation, ie "s3" and "PutObject".
+func InstrumentAWSSession(sess *session.Session) {
+	sess.Handlers.Complete.PushBack(func(r *request.Request) {
+		failed := r.Error != nil
+
+		// Missing objects are an expected outcome rather than a failure.
+		if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == http.StatusNotFound {
+			failed = false
+		}
+
+		operation := "unknown"
+		if r.Operation != nil {
+			operation = r.Operation.Name
+		}
+
+		recordDependencyCall(r.ClientInfo.ServiceName, operation, time.Since(r.Time), failed)
+	})
+}
@@ -0,0 +1,43 @@
+package control
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyMetrics(t *testing.T) {
+	t.Run("groups vault requests by operation", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/v1/hzn-k1/sign/some-key", nil)
+		assert.Equal(t, "PUT hzn-k1/sign", vaultOperation(req))
+
+		req = httptest.NewRequest("GET", "/v1/sys/health", nil)
+		assert.Equal(t, "GET sys/health", vaultOperation(req))
+	})
+
+	t.Run("counts failed calls", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/test/fail" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: InstrumentTransport("metrics-test", nil)}
+
+		resp, err := client.Get(srv.URL + "/v1/test/ok")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = client.Get(srv.URL + "/v1/test/fail")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, float64(0), testutil.ToFloat64(dependencyErrors.WithLabelValues("metrics-test", "GET test/ok")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(dependencyErrors.WithLabelValues("metrics-test", "GET test/fail")))
+	})
+}
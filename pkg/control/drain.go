@@ -0,0 +1,121 @@
+package control
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
+)
+
+// The window hubs are given to move their activity streams when
+// ServerConfig.DrainWindow isn't set.
+const DefaultDrainWindow = 30 * time.Second
+
+// Extra time past the drain window for the last streams to close before
+// the remaining connections are cut.
+var DrainGrace = 5 * time.Second
+
+var errDraining = errors.New("server is draining")
+
+// Drain shuts down hs gracefully. The server reports itself as not ready,
+// stops accepting new connections and streams, and asks every connected
+// hub to move its activity stream to another control server within the
+// drain window. Connections still open once the window and DrainGrace
+// have passed are closed.
+func (s *Server) Drain(ctx context.Context, hs *http.Server) error {
+	if !atomic.CompareAndSwapInt32(&s.draining, 0, 1) {
+		return errDraining
+	}
+
+	window := s.config().DrainWindow
+	if window <= 0 {
+		window = DefaultDrainWindow
+	}
+
+	s.AddReadinessCheck("draining", func(ctx context.Context) error {
+		return errDraining
+	})
+
+	s.L.Info("draining, asking hubs to move their activity streams", "window", window)
+
+	ctx, cancel := context.WithTimeout(ctx, window+DrainGrace)
+	defer cancel()
+
+	// Shutdown tells HTTP/2 clients to open new streams on a new
+	// connection, so it has to start before the hubs move.
+	shutdown := make(chan error, 1)
+
+	go func() {
+		shutdown <- hs.Shutdown(ctx)
+	}()
+
+	hubs := s.migrateHubStreams(window)
+
+	s.L.Info("asked hubs to move their activity streams", "hubs", hubs)
+
+	err := <-shutdown
+	if err == nil {
+		s.L.Info("drain complete, all connections closed")
+		return nil
+	}
+
+	s.mu.Lock()
+	remaining := len(s.connectedHubs)
+	s.mu.Unlock()
+
+	s.L.Warn("drain window passed, closing remaining connections", "hubs", remaining)
+
+	return hs.Close()
+}
+
+// Send every connected hub a request to move its activity stream within
+// window, returning the number of hubs asked. The hubs are asked at once,
+// so a hub that's slow to take the request only holds up itself, and any
+// not asked within the window are given up on.
+func (s *Server) migrateHubStreams(window time.Duration) int {
+	act := &pb.CentralActivity{
+		MigrateStream: &pb.MigrateStream{
+			Within: int64(window),
+		},
+	}
+
+	s.mu.Lock()
+
+	hubs := make(map[string]*connectedHub, len(s.connectedHubs))
+	for key, ch := range s.connectedHubs {
+		hubs[key] = ch
+	}
+
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), window)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		sent int64
+	)
+
+	for key, ch := range hubs {
+		wg.Add(1)
+
+		go func(key string, ch *connectedHub) {
+			defer wg.Done()
+
+			select {
+			case ch.xmit <- act:
+				atomic.AddInt64(&sent, 1)
+			case <-ctx.Done():
+				s.L.Warn("time out asking hub to move its activity stream", "hub", key)
+			}
+		}(key, ch)
+	}
+
+	wg.Wait()
+
+	return int(sent)
+}
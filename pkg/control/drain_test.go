@@ -0,0 +1,94 @@
+package control
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrain(t *testing.T) {
+	t.Run("asks connected hubs to move their streams", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.connectedHubs = map[string]*connectedHub{
+			"hub1": {xmit: make(chan *pb.CentralActivity, 1)},
+			"hub2": {xmit: make(chan *pb.CentralActivity, 1)},
+		}
+
+		n := s.migrateHubStreams(time.Minute)
+		assert.Equal(t, 2, n)
+
+		for _, ch := range s.connectedHubs {
+			act := <-ch.xmit
+			require.NotNil(t, act.MigrateStream)
+			assert.Equal(t, int64(time.Minute), act.MigrateStream.Within)
+		}
+	})
+
+	t.Run("asks every hub at once within the window", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.connectedHubs = map[string]*connectedHub{
+			"stuck1": {xmit: make(chan *pb.CentralActivity)},
+			"stuck2": {xmit: make(chan *pb.CentralActivity)},
+			"hub":    {xmit: make(chan *pb.CentralActivity, 1)},
+		}
+
+		start := time.Now()
+
+		n := s.migrateHubStreams(200 * time.Millisecond)
+		assert.Equal(t, 1, n)
+
+		// The stuck hubs are waited on together, not one after another.
+		assert.True(t, time.Since(start) < 400*time.Millisecond)
+	})
+
+	t.Run("shuts down the http server and reports not ready", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.cfg.DrainWindow = time.Second
+		s.connectedHubs = map[string]*connectedHub{}
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		hs := &http.Server{Handler: http.NotFoundHandler()}
+
+		served := make(chan error, 1)
+		go func() {
+			served <- hs.Serve(l)
+		}()
+
+		err = s.Drain(context.Background(), hs)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.ErrServerClosed, <-served)
+
+		failures := s.checkReadiness(context.Background())
+		assert.Contains(t, failures, "draining")
+
+		err = s.Drain(context.Background(), hs)
+		assert.Error(t, err)
+
+		err = s.StreamActivity(nil)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("spreads stream moves over the window", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), migrateDelay(0))
+
+		for i := 0; i < 100; i++ {
+			d := migrateDelay(int64(time.Second))
+			assert.True(t, d >= 0 && d < time.Second)
+		}
+	})
+}
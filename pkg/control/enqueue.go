@@ -0,0 +1,85 @@
+package control
+
+import (
+	"context"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) jobRegistry() *workq.Registry {
+	cfg := s.config()
+
+	if cfg.JobRegistry != nil {
+		return cfg.JobRegistry
+	}
+
+	return workq.GlobalRegistry
+}
+
+// EnqueueJob queues a job for the workers, such as a run of
+// cleanup-activity-log outside of its schedule. The job type must have a
+// registered handler that accepts the payload, given as JSON. Jobs done on
+// behalf of an account can be queued on the account's own queue when
+// AccountJobQueues is set. It requires the ops token.
+func (s *Server) EnqueueJob(ctx context.Context, req *pb.EnqueueJobRequest) (*pb.EnqueueJobResponse, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	if req.JobType == "" {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing job type")
+	}
+
+	payload := req.Payload
+	if len(payload) == 0 {
+		payload = []byte("null")
+	}
+
+	// The payload is given as JSON, whatever codec the job type uses.
+	payload, codec, err := s.jobRegistry().PayloadFromJSON(req.JobType, payload)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "%s", err)
+	}
+
+	job := workq.NewJob()
+	job.JobType = req.JobType
+	job.Payload = payload
+	job.PayloadCodec = codec
+	job.Queue = req.Queue
+	job.Priority = int(req.Priority)
+
+	if req.Account != nil {
+		if req.Queue != "" {
+			return nil, errors.Wrapf(ErrInvalidRequest, "only one of queue and account may be given")
+		}
+
+		// No worker would serve the account's queue.
+		if !s.config().AccountJobQueues {
+			return nil, errors.Wrapf(ErrInvalidRequest, "account job queues aren't enabled")
+		}
+
+		err = s.resolveAlias(req.Account, "")
+		if err != nil {
+			return nil, err
+		}
+
+		job.Queue = workq.AccountQueue(req.Account.StringKey())
+	}
+
+	if job.Queue == "" {
+		job.Queue = "default"
+	}
+
+	err = workq.NewInjector(s.L, s.db).Inject(job)
+	if err != nil {
+		return nil, err
+	}
+
+	id := pb.ULIDFromBytes(job.Id)
+
+	s.logger(ctx).Info("enqueued job", "job-id", id.SpecString(), "job-type", job.JobType, "queue", job.Queue, "priority", job.Priority)
+
+	return &pb.EnqueueJobResponse{JobId: id}, nil
+}
@@ -0,0 +1,133 @@
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestEnqueueJob(t *testing.T) {
+	db := testsql.TestPostgresDB(t, "hzn")
+	defer db.Close()
+
+	type cleanup struct {
+		Before string
+	}
+
+	var reg workq.Registry
+
+	reg.Register("cleanup-things", func(ctx context.Context, jt string, c *cleanup) error {
+		return nil
+	})
+
+	reg.Register("cleanup-activity-log", func(ctx context.Context, jt string, _ *struct{}) error {
+		return nil
+	})
+
+	var s Server
+	s.L = hclog.L()
+	s.db = db
+	s.opsToken = "ddeeff"
+	s.cfg.JobRegistry = &reg
+
+	withAuth := func(auth string) context.Context {
+		md := make(metadata.MD)
+		md.Set("authorization", auth)
+
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	t.Run("enqueues a job for a registered handler", func(t *testing.T) {
+		resp, err := s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType:  "cleanup-things",
+			Payload:  []byte(`{"Before": "1h"}`),
+			Queue:    "maintenance",
+			Priority: 5,
+		})
+		require.NoError(t, err)
+
+		job, err := workq.GetJob(db, resp.JobId.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, "cleanup-things", job.JobType)
+		assert.Equal(t, "maintenance", job.Queue)
+		assert.Equal(t, 5, job.Priority)
+		assert.Equal(t, "queued", job.Status)
+		assert.JSONEq(t, `{"Before": "1h"}`, string(job.Payload))
+	})
+
+	t.Run("defaults the queue and payload", func(t *testing.T) {
+		resp, err := s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-activity-log",
+		})
+		require.NoError(t, err)
+
+		job, err := workq.GetJob(db, resp.JobId.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, "default", job.Queue)
+		assert.Equal(t, "null", string(job.Payload))
+	})
+
+	t.Run("queues jobs of an account on its own queue", func(t *testing.T) {
+		account := &pb.Account{Namespace: "/test", AccountId: pb.NewULID()}
+
+		_, err := s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-activity-log",
+			Account: account,
+		})
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+
+		s.cfg.AccountJobQueues = true
+		defer func() { s.cfg.AccountJobQueues = false }()
+
+		resp, err := s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-activity-log",
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		job, err := workq.GetJob(db, resp.JobId.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, workq.AccountQueue(account.StringKey()), job.Queue)
+
+		_, err = s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-activity-log",
+			Account: account,
+			Queue:   "maintenance",
+		})
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+	})
+
+	t.Run("rejects unknown job types and bad payloads", func(t *testing.T) {
+		_, err := s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "drop-everything",
+		})
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+
+		_, err = s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-things",
+			Payload: []byte(`{"Before": 3}`),
+		})
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+
+		_, err = s.EnqueueJob(withAuth("ddeeff"), &pb.EnqueueJobRequest{})
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+	})
+
+	t.Run("requires the ops token", func(t *testing.T) {
+		_, err := s.EnqueueJob(withAuth("aabbcc"), &pb.EnqueueJobRequest{
+			JobType: "cleanup-activity-log",
+		})
+		assert.Equal(t, ErrBadAuthentication, err)
+	})
+}
@@ -0,0 +1,238 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/pkg/errors"
+)
+
+// The job type events are delivered to the EventSink under. The handler is
+// registered by passing Server.PublishEvent to workq.RegisterHandler.
+const PublishEventJob = "publish-event"
+
+// The event types emitted for the lifecycle of accounts, services, hubs and
+// flows. Quota warnings are sent as "quota-warning". Flows starting and
+// configuration changes, including maintenance mode, are only sent to
+// WatchEvents streams.
+const (
+	EventAccountCreated  = "account-created"
+	EventServiceAdded    = "service-added"
+	EventServiceRemoved  = "service-removed"
+	EventHubConnected    = "hub-connected"
+	EventHubDisconnected = "hub-disconnected"
+	EventFlowStarted     = "flow-started"
+	EventFlowEnded       = "flow-ended"
+	EventConfigChanged   = "config-changed"
+)
+
+// An EventSink publishes events to external streaming infrastructure.
+// Events are queued as workq jobs before being published, so a Publish
+// that returns an error is retried.
+type EventSink interface {
+	Publish(ctx context.Context, ev *WebhookEvent) error
+}
+
+// NoopEventSink discards events. It's the default when no sink is
+// configured, in which case nothing is queued.
+type NoopEventSink struct{}
+
+func (NoopEventSink) Publish(ctx context.Context, ev *WebhookEvent) error {
+	return nil
+}
+
+// NewEventSink returns the sink for a URL of the form
+// nats://[user:pass@]host:port/subject-prefix. An empty URL returns a
+// NoopEventSink.
+func NewEventSink(sinkURL string) (EventSink, error) {
+	if sinkURL == "" {
+		return NoopEventSink{}, nil
+	}
+
+	u, err := url.Parse(sinkURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "nats":
+		return NewNATSEventSink(u)
+	default:
+		return nil, fmt.Errorf("unsupported event sink: %s", u.Scheme)
+	}
+}
+
+// The most events waiting to be queued for the event sink. Events emitted
+// while that many are waiting are dropped and counted as queue_failed.
+const MaxPendingSinkEvents = 10000
+
+// The most events queued for the event sink in one transaction.
+const sinkEventBatchSize = 100
+
+// Events waiting to be queued for the event sink. emitEvent only adds to
+// it, so callers such as the hub activity stream don't wait on the
+// database. RunEventQueue queues them in batches.
+type sinkEventBuffer struct {
+	mu      sync.Mutex
+	pending []*WebhookEvent
+	wake    chan struct{}
+}
+
+func (b *sinkEventBuffer) wakeup() chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.wake == nil {
+		b.wake = make(chan struct{}, 1)
+	}
+
+	return b.wake
+}
+
+func (b *sinkEventBuffer) add(ev *WebhookEvent) bool {
+	wake := b.wakeup()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.pending) >= MaxPendingSinkEvents {
+		return false
+	}
+
+	b.pending = append(b.pending, ev)
+
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+func (b *sinkEventBuffer) take(max int) []*WebhookEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.pending) < max {
+		max = len(b.pending)
+	}
+
+	out := b.pending[:max:max]
+	b.pending = b.pending[max:]
+
+	return out
+}
+
+// Emit ev to WatchEvents streams and the configured webhook and event sink.
+// Delivery to the sink goes through workq so events survive a restart of
+// the server. Events are queued in the background by RunEventQueue; a
+// failure to queue is logged.
+func (s *Server) emitEvent(ev *WebhookEvent) {
+	cfg := s.config()
+
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	s.events.publish(ev)
+
+	s.sendWebhook(ev)
+
+	if _, ok := cfg.EventSink.(NoopEventSink); cfg.EventSink == nil || ok {
+		return
+	}
+
+	if !s.sinkEvents.add(ev) {
+		s.L.Error("too many events waiting to be queued for the event sink, dropping event", "type", ev.Type)
+		s.m.IncrCounter([]string{"events", "queue_failed"}, 1)
+	}
+}
+
+// FlushEventQueue queues the events waiting for the event sink as workq
+// jobs, a batch per transaction.
+func (s *Server) FlushEventQueue() error {
+	for {
+		events := s.sinkEvents.take(sinkEventBatchSize)
+		if len(events) == 0 {
+			return nil
+		}
+
+		jobs := make([]*workq.Job, 0, len(events))
+
+		for _, ev := range events {
+			job := workq.NewJob()
+			job.Queue = s.eventQueue(ev)
+
+			err := job.Set(PublishEventJob, ev)
+			if err != nil {
+				s.L.Error("error encoding event for the event sink", "type", ev.Type, "error", err)
+				s.m.IncrCounter([]string{"events", "queue_failed"}, 1)
+				continue
+			}
+
+			jobs = append(jobs, job)
+		}
+
+		err := workq.NewInjector(s.L, s.db).InjectAll(jobs)
+		if err != nil {
+			s.m.IncrCounter([]string{"events", "queue_failed"}, float32(len(jobs)))
+			return errors.Wrapf(err, "queueing %d events for the event sink", len(jobs))
+		}
+
+		s.m.IncrCounter([]string{"events", "queued"}, float32(len(jobs)))
+	}
+}
+
+// RunEventQueue queues the events emitted for the event sink as they come
+// in until ctx is done, then flushes once more.
+func (s *Server) RunEventQueue(ctx context.Context) {
+	wake := s.sinkEvents.wakeup()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.FlushEventQueue(); err != nil {
+				s.L.Error("error queueing events", "error", err)
+			}
+
+			return
+		case <-wake:
+			if err := s.FlushEventQueue(); err != nil {
+				s.L.Error("error queueing events", "error", err)
+			}
+		}
+	}
+}
+
+// PublishEvent is the workq handler that delivers a queued event to the
+// configured EventSink.
+func (s *Server) PublishEvent(ctx context.Context, jobType string, ev *WebhookEvent) error {
+	cfg := s.config()
+
+	if cfg.EventSink == nil {
+		return nil
+	}
+
+	err := cfg.EventSink.Publish(ctx, ev)
+	if err != nil {
+		s.m.IncrCounter([]string{"events", "failed"}, 1)
+		return err
+	}
+
+	s.m.IncrCounter([]string{"events", "published"}, 1)
+
+	return nil
+}
+
+// The queue an event is delivered to the EventSink from.
+func (s *Server) eventQueue(ev *WebhookEvent) string {
+	if s.config().AccountJobQueues && ev.Account != "" {
+		return workq.AccountQueue(ev.Namespace + "!" + ev.Account)
+	}
+
+	return "default"
+}
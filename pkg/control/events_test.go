@@ -0,0 +1,224 @@
+package control
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	events []*WebhookEvent
+}
+
+func (r *recordingSink) Publish(ctx context.Context, ev *WebhookEvent) error {
+	r.events = append(r.events, ev)
+	return nil
+}
+
+// Accepts a single client, greeting it with info, answering the handshake
+// and recording the subject and payload of each PUB.
+func fakeNATSServer(t *testing.T, info string, pubs chan<- [2]string) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		defer l.Close()
+
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		conn.Write([]byte("INFO " + info + "\r\n"))
+
+		rd := bufio.NewReader(conn)
+
+		for {
+			line, err := rd.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+
+			switch fields[0] {
+			case "PING":
+				conn.Write([]byte("PONG\r\n"))
+			case "PUB":
+				payload, err := rd.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				pubs <- [2]string{fields[1], strings.TrimSpace(payload)}
+			}
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestEvents(t *testing.T) {
+	t.Run("selects the sink from the url", func(t *testing.T) {
+		sink, err := NewEventSink("")
+		require.NoError(t, err)
+		assert.Equal(t, NoopEventSink{}, sink)
+
+		sink, err = NewEventSink("nats://user:pw@nats.test/hzn/events")
+		require.NoError(t, err)
+
+		ns := sink.(*NATSEventSink)
+		assert.Equal(t, "nats.test:4222", ns.addr)
+		assert.Equal(t, "hzn.events", ns.prefix)
+		assert.Equal(t, "user", ns.user)
+		assert.Equal(t, "pw", ns.pass)
+
+		sink, err = NewEventSink("nats://nats.test:4333")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultNATSSubjectPrefix, sink.(*NATSEventSink).prefix)
+
+		_, err = NewEventSink("kafka://kafka.test")
+		assert.Error(t, err)
+	})
+
+	t.Run("publishes events to nats", func(t *testing.T) {
+		pubs := make(chan [2]string, 2)
+
+		u, err := url.Parse("nats://" + fakeNATSServer(t, `{"server_id":"test"}`, pubs))
+		require.NoError(t, err)
+
+		sink, err := NewNATSEventSink(u)
+		require.NoError(t, err)
+
+		defer sink.Close()
+
+		ctx := context.Background()
+
+		for _, typ := range []string{EventServiceAdded, EventHubConnected} {
+			err = sink.Publish(ctx, &WebhookEvent{
+				Type:    typ,
+				Account: "acc",
+			})
+			require.NoError(t, err)
+		}
+
+		pub := <-pubs
+		assert.Equal(t, "horizon.events.service-added", pub[0])
+
+		var ev WebhookEvent
+		require.NoError(t, json.Unmarshal([]byte(pub[1]), &ev))
+		assert.Equal(t, EventServiceAdded, ev.Type)
+		assert.Equal(t, "acc", ev.Account)
+
+		pub = <-pubs
+		assert.Equal(t, "horizon.events.hub-connected", pub[0])
+	})
+
+	t.Run("refuses nats servers that require tls", func(t *testing.T) {
+		pubs := make(chan [2]string, 1)
+
+		u, err := url.Parse("nats://user:pw@" + fakeNATSServer(t, `{"server_id":"test","tls_required":true}`, pubs))
+		require.NoError(t, err)
+
+		sink, err := NewNATSEventSink(u)
+		require.NoError(t, err)
+
+		defer sink.Close()
+
+		err = sink.Publish(context.Background(), &WebhookEvent{Type: EventServiceAdded})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires TLS")
+		assert.Empty(t, pubs)
+	})
+
+	t.Run("delivers events to the sink through workq", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var sink recordingSink
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+		s.cfg.EventSink = &sink
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		s.emitEvent(&WebhookEvent{
+			Type:    EventAccountCreated,
+			Account: "acc",
+		})
+
+		require.NoError(t, s.FlushEventQueue())
+
+		var jobs []*workq.Job
+		require.NoError(t, dbx.Check(db.Where("job_type = ?", PublishEventJob).Find(&jobs)))
+		require.Equal(t, 1, len(jobs))
+
+		var ev WebhookEvent
+		require.NoError(t, json.Unmarshal(jobs[0].Payload, &ev))
+
+		err := s.PublishEvent(context.Background(), PublishEventJob, &ev)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(sink.events))
+		assert.Equal(t, EventAccountCreated, sink.events[0].Type)
+		assert.Equal(t, "acc", sink.events[0].Account)
+
+		s.cfg.EventSink = NoopEventSink{}
+
+		s.emitEvent(&WebhookEvent{Type: EventAccountCreated})
+
+		require.NoError(t, s.FlushEventQueue())
+
+		require.NoError(t, dbx.Check(db.Where("job_type = ?", PublishEventJob).Find(&jobs)))
+		assert.Equal(t, 1, len(jobs))
+	})
+
+	t.Run("queues events of an account on its own queue", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+		s.cfg.EventSink = &recordingSink{}
+		s.cfg.AccountJobQueues = true
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		s.emitEvent(&WebhookEvent{
+			Type:      EventAccountCreated,
+			Namespace: "/test",
+			Account:   "acc",
+		})
+
+		s.emitEvent(&WebhookEvent{Type: EventHubConnected})
+
+		require.NoError(t, s.FlushEventQueue())
+
+		var jobs []*workq.Job
+		require.NoError(t, dbx.Check(db.Where("job_type = ?", PublishEventJob).Find(&jobs)))
+		require.Equal(t, 2, len(jobs))
+
+		// Events not about an account stay on the default queue.
+		assert.ElementsMatch(t,
+			[]string{workq.AccountQueue("/test!acc"), "default"},
+			[]string{jobs[0].Queue, jobs[1].Queue})
+	})
+}
@@ -0,0 +1,225 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The number of recent events kept for WatchEvents callers to resume from.
+// A caller that falls further behind than this has its stream ended.
+var WatchEventsBacklog = 1024
+
+type watchedEvent struct {
+	seq uint64
+	ev  *pb.ControlEvent
+}
+
+// Fans the server's events out to WatchEvents streams, keeping the most
+// recent ones so streams can resume from a cursor. Cursors are only valid
+// for the process that issued them, they're prefixed with an epoch that
+// changes on every start.
+type eventBroker struct {
+	mu      sync.Mutex
+	epoch   string
+	seq     uint64
+	recent  []watchedEvent
+	waiters map[chan struct{}]struct{}
+}
+
+func (b *eventBroker) init() {
+	if b.epoch == "" {
+		b.epoch = pb.NewULID().SpecString()
+		b.waiters = make(map[chan struct{}]struct{})
+	}
+}
+
+func (b *eventBroker) cursor(seq uint64) string {
+	return fmt.Sprintf("%s-%d", b.epoch, seq)
+}
+
+// Record ev and wake any watchers.
+func (b *eventBroker) publish(ev *WebhookEvent) {
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		data = nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.init()
+
+	b.seq++
+
+	b.recent = append(b.recent, watchedEvent{
+		seq: b.seq,
+		ev: &pb.ControlEvent{
+			Cursor:    b.cursor(b.seq),
+			Type:      ev.Type,
+			Time:      pb.NewTimestamp(ev.Time),
+			Namespace: ev.Namespace,
+			Account:   ev.Account,
+			Data:      data,
+		},
+	})
+
+	if over := len(b.recent) - WatchEventsBacklog; over > 0 {
+		b.recent = append(b.recent[:0:0], b.recent[over:]...)
+	}
+
+	for ch := range b.waiters {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// The sequence number a stream starting from cursor has seen up to. b.mu
+// must be held.
+func (b *eventBroker) resumeFrom(cursor string) (uint64, error) {
+	if cursor == "" {
+		return b.seq, nil
+	}
+
+	idx := strings.LastIndexByte(cursor, '-')
+	if idx == -1 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", cursor)
+	}
+
+	seq, err := strconv.ParseUint(cursor[idx+1:], 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", cursor)
+	}
+
+	if cursor[:idx] != b.epoch {
+		return 0, status.Errorf(codes.OutOfRange, "cursor was issued before the server restarted, watch without one")
+	}
+
+	if seq > b.seq {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", cursor)
+	}
+
+	return seq, nil
+}
+
+// The events after seq, or false if some have already been dropped from
+// the backlog.
+func (b *eventBroker) since(seq uint64) ([]watchedEvent, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.recent) > 0 && b.recent[0].seq > seq+1 {
+		return nil, false
+	}
+
+	var out []watchedEvent
+
+	for _, we := range b.recent {
+		if we.seq > seq {
+			out = append(out, we)
+		}
+	}
+
+	return out, true
+}
+
+// Start watching for events after cursor, returning the channel that's
+// signaled as they're published and the sequence number seen up to.
+func (b *eventBroker) watch(cursor string) (chan struct{}, uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.init()
+
+	seq, err := b.resumeFrom(cursor)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ch := make(chan struct{}, 1)
+	b.waiters[ch] = struct{}{}
+
+	return ch, seq, nil
+}
+
+func (b *eventBroker) unwatch(ch chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.waiters, ch)
+}
+
+// Emit an event to WatchEvents streams only, never the webhook or event
+// sink. That's the case for operational events, such as a configuration
+// change, which aren't about any account, and for flows starting, which
+// happen too often to queue a job for each.
+func (s *Server) emitWatchEvent(ev *WebhookEvent) {
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	s.events.publish(ev)
+}
+
+// WatchEvents streams events as they're emitted, such as hubs connecting,
+// flows starting and ending, and configuration changes, optionally only
+// those of the requested types. Each event carries a cursor which can be
+// passed back to resume after it, provided the caller hasn't fallen more
+// than WatchEventsBacklog events behind. It requires the ops token.
+func (s *Server) WatchEvents(req *pb.WatchEventsRequest, stream pb.ControlManagement_WatchEventsServer) error {
+	ctx := stream.Context()
+
+	if !s.checkOpsAllowed(ctx) {
+		return ErrBadAuthentication
+	}
+
+	types := make(map[string]bool)
+	for _, t := range req.Types {
+		types[t] = true
+	}
+
+	ch, seq, err := s.events.watch(req.Cursor)
+	if err != nil {
+		return err
+	}
+
+	defer s.events.unwatch(ch)
+
+	s.m.IncrCounter([]string{"events", "watchers"}, 1)
+
+	for {
+		evs, ok := s.events.since(seq)
+		if !ok {
+			s.logger(ctx).Warn("ending event watch that fell behind the backlog", "backlog", WatchEventsBacklog)
+			return status.Errorf(codes.OutOfRange, "events after cursor %s have been dropped", s.events.cursor(seq))
+		}
+
+		for _, we := range evs {
+			seq = we.seq
+
+			if len(types) > 0 && !types[we.ev.Type] {
+				continue
+			}
+
+			err = stream.Send(we.ev)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ch:
+		}
+	}
+}
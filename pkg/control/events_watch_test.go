@@ -0,0 +1,212 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.ControlEvent
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(ev *pb.ControlEvent) error {
+	w.events <- ev
+	return nil
+}
+
+func TestWatchEvents(t *testing.T) {
+	var s Server
+	s.L = hclog.L()
+	s.opsToken = "ddeeff"
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	watch := func(t *testing.T, req *pb.WatchEventsRequest) (*watchStream, func() error) {
+		ctx, cancel := context.WithCancel(
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "ddeeff")))
+
+		ws := &watchStream{ctx: ctx, events: make(chan *pb.ControlEvent, 10)}
+
+		done := make(chan error, 1)
+
+		go func() {
+			done <- s.WatchEvents(req, ws)
+		}()
+
+		return ws, func() error {
+			cancel()
+			return <-done
+		}
+	}
+
+	next := func(t *testing.T, ws *watchStream) *pb.ControlEvent {
+		select {
+		case ev := <-ws.events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+			return nil
+		}
+	}
+
+	// Waits for a watcher to be registered, so events emitted after it are
+	// seen by the new stream.
+	waitWatching := func(n int) {
+		for {
+			s.events.mu.Lock()
+			cur := len(s.events.waiters)
+			s.events.mu.Unlock()
+
+			if cur >= n {
+				return
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	t.Run("requires the ops token", func(t *testing.T) {
+		err := s.WatchEvents(&pb.WatchEventsRequest{}, &watchStream{ctx: context.Background()})
+		assert.Equal(t, ErrBadAuthentication, err)
+	})
+
+	t.Run("streams new events of the requested types", func(t *testing.T) {
+		ws, stop := watch(t, &pb.WatchEventsRequest{
+			Types: []string{EventHubConnected},
+		})
+
+		waitWatching(1)
+
+		s.emitWatchEvent(&WebhookEvent{Type: EventConfigChanged})
+		s.emitWatchEvent(&WebhookEvent{
+			Type: EventHubConnected,
+			Data: map[string]interface{}{"hub": "h1"},
+		})
+
+		ev := next(t, ws)
+		assert.Equal(t, EventHubConnected, ev.Type)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(ev.Data, &data))
+		assert.Equal(t, "h1", data["hub"])
+
+		require.NoError(t, stop())
+	})
+
+	t.Run("resumes from a cursor", func(t *testing.T) {
+		ws, stop := watch(t, &pb.WatchEventsRequest{})
+
+		waitWatching(1)
+
+		s.emitWatchEvent(&WebhookEvent{Type: EventHubConnected})
+
+		first := next(t, ws)
+
+		require.NoError(t, stop())
+
+		s.emitWatchEvent(&WebhookEvent{Type: EventHubDisconnected})
+
+		ws, stop = watch(t, &pb.WatchEventsRequest{Cursor: first.Cursor})
+
+		ev := next(t, ws)
+		assert.Equal(t, EventHubDisconnected, ev.Type)
+
+		require.NoError(t, stop())
+	})
+
+	t.Run("reports the totals of ended flows", func(t *testing.T) {
+		ws, stop := watch(t, &pb.WatchEventsRequest{Types: []string{EventFlowEnded}})
+
+		waitWatching(1)
+
+		ft, err := NewFlowTop(10)
+		require.NoError(t, err)
+
+		s.flowTop = ft
+		s.activeFlows = make(map[string]*activeFlow)
+
+		ch := &connectedHub{
+			messages:     new(int64),
+			bytes:        new(int64),
+			activeAgents: new(int64),
+			services:     new(int64),
+			activeFlows:  new(int64),
+		}
+
+		account := &pb.Account{Namespace: "/test", AccountId: pb.NewULID()}
+		flow := pb.NewULID()
+
+		// Hubs report what's been sent since their last report.
+		for _, n := range []int64{3, 4} {
+			s.processFlows(ch, []*pb.FlowRecord{{
+				Stream: &pb.FlowStream{
+					FlowId:      flow,
+					Account:     account,
+					NumMessages: n,
+					NumBytes:    n * 10,
+				},
+			}})
+		}
+
+		s.processFlows(ch, []*pb.FlowRecord{{
+			Stream: &pb.FlowStream{
+				FlowId:      flow,
+				Account:     account,
+				NumMessages: 1,
+				NumBytes:    10,
+				EndedAt:     pb.NewTimestamp(time.Now()),
+			},
+		}})
+
+		ev := next(t, ws)
+		assert.Equal(t, EventFlowEnded, ev.Type)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(ev.Data, &data))
+		assert.Equal(t, float64(8), data["messages"])
+		assert.Equal(t, float64(80), data["bytes"])
+
+		require.NoError(t, stop())
+	})
+
+	t.Run("rejects cursors it can't resume from", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "ddeeff"))
+
+		err := s.WatchEvents(&pb.WatchEventsRequest{Cursor: "nope"}, &watchStream{ctx: ctx})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		err = s.WatchEvents(&pb.WatchEventsRequest{Cursor: pb.NewULID().SpecString() + "-1"}, &watchStream{ctx: ctx})
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+		old := WatchEventsBacklog
+		defer func() { WatchEventsBacklog = old }()
+
+		WatchEventsBacklog = 2
+
+		first := s.events.cursor(s.events.seq)
+
+		for i := 0; i < 3; i++ {
+			s.emitWatchEvent(&WebhookEvent{Type: EventHubConnected})
+		}
+
+		err = s.WatchEvents(&pb.WatchEventsRequest{Cursor: first}, &watchStream{ctx: ctx})
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+}
@@ -0,0 +1,127 @@
+package control
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// External ids are assigned by callers to correlate accounts with their own
+// tenant identifiers. They're restricted to a conservative character set so
+// they can be used safely in logs and URLs.
+var externalIDRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:-]{0,127}$`)
+
+func validExternalID(id string) bool {
+	return externalIDRegexp.MatchString(id)
+}
+
+// Find the account in namespace with the given external id.
+func (s *Server) accountByExternalID(namespace, id string) (*pb.Account, error) {
+	if !validExternalID(id) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid external id")
+	}
+
+	var ao Account
+
+	err := dbx.Check(
+		s.db.Where("namespace = ?", namespace).
+			Where("external_id = ?", id).
+			First(&ao),
+	)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(ErrInvalidRequest, "no account with external id %s", id)
+		}
+
+		return nil, err
+	}
+
+	return pb.AccountFromKey(ao.ID)
+}
+
+// Resolve the account a request refers to, and check that caller may act
+// on it. When externalID is set, the account is looked up by it within the
+// requested namespace, defaulting to the caller's. If the request also
+// names an account id, it must match. Otherwise account is resolved in
+// place, in case it's an alias, with an unset namespace filled in from the
+// caller.
+func (s *Server) resolveAccount(caller *accountCaller, account *pb.Account, externalID string) (*pb.Account, error) {
+	if externalID == "" {
+		if account == nil || account.AccountId == nil {
+			return nil, errors.Wrapf(ErrInvalidRequest, "missing account")
+		}
+
+		if account.Namespace == "" {
+			account.Namespace = caller.namespace()
+		}
+
+		err := s.resolveAlias(account, "")
+		if err != nil {
+			return nil, err
+		}
+
+		err = caller.allowed(account)
+		if err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	}
+
+	namespace := caller.namespace()
+	if account != nil && account.Namespace != "" {
+		namespace = account.Namespace
+	}
+
+	if caller.mgmt != nil && !caller.mgmt.AllowAccount(namespace) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	}
+
+	found, err := s.accountByExternalID(namespace, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account != nil && account.AccountId != nil {
+		err = s.resolveAlias(account, namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if account != nil && account.AccountId != nil && !account.AccountId.Equal(found.AccountId) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "external id does not match the account")
+	}
+
+	err = caller.allowed(found)
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (s *Server) LookupAccount(ctx context.Context, req *pb.LookupAccountRequest) (*pb.LookupAccountResponse, error) {
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExternalId == "" {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing external id")
+	}
+
+	account, err := s.resolveAccount(&accountCaller{mgmt: caller}, &pb.Account{Namespace: req.Namespace}, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LookupAccountResponse{
+		Account:    account,
+		ExternalId: req.ExternalId,
+	}, nil
+}
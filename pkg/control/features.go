@@ -0,0 +1,142 @@
+package control
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// An AccountFeature is a named flag enabled or disabled for a single
+// account, used to roll out new behavior to some accounts before others.
+type AccountFeature struct {
+	AccountID []byte `gorm:"primary_key"`
+	Name      string `gorm:"primary_key"`
+	Enabled   bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var featureNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// AccountHasFeature reports whether the named feature is enabled for
+// account. Features that have never been set are disabled.
+func (s *Server) AccountHasFeature(account *pb.Account, name string) (bool, error) {
+	var feat AccountFeature
+
+	err := dbx.Check(
+		s.db.Where("account_id = ?", account.Key()).
+			Where("name = ?", name).
+			First(&feat),
+	)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return feat.Enabled, nil
+}
+
+// Check that caller may manage the features of account, which must exist.
+// account is resolved in place, see resolveAccount.
+func (s *Server) checkFeatureAccount(caller *accountCaller, account *pb.Account) error {
+	_, err := s.resolveAccount(caller, account, "")
+	if err != nil {
+		return err
+	}
+
+	var ao Account
+
+	err = dbx.Check(s.db.First(&ao, account.Key()))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.Wrapf(ErrInvalidRequest, "unknown account")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) SetAccountFeature(ctx context.Context, req *pb.SetAccountFeatureRequest) (*pb.Noop, error) {
+	caller, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !featureNameRegexp.MatchString(req.Name) {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid feature name")
+	}
+
+	err = s.checkFeatureAccount(&accountCaller{mgmt: caller}, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Disabled features are kept rather than deleted so the explicit
+	// decision is visible when listing them.
+	err = dbx.Check(s.db.Exec(
+		`INSERT INTO account_features (account_id, name, enabled) VALUES (?, ?, ?)
+		 ON CONFLICT (account_id, name) DO UPDATE SET enabled = EXCLUDED.enabled, updated_at = now()`,
+		req.Account.Key(), req.Name, req.Enabled,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("account feature set",
+		"account", req.Account.SpecString(),
+		"feature", req.Name,
+		"enabled", req.Enabled,
+	)
+
+	s.audit(ctx, caller, "set-account-feature", req.Account.SpecString(), map[string]interface{}{
+		"feature": req.Name,
+		"enabled": req.Enabled,
+	})
+
+	return &pb.Noop{}, nil
+}
+
+func (s *Server) GetAccountFeatures(ctx context.Context, req *pb.GetAccountFeaturesRequest) (*pb.GetAccountFeaturesResponse, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkFeatureAccount(caller, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	var feats []*AccountFeature
+
+	err = dbx.Check(
+		s.listDB(ctx, false).Where("account_id = ?", req.Account.Key()).
+			Order("name").
+			Find(&feats),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.GetAccountFeaturesResponse
+
+	for _, f := range feats {
+		resp.Features = append(resp.Features, &pb.AccountFeature{
+			Name:    f.Name,
+			Enabled: f.Enabled,
+		})
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,51 @@
+package control
+
+import (
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// go-metrics only exposes samples as prometheus summaries, so the flow
+// duration histogram is registered with prometheus directly. It is served
+// by the same /metrics endpoint as everything else.
+var flowDurations = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "flow_duration_seconds",
+		Help:    "The lifetime of flows that have ended, by termination reason.",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	prometheus.MustRegister(flowDurations)
+}
+
+// The label value used for a flow's termination reason, ie "hub_drain".
+func flowEndReasonLabel(r pb.FlowStream_EndReason) string {
+	return strings.ToLower(strings.TrimPrefix(r.String(), "END_"))
+}
+
+// Record the duration and termination reason of a flow that has ended.
+// Flows sent by hubs that predate end reasons are recorded as unknown.
+func recordFlowEnd(m *metrics.Metrics, fs *pb.FlowStream) {
+	reason := flowEndReasonLabel(fs.EndReason)
+
+	dur := time.Duration(fs.Duration)
+	if dur <= 0 && fs.StartedAt != nil {
+		dur = fs.EndedAt.Time().Sub(fs.StartedAt.Time())
+	}
+
+	flowDurations.WithLabelValues(reason).Observe(dur.Seconds())
+
+	m.IncrCounterWithLabels([]string{"flow", "terminated"}, 1, []metrics.Label{
+		{
+			Name:  "reason",
+			Value: reason,
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowMetrics(t *testing.T) {
+	t.Run("labels reasons without the enum prefix", func(t *testing.T) {
+		assert.Equal(t, "normal", flowEndReasonLabel(pb.END_NORMAL))
+		assert.Equal(t, "hub_drain", flowEndReasonLabel(pb.END_HUB_DRAIN))
+		assert.Equal(t, "unknown", flowEndReasonLabel(pb.END_UNKNOWN))
+	})
+
+	t.Run("counts ended flows by reason", func(t *testing.T) {
+		sink := metrics.NewInmemSink(time.Minute, time.Minute)
+
+		m, err := metrics.New(metrics.DefaultConfig("test"), sink)
+		require.NoError(t, err)
+
+		m.EnableHostname = false
+
+		now := time.Now()
+
+		recordFlowEnd(m, &pb.FlowStream{
+			StartedAt: pb.NewTimestamp(now.Add(-time.Minute)),
+			EndedAt:   pb.NewTimestamp(now),
+			EndReason: pb.END_TIMEOUT,
+		})
+
+		data := sink.Data()
+		require.Len(t, data, 1)
+
+		c, ok := data[0].Counters["test.flow.terminated;reason=timeout"]
+		require.True(t, ok, "counters: %v", data[0].Counters)
+
+		assert.Equal(t, 1, c.Count)
+	})
+}
@@ -0,0 +1,354 @@
+package control
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// The rollup windows flow activity is aggregated into, by the name they
+// can be requested by in FlowTopRequest.
+var FlowRollupWindows = map[string]time.Duration{
+	"1m": time.Minute,
+	"5m": 5 * time.Minute,
+	"1h": time.Hour,
+}
+
+var (
+	// How long rollups are kept by default before CleanupFlowRollups
+	// removes them.
+	DefaultFlowRollupRetention = 7 * 24 * time.Hour
+
+	// How often the aggregated flow activity is written to the database.
+	FlowRollupFlushInterval = 10 * time.Second
+
+	// The most rollups held in memory. Rollups that couldn't be written
+	// are kept for the next flush, so this bounds what an outage of the
+	// database costs; activity for further flows and buckets is dropped.
+	MaxPendingFlowRollups = 100000
+)
+
+// A FlowRollup is the activity of a single flow within one bucket of a
+// rollup window.
+type FlowRollup struct {
+	WindowSeconds int       `gorm:"primary_key"`
+	BucketStart   time.Time `gorm:"primary_key"`
+	FlowID        []byte    `gorm:"primary_key"`
+
+	AccountID []byte
+	HubID     []byte
+	AgentID   []byte
+	ServiceID []byte
+
+	NumMessages int64
+	NumBytes    int64
+	EndedAt     *time.Time
+
+	UpdatedAt time.Time
+}
+
+type flowRollupKey struct {
+	window int
+	bucket int64
+	flow   string
+}
+
+// Accumulates flow activity in memory between flushes, so that the
+// database sees one write per flow and bucket rather than one per report.
+type flowRollupBuffer struct {
+	mu      sync.Mutex
+	pending map[flowRollupKey]*FlowRollup
+
+	// The rollups dropped over MaxPendingFlowRollups since the last take.
+	dropped int
+}
+
+func rollupKey(fr *FlowRollup) flowRollupKey {
+	return flowRollupKey{
+		window: fr.WindowSeconds,
+		bucket: fr.BucketStart.Unix(),
+		flow:   string(fr.FlowID),
+	}
+}
+
+func ulidBytes(u *pb.ULID) []byte {
+	if u == nil {
+		return nil
+	}
+
+	return u.Bytes()
+}
+
+func (b *flowRollupBuffer) add(rec *pb.FlowStream, now time.Time) {
+	if rec.FlowId == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending == nil {
+		b.pending = make(map[flowRollupKey]*FlowRollup)
+	}
+
+	for _, window := range FlowRollupWindows {
+		bucket := now.Truncate(window)
+
+		key := flowRollupKey{
+			window: int(window / time.Second),
+			bucket: bucket.Unix(),
+			flow:   string(rec.FlowId.Bytes()),
+		}
+
+		fr, ok := b.pending[key]
+		if !ok {
+			if len(b.pending) >= MaxPendingFlowRollups {
+				b.dropped++
+				continue
+			}
+
+			fr = &FlowRollup{
+				WindowSeconds: key.window,
+				BucketStart:   bucket,
+				FlowID:        rec.FlowId.Bytes(),
+				HubID:         ulidBytes(rec.HubId),
+				AgentID:       ulidBytes(rec.AgentId),
+				ServiceID:     ulidBytes(rec.ServiceId),
+			}
+
+			if rec.Account != nil && rec.Account.AccountId != nil {
+				fr.AccountID = rec.Account.Key()
+			}
+
+			b.pending[key] = fr
+		}
+
+		fr.NumMessages += rec.NumMessages
+		fr.NumBytes += rec.NumBytes
+
+		if rec.EndedAt != nil {
+			t := rec.EndedAt.Time()
+			fr.EndedAt = &t
+		}
+	}
+}
+
+// Remove the pending rollups, returning them and how many were dropped
+// since the last take.
+func (b *flowRollupBuffer) take() ([]*FlowRollup, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]*FlowRollup, 0, len(b.pending))
+
+	for _, fr := range b.pending {
+		out = append(out, fr)
+	}
+
+	dropped := b.dropped
+
+	b.pending = nil
+	b.dropped = 0
+
+	return out, dropped
+}
+
+// Put back rollups that couldn't be written, merging them with whatever
+// was added to the same buckets in the meantime.
+func (b *flowRollupBuffer) restore(rollups []*FlowRollup) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending == nil {
+		b.pending = make(map[flowRollupKey]*FlowRollup)
+	}
+
+	for _, fr := range rollups {
+		key := rollupKey(fr)
+
+		cur, ok := b.pending[key]
+		if !ok {
+			if len(b.pending) >= MaxPendingFlowRollups {
+				b.dropped++
+				continue
+			}
+
+			b.pending[key] = fr
+			continue
+		}
+
+		cur.NumMessages += fr.NumMessages
+		cur.NumBytes += fr.NumBytes
+
+		if cur.EndedAt == nil {
+			cur.EndedAt = fr.EndedAt
+		}
+	}
+}
+
+// FlushFlowRollups writes the flow activity aggregated since the last
+// flush, adding it to what's already stored for each bucket. Rollups that
+// aren't written are kept for the next flush.
+func (s *Server) FlushFlowRollups() error {
+	rollups, dropped := s.rollups.take()
+
+	if dropped > 0 {
+		s.L.Warn("dropped flow rollups over the pending limit", "dropped", dropped, "limit", MaxPendingFlowRollups)
+		s.m.IncrCounter([]string{"flow_rollups", "dropped"}, float32(dropped))
+	}
+
+	for i, fr := range rollups {
+		err := dbx.Check(s.db.Exec(
+			`INSERT INTO flow_rollups
+			   (window_seconds, bucket_start, flow_id, account_id, hub_id, agent_id, service_id, num_messages, num_bytes, ended_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			 ON CONFLICT (window_seconds, bucket_start, flow_id) DO UPDATE SET
+			   num_messages = flow_rollups.num_messages + EXCLUDED.num_messages,
+			   num_bytes = flow_rollups.num_bytes + EXCLUDED.num_bytes,
+			   ended_at = COALESCE(EXCLUDED.ended_at, flow_rollups.ended_at),
+			   updated_at = now()`,
+			fr.WindowSeconds, fr.BucketStart, fr.FlowID, fr.AccountID, fr.HubID, fr.AgentID, fr.ServiceID,
+			fr.NumMessages, fr.NumBytes, fr.EndedAt,
+		))
+		if err != nil {
+			s.rollups.restore(rollups[i:])
+			return errors.Wrapf(err, "writing flow rollup")
+		}
+	}
+
+	return nil
+}
+
+// RunFlowRollups flushes the aggregated flow activity every
+// FlowRollupFlushInterval until ctx is done, then flushes once more.
+func (s *Server) RunFlowRollups(ctx context.Context) {
+	ticker := time.NewTicker(FlowRollupFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.FlushFlowRollups(); err != nil {
+				s.L.Error("error flushing flow rollups", "error", err)
+			}
+
+			return
+		case <-ticker.C:
+			if err := s.FlushFlowRollups(); err != nil {
+				s.L.Error("error flushing flow rollups", "error", err)
+			}
+		}
+	}
+}
+
+// The records of the most recent bucket of the named rollup window, with
+// the busiest flows first.
+func (s *Server) flowRollupSnapshot(name string, max int32) (*pb.FlowTopSnapshot, error) {
+	window, ok := FlowRollupWindows[name]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidRequest, "unknown rollup window: %s", name)
+	}
+
+	seconds := int(window / time.Second)
+
+	var latest FlowRollup
+
+	err := dbx.Check(
+		s.db.Where("window_seconds = ?", seconds).
+			Order("bucket_start DESC").
+			First(&latest),
+	)
+
+	snap := &pb.FlowTopSnapshot{Window: name}
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return snap, nil
+		}
+
+		return nil, err
+	}
+
+	snap.BucketStart = pb.NewTimestamp(latest.BucketStart)
+
+	q := s.db.Where("window_seconds = ?", seconds).
+		Where("bucket_start = ?", latest.BucketStart).
+		Order("num_bytes DESC, flow_id ASC")
+
+	if max > 0 {
+		q = q.Limit(max)
+	}
+
+	var rollups []*FlowRollup
+
+	err = dbx.Check(q.Find(&rollups))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fr := range rollups {
+		rec := &pb.FlowStream{
+			FlowId:      pb.ULIDFromBytes(fr.FlowID),
+			NumMessages: fr.NumMessages,
+			NumBytes:    fr.NumBytes,
+		}
+
+		if len(fr.HubID) > 0 {
+			rec.HubId = pb.ULIDFromBytes(fr.HubID)
+		}
+
+		if len(fr.AgentID) > 0 {
+			rec.AgentId = pb.ULIDFromBytes(fr.AgentID)
+		}
+
+		if len(fr.ServiceID) > 0 {
+			rec.ServiceId = pb.ULIDFromBytes(fr.ServiceID)
+		}
+
+		if len(fr.AccountID) > 0 {
+			if account, err := pb.AccountFromKey(fr.AccountID); err == nil {
+				rec.Account = account
+			}
+		}
+
+		if fr.EndedAt != nil {
+			rec.EndedAt = pb.NewTimestamp(*fr.EndedAt)
+		}
+
+		snap.Records = append(snap.Records, rec)
+	}
+
+	return snap, nil
+}
+
+// FlowRollupCleaner removes flow rollups older than Retention.
+type FlowRollupCleaner struct {
+	DB *gorm.DB
+
+	// Defaults to DefaultFlowRollupRetention.
+	Retention time.Duration
+}
+
+func (c *FlowRollupCleaner) CleanupFlowRollups(ctx context.Context, jobType string, _ *struct{}) error {
+	retention := c.Retention
+	if retention <= 0 {
+		retention = DefaultFlowRollupRetention
+	}
+
+	res := c.DB.Exec("DELETE FROM flow_rollups WHERE bucket_start < ?", time.Now().Add(-retention))
+
+	err := dbx.Check(res)
+	if err != nil {
+		return err
+	}
+
+	hclog.FromContext(ctx).Info("pruned flow rollups", "deleted", res.RowsAffected, "older-than", retention)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowRollups(t *testing.T) {
+	L := hclog.L()
+
+	setup := func(t *testing.T) *Server {
+		db := testsql.TestPostgresDB(t, "hzn")
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.cfg.FlowRollups = true
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		return &s
+	}
+
+	account := &pb.Account{
+		Namespace: "/",
+		AccountId: pb.NewULID(),
+	}
+
+	t.Run("aggregates flow activity into each window", func(t *testing.T) {
+		s := setup(t)
+		defer s.db.Close()
+
+		busy := pb.NewULID()
+		quiet := pb.NewULID()
+
+		now := time.Now()
+
+		s.rollups.add(&pb.FlowStream{FlowId: busy, Account: account, NumMessages: 2, NumBytes: 200}, now)
+		s.rollups.add(&pb.FlowStream{FlowId: quiet, Account: account, NumMessages: 1, NumBytes: 10}, now)
+		require.NoError(t, s.FlushFlowRollups())
+
+		// Later reports are added to the stored bucket.
+		s.rollups.add(&pb.FlowStream{FlowId: busy, Account: account, NumMessages: 3, NumBytes: 300}, now)
+		require.NoError(t, s.FlushFlowRollups())
+
+		var count int
+		require.NoError(t, dbx.Check(s.db.Model(&FlowRollup{}).Count(&count)))
+		assert.Equal(t, 2*len(FlowRollupWindows), count)
+
+		for name := range FlowRollupWindows {
+			snap, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: name})
+			require.NoError(t, err)
+
+			assert.Equal(t, name, snap.Window)
+			require.NotNil(t, snap.BucketStart)
+			require.Len(t, snap.Records, 2)
+
+			assert.Equal(t, busy, snap.Records[0].FlowId)
+			assert.Equal(t, int64(5), snap.Records[0].NumMessages)
+			assert.Equal(t, int64(500), snap.Records[0].NumBytes)
+			assert.True(t, account.Equal(snap.Records[0].Account))
+
+			assert.Equal(t, quiet, snap.Records[1].FlowId)
+		}
+
+		snap, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: "1m", MaxRecords: 1})
+		require.NoError(t, err)
+		require.Len(t, snap.Records, 1)
+		assert.Equal(t, busy, snap.Records[0].FlowId)
+	})
+
+	t.Run("reads the latest bucket of the window", func(t *testing.T) {
+		s := setup(t)
+		defer s.db.Close()
+
+		old := pb.NewULID()
+		cur := pb.NewULID()
+
+		now := time.Now()
+
+		s.rollups.add(&pb.FlowStream{FlowId: old, NumBytes: 1000}, now.Add(-2*time.Minute))
+		s.rollups.add(&pb.FlowStream{FlowId: cur, NumBytes: 1}, now)
+		require.NoError(t, s.FlushFlowRollups())
+
+		snap, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: "1m"})
+		require.NoError(t, err)
+		require.Len(t, snap.Records, 1)
+		assert.Equal(t, cur, snap.Records[0].FlowId)
+		assert.Equal(t, now.Truncate(time.Minute).Unix(), snap.BucketStart.Time().Unix())
+	})
+
+	t.Run("rejects unknown windows", func(t *testing.T) {
+		s := setup(t)
+		defer s.db.Close()
+
+		_, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: "1d"})
+		require.Error(t, err)
+		assert.Equal(t, ErrInvalidRequest, errors.Cause(err))
+
+		snap, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: "5m"})
+		require.NoError(t, err)
+		assert.Empty(t, snap.Records)
+	})
+
+	t.Run("removes rollups past the retention", func(t *testing.T) {
+		s := setup(t)
+		defer s.db.Close()
+
+		now := time.Now()
+
+		s.rollups.add(&pb.FlowStream{FlowId: pb.NewULID(), NumBytes: 1}, now.Add(-3*time.Hour))
+		s.rollups.add(&pb.FlowStream{FlowId: pb.NewULID(), NumBytes: 1}, now)
+		require.NoError(t, s.FlushFlowRollups())
+
+		rc := &FlowRollupCleaner{DB: s.db, Retention: 2 * time.Hour}
+
+		err := rc.CleanupFlowRollups(context.Background(), "cleanup-flow-rollups", nil)
+		require.NoError(t, err)
+
+		var count int
+		require.NoError(t, dbx.Check(s.db.Model(&FlowRollup{}).Count(&count)))
+		assert.Equal(t, len(FlowRollupWindows), count)
+	})
+
+	t.Run("keeps rollups that couldn't be written for the next flush", func(t *testing.T) {
+		s := setup(t)
+		defer s.db.Close()
+
+		db := s.db
+
+		// A database that's gone away.
+		s.db = testsql.TestPostgresDB(t, "hzn")
+		s.db.Close()
+
+		flow := pb.NewULID()
+
+		now := time.Now()
+
+		s.rollups.add(&pb.FlowStream{FlowId: flow, NumBytes: 100}, now)
+		require.Error(t, s.FlushFlowRollups())
+
+		s.db = db
+
+		s.rollups.add(&pb.FlowStream{FlowId: flow, NumBytes: 10}, now)
+		require.NoError(t, s.FlushFlowRollups())
+
+		snap, err := s.CurrentFlowTop(context.Background(), &pb.FlowTopRequest{Window: "1m"})
+		require.NoError(t, err)
+		require.Len(t, snap.Records, 1)
+		assert.Equal(t, int64(110), snap.Records[0].NumBytes)
+	})
+
+	t.Run("bounds the rollups held in memory", func(t *testing.T) {
+		defer func(max int) { MaxPendingFlowRollups = max }(MaxPendingFlowRollups)
+
+		MaxPendingFlowRollups = len(FlowRollupWindows)
+
+		var b flowRollupBuffer
+
+		now := time.Now()
+
+		b.add(&pb.FlowStream{FlowId: pb.NewULID(), NumBytes: 1}, now)
+		b.add(&pb.FlowStream{FlowId: pb.NewULID(), NumBytes: 1}, now)
+
+		rollups, dropped := b.take()
+		assert.Len(t, rollups, len(FlowRollupWindows))
+		assert.Equal(t, len(FlowRollupWindows), dropped)
+	})
+}
@@ -43,6 +43,8 @@ func (f *FlowTop) Add(rec *pb.FlowStream) {
 
 		entry.updated = time.Now()
 		entry.agg.EndedAt = rec.EndedAt
+		entry.agg.EndReason = rec.EndReason
+		entry.agg.Duration = rec.Duration
 		entry.agg.NumMessages += rec.NumMessages
 		entry.agg.NumBytes += rec.NumBytes
 	}
@@ -96,7 +98,14 @@ func (s *Server) checkOpsAllowed(ctx context.Context) bool {
 	return auth[0] == s.opsToken
 }
 
+// CurrentFlowTop returns the most recently active flows. When a rollup
+// window is requested, the flows of the latest persisted bucket of that
+// window are returned instead, busiest first.
 func (s *Server) CurrentFlowTop(ctx context.Context, req *pb.FlowTopRequest) (*pb.FlowTopSnapshot, error) {
+	if req.Window != "" {
+		return s.flowRollupSnapshot(req.Window, req.MaxRecords)
+	}
+
 	entries, err := s.flowTop.Export()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,153 @@
+package control
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// Holds back the start of a response until it's known to be at least min
+// bytes, sending it compressed if so and as is otherwise.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	min int
+
+	code    int
+	buf     []byte
+	gw      *gzip.Writer
+	started bool
+}
+
+func (g *gzipResponseWriter) WriteHeader(code int) {
+	if g.started || g.code != 0 {
+		return
+	}
+
+	g.code = code
+
+	// These have no body to compress.
+	if code == http.StatusNoContent || code == http.StatusNotModified {
+		g.start(false)
+	}
+}
+
+func (g *gzipResponseWriter) Write(b []byte) (int, error) {
+	if g.code == 0 {
+		g.code = http.StatusOK
+	}
+
+	if !g.started {
+		if len(g.buf)+len(b) < g.min {
+			g.buf = append(g.buf, b...)
+			return len(b), nil
+		}
+
+		// Handlers that encoded the body themselves are left alone.
+		g.start(g.Header().Get("Content-Encoding") == "")
+
+		if err := g.flushBuffer(); err != nil {
+			return 0, err
+		}
+	}
+
+	if g.gw != nil {
+		return g.gw.Write(b)
+	}
+
+	return g.ResponseWriter.Write(b)
+}
+
+// Send the headers, with the body compressed from now on if compress is
+// set.
+func (g *gzipResponseWriter) start(compress bool) {
+	g.started = true
+
+	if compress {
+		g.Header().Set("Content-Encoding", "gzip")
+		g.Header().Del("Content-Length")
+
+		g.gw = gzip.NewWriter(g.ResponseWriter)
+	}
+
+	g.ResponseWriter.WriteHeader(g.code)
+}
+
+func (g *gzipResponseWriter) flushBuffer() error {
+	buf := g.buf
+	g.buf = nil
+
+	if len(buf) == 0 {
+		return nil
+	}
+
+	var err error
+
+	if g.gw != nil {
+		_, err = g.gw.Write(buf)
+	} else {
+		_, err = g.ResponseWriter.Write(buf)
+	}
+
+	return err
+}
+
+// Send whatever the handler left, uncompressed if it never reached min
+// bytes.
+func (g *gzipResponseWriter) finish() error {
+	if !g.started {
+		if g.code == 0 {
+			g.code = http.StatusOK
+		}
+
+		g.start(false)
+	}
+
+	if err := g.flushBuffer(); err != nil {
+		return err
+	}
+
+	if g.gw != nil {
+		return g.gw.Close()
+	}
+
+	return nil
+}
+
+// Compress the response with gzip when the client accepts it and the body
+// is at least min bytes. HEAD requests and responses without a body are
+// passed through untouched.
+func gzipHandler(h http.Handler, min int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		if req.Method == http.MethodHead || !acceptsGzip(req) {
+			h.ServeHTTP(w, req)
+			return
+		}
+
+		gw := &gzipResponseWriter{ResponseWriter: w, min: min}
+		defer gw.finish()
+
+		h.ServeHTTP(gw, req)
+	})
+}
+
+func acceptsGzip(req *http.Request) bool {
+	for _, enc := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		enc = strings.TrimSpace(enc)
+
+		if idx := strings.IndexByte(enc, ';'); idx != -1 {
+			if strings.TrimSpace(enc[idx+1:]) == "q=0" {
+				continue
+			}
+
+			enc = strings.TrimSpace(enc[:idx])
+		}
+
+		if enc == "gzip" {
+			return true
+		}
+	}
+
+	return false
+}
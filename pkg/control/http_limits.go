@@ -0,0 +1,126 @@
+package control
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// The request body size limit used when ServerConfig.MaxRequestBodySize
+// is not set.
+const DefaultMaxRequestBodySize = 1 << 20
+
+// Reject request bodies larger than max with a 413. Bodies that don't
+// declare their length are read up front, so that they get the same
+// response rather than a handler failing partway through reading them.
+func maxBodyHandler(h http.Handler, max int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.ContentLength > max {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if req.Body != nil && req.ContentLength < 0 {
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, max))
+			if err != nil {
+				if isBodyTooLarge(err) {
+					http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				} else {
+					http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				}
+
+				return
+			}
+
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		} else if req.Body != nil {
+			req.Body = http.MaxBytesReader(w, req.Body, max)
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
+
+// Reports whether err was returned by reading past the body limit.
+// http.MaxBytesReader doesn't export a distinct error type.
+func isBodyTooLarge(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "request body too large")
+}
+
+// The connection limit used when ServerConfig.MaxConns is not set. It's
+// well above what a control server sees in normal operation, and below the
+// usual file descriptor limits.
+const DefaultMaxConns = 10000
+
+// LimitListener limits l to ServerConfig.MaxConns concurrent connections,
+// reporting the number open in the http.conns gauge. Once at the limit, new
+// connections are accepted and closed at once, counted in
+// http.conns_rejected, so that clients fail fast and retry elsewhere rather
+// than wait in the kernel's backlog. Accept keeps serving the connections
+// that fit while it sheds the rest.
+func (s *Server) LimitListener(l net.Listener) net.Listener {
+	max := s.config().MaxConns
+	if max == 0 {
+		max = DefaultMaxConns
+	}
+
+	cl := &countingListener{Listener: l, s: s, max: int64(max)}
+
+	s.load.addListener(cl)
+
+	return cl
+}
+
+type countingListener struct {
+	// First for 64-bit alignment of the atomic ops on 32-bit platforms.
+	open int64
+
+	net.Listener
+	s *Server
+
+	// Negative for no limit.
+	max int64
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		open := atomic.AddInt64(&l.open, 1)
+
+		if l.max > 0 && open > l.max {
+			atomic.AddInt64(&l.open, -1)
+			c.Close()
+
+			l.s.m.IncrCounter([]string{"http", "conns_rejected"}, 1)
+			continue
+		}
+
+		l.s.m.SetGauge([]string{"http", "conns"}, float32(open))
+
+		return &countedConn{Conn: c, l: l}, nil
+	}
+}
+
+type countedConn struct {
+	net.Conn
+	l    *countingListener
+	once sync.Once
+}
+
+func (c *countedConn) Close() error {
+	err := c.Conn.Close()
+
+	c.once.Do(func() {
+		c.l.s.m.SetGauge([]string{"http", "conns"}, float32(atomic.AddInt64(&c.l.open, -1)))
+	})
+
+	return err
+}
@@ -0,0 +1,67 @@
+package control
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+// The strategy ordering the hubs for ar. The accounts in
+// ServerConfig.HubAffinityAccounts are always ordered by account hash, so
+// their agents prefer the same hubs whatever the configured strategy.
+func (s *Server) strategyFor(ar *AssignmentRequest) AssignmentStrategy {
+	if ar.Account != "" {
+		for _, account := range s.config().HubAffinityAccounts {
+			if account == ar.Account {
+				return accountHash{}
+			}
+		}
+	}
+
+	if s.assignment == nil {
+		return allHubs{}
+	}
+
+	return s.assignment
+}
+
+type hubAffinity struct {
+	Strategy string   `json:"strategy"`
+	Hubs     []string `json:"hubs"`
+}
+
+// Serves the hubs the agents of each account with hub affinity, and of any
+// accounts given in account query parameters, are currently assigned, most
+// preferred first. Requires the ops token.
+func (s *Server) httpHubAffinity(w http.ResponseWriter, req *http.Request) {
+	auth := req.Header.Get("Authorization")
+
+	if s.opsToken == "" || subtle.ConstantTimeCompare([]byte(auth), []byte(s.opsToken)) != 1 {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	accounts := append(append([]string(nil), s.config().HubAffinityAccounts...), req.URL.Query()["account"]...)
+
+	out := make(map[string]*hubAffinity)
+
+	for _, account := range accounts {
+		strategy, hubs, err := s.assignHubs(&AssignmentRequest{Account: account})
+		if err != nil {
+			s.L.Error("error computing hub affinity", "account", account, "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ha := &hubAffinity{Strategy: strategy.Name()}
+
+		for _, h := range hubs {
+			ha.Hubs = append(ha.Hubs, h.StableID.SpecString())
+		}
+
+		out[account] = ha
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
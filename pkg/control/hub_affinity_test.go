@@ -0,0 +1,85 @@
+package control
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubAffinity(t *testing.T) {
+	t.Run("orders affinity accounts by account hash", func(t *testing.T) {
+		var s Server
+		s.cfg.HubAffinityAccounts = []string{"busy"}
+		s.assignment = leastConnections{}
+
+		assert.Equal(t, AssignAccountHash, s.strategyFor(&AssignmentRequest{Account: "busy"}).Name())
+		assert.Equal(t, AssignLeastConnections, s.strategyFor(&AssignmentRequest{Account: "quiet"}).Name())
+		assert.Equal(t, AssignLeastConnections, s.strategyFor(&AssignmentRequest{}).Name())
+	})
+
+	t.Run("serves the affinity mapping to the ops token", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+		s.opsToken = "ddeeff"
+		s.hubDomain = "hub.test"
+		s.cfg.HubAffinityAccounts = []string{"busy"}
+
+		locs, err := json.Marshal([]*pb.NetworkLocation{
+			{Addresses: []string{"1.1.1.1"}},
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			err = dbx.Check(db.Create(&Hub{
+				StableID:       pb.NewULID().Bytes(),
+				InstanceID:     pb.NewULID().Bytes(),
+				ConnectionInfo: locs,
+				LastCheckin:    time.Now(),
+			}))
+			require.NoError(t, err)
+		}
+
+		w := httptest.NewRecorder()
+		s.httpHubAffinity(w, httptest.NewRequest("GET", "/debug/hub-affinity", nil))
+
+		assert.Equal(t, 403, w.Code)
+
+		req := httptest.NewRequest("GET", "/debug/hub-affinity?account=quiet", nil)
+		req.Header.Set("Authorization", "ddeeff")
+
+		w = httptest.NewRecorder()
+		s.httpHubAffinity(w, req)
+
+		require.Equal(t, 200, w.Code)
+
+		var out map[string]*hubAffinity
+
+		err = json.NewDecoder(w.Body).Decode(&out)
+		require.NoError(t, err)
+
+		require.Contains(t, out, "busy")
+		require.Contains(t, out, "quiet")
+
+		assert.Equal(t, AssignAccountHash, out["busy"].Strategy)
+		assert.Len(t, out["busy"].Hubs, 3)
+
+		assert.Equal(t, AssignAll, out["quiet"].Strategy)
+
+		_, hubs, err := s.assignHubs(&AssignmentRequest{Account: "busy"})
+		require.NoError(t, err)
+
+		assert.Equal(t, hubs[0].StableID.SpecString(), out["busy"].Hubs[0])
+	})
+}
@@ -0,0 +1,287 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// A HubCredential authenticates a single hub to ControlServices. Unlike
+// the HUB role tokens signed by the server, each credential can be revoked
+// on its own without affecting other hubs or any agent tokens. Only a hash
+// of the secret is stored.
+type HubCredential struct {
+	ID         []byte `gorm:"primary_key"`
+	Name       string
+	SecretHash []byte
+
+	// The stable id of the hub allowed to present the credential.
+	HubID []byte
+
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+
+	CreatedAt time.Time
+}
+
+// Hub credentials are presented as hubCredentialPrefix, the credential id
+// and the secret, separated by dots.
+const hubCredentialPrefix = "hzn-hc"
+
+const maxHubCredentialNameLength = 128
+
+// How often activity streams check that the hub credential they were opened
+// with hasn't been revoked on another control server.
+const hubCredentialRecheckInterval = time.Minute
+
+func isHubCredential(auth string) bool {
+	return strings.HasPrefix(auth, hubCredentialPrefix+".")
+}
+
+// Validate a presented hub credential. Revoked and unknown credentials are
+// rejected. On success the credential's last used time is updated.
+func (s *Server) checkHubCredential(auth string) (*HubCredential, error) {
+	id, secret, err := parseSecretKey(hubCredentialPrefix, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	var cred HubCredential
+
+	err = dbx.Check(s.db.Where("id = ?", id.Bytes()).First(&cred))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrBadAuthentication
+		}
+
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare(hashSecretKey(secret), cred.SecretHash) != 1 {
+		return nil, ErrBadAuthentication
+	}
+
+	if cred.RevokedAt != nil {
+		return nil, errors.Wrapf(ErrBadAuthentication, "hub credential has been revoked")
+	}
+
+	err = dbx.Check(s.db.Model(&cred).Update("last_used_at", time.Now()))
+	if err != nil {
+		s.L.Error("error updating hub credential last used time", "error", err)
+	}
+
+	return &cred, nil
+}
+
+// Returns the hub credential ctx authenticates with, or nil when the hub
+// presented a token instead. authorize leaves the credential it checked in
+// ctx, so that a call is only checked, and its use recorded, once.
+func (s *Server) contextHubCredential(ctx context.Context) (*HubCredential, error) {
+	if ac := authorizedFrom(ctx); ac != nil && ac.hubCred != nil {
+		return ac.hubCred, nil
+	}
+
+	auth, ok := contextAuthorization(ctx)
+	if !ok || !isHubCredential(auth) {
+		return nil, nil
+	}
+
+	return s.checkHubCredential(auth)
+}
+
+// Rejects hub credentials presented by a hub other than the one they were
+// issued to. Credentials issued without a hub id are bound to the first hub
+// that presents them.
+func (s *Server) checkHubCredentialBinding(ctx context.Context, stableId *pb.ULID) error {
+	cred, err := s.contextHubCredential(ctx)
+	if err != nil || cred == nil {
+		return err
+	}
+
+	if stableId == nil {
+		return errors.Wrapf(ErrInvalidRequest, "missing hub stable id")
+	}
+
+	if cred.HubID == nil {
+		res := s.db.Model(&HubCredential{}).
+			Where("id = ?", cred.ID).
+			Where("hub_id IS NULL").
+			Update("hub_id", stableId.Bytes())
+
+		err = dbx.Check(res)
+		if err != nil {
+			return err
+		}
+
+		if res.RowsAffected == 1 {
+			s.logger(ctx).Info("bound hub credential to hub",
+				"credential", cred.Name, "hub", stableId.SpecString())
+
+			cred.HubID = stableId.Bytes()
+			return nil
+		}
+
+		// Another hub bound it first.
+		err = dbx.Check(s.db.Where("id = ?", cred.ID).First(cred))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bytes.Equal(cred.HubID, stableId.Bytes()) {
+		return errors.Wrapf(ErrBadAuthentication, "hub credential belongs to another hub")
+	}
+
+	return nil
+}
+
+// Reports whether the credential has been revoked since it was checked.
+func (s *Server) hubCredentialRevoked(id []byte) (bool, error) {
+	var count int
+
+	err := dbx.Check(s.db.Model(&HubCredential{}).
+		Where("id = ?", id).
+		Where("revoked_at IS NOT NULL").
+		Count(&count))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func hubCredentialToPB(cred *HubCredential) *pb.HubCredential {
+	out := &pb.HubCredential{
+		Id:        pb.ULIDFromBytes(cred.ID),
+		Name:      cred.Name,
+		CreatedAt: pb.NewTimestamp(cred.CreatedAt),
+	}
+
+	if cred.HubID != nil {
+		out.HubId = pb.ULIDFromBytes(cred.HubID)
+	}
+
+	if cred.LastUsedAt != nil {
+		out.LastUsedAt = pb.NewTimestamp(*cred.LastUsedAt)
+	}
+
+	if cred.RevokedAt != nil {
+		out.RevokedAt = pb.NewTimestamp(*cred.RevokedAt)
+	}
+
+	return out
+}
+
+// IssueHubCredential creates a credential for a newly provisioned hub. It
+// requires the register token, like IssueHubToken.
+func (s *Server) IssueHubCredential(ctx context.Context, req *pb.IssueHubCredentialRequest) (*pb.IssueHubCredentialResponse, error) {
+	auth, ok := contextAuthorization(ctx)
+	if !ok || !staticTokenMatches(auth, s.registerToken) {
+		return nil, ErrBadAuthentication
+	}
+
+	if req.Name == "" || len(req.Name) > maxHubCredentialNameLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "invalid hub credential name")
+	}
+
+	secret := make([]byte, secretKeySize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	id := pb.NewULID()
+
+	cred := HubCredential{
+		ID:         id.Bytes(),
+		Name:       req.Name,
+		SecretHash: hashSecretKey(secret),
+	}
+
+	if req.HubId != nil {
+		cred.HubID = req.HubId.Bytes()
+	}
+
+	err = dbx.Check(s.db.Create(&cred))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).Info("issued hub credential", "credential-id", id.SpecString(), "name", req.Name)
+
+	return &pb.IssueHubCredentialResponse{
+		Credential: hubCredentialToPB(&cred),
+		Secret:     strings.Join([]string{hubCredentialPrefix, id.SpecString(), hex.EncodeToString(secret)}, "."),
+	}, nil
+}
+
+func (s *Server) ListHubCredentials(ctx context.Context, _ *pb.Noop) (*pb.ListHubCredentialsResponse, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	var creds []*HubCredential
+
+	err := dbx.Check(s.listDB(ctx, false).Order("created_at ASC, id ASC").Find(&creds))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.ListHubCredentialsResponse
+
+	for _, cred := range creds {
+		resp.Credentials = append(resp.Credentials, hubCredentialToPB(cred))
+	}
+
+	return &resp, nil
+}
+
+// RevokeHubCredential stops the credential from authenticating any further
+// calls. Activity streams opened with it on this server are closed at once;
+// other control servers close theirs when they next recheck the credential,
+// within hubCredentialRecheckInterval.
+func (s *Server) RevokeHubCredential(ctx context.Context, req *pb.RevokeHubCredentialRequest) (*pb.Noop, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	if req.CredentialId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing credential id")
+	}
+
+	res := s.db.Model(&HubCredential{}).
+		Where("id = ?", req.CredentialId.Bytes()).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", time.Now())
+
+	err := dbx.Check(res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, errors.Wrapf(ErrInvalidRequest, "no active hub credential with that id")
+	}
+
+	s.logger(ctx).Info("revoked hub credential", "credential-id", req.CredentialId.SpecString())
+
+	s.mu.Lock()
+	for _, ch := range s.connectedHubs {
+		if ch.credentialId != nil && bytes.Equal(ch.credentialId, req.CredentialId.Bytes()) {
+			ch.cancel()
+		}
+	}
+	s.mu.Unlock()
+
+	return &pb.Noop{}, nil
+}
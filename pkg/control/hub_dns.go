@@ -0,0 +1,196 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultSRVPort = 443
+	DefaultSRVTTL  = 60
+
+	srvPriority  = 10
+	srvMaxWeight = 100
+)
+
+// SRVPublisher maintains an SRV record in Route53 that advertises the
+// currently available hubs, weighted by their remaining flow capacity, so
+// that clients can balance across them rather than relying solely on the
+// wildcard record.
+type SRVPublisher struct {
+	Server  *Server
+	Route53 route53iface.Route53API
+	ZoneID  string
+
+	// The fully qualified record name, for instance _hzn._tcp.hub.example.com
+	Name string
+
+	// The port advertised for every hub. Defaults to DefaultSRVPort.
+	Port int
+
+	// Defaults to DefaultSRVTTL.
+	TTL int64
+}
+
+// The weight a hub should be advertised with. Hubs without a flow limit,
+// or that aren't connected to this server, get the maximum weight. Full
+// hubs return zero.
+func (p *SRVPublisher) hubWeight(h *Hub) int64 {
+	max := p.Server.hubMaxFlows(h)
+	if max <= 0 {
+		return srvMaxWeight
+	}
+
+	flows, ok := p.Server.hubActiveFlows(h)
+	if !ok {
+		return srvMaxWeight
+	}
+
+	if flows >= max {
+		return 0
+	}
+
+	weight := (max - flows) * srvMaxWeight / max
+	if weight < 1 {
+		weight = 1
+	}
+
+	return weight
+}
+
+func (p *SRVPublisher) desiredRecords(hubs []*Hub) []string {
+	port := p.Port
+	if port == 0 {
+		port = DefaultSRVPort
+	}
+
+	var records []string
+
+	for _, h := range hubs {
+		if p.Server.hubUnavailable(h) != "" {
+			continue
+		}
+
+		weight := p.hubWeight(h)
+		if weight == 0 {
+			continue
+		}
+
+		target := h.StableIdULID().String() + "." + p.Server.hubDomain + "."
+
+		records = append(records, fmt.Sprintf("%d %d %d %s", srvPriority, weight, port, target))
+	}
+
+	sort.Strings(records)
+
+	return records
+}
+
+func (p *SRVPublisher) currentRecords(ctx context.Context) ([]string, error) {
+	out, err := p.Route53.ListResourceRecordSetsWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(p.ZoneID),
+		StartRecordName: aws.String(p.Name),
+		StartRecordType: aws.String(route53.RRTypeSrv),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing srv records for %s", p.Name)
+	}
+
+	var records []string
+
+	for _, set := range out.ResourceRecordSets {
+		if strings.TrimSuffix(aws.StringValue(set.Name), ".") != strings.TrimSuffix(p.Name, ".") ||
+			aws.StringValue(set.Type) != route53.RRTypeSrv {
+			continue
+		}
+
+		for _, rr := range set.ResourceRecords {
+			records = append(records, aws.StringValue(rr.Value))
+		}
+	}
+
+	sort.Strings(records)
+
+	return records, nil
+}
+
+// ReconcileHubSRV is a workq handler that updates the SRV record to match
+// the set of hubs currently registered.
+func (p *SRVPublisher) ReconcileHubSRV(ctx context.Context, jobType string, _ *struct{}) error {
+	L := hclog.FromContext(ctx)
+
+	var hubs []*Hub
+
+	err := dbx.Check(p.Server.db.Order("stable_id ASC").Find(&hubs))
+	if err != nil {
+		return err
+	}
+
+	desired := p.desiredRecords(hubs)
+
+	current, err := p.currentRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	if strings.Join(desired, "\n") == strings.Join(current, "\n") {
+		L.Debug("hub srv records up to date", "name", p.Name, "records", len(current))
+		return nil
+	}
+
+	ttl := p.TTL
+	if ttl == 0 {
+		ttl = DefaultSRVTTL
+	}
+
+	action := route53.ChangeActionUpsert
+	values := desired
+
+	// Route53 doesn't allow an empty record set, so remove the record
+	// entirely when no hubs are available.
+	if len(desired) == 0 {
+		action = route53.ChangeActionDelete
+		values = current
+	}
+
+	var rrs []*route53.ResourceRecord
+
+	for _, v := range values {
+		rrs = append(rrs, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	_, err = p.Route53.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(p.ZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("hub srv reconcile"),
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(action),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(p.Name),
+						Type:            aws.String(route53.RRTypeSrv),
+						TTL:             aws.Int64(ttl),
+						ResourceRecords: rrs,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "updating srv records for %s", p.Name)
+	}
+
+	L.Info("updated hub srv records", "name", p.Name, "action", action, "records", len(desired))
+
+	return nil
+}
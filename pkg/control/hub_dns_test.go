@@ -0,0 +1,166 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRoute53 struct {
+	route53iface.Route53API
+
+	sets    []*route53.ResourceRecordSet
+	changes []*route53.Change
+}
+
+func (f *fakeRoute53) ListResourceRecordSetsWithContext(ctx aws.Context, in *route53.ListResourceRecordSetsInput, opts ...request.Option) (*route53.ListResourceRecordSetsOutput, error) {
+	return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: f.sets}, nil
+}
+
+func (f *fakeRoute53) ChangeResourceRecordSetsWithContext(ctx aws.Context, in *route53.ChangeResourceRecordSetsInput, opts ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	f.changes = append(f.changes, in.ChangeBatch.Changes...)
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func TestHubSRV(t *testing.T) {
+	t.Run("weights hubs by remaining capacity", func(t *testing.T) {
+		s := &Server{
+			cfg:           ServerConfig{MaxFlowsPerHub: 10},
+			connectedHubs: make(map[string]*connectedHub),
+			hubDomain:     "hub.test",
+		}
+
+		p := &SRVPublisher{Server: s, Name: "_hzn._tcp.hub.test"}
+
+		var hubs []*Hub
+
+		for _, flows := range []int64{0, 5, 10} {
+			instance := pb.NewULID()
+
+			ch := &connectedHub{activeFlows: new(int64)}
+			*ch.activeFlows = flows
+			s.connectedHubs[instance.SpecString()] = ch
+
+			hubs = append(hubs, &Hub{
+				StableID:    pb.NewULID().Bytes(),
+				InstanceID:  instance.Bytes(),
+				LastCheckin: time.Now(),
+			})
+		}
+
+		records := p.desiredRecords(hubs)
+		require.Equal(t, 2, len(records))
+
+		assert.Contains(t, records, "10 100 443 "+hubs[0].StableIdULID().String()+".hub.test.")
+		assert.Contains(t, records, "10 50 443 "+hubs[1].StableIdULID().String()+".hub.test.")
+	})
+
+	t.Run("leaves out hubs discovery wouldn't hand out", func(t *testing.T) {
+		s := &Server{
+			cfg:           ServerConfig{MinHubProtocolVersion: 1},
+			connectedHubs: make(map[string]*connectedHub),
+			hubDomain:     "hub.test",
+		}
+
+		p := &SRVPublisher{Server: s, Name: "_hzn._tcp.hub.test"}
+
+		healthy := &Hub{
+			StableID:        pb.NewULID().Bytes(),
+			InstanceID:      pb.NewULID().Bytes(),
+			LastCheckin:     time.Now(),
+			ProtocolVersion: 1,
+		}
+
+		stale := &Hub{
+			StableID:        pb.NewULID().Bytes(),
+			InstanceID:      pb.NewULID().Bytes(),
+			LastCheckin:     time.Now().Add(-2 * hubCheckinTimeout),
+			ProtocolVersion: 1,
+		}
+
+		refused := &Hub{
+			StableID:    pb.NewULID().Bytes(),
+			InstanceID:  pb.NewULID().Bytes(),
+			LastCheckin: time.Now(),
+		}
+
+		records := p.desiredRecords([]*Hub{healthy, stale, refused})
+
+		assert.Equal(t, []string{"10 100 443 " + healthy.StableIdULID().String() + ".hub.test."}, records)
+	})
+
+	t.Run("reconciles the record with the registered hubs", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		s := &Server{
+			db:            db,
+			connectedHubs: make(map[string]*connectedHub),
+			hubDomain:     "hub.test",
+		}
+
+		var r53 fakeRoute53
+
+		p := &SRVPublisher{Server: s, Route53: &r53, ZoneID: "z", Name: "_hzn._tcp.hub.test"}
+
+		ctx := context.Background()
+
+		// Nothing registered and nothing published
+		err := p.ReconcileHubSRV(ctx, "reconcile-hub-srv", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, len(r53.changes))
+
+		h := &Hub{
+			StableID:       pb.NewULID().Bytes(),
+			InstanceID:     pb.NewULID().Bytes(),
+			ConnectionInfo: []byte("[]"),
+			LastCheckin:    time.Now(),
+		}
+
+		err = dbx.Check(db.Create(h))
+		require.NoError(t, err)
+
+		err = p.ReconcileHubSRV(ctx, "reconcile-hub-srv", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(r53.changes))
+
+		change := r53.changes[0]
+		assert.Equal(t, route53.ChangeActionUpsert, aws.StringValue(change.Action))
+
+		value := "10 100 443 " + h.StableIdULID().String() + ".hub.test."
+
+		require.Equal(t, 1, len(change.ResourceRecordSet.ResourceRecords))
+		assert.Equal(t, value, aws.StringValue(change.ResourceRecordSet.ResourceRecords[0].Value))
+
+		// Once published, an unchanged set of hubs is a noop.
+		r53.sets = []*route53.ResourceRecordSet{change.ResourceRecordSet}
+		r53.sets[0].Name = aws.String("_hzn._tcp.hub.test.")
+
+		err = p.ReconcileHubSRV(ctx, "reconcile-hub-srv", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, len(r53.changes))
+
+		// And the record is removed when the last hub goes away.
+		err = dbx.Check(db.Delete(h))
+		require.NoError(t, err)
+
+		err = p.ReconcileHubSRV(ctx, "reconcile-hub-srv", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(r53.changes))
+		assert.Equal(t, route53.ChangeActionDelete, aws.StringValue(r53.changes[1].Action))
+	})
+}
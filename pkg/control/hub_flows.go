@@ -0,0 +1,103 @@
+package control
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+// The flow limit for h, either its own override or the configured default.
+// Zero means the hub has no limit.
+func (s *Server) hubMaxFlows(h *Hub) int64 {
+	if h.MaxFlows != nil {
+		return *h.MaxFlows
+	}
+
+	return s.config().MaxFlowsPerHub
+}
+
+// The number of flows h last reported. Returns false if the hub is not
+// currently connected to this server.
+func (s *Server) hubActiveFlows(h *Hub) (int64, bool) {
+	s.mu.RLock()
+	ch, ok := s.connectedHubs[pb.ULIDFromBytes(h.InstanceID).SpecString()]
+	s.mu.RUnlock()
+
+	if !ok {
+		return 0, false
+	}
+
+	return atomic.LoadInt64(ch.activeFlows), true
+}
+
+func (s *Server) hubAtCapacity(h *Hub) bool {
+	max := s.hubMaxFlows(h)
+	if max <= 0 {
+		return false
+	}
+
+	flows, ok := s.hubActiveFlows(h)
+	if !ok {
+		return false
+	}
+
+	return flows >= max
+}
+
+// Hubs refetch their config hourly, so one that hasn't for this long has
+// gone away without disconnecting.
+const hubCheckinTimeout = 3 * time.Hour
+
+// Why h shouldn't be handed to agents right now, or "" if it can be. Both
+// discovery and the published SRV record leave such hubs out.
+func (s *Server) hubUnavailable(h *Hub) string {
+	if time.Since(h.LastCheckin) > hubCheckinTimeout {
+		return "stale"
+	}
+
+	// Hubs below the minimum version have their activity stream refused.
+	if h.ProtocolVersion < s.config().MinHubProtocolVersion {
+		return "protocol-version"
+	}
+
+	if s.hubAtCapacity(h) {
+		return "full"
+	}
+
+	return ""
+}
+
+func (s *Server) SetHubMaxFlows(ctx context.Context, req *pb.SetHubMaxFlowsRequest) (*pb.Noop, error) {
+	_, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StableId == nil || req.MaxFlows < 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	// A zero limit removes the override, reverting to the default.
+	var max *int64
+	if req.MaxFlows > 0 {
+		max = &req.MaxFlows
+	}
+
+	res := s.db.Model(&Hub{}).
+		Where("stable_id = ?", req.StableId.Bytes()).
+		Update("max_flows", max)
+
+	err = dbx.Check(res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	return &pb.Noop{}, nil
+}
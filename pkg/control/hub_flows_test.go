@@ -0,0 +1,59 @@
+package control
+
+import (
+	"testing"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHubFlows(t *testing.T) {
+	t.Run("applies the default and per-hub flow limits", func(t *testing.T) {
+		s := &Server{
+			cfg:           ServerConfig{MaxFlowsPerHub: 10},
+			connectedHubs: make(map[string]*connectedHub),
+		}
+
+		instance := pb.NewULID()
+
+		h := &Hub{
+			StableID:   pb.NewULID().Bytes(),
+			InstanceID: instance.Bytes(),
+		}
+
+		// Hubs that aren't connected have no known flow count.
+		assert.False(t, s.hubAtCapacity(h))
+
+		ch := &connectedHub{activeFlows: new(int64)}
+		s.connectedHubs[instance.SpecString()] = ch
+
+		*ch.activeFlows = 9
+		assert.False(t, s.hubAtCapacity(h))
+
+		*ch.activeFlows = 10
+		assert.True(t, s.hubAtCapacity(h))
+
+		max := int64(20)
+		h.MaxFlows = &max
+
+		assert.Equal(t, int64(20), s.hubMaxFlows(h))
+		assert.False(t, s.hubAtCapacity(h))
+
+		flows, ok := s.hubActiveFlows(h)
+		assert.True(t, ok)
+		assert.Equal(t, int64(10), flows)
+	})
+
+	t.Run("has no limit by default", func(t *testing.T) {
+		s := &Server{connectedHubs: make(map[string]*connectedHub)}
+
+		instance := pb.NewULID()
+		h := &Hub{InstanceID: instance.Bytes()}
+
+		ch := &connectedHub{activeFlows: new(int64)}
+		*ch.activeFlows = 1000000
+		s.connectedHubs[instance.SpecString()] = ch
+
+		assert.False(t, s.hubAtCapacity(h))
+	})
+}
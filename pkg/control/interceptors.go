@@ -0,0 +1,43 @@
+package control
+
+import (
+	"context"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Translate a query canceled by statement_timeout into DeadlineExceeded so
+// that callers can tell a slow database apart from a failed request.
+func translateDBError(err error) error {
+	if err != nil && dbx.IsStatementTimeout(err) {
+		return status.Error(codes.DeadlineExceeded, "database query exceeded statement timeout")
+	}
+
+	return err
+}
+
+// UnaryDBErrorInterceptor translates database errors returned by unary
+// handlers into grpc status errors.
+func UnaryDBErrorInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, translateDBError(err)
+}
+
+// StreamDBErrorInterceptor translates database errors returned by stream
+// handlers into grpc status errors.
+func StreamDBErrorInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return translateDBError(handler(srv, ss))
+}
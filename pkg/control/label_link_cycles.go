@@ -0,0 +1,156 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// The most label links followed to resolve a single label set. Adding links
+// that form a cycle is normally rejected, this bounds resolution of any that
+// exist regardless.
+const MaxLabelLinkHops = 8
+
+// ErrLabelLinkHops is returned when resolving a label set follows more than
+// MaxLabelLinkHops links, which means the links likely form a cycle.
+var ErrLabelLinkHops = errors.New("label link resolution exceeded the hop limit")
+
+// How a label link that would form a cycle with the account's other links
+// is handled when it's added.
+type LabelLinkCycleMode int
+
+const (
+	// Reject the link with InvalidArgument.
+	LabelLinkCyclesReject LabelLinkCycleMode = iota
+
+	// Add the link, logging the cycle. Resolution stops after
+	// MaxLabelLinkHops.
+	LabelLinkCyclesWarn
+)
+
+func (m LabelLinkCycleMode) String() string {
+	switch m {
+	case LabelLinkCyclesReject:
+		return "reject"
+	case LabelLinkCyclesWarn:
+		return "warn"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseLabelLinkCycleMode parses "reject" or "warn".
+func ParseLabelLinkCycleMode(str string) (LabelLinkCycleMode, error) {
+	switch str {
+	case "reject":
+		return LabelLinkCyclesReject, nil
+	case "warn":
+		return LabelLinkCyclesWarn, nil
+	default:
+		return 0, fmt.Errorf("label link cycle mode must be reject or warn: %s", str)
+	}
+}
+
+// Returns the cycle that a link from labels to target would close among
+// links, as the flattened label sets along it starting and ending with
+// labels, or nil if it wouldn't close one. A link's target leads on to the
+// links for exactly those labels.
+func findLabelLinkCycle(links []*LabelLink, labels, target string) []string {
+	next := make(map[string][]string)
+
+	for _, ll := range links {
+		next[ll.Labels] = append(next[ll.Labels], ll.Target)
+	}
+
+	visited := make(map[string]bool)
+
+	var (
+		path  []string
+		visit func(node string) bool
+	)
+
+	visit = func(node string) bool {
+		path = append(path, node)
+
+		if node == labels {
+			return true
+		}
+
+		if !visited[node] {
+			visited[node] = true
+
+			for _, to := range next[node] {
+				if visit(to) {
+					return true
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+
+		return false
+	}
+
+	if !visit(target) {
+		return nil
+	}
+
+	return append([]string{labels}, path...)
+}
+
+// Whether saving llr with mode replaces ll, following saveLabelLink.
+func labelLinkReplaces(llr, ll *LabelLink, mode pb.AddLabelLinkRequest_ConflictMode) bool {
+	if mode != pb.LINK_CONFLICT_UPDATE || ll.Labels != llr.Labels {
+		return false
+	}
+
+	return llr.Weight == nil || ll.Weight == nil || ll.Target == llr.Target
+}
+
+// Check that llr doesn't form a cycle with the account's existing links,
+// handling one according to ServerConfig.LabelLinkCycles. The links that
+// saving llr with mode replaces aren't counted. tx must hold the account's
+// label links lock, so that the links can't change before llr is saved.
+func (s *Server) checkLabelLinkCycle(ctx context.Context, tx *gorm.DB, llr *LabelLink, mode pb.AddLabelLinkRequest_ConflictMode) error {
+	var all []*LabelLink
+
+	err := dbx.Check(tx.Where("account_id = ?", llr.AccountID).Find(&all))
+	if err != nil {
+		return err
+	}
+
+	var links []*LabelLink
+
+	for _, ll := range all {
+		if !labelLinkReplaces(llr, ll, mode) {
+			links = append(links, ll)
+		}
+	}
+
+	cycle := findLabelLinkCycle(links, llr.Labels, llr.Target)
+	if cycle == nil {
+		return nil
+	}
+
+	desc := strings.Join(cycle, " -> ")
+
+	s.m.IncrCounter([]string{"label_links", "cycle"}, 1)
+
+	if s.config().LabelLinkCycles == LabelLinkCyclesWarn {
+		account, _ := pb.AccountFromKey(llr.AccountID)
+
+		s.logger(ctx).Warn("adding label link that forms a cycle",
+			"account", account.SpecString(),
+			"cycle", desc,
+		)
+
+		return nil
+	}
+
+	return errors.Wrapf(ErrInvalidRequest, "label link would form a cycle: %s", desc)
+}
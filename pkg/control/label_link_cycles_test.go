@@ -0,0 +1,123 @@
+package control
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelLinkCycles(t *testing.T) {
+	link := func(labels, target string) *LabelLink {
+		return &LabelLink{Labels: labels, Target: target}
+	}
+
+	t.Run("finds the cycle a new link would close", func(t *testing.T) {
+		links := []*LabelLink{
+			link("app=a", "app=b"),
+			link("app=b", "app=c"),
+			link("app=b", "app=d"),
+		}
+
+		assert.Nil(t, findLabelLinkCycle(links, "app=d", "app=e"))
+		assert.Nil(t, findLabelLinkCycle(links, ":hostname=a.test", "app=a"))
+
+		assert.Equal(t,
+			[]string{"app=c", "app=a", "app=b", "app=c"},
+			findLabelLinkCycle(links, "app=c", "app=a"),
+		)
+
+		assert.Equal(t,
+			[]string{"app=a", "app=a"},
+			findLabelLinkCycle(links, "app=a", "app=a"),
+		)
+	})
+
+	t.Run("leaves out the links an update replaces", func(t *testing.T) {
+		w := int64(1)
+
+		weighted := func(labels, target string) *LabelLink {
+			ll := link(labels, target)
+			ll.Weight = &w
+			return ll
+		}
+
+		old := link("app=a", "app=b")
+
+		assert.False(t, labelLinkReplaces(link("app=a", "app=c"), old, pb.LINK_CONFLICT_ERROR))
+		assert.True(t, labelLinkReplaces(link("app=a", "app=c"), old, pb.LINK_CONFLICT_UPDATE))
+		assert.True(t, labelLinkReplaces(weighted("app=a", "app=c"), old, pb.LINK_CONFLICT_UPDATE))
+		assert.False(t, labelLinkReplaces(link("app=x", "app=c"), old, pb.LINK_CONFLICT_UPDATE))
+
+		assert.True(t, labelLinkReplaces(link("app=a", "app=c"), weighted("app=a", "app=b"), pb.LINK_CONFLICT_UPDATE))
+		assert.True(t, labelLinkReplaces(weighted("app=a", "app=b"), weighted("app=a", "app=b"), pb.LINK_CONFLICT_UPDATE))
+		assert.False(t, labelLinkReplaces(weighted("app=a", "app=c"), weighted("app=a", "app=b"), pb.LINK_CONFLICT_UPDATE))
+	})
+
+	t.Run("bounds resolution of links that form a cycle", func(t *testing.T) {
+		account := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+
+		pbLink := func(labels, target string) *pb.LabelLink {
+			return &pb.LabelLink{
+				Account: account,
+				Labels:  pb.ParseLabelSet(labels),
+				Target:  pb.ParseLabelSet(target),
+				Limits:  &pb.Account_Limits{},
+			}
+		}
+
+		var c Client
+		c.L = hclog.L()
+
+		c.labelLinks = &pb.LabelLinks{
+			LabelLinks: []*pb.LabelLink{
+				pbLink(":hostname=www.test", "app=www"),
+				pbLink("app=www", "app=www-v2"),
+				pbLink(":hostname=loop.test", "app=a"),
+				pbLink("app=a", "app=b"),
+				pbLink("app=b", "app=a"),
+			},
+		}
+
+		// Only the first link is resolved by default.
+		acc, target, _, err := c.ResolveLabelLink(pb.ParseLabelSet(":hostname=www.test"))
+		require.NoError(t, err)
+
+		assert.Equal(t, account, acc)
+		assert.Equal(t, "app=www", target.SpecString())
+
+		_, target, _, err = c.ResolveLabelLink(pb.ParseLabelSet(":hostname=loop.test"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "app=a", target.SpecString())
+
+		c.cfg.FollowLabelLinks = true
+
+		acc, target, _, err = c.ResolveLabelLink(pb.ParseLabelSet(":hostname=www.test"))
+		require.NoError(t, err)
+
+		assert.Equal(t, account, acc)
+		assert.Equal(t, "app=www-v2", target.SpecString())
+
+		_, _, _, err = c.ResolveLabelLink(pb.ParseLabelSet(":hostname=loop.test"))
+		require.Error(t, err)
+
+		assert.Equal(t, ErrLabelLinkHops, errors.Cause(err))
+	})
+
+	t.Run("parses cycle modes", func(t *testing.T) {
+		for _, mode := range []LabelLinkCycleMode{LabelLinkCyclesReject, LabelLinkCyclesWarn} {
+			parsed, err := ParseLabelLinkCycleMode(mode.String())
+			require.NoError(t, err)
+
+			assert.Equal(t, mode, parsed, fmt.Sprint(mode))
+		}
+
+		_, err := ParseLabelLinkCycleMode("ignore")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLabelLinkSnapshot(t *testing.T) {
+	db := testsql.TestPostgresDB(t, "hzn")
+	defer db.Close()
+
+	var s Server
+	s.L = hclog.L()
+	s.db = db
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	busy := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+	quiet := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+
+	for _, account := range []*pb.Account{busy, quiet} {
+		err := dbx.Check(db.Create(&Account{ID: account.Key(), Namespace: account.Namespace}))
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 5; i++ {
+		err := dbx.Check(db.Create(&LabelLink{
+			AccountID: busy.Key(),
+			Labels:    FlattenLabels(pb.ParseLabelSet(fmt.Sprintf(":hostname=%d.busy.test", i))),
+			Target:    FlattenLabels(pb.ParseLabelSet("service=www")),
+		}))
+		require.NoError(t, err)
+	}
+
+	err := dbx.Check(db.Create(&LabelLink{
+		AccountID: quiet.Key(),
+		Labels:    FlattenLabels(pb.ParseLabelSet(":hostname=quiet.test")),
+		Target:    FlattenLabels(pb.ParseLabelSet("service=www")),
+	}))
+	require.NoError(t, err)
+
+	t.Run("publishes every link without a limit", func(t *testing.T) {
+		out, err := s.labelLinkSnapshot(context.Background())
+		require.NoError(t, err)
+
+		assert.Len(t, out.LabelLinks, 6)
+	})
+
+	t.Run("keeps the oldest links of an account over the limit", func(t *testing.T) {
+		s.cfg.MaxLabelLinksPerAccount = 2
+		defer func() { s.cfg.MaxLabelLinksPerAccount = 0 }()
+
+		out, err := s.labelLinkSnapshot(context.Background())
+		require.NoError(t, err)
+
+		var hosts []string
+
+		for _, ll := range out.LabelLinks {
+			hosts = append(hosts, ll.Labels.SpecString())
+		}
+
+		assert.Equal(t, []string{
+			":hostname=0.busy.test",
+			":hostname=1.busy.test",
+			":hostname=quiet.test",
+		}, hosts)
+	})
+}
+
+func TestLabelLinkLimit(t *testing.T) {
+	db := testsql.TestPostgresDB(t, "hzn")
+	defer db.Close()
+
+	var s Server
+	s.L = hclog.L()
+	s.db = db
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+	s.cfg.MaxLabelLinksPerAccount = 2
+
+	account := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+
+	err := dbx.Check(db.Create(&Account{ID: account.Key(), Namespace: account.Namespace}))
+	require.NoError(t, err)
+
+	link := func(host, target string) *LabelLink {
+		return &LabelLink{
+			AccountID: account.Key(),
+			Labels:    FlattenLabels(pb.ParseLabelSet(":hostname=" + host)),
+			Target:    FlattenLabels(pb.ParseLabelSet("service=" + target)),
+		}
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, s.saveLabelLink(ctx, link("a.test", "www"), pb.LINK_CONFLICT_ERROR))
+	require.NoError(t, s.saveLabelLink(ctx, link("b.test", "www"), pb.LINK_CONFLICT_ERROR))
+
+	t.Run("rejects links past the limit", func(t *testing.T) {
+		err := s.saveLabelLink(ctx, link("c.test", "www"), pb.LINK_CONFLICT_ERROR)
+		require.Error(t, err)
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var count int
+
+		require.NoError(t, dbx.Check(db.Model(&LabelLink{}).Where("account_id = ?", account.Key()).Count(&count)))
+
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("allows updates at the limit", func(t *testing.T) {
+		require.NoError(t, s.saveLabelLink(ctx, link("a.test", "api"), pb.LINK_CONFLICT_UPDATE))
+	})
+}
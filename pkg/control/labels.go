@@ -6,8 +6,26 @@ import (
 	"strings"
 
 	"github.com/hashicorp/horizon/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Reject labels that don't satisfy pb.LabelSet.Validate before they're
+// stored, where a malformed label would later break routing. They're
+// normalized in place first, so differences in case or surrounding spaces
+// aren't rejected. field names the request field in the returned
+// InvalidArgument error.
+func validateLabels(field string, labels *pb.LabelSet) error {
+	labels.Normalize()
+
+	err := labels.Validate()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s: %s", field, err)
+	}
+
+	return nil
+}
+
 func FlattenLabels(labels *pb.LabelSet) string {
 	sort.Sort(labels)
 
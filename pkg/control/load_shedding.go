@@ -0,0 +1,275 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/periodic"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How often MonitorLoad samples the load signals.
+var LoadSampleInterval = 5 * time.Second
+
+// Once overloaded, the server isn't considered recovered until every signal
+// is below this fraction of its threshold, so that it doesn't flap between
+// ready and not while load hovers around a threshold.
+const loadRecoveryFraction = 0.8
+
+// A sample of the signals compared against the ServerConfig.LoadMax
+// thresholds.
+type loadSample struct {
+	JobBacklog int
+	DBLatency  time.Duration
+	Conns      int
+
+	// Set if the database couldn't be queried, which counts as overloaded.
+	DBErr error
+}
+
+type loadMonitor struct {
+	mu         sync.RWMutex
+	listeners  []*countingListener
+	overloaded bool
+	reasons    []string
+
+	// Whether the signals of this server alone, rather than the job backlog
+	// and database every control server shares, are over their thresholds.
+	// Only these fail readiness: shared signals would take every server out
+	// of rotation at once.
+	instanceOverloaded bool
+	instanceReasons    []string
+}
+
+func (l *loadMonitor) addListener(cl *countingListener) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.listeners = append(l.listeners, cl)
+}
+
+func (l *loadMonitor) conns() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var n int64
+
+	for _, cl := range l.listeners {
+		n += atomic.LoadInt64(&cl.open)
+	}
+
+	return int(n)
+}
+
+// Why the server is overloaded, or nil if it isn't.
+func (l *loadMonitor) status() (bool, []string) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.overloaded, l.reasons
+}
+
+// Why the server's own signals are overloaded, or nil if they aren't.
+func (l *loadMonitor) instanceStatus() (bool, []string) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.instanceOverloaded, l.instanceReasons
+}
+
+// LoadSheddingEnabled reports whether any of the ServerConfig.LoadMax
+// thresholds are set, in which case MonitorLoad should be run.
+func (s *Server) LoadSheddingEnabled() bool {
+	cfg := s.config()
+
+	return cfg.LoadMaxJobBacklog > 0 || cfg.LoadMaxDBLatency > 0 || cfg.LoadMaxConns > 0
+}
+
+// Sample the signals that have a threshold set.
+func (s *Server) sampleLoad(ctx context.Context) loadSample {
+	cfg := s.config()
+
+	var sample loadSample
+
+	if cfg.LoadMaxDBLatency > 0 {
+		ctx, cancel := context.WithTimeout(ctx, ReadinessCheckTimeout)
+
+		var n int
+
+		start := time.Now()
+		err := s.db.DB().QueryRowContext(ctx, "SELECT 1").Scan(&n)
+		sample.DBLatency = time.Since(start)
+
+		cancel()
+
+		if err != nil {
+			sample.DBErr = err
+		}
+	}
+
+	if cfg.LoadMaxJobBacklog > 0 && sample.DBErr == nil {
+		n, err := workq.Backlog(s.db)
+		if err != nil {
+			sample.DBErr = err
+		}
+
+		sample.JobBacklog = n
+	}
+
+	if cfg.LoadMaxConns > 0 {
+		sample.Conns = s.load.conns()
+	}
+
+	return sample
+}
+
+// The signals in sample that are over fraction of their threshold. The job
+// backlog and the database are shared by every control server, so are
+// returned apart from the signals of this server alone.
+func (s *Server) overloadReasons(sample loadSample, fraction float64) (shared, instance []string) {
+	cfg := s.config()
+
+	if sample.DBErr != nil {
+		shared = append(shared, fmt.Sprintf("database unavailable: %s", sample.DBErr))
+	}
+
+	if max := cfg.LoadMaxJobBacklog; max > 0 {
+		if limit := int(float64(max) * fraction); sample.JobBacklog > limit {
+			shared = append(shared, fmt.Sprintf("job backlog of %d is over %d", sample.JobBacklog, limit))
+		}
+	}
+
+	if max := cfg.LoadMaxDBLatency; max > 0 {
+		if limit := time.Duration(float64(max) * fraction); sample.DBLatency > limit {
+			shared = append(shared, fmt.Sprintf("database latency of %s is over %s", sample.DBLatency, limit))
+		}
+	}
+
+	if max := cfg.LoadMaxConns; max > 0 {
+		if limit := int(float64(max) * fraction); sample.Conns > limit {
+			instance = append(instance, fmt.Sprintf("%d connections open is over %d", sample.Conns, limit))
+		}
+	}
+
+	return shared, instance
+}
+
+// Update whether the server is overloaded from sample.
+func (s *Server) updateLoad(ctx context.Context, sample loadSample) {
+	s.load.mu.Lock()
+	defer s.load.mu.Unlock()
+
+	fraction := 1.0
+	if s.load.overloaded {
+		fraction = loadRecoveryFraction
+	}
+
+	shared, instance := s.overloadReasons(sample, fraction)
+
+	reasons := append(shared, instance...)
+	overloaded := len(reasons) > 0
+
+	// Recovery is tracked separately for readiness, which has its own
+	// hysteresis.
+	fraction = 1.0
+	if s.load.instanceOverloaded {
+		fraction = loadRecoveryFraction
+	}
+
+	_, instanceReasons := s.overloadReasons(sample, fraction)
+	instanceOverloaded := len(instanceReasons) > 0
+
+	s.m.SetGauge([]string{"load", "job_backlog"}, float32(sample.JobBacklog))
+	s.m.SetGauge([]string{"load", "db_latency_ms"}, float32(sample.DBLatency)/float32(time.Millisecond))
+
+	var val float32
+	if overloaded {
+		val = 1
+	}
+
+	s.m.SetGauge([]string{"load", "overloaded"}, val)
+
+	switch {
+	case overloaded && !s.load.overloaded:
+		s.logger(ctx).Warn("control server overloaded", "reasons", reasons)
+	case !overloaded && s.load.overloaded:
+		s.logger(ctx).Info("control server recovered from overload")
+	}
+
+	switch {
+	case instanceOverloaded && !s.load.instanceOverloaded:
+		s.logger(ctx).Warn("control server overloaded, reporting not ready", "reasons", instanceReasons)
+	case !instanceOverloaded && s.load.instanceOverloaded:
+		s.logger(ctx).Info("control server recovered from overload, reporting ready")
+	}
+
+	s.load.overloaded = overloaded
+	s.load.reasons = reasons
+	s.load.instanceOverloaded = instanceOverloaded
+	s.load.instanceReasons = instanceReasons
+}
+
+// MonitorLoad samples the load signals every LoadSampleInterval until ctx
+// is done. While any is over its threshold, non-critical RPCs are rejected
+// if ServerConfig.LoadShedRPCs is set. LoadReadinessCheck only fails for
+// the server's own connections.
+func (s *Server) MonitorLoad(ctx context.Context) {
+	s.updateLoad(ctx, s.sampleLoad(ctx))
+
+	periodic.Run(ctx, LoadSampleInterval, func() {
+		s.updateLoad(ctx, s.sampleLoad(ctx))
+	})
+}
+
+// LoadReadinessCheck returns a check that fails while MonitorLoad has found
+// the server's own connections over their threshold. The job backlog and
+// the database are shared, so being over their thresholds would fail every
+// server's check at once and leave nothing to send traffic to; the RPCs
+// shed by LoadShedRPCs cover those.
+func (s *Server) LoadReadinessCheck() ReadinessCheck {
+	return func(ctx context.Context) error {
+		overloaded, reasons := s.load.instanceStatus()
+		if !overloaded {
+			return nil
+		}
+
+		return fmt.Errorf("overloaded: %s", strings.Join(reasons, ", "))
+	}
+}
+
+// Hubs and operators keep being served while overloaded, so that flows
+// keep running and the server can still be inspected. The rest can be
+// retried once it has recovered.
+func loadCriticalMethod(method string) bool {
+	switch methodPolicies[method].auth {
+	case authHub, authOps:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnaryLoadSheddingInterceptor rejects non-critical RPCs with Unavailable
+// while the server is overloaded, if ServerConfig.LoadShedRPCs is set.
+func (s *Server) UnaryLoadSheddingInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if s.config().LoadShedRPCs && !loadCriticalMethod(info.FullMethod) {
+		if overloaded, _ := s.load.status(); overloaded {
+			s.m.IncrCounter([]string{"load", "shed"}, 1)
+			return nil, status.Errorf(codes.Unavailable, "the control server is overloaded, try again later")
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,105 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadShedding(t *testing.T) {
+	newServer := func() *Server {
+		var s Server
+		s.L = hclog.NewNullLogger()
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+		s.cfg.LoadMaxJobBacklog = 100
+		s.cfg.LoadMaxDBLatency = time.Second
+		s.cfg.LoadMaxConns = 100
+
+		return &s
+	}
+
+	ctx := context.Background()
+
+	t.Run("reports not ready until load drops well below the thresholds", func(t *testing.T) {
+		s := newServer()
+		check := s.LoadReadinessCheck()
+
+		s.updateLoad(ctx, loadSample{Conns: 50})
+		require.NoError(t, check(ctx))
+
+		s.updateLoad(ctx, loadSample{Conns: 150})
+
+		err := check(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "150 connections open is over 100")
+
+		// Below the thresholds, but not yet below the recovery fraction
+		s.updateLoad(ctx, loadSample{Conns: 90})
+		require.Error(t, check(ctx))
+
+		s.updateLoad(ctx, loadSample{Conns: 70})
+		require.NoError(t, check(ctx))
+	})
+
+	t.Run("stays ready while only shared signals are overloaded", func(t *testing.T) {
+		s := newServer()
+
+		s.updateLoad(ctx, loadSample{JobBacklog: 150, DBLatency: 2 * time.Second})
+		require.NoError(t, s.LoadReadinessCheck()(ctx))
+
+		overloaded, reasons := s.load.status()
+		require.True(t, overloaded)
+
+		assert.Contains(t, reasons, "job backlog of 150 is over 100")
+		assert.Contains(t, reasons, "database latency of 2s is over 1s")
+	})
+
+	t.Run("counts an unreachable database as overloaded", func(t *testing.T) {
+		s := newServer()
+
+		s.updateLoad(ctx, loadSample{DBErr: errors.New("connection refused")})
+
+		overloaded, reasons := s.load.status()
+		require.True(t, overloaded)
+
+		assert.Contains(t, reasons, "database unavailable: connection refused")
+	})
+
+	t.Run("sheds only non-critical RPCs when enabled", func(t *testing.T) {
+		s := newServer()
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+
+		call := func(method string) error {
+			_, err := s.UnaryLoadSheddingInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+
+		s.updateLoad(ctx, loadSample{JobBacklog: 150})
+
+		require.NoError(t, call("/pb.ControlManagement/ListAccounts"))
+
+		s.cfg.LoadShedRPCs = true
+
+		err := call("/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		require.NoError(t, call("/pb.ControlServices/FetchConfig"))
+		require.NoError(t, call("/pb.ControlManagement/SetMaintenanceMode"))
+
+		s.updateLoad(ctx, loadSample{})
+
+		require.NoError(t, call("/pb.ControlManagement/ListAccounts"))
+	})
+}
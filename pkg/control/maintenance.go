@@ -0,0 +1,54 @@
+package control
+
+import (
+	"context"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/workq"
+)
+
+// GetMaintenanceStatus reports the last outcome and next run of every
+// periodic job, such as cleanup-activity-log, from the state persisted by
+// the workq workers. It requires the ops token.
+func (s *Server) GetMaintenanceStatus(ctx context.Context, _ *pb.Noop) (*pb.MaintenanceStatus, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	pjobs, err := workq.ListPeriodicStatus(s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.MaintenanceStatus
+
+	for _, pj := range pjobs {
+		st := &pb.PeriodicJobStatus{
+			Name:            pj.Name,
+			JobType:         pj.JobType,
+			Period:          pj.Period,
+			NextRun:         pb.NewTimestamp(pj.NextRun),
+			PendingAttempts: int32(pj.PendingAttempts),
+		}
+
+		if pj.LastRunAt != nil {
+			st.LastRunAt = pb.NewTimestamp(*pj.LastRunAt)
+		}
+
+		if pj.LastStatus != nil {
+			st.LastStatus = *pj.LastStatus
+		}
+
+		if pj.LastError != nil {
+			st.LastError = *pj.LastError
+		}
+
+		if pj.RetryAt != nil {
+			st.RetryAt = pb.NewTimestamp(*pj.RetryAt)
+		}
+
+		resp.Jobs = append(resp.Jobs, st)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,259 @@
+package control
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The reason given to rejected callers when none was provided.
+const defaultMaintenanceReason = "the control server is in maintenance mode"
+
+// Reports whether method changes state, and is rejected in maintenance
+// mode. The RPCs hubs call are never marked as writes, so that hubs stay
+// connected and flows keep running while management is frozen.
+func writeMethod(method string) bool {
+	return methodPolicies[method].write
+}
+
+// How long a control server goes on the maintenance mode it last read
+// before reading it from the database again.
+const maintenanceRefresh = 5 * time.Second
+
+// Maintenance mode is kept in the database, so that it applies to every
+// control server, and cached here. Whether it's on, the reason and since
+// when are persisted in the single row of maintenance_mode, and so survive
+// restarts; servers without a database keep the mode in memory only, and
+// start with it off.
+type maintenanceMode struct {
+	mu      sync.RWMutex
+	enabled bool
+	reason  string
+	since   time.Time
+	checked time.Time
+}
+
+// The row of the maintenance_mode table, which only ever has the one.
+type maintenanceRow struct {
+	Enabled bool
+	Reason  string
+	Since   *time.Time
+}
+
+// Read the maintenance mode from the database when the cached one is older
+// than maintenanceRefresh. On error the cached mode stays in effect. The
+// read and the update of the cache happen under the lock, so that a stale
+// read can't overwrite a mode setMaintenanceMode just applied, and only one
+// caller reads the database at a time.
+func (s *Server) refreshMaintenanceMode() {
+	if s.db == nil {
+		return
+	}
+
+	s.maintenance.mu.RLock()
+	fresh := time.Since(s.maintenance.checked) < maintenanceRefresh
+	s.maintenance.mu.RUnlock()
+
+	if fresh {
+		return
+	}
+
+	s.maintenance.mu.Lock()
+	defer s.maintenance.mu.Unlock()
+
+	// Another caller may have refreshed it while this one waited.
+	if time.Since(s.maintenance.checked) < maintenanceRefresh {
+		return
+	}
+
+	var row maintenanceRow
+
+	err := s.db.Raw("SELECT enabled, reason, since FROM maintenance_mode WHERE id = 1").Row().
+		Scan(&row.Enabled, &row.Reason, &row.Since)
+	if err != nil && err != sql.ErrNoRows {
+		s.L.Error("error reading maintenance mode", "error", err)
+		return
+	}
+
+	s.maintenance.checked = time.Now()
+	s.applyMaintenanceMode(row)
+}
+
+// Update the cached mode to row. Requires s.maintenance.mu.
+func (s *Server) applyMaintenanceMode(row maintenanceRow) {
+	s.maintenance.enabled = row.Enabled
+	s.maintenance.reason = row.Reason
+	s.maintenance.since = time.Time{}
+
+	if row.Since != nil {
+		s.maintenance.since = *row.Since
+	}
+
+	var val float32
+	if row.Enabled {
+		val = 1
+	}
+
+	s.m.SetGauge([]string{"maintenance_mode"}, val)
+}
+
+func (s *Server) maintenanceStatus() *pb.MaintenanceMode {
+	s.refreshMaintenanceMode()
+
+	s.maintenance.mu.RLock()
+	defer s.maintenance.mu.RUnlock()
+
+	out := &pb.MaintenanceMode{
+		Enabled: s.maintenance.enabled,
+		Reason:  s.maintenance.reason,
+	}
+
+	if !s.maintenance.since.IsZero() {
+		out.Since = pb.NewTimestamp(s.maintenance.since)
+	}
+
+	return out
+}
+
+// Turn maintenance mode on or off for every control server.
+func (s *Server) setMaintenanceMode(enabled bool, reason string) error {
+	s.maintenance.mu.Lock()
+	defer s.maintenance.mu.Unlock()
+
+	if enabled && reason == "" {
+		reason = defaultMaintenanceReason
+	}
+
+	if !enabled {
+		reason = ""
+	}
+
+	row := maintenanceRow{
+		Enabled: enabled,
+		Reason:  reason,
+	}
+
+	// Without a database, the mode only applies to this server.
+	if s.db == nil {
+		if enabled != s.maintenance.enabled {
+			now := time.Now()
+			row.Since = &now
+		} else if !s.maintenance.since.IsZero() {
+			since := s.maintenance.since
+			row.Since = &since
+		}
+
+		s.applyMaintenanceMode(row)
+
+		return nil
+	}
+
+	// since only moves when the mode actually changes, as seen by the
+	// database rather than this server's possibly stale copy.
+	err := s.db.Raw(
+		`INSERT INTO maintenance_mode (id, enabled, reason, since) VALUES (1, ?, ?, now())
+		 ON CONFLICT (id) DO UPDATE SET
+		   enabled = EXCLUDED.enabled,
+		   reason = EXCLUDED.reason,
+		   since = CASE WHEN maintenance_mode.enabled = EXCLUDED.enabled THEN maintenance_mode.since ELSE now() END,
+		   updated_at = now()
+		 RETURNING enabled, reason, since`,
+		enabled, reason,
+	).Row().Scan(&row.Enabled, &row.Reason, &row.Since)
+	if err != nil {
+		return err
+	}
+
+	s.maintenance.checked = time.Now()
+	s.applyMaintenanceMode(row)
+
+	return nil
+}
+
+// SetMaintenanceMode turns maintenance mode on or off. While it's on, the
+// RPCs that change state fail with Unavailable and the given reason, while
+// reads and hub traffic carry on. The mode is persisted, so it stays on
+// across restarts until turned off. It requires the ops token.
+func (s *Server) SetMaintenanceMode(ctx context.Context, req *pb.SetMaintenanceModeRequest) (*pb.MaintenanceMode, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	err := s.setMaintenanceMode(req.Enabled, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	out := s.maintenanceStatus()
+
+	s.logger(ctx).Warn("maintenance mode changed", "enabled", out.Enabled, "reason", out.Reason)
+
+	s.emitWatchEvent(&WebhookEvent{
+		Type: EventConfigChanged,
+		Data: map[string]interface{}{
+			"settings": []string{"maintenance_mode"},
+			"enabled":  out.Enabled,
+			"reason":   out.Reason,
+		},
+	})
+
+	return out, nil
+}
+
+// UnaryMaintenanceModeInterceptor rejects the RPCs that change state while
+// maintenance mode is on.
+func (s *Server) UnaryMaintenanceModeInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if writeMethod(info.FullMethod) && !dryRun(req) {
+		mode := s.maintenanceStatus()
+
+		if mode.Enabled {
+			s.m.IncrCounter([]string{"maintenance_mode", "rejected"}, 1)
+			return nil, status.Errorf(codes.Unavailable, "%s, try again later", mode.Reason)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// Whether req asks for a dry run, which only reports what the call would
+// change, so is allowed in maintenance mode.
+func dryRun(req interface{}) bool {
+	dr, ok := req.(interface{ GetDryRun() bool })
+	return ok && dr.GetDryRun()
+}
+
+type serverInfo struct {
+	MaintenanceMode   bool       `json:"maintenance_mode"`
+	MaintenanceReason string     `json:"maintenance_reason,omitempty"`
+	MaintenanceSince  *time.Time `json:"maintenance_since,omitempty"`
+}
+
+func (s *Server) httpInfo(w http.ResponseWriter, req *http.Request) {
+	mode := s.maintenanceStatus()
+
+	info := serverInfo{
+		MaintenanceMode:   mode.Enabled,
+		MaintenanceReason: mode.Reason,
+	}
+
+	if mode.Since != nil {
+		since := mode.Since.Time()
+		info.MaintenanceSince = &since
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&info)
+}
@@ -0,0 +1,140 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	var s Server
+	s.L = hclog.L()
+	s.opsToken = "ddeeff"
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	ops := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "ddeeff"))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.Noop{}, nil
+	}
+
+	call := func(method string) error {
+		_, err := s.UnaryMaintenanceModeInterceptor(context.Background(), &pb.Noop{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("leaves the hub services writable", func(t *testing.T) {
+		for method, mp := range methodPolicies {
+			if mp.auth == authHub {
+				assert.False(t, mp.write, method)
+			}
+		}
+	})
+
+	t.Run("requires the ops token", func(t *testing.T) {
+		_, err := s.SetMaintenanceMode(context.Background(), &pb.SetMaintenanceModeRequest{Enabled: true})
+		assert.Equal(t, ErrBadAuthentication, err)
+
+		assert.False(t, s.maintenanceStatus().Enabled)
+	})
+
+	t.Run("rejects writes while allowing reads", func(t *testing.T) {
+		resp, err := s.SetMaintenanceMode(ops, &pb.SetMaintenanceModeRequest{
+			Enabled: true,
+			Reason:  "database migration",
+		})
+		require.NoError(t, err)
+
+		assert.True(t, resp.Enabled)
+		assert.NotNil(t, resp.Since)
+
+		err = call("/pb.ControlManagement/AddAccount")
+		require.Error(t, err)
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "database migration")
+
+		err = call("/pb.ControlManagement/RebuildRoutingState")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		assert.NoError(t, call("/pb.ControlManagement/ListAccounts"))
+		assert.NoError(t, call("/pb.ControlServices/AddService"))
+		assert.NoError(t, call("/pb.ControlManagement/SetMaintenanceMode"))
+	})
+
+	t.Run("allows dry runs of writes", func(t *testing.T) {
+		dryRun := func(method string, req interface{}) error {
+			_, err := s.UnaryMaintenanceModeInterceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+
+		assert.NoError(t, dryRun("/pb.ControlManagement/CleanupOrphanedObjects", &pb.CleanupOrphanedObjectsRequest{DryRun: true}))
+		assert.NoError(t, dryRun("/pb.ControlManagement/RebuildRoutingState", &pb.RebuildRoutingStateRequest{DryRun: true}))
+
+		err := dryRun("/pb.ControlManagement/CleanupOrphanedObjects", &pb.CleanupOrphanedObjectsRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("reports the mode in /info", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.httpInfo(w, httptest.NewRequest("GET", "/info", nil))
+
+		var info serverInfo
+
+		err := json.NewDecoder(w.Body).Decode(&info)
+		require.NoError(t, err)
+
+		assert.True(t, info.MaintenanceMode)
+		assert.Equal(t, "database migration", info.MaintenanceReason)
+		assert.NotNil(t, info.MaintenanceSince)
+	})
+
+	t.Run("accepts writes once turned off", func(t *testing.T) {
+		resp, err := s.SetMaintenanceMode(ops, &pb.SetMaintenanceModeRequest{})
+		require.NoError(t, err)
+
+		assert.False(t, resp.Enabled)
+		assert.Empty(t, resp.Reason)
+
+		assert.NoError(t, call("/pb.ControlManagement/AddAccount"))
+	})
+
+	t.Run("applies to every server sharing the database", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		a := &Server{L: hclog.L(), m: s.m, db: db, opsToken: "ddeeff"}
+		b := &Server{L: hclog.L(), m: s.m, db: db}
+
+		_, err := a.SetMaintenanceMode(ops, &pb.SetMaintenanceModeRequest{Enabled: true, Reason: "failover"})
+		require.NoError(t, err)
+
+		mode := b.maintenanceStatus()
+		assert.True(t, mode.Enabled)
+		assert.Equal(t, "failover", mode.Reason)
+
+		_, err = b.UnaryMaintenanceModeInterceptor(context.Background(), &pb.Noop{}, &grpc.UnaryServerInfo{FullMethod: "/pb.ControlManagement/AddAccount"}, handler)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		_, err = a.SetMaintenanceMode(ops, &pb.SetMaintenanceModeRequest{})
+		require.NoError(t, err)
+
+		// b goes on its cached copy until it's due for a refresh.
+		b.maintenance.checked = time.Time{}
+
+		assert.False(t, b.maintenanceStatus().Enabled)
+	})
+}
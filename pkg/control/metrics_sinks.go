@@ -0,0 +1,56 @@
+package control
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/datadog"
+	"github.com/armon/go-metrics/prometheus"
+	"github.com/hashicorp/go-hclog"
+)
+
+// The sinks the server's metrics are emitted to. They all sit behind the
+// one metrics.Metrics of the server, so each sink sees every counter, gauge
+// and sample under the same name, however many of them are configured.
+func newMetricsSinks(L hclog.Logger, cfg ServerConfig, hostname string) (metrics.FanoutSink, *metrics.InmemSink, error) {
+	var fanout metrics.FanoutSink
+
+	if !cfg.DisablePrometheus {
+		psink, err := prometheus.NewPrometheusSinkFrom(prometheus.PrometheusOpts{
+			Expiration: time.Hour,
+		})
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fanout = append(fanout, psink)
+	}
+
+	msink := metrics.NewInmemSink(time.Minute, time.Hour)
+	fanout = append(fanout, msink)
+
+	if cfg.DataDogAddr != "" {
+		L.Info("configured to send stats to datadog", "addr", cfg.DataDogAddr)
+
+		dsink, err := datadog.NewDogStatsdSink(cfg.DataDogAddr, hostname)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fanout = append(fanout, dsink)
+	}
+
+	if cfg.StatsDAddr != "" {
+		L.Info("configured to send stats to statsd", "addr", cfg.StatsDAddr)
+
+		ssink, err := metrics.NewStatsdSink(cfg.StatsDAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fanout = append(fanout, ssink)
+	}
+
+	return fanout, msink, nil
+}
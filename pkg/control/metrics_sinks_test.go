@@ -0,0 +1,55 @@
+package control
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsSinks(t *testing.T) {
+	t.Run("pushes metrics to statsd", func(t *testing.T) {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		defer pc.Close()
+
+		fanout, msink, err := newMetricsSinks(hclog.L(), ServerConfig{
+			DisablePrometheus: true,
+			StatsDAddr:        pc.LocalAddr().String(),
+		}, "")
+		require.NoError(t, err)
+
+		require.Len(t, fanout, 2)
+
+		mcfg := metrics.DefaultConfig("control")
+		mcfg.EnableHostname = false
+		mcfg.EnableRuntimeMetrics = false
+
+		m, err := metrics.New(mcfg, fanout)
+		require.NoError(t, err)
+
+		m.IncrCounter([]string{"service", "add"}, 1)
+
+		pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+		buf := make([]byte, 1024)
+
+		n, _, err := pc.ReadFrom(buf)
+		require.NoError(t, err)
+
+		assert.True(t, strings.Contains(string(buf[:n]), "control.service.add:1.000000|c"), string(buf[:n]))
+
+		// The in memory sink sees the same metric.
+		data := msink.Data()
+		require.NotEmpty(t, data)
+
+		_, ok := data[len(data)-1].Counters["control.service.add"]
+		assert.True(t, ok)
+	})
+}
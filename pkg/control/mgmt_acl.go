@@ -0,0 +1,223 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// ParseCIDRList parses a comma separated list of CIDRs. Bare addresses are
+// accepted as a network of just that host.
+func ParseCIDRList(str string) ([]*net.IPNet, error) {
+	var out []*net.IPNet
+
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if !strings.Contains(part, "/") {
+			ip := net.ParseIP(part)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address: %s", part)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+
+			out = append(out, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, network)
+	}
+
+	return out, nil
+}
+
+func cidrsContain(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reports whether ip may reach the management surface. Denied networks take
+// precedence over allowed ones, and an empty allowlist allows everything
+// that isn't denied. Peers with an unknown address are only allowed when
+// neither list is configured.
+func (s *Server) mgmtPeerAllowed(ip net.IP) bool {
+	cfg := s.config()
+
+	if len(cfg.MgmtAllowCIDRs) == 0 && len(cfg.MgmtDenyCIDRs) == 0 {
+		return true
+	}
+
+	if ip == nil {
+		return false
+	}
+
+	if cidrsContain(cfg.MgmtDenyCIDRs, ip) {
+		return false
+	}
+
+	if len(cfg.MgmtAllowCIDRs) == 0 {
+		return true
+	}
+
+	return cidrsContain(cfg.MgmtAllowCIDRs, ip)
+}
+
+// The hub facing services stay reachable from anywhere, every other
+// service is part of the management surface.
+func isMgmtMethod(method string) bool {
+	return !strings.HasPrefix(method, "/pb.ControlServices/")
+}
+
+// The address of the caller, given the address of its peer and a way to
+// read the forwarding headers of the request. Anyone can set those headers,
+// so they're only honored when the peer is one of MgmtTrustedProxyCIDRs.
+// The caller is then the last address in X-Forwarded-For not added by a
+// trusted proxy. Headers that can't be parsed yield no address at all,
+// rather than that of the proxy.
+func (s *Server) mgmtCallerIP(peerIP net.IP, header func(name string) string) net.IP {
+	trusted := s.config().MgmtTrustedProxyCIDRs
+
+	if peerIP == nil || !cidrsContain(trusted, peerIP) {
+		return peerIP
+	}
+
+	if v := strings.TrimSpace(header("X-Real-IP")); v != "" {
+		return net.ParseIP(v)
+	}
+
+	xff := header("X-Forwarded-For")
+	if strings.TrimSpace(xff) == "" {
+		return peerIP
+	}
+
+	hops := strings.Split(xff, ",")
+
+	var ip net.IP
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip = net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil || !cidrsContain(trusted, ip) {
+			return ip
+		}
+	}
+
+	return ip
+}
+
+func (s *Server) contextPeerIP(ctx context.Context) net.IP {
+	var peerIP net.IP
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+
+		peerIP = net.ParseIP(host)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return s.mgmtCallerIP(peerIP, func(name string) string {
+		return strings.Join(md[strings.ToLower(name)], ",")
+	})
+}
+
+func (s *Server) requestPeerIP(req *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+
+	return s.mgmtCallerIP(net.ParseIP(host), func(name string) string {
+		return strings.Join(req.Header[http.CanonicalHeaderKey(name)], ",")
+	})
+}
+
+func (s *Server) checkMgmtPeer(ctx context.Context, method string) error {
+	if !isMgmtMethod(method) {
+		return nil
+	}
+
+	ip := s.contextPeerIP(ctx)
+
+	if s.mgmtPeerAllowed(ip) {
+		return nil
+	}
+
+	s.logger(ctx).Warn("rejected management call from disallowed address", "method", method, "peer", ip.String())
+	s.m.IncrCounter([]string{"mgmt_acl", "denied"}, 1)
+
+	return status.Errorf(codes.PermissionDenied, "address not allowed to call %s", method)
+}
+
+// UnaryMgmtACLInterceptor rejects management calls from addresses outside
+// of MgmtAllowCIDRs, or inside MgmtDenyCIDRs. It runs ahead of the auth
+// interceptors so that disallowed peers never have their credentials
+// checked.
+func (s *Server) UnaryMgmtACLInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.checkMgmtPeer(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamMgmtACLInterceptor is the streaming counterpart of
+// UnaryMgmtACLInterceptor.
+func (s *Server) StreamMgmtACLInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := s.checkMgmtPeer(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// Restricts h, an HTTP management endpoint, to the allowed addresses.
+func (s *Server) mgmtACLHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !s.mgmtPeerAllowed(s.requestPeerIP(req)) {
+			s.m.IncrCounter([]string{"mgmt_acl", "denied"}, 1)
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,179 @@
+package control
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMgmtACL(t *testing.T) {
+	t.Run("parses cidrs and bare addresses", func(t *testing.T) {
+		nets, err := ParseCIDRList("10.0.0.0/8, 192.168.1.5,fd00::/8,")
+		require.NoError(t, err)
+		require.Len(t, nets, 3)
+
+		assert.True(t, nets[0].Contains(net.ParseIP("10.1.2.3")))
+		assert.True(t, nets[1].Contains(net.ParseIP("192.168.1.5")))
+		assert.False(t, nets[1].Contains(net.ParseIP("192.168.1.6")))
+		assert.True(t, nets[2].Contains(net.ParseIP("fd00::1")))
+
+		_, err = ParseCIDRList("10.0.0.0/33")
+		assert.Error(t, err)
+
+		_, err = ParseCIDRList("not-an-address")
+		assert.Error(t, err)
+
+		nets, err = ParseCIDRList("")
+		require.NoError(t, err)
+		assert.Empty(t, nets)
+	})
+
+	allow, err := ParseCIDRList("10.0.0.0/8")
+	require.NoError(t, err)
+
+	deny, err := ParseCIDRList("10.9.0.0/16")
+	require.NoError(t, err)
+
+	proxies, err := ParseCIDRList("192.0.2.0/24")
+	require.NoError(t, err)
+
+	s := &Server{
+		L: hclog.L(),
+		cfg: ServerConfig{
+			MgmtAllowCIDRs:        allow,
+			MgmtDenyCIDRs:         deny,
+			MgmtTrustedProxyCIDRs: proxies,
+		},
+	}
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	fromAddr := func(addr string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 4433},
+		})
+	}
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &pb.Noop{}, nil
+	}
+
+	call := func(ctx context.Context, method string) error {
+		called = false
+		_, err := s.UnaryMgmtACLInterceptor(ctx, &pb.Noop{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("allows management calls from allowed networks", func(t *testing.T) {
+		err := call(fromAddr("10.1.2.3"), "/pb.ControlManagement/ListAccounts")
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("rejects management calls from other networks", func(t *testing.T) {
+		err := call(fromAddr("203.0.113.7"), "/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, called)
+
+		err = call(fromAddr("10.9.1.1"), "/pb.FlowTopReporter/CurrentFlowTop")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, called)
+
+		err = call(context.Background(), "/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("leaves the hub services open", func(t *testing.T) {
+		err := call(fromAddr("203.0.113.7"), "/pb.ControlServices/FetchConfig")
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("uses the address set by a trusted proxy", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(fromAddr("192.0.2.1"), metadata.Pairs("x-forwarded-for", "10.1.2.3, 203.0.113.7, 192.0.2.2"))
+
+		err := call(ctx, "/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		ctx = metadata.NewIncomingContext(fromAddr("192.0.2.1"), metadata.Pairs("x-forwarded-for", "203.0.113.7, 10.1.2.3"))
+
+		err = call(ctx, "/pb.ControlManagement/ListAccounts")
+		require.NoError(t, err)
+
+		ctx = metadata.NewIncomingContext(fromAddr("192.0.2.1"), metadata.Pairs("x-real-ip", "10.1.2.3"))
+
+		err = call(ctx, "/pb.ControlManagement/ListAccounts")
+		require.NoError(t, err)
+
+		ctx = metadata.NewIncomingContext(fromAddr("192.0.2.1"), metadata.Pairs("x-forwarded-for", "bogus"))
+
+		err = call(ctx, "/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("ignores forwarding headers from other peers", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(fromAddr("203.0.113.7"), metadata.Pairs("x-real-ip", "10.1.2.3"))
+
+		err := call(ctx, "/pb.ControlManagement/ListAccounts")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		ctx = metadata.NewIncomingContext(fromAddr("10.1.2.3"), metadata.Pairs("x-forwarded-for", "203.0.113.7"))
+
+		err = call(ctx, "/pb.ControlManagement/ListAccounts")
+		require.NoError(t, err)
+	})
+
+	t.Run("restricts the http management endpoints", func(t *testing.T) {
+		h := s.mgmtACLHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(200)
+		}))
+
+		req := httptest.NewRequest("GET", "/debug/pprof/", nil)
+		req.RemoteAddr = "203.0.113.7:5555"
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req.Header.Set("X-Real-IP", "10.1.2.3")
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req.RemoteAddr = "192.0.2.1:5555"
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		req.Header.Del("X-Real-IP")
+		req.RemoteAddr = "10.1.2.3:5555"
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("allows everything when unconfigured", func(t *testing.T) {
+		open := &Server{L: hclog.L(), m: s.m}
+
+		_, err := open.UnaryMgmtACLInterceptor(context.Background(), &pb.Noop{},
+			&grpc.UnaryServerInfo{FullMethod: "/pb.ControlManagement/ListAccounts"}, handler)
+		require.NoError(t, err)
+	})
+}
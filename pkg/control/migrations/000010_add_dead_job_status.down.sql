@@ -0,0 +1,11 @@
+DELETE FROM jobs WHERE status = 'dead';
+
+ALTER TYPE job_status RENAME TO job_status_old;
+
+CREATE TYPE job_status AS ENUM ('queued', 'finished');
+
+ALTER TABLE jobs ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE jobs ALTER COLUMN status TYPE job_status USING status::text::job_status;
+ALTER TABLE jobs ALTER COLUMN status SET DEFAULT 'queued';
+
+DROP TYPE job_status_old;
@@ -0,0 +1 @@
+ALTER TABLE hubs DROP COLUMN max_flows;
@@ -0,0 +1 @@
+ALTER TABLE hubs ADD COLUMN max_flows bigint NULL;
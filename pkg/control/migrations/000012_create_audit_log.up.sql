@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id bigserial PRIMARY KEY,
+  action text NOT NULL,
+  namespace text NOT NULL,
+  actor_id bytea,
+  target text NOT NULL,
+  details jsonb,
+  created_at timestamp(6) with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_logs_created_at ON audit_logs (created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS accounts_namespace_external_id;
+ALTER TABLE accounts DROP COLUMN external_id;
@@ -0,0 +1,3 @@
+ALTER TABLE accounts ADD COLUMN external_id text NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS accounts_namespace_external_id ON accounts (namespace, external_id) WHERE external_id IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS accounts_namespace_idempotency_key;
+ALTER TABLE accounts DROP COLUMN idempotency_key;
@@ -0,0 +1,3 @@
+ALTER TABLE accounts ADD COLUMN idempotency_key text NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS accounts_namespace_idempotency_key ON accounts (namespace, idempotency_key) WHERE idempotency_key IS NOT NULL;
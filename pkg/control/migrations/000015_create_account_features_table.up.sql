@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS account_features (
+  account_id bytea NOT NULL,
+  name text NOT NULL,
+  enabled boolean NOT NULL DEFAULT false,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT now(),
+
+  PRIMARY KEY(account_id, name)
+);
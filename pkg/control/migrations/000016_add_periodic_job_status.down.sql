@@ -0,0 +1,5 @@
+ALTER TABLE periodic_jobs DROP COLUMN last_error;
+ALTER TABLE periodic_jobs DROP COLUMN last_status;
+ALTER TABLE periodic_jobs DROP COLUMN last_run_at;
+
+ALTER TABLE jobs DROP COLUMN periodic_job;
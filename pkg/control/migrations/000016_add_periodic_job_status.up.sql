@@ -0,0 +1,5 @@
+ALTER TABLE jobs ADD COLUMN periodic_job text NULL;
+
+ALTER TABLE periodic_jobs ADD COLUMN last_run_at timestamp with time zone NULL;
+ALTER TABLE periodic_jobs ADD COLUMN last_status text NULL;
+ALTER TABLE periodic_jobs ADD COLUMN last_error text NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS account_keys (
+  id bytea PRIMARY KEY,
+  account_id bytea NOT NULL,
+  name text NOT NULL,
+  secret_hash bytea NOT NULL,
+
+  last_used_at timestamp with time zone,
+  revoked_at timestamp with time zone,
+
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS account_keys_account_id ON account_keys (account_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS hub_credentials (
+  id bytea PRIMARY KEY,
+  name text NOT NULL,
+  secret_hash bytea NOT NULL,
+
+  last_used_at timestamp with time zone,
+  revoked_at timestamp with time zone,
+
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
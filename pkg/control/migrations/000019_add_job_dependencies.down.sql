@@ -0,0 +1,3 @@
+ALTER TABLE jobs DROP COLUMN cancel_reason;
+ALTER TABLE jobs DROP COLUMN pending_parents;
+ALTER TABLE jobs DROP COLUMN parent_ids;
@@ -0,0 +1,3 @@
+ALTER TABLE jobs ADD COLUMN parent_ids bytea[] NULL;
+ALTER TABLE jobs ADD COLUMN pending_parents int NOT NULL DEFAULT 0;
+ALTER TABLE jobs ADD COLUMN cancel_reason text NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS flow_rollups (
+  window_seconds int NOT NULL,
+  bucket_start timestamp with time zone NOT NULL,
+  flow_id bytea NOT NULL,
+
+  account_id bytea NULL,
+  hub_id bytea NULL,
+  agent_id bytea NULL,
+  service_id bytea NULL,
+
+  num_messages bigint NOT NULL DEFAULT 0,
+  num_bytes bigint NOT NULL DEFAULT 0,
+  ended_at timestamp with time zone NULL,
+
+  updated_at timestamp with time zone NOT NULL DEFAULT now(),
+
+  PRIMARY KEY (window_seconds, bucket_start, flow_id)
+);
+
+CREATE INDEX IF NOT EXISTS flow_rollups_bucket_start ON flow_rollups (bucket_start);
@@ -0,0 +1 @@
+ALTER TABLE jobs ADD COLUMN priority int NOT NULL DEFAULT 0;
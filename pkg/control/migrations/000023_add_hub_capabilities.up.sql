@@ -0,0 +1 @@
+ALTER TABLE hubs ADD COLUMN capabilities text[] NULL;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS account_default_labels (
+  account_id bytea PRIMARY KEY,
+  labels text[] NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT now()
+);
@@ -0,0 +1,8 @@
+DELETE FROM label_links WHERE weight IS NOT NULL;
+
+DROP INDEX IF EXISTS label_links_account_id_labels_target;
+DROP INDEX IF EXISTS label_links_unweighted;
+
+ALTER TABLE label_links ADD CONSTRAINT label_links_account_id_labels_key UNIQUE (account_id, labels);
+
+ALTER TABLE label_links DROP COLUMN weight;
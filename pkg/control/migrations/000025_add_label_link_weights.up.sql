@@ -0,0 +1,6 @@
+ALTER TABLE label_links ADD COLUMN weight integer NULL CHECK (weight >= 0);
+
+ALTER TABLE label_links DROP CONSTRAINT label_links_account_id_labels_key;
+
+CREATE UNIQUE INDEX label_links_unweighted ON label_links (account_id, labels) WHERE weight IS NULL;
+CREATE UNIQUE INDEX label_links_account_id_labels_target ON label_links (account_id, labels, target);
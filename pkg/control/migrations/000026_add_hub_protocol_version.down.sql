@@ -0,0 +1 @@
+ALTER TABLE hubs DROP COLUMN protocol_version;
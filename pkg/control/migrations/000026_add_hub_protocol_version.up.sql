@@ -0,0 +1 @@
+ALTER TABLE hubs ADD COLUMN protocol_version integer NOT NULL DEFAULT 0;
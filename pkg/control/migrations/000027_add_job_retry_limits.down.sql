@@ -0,0 +1,4 @@
+ALTER TABLE jobs DROP COLUMN abandon_reason;
+ALTER TABLE jobs DROP COLUMN first_attempt_at;
+ALTER TABLE jobs DROP COLUMN max_elapsed;
+ALTER TABLE jobs DROP COLUMN max_attempts;
@@ -0,0 +1,4 @@
+ALTER TABLE jobs ADD COLUMN max_attempts int NOT NULL DEFAULT 0;
+ALTER TABLE jobs ADD COLUMN max_elapsed bigint NOT NULL DEFAULT 0;
+ALTER TABLE jobs ADD COLUMN first_attempt_at timestamp with time zone NULL;
+ALTER TABLE jobs ADD COLUMN abandon_reason text NULL;
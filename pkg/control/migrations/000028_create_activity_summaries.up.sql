@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS activity_summaries (
+  granularity text NOT NULL,
+  bucket_start timestamp with time zone NOT NULL,
+  event_type text NOT NULL,
+  count bigint NOT NULL DEFAULT 0,
+  key_events jsonb NOT NULL DEFAULT '[]',
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (granularity, bucket_start, event_type)
+);
+
+CREATE INDEX IF NOT EXISTS activity_summaries_bucket_start ON activity_summaries (bucket_start);
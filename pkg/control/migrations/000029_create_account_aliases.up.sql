@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS account_aliases (
+  alias_id bytea PRIMARY KEY,
+  account_id bytea NOT NULL,
+
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS account_aliases_account_id ON account_aliases (account_id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS maintenance_mode (
+  id integer PRIMARY KEY CHECK (id = 1),
+  enabled boolean NOT NULL DEFAULT false,
+  reason text NOT NULL DEFAULT '',
+  since timestamp with time zone,
+
+  updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
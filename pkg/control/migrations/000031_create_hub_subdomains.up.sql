@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS hub_subdomains (
+  stable_id bytea PRIMARY KEY,
+  last_seen_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+INSERT INTO hub_subdomains (stable_id, last_seen_at)
+  SELECT stable_id, last_checkin FROM hubs
+  ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE hub_credentials DROP COLUMN IF EXISTS hub_id;
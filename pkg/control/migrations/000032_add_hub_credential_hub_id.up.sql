@@ -0,0 +1 @@
+ALTER TABLE hub_credentials ADD COLUMN IF NOT EXISTS hub_id bytea;
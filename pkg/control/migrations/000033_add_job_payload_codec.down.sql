@@ -0,0 +1,7 @@
+UPDATE jobs SET payload = jsonb_build_object('$codec', payload_codec, 'data', payload)
+  WHERE payload_codec <> 'json';
+UPDATE periodic_jobs SET payload = jsonb_build_object('$codec', payload_codec, 'data', payload)
+  WHERE payload_codec <> 'json';
+
+ALTER TABLE periodic_jobs DROP COLUMN payload_codec;
+ALTER TABLE jobs DROP COLUMN payload_codec;
@@ -0,0 +1,7 @@
+ALTER TABLE jobs ADD COLUMN payload_codec text NOT NULL DEFAULT 'json';
+ALTER TABLE periodic_jobs ADD COLUMN payload_codec text NOT NULL DEFAULT 'json';
+
+UPDATE jobs SET payload_codec = payload->>'$codec', payload = payload->'data'
+  WHERE jsonb_typeof(payload) = 'object' AND payload ? '$codec';
+UPDATE periodic_jobs SET payload_codec = payload->>'$codec', payload = payload->'data'
+  WHERE jsonb_typeof(payload) = 'object' AND payload ? '$codec';
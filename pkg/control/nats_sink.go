@@ -0,0 +1,217 @@
+package control
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The subject prefix used when the sink URL doesn't give one. Events are
+// published to the prefix followed by the event type, eg.
+// horizon.events.service-added.
+const DefaultNATSSubjectPrefix = "horizon.events"
+
+var NATSTimeout = 10 * time.Second
+
+// A NATSEventSink publishes events as JSON to a NATS server, speaking the
+// core client protocol directly. Each publish is followed by a PING, so
+// Publish only returns once the server has processed the message.
+type NATSEventSink struct {
+	addr   string
+	user   string
+	pass   string
+	prefix string
+
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// NewNATSEventSink returns a sink for a nats:// URL. The path, if any, is
+// used as the subject prefix. The connection is established on first use.
+func NewNATSEventSink(u *url.URL) (*NATSEventSink, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("nats event sink requires a host")
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	sink := &NATSEventSink{
+		addr:   addr,
+		prefix: strings.Trim(strings.Replace(u.Path, "/", ".", -1), "."),
+	}
+
+	if sink.prefix == "" {
+		sink.prefix = DefaultNATSSubjectPrefix
+	}
+
+	if u.User != nil {
+		sink.user = u.User.Username()
+		sink.pass, _ = u.User.Password()
+	}
+
+	return sink, nil
+}
+
+func (n *NATSEventSink) Publish(ctx context.Context, ev *WebhookEvent) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err = n.publish(ctx, n.prefix+"."+ev.Type, data)
+	if err != nil {
+		// Start over with a new connection on the next attempt.
+		n.close()
+		return errors.Wrapf(err, "publishing event to nats")
+	}
+
+	return nil
+}
+
+// Close the connection to the server, if open.
+func (n *NATSEventSink) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.close()
+}
+
+func (n *NATSEventSink) close() error {
+	if n.conn == nil {
+		return nil
+	}
+
+	err := n.conn.Close()
+	n.conn = nil
+	n.rd = nil
+
+	return err
+}
+
+func (n *NATSEventSink) publish(ctx context.Context, subject string, data []byte) error {
+	if n.conn == nil {
+		err := n.connect(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(NATSTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	n.conn.SetDeadline(deadline)
+
+	_, err := fmt.Fprintf(n.conn, "PUB %s %d\r\n%s\r\nPING\r\n", subject, len(data), data)
+	if err != nil {
+		return err
+	}
+
+	return n.waitPong()
+}
+
+func (n *NATSEventSink) connect(ctx context.Context) error {
+	var d net.Dialer
+
+	ctx, cancel := context.WithTimeout(ctx, NATSTimeout)
+	defer cancel()
+
+	conn, err := d.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+
+	conn.SetDeadline(time.Now().Add(NATSTimeout))
+
+	n.conn = conn
+	n.rd = bufio.NewReader(conn)
+
+	line, err := n.rd.ReadString('\n')
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected greeting from nats server: %s", strings.TrimSpace(line))
+	}
+
+	var info struct {
+		TLSRequired bool `json:"tls_required"`
+	}
+
+	err = json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info)
+	if err != nil {
+		return errors.Wrapf(err, "decoding nats server info")
+	}
+
+	// The sink only speaks plain TCP, so rather than sending the
+	// credentials to a server that expects TLS, give up.
+	if info.TLSRequired {
+		return fmt.Errorf("nats server requires TLS, which the event sink doesn't support")
+	}
+
+	opts := map[string]interface{}{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "horizon-control",
+		"lang":     "go",
+	}
+
+	if n.user != "" {
+		opts["user"] = n.user
+		opts["pass"] = n.pass
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", data)
+	if err != nil {
+		return err
+	}
+
+	return n.waitPong()
+}
+
+// Read until the server answers our PING, which it does only after
+// processing everything sent before it.
+func (n *NATSEventSink) waitPong() error {
+	for {
+		line, err := n.rd.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			_, err = n.conn.Write([]byte("PONG\r\n"))
+			if err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
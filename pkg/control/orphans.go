@@ -0,0 +1,178 @@
+package control
+
+import (
+	context "context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Objects are only considered orphaned once they've been unmodified for
+// this long, so that an account being created concurrently with the
+// cleanup doesn't have its routing removed.
+var DefaultOrphanGracePeriod = 24 * time.Hour
+
+const accountServicesPrefix = "account_services/"
+
+// OrphanCleaner removes account routing objects from S3 whose account no
+// longer exists in the database.
+type OrphanCleaner struct {
+	DB      *gorm.DB
+	Session *session.Session
+	Bucket  string
+
+	// Defaults to DefaultOrphanGracePeriod.
+	GracePeriod time.Duration
+
+	// Only log the objects that would be deleted.
+	DryRun bool
+}
+
+// The S3 key suffixes, as used by updateAccountRouting, of all accounts.
+func (o *OrphanCleaner) liveAccountKeys() (map[string]struct{}, error) {
+	live := make(map[string]struct{})
+
+	var lastId []byte
+
+	for {
+		var accounts []*Account
+
+		q := o.DB.Select("id").Order("id ASC").Limit(1000)
+		if lastId != nil {
+			q = q.Where("id > ?", lastId)
+		}
+
+		err := dbx.Check(q.Find(&accounts))
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+
+		if len(accounts) == 0 {
+			break
+		}
+
+		for _, acc := range accounts {
+			account, err := pb.AccountFromKey(acc.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			live[account.HashKey()] = struct{}{}
+		}
+
+		lastId = accounts[len(accounts)-1].ID
+	}
+
+	return live, nil
+}
+
+func (o *OrphanCleaner) CleanupOrphanedObjects(ctx context.Context, jobType string, _ *struct{}) error {
+	_, _, err := o.Cleanup(ctx, o.DryRun)
+	return err
+}
+
+// Cleanup deletes the routing objects of accounts that no longer exist,
+// returning their keys and the number of live accounts. With dryRun set
+// the objects are only found, not deleted.
+func (o *OrphanCleaner) Cleanup(ctx context.Context, dryRun bool) ([]string, int, error) {
+	L := hclog.FromContext(ctx)
+
+	grace := o.GracePeriod
+	if grace == 0 {
+		grace = DefaultOrphanGracePeriod
+	}
+
+	// Load the accounts before listing, so any account created after this
+	// point has objects newer than the grace period.
+	live, err := o.liveAccountKeys()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cutoff := time.Now().Add(-grace)
+
+	api := s3.New(o.Session)
+
+	var orphans []string
+
+	err = api.ListObjectsPagesWithContext(ctx, &s3.ListObjectsInput{
+		Bucket: aws.String(o.Bucket),
+		Prefix: aws.String(accountServicesPrefix),
+	}, func(page *s3.ListObjectsOutput, last bool) bool {
+		for _, obj := range page.Contents {
+			key := aws.StringValue(obj.Key)
+
+			if _, ok := live[strings.TrimPrefix(key, accountServicesPrefix)]; ok {
+				continue
+			}
+
+			if obj.LastModified != nil && obj.LastModified.After(cutoff) {
+				continue
+			}
+
+			orphans = append(orphans, key)
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "listing s3 objects")
+	}
+
+	for _, key := range orphans {
+		if dryRun {
+			L.Info("would delete orphaned s3 object", "key", key)
+			continue
+		}
+
+		_, err = api.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(o.Bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "deleting orphaned object %s", key)
+		}
+
+		L.Info("deleted orphaned s3 object", "key", key)
+	}
+
+	L.Info("orphaned s3 object cleanup finished",
+		"orphans", len(orphans), "live-accounts", len(live), "dry-run", dryRun)
+
+	return orphans, len(live), nil
+}
+
+// CleanupOrphanedObjects runs the orphaned object cleanup now, returning
+// the keys of the objects deleted, or with dry_run that would be. It
+// requires the ops token.
+func (s *Server) CleanupOrphanedObjects(ctx context.Context, req *pb.CleanupOrphanedObjectsRequest) (*pb.CleanupOrphanedObjectsResponse, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	oc := &OrphanCleaner{
+		DB:          s.db,
+		Session:     s.awsSess,
+		Bucket:      s.bucket,
+		GracePeriod: s.config().OrphanGracePeriod,
+	}
+
+	keys, live, err := oc.Cleanup(hclog.WithContext(ctx, s.logger(ctx)), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CleanupOrphanedObjectsResponse{
+		Keys:         keys,
+		LiveAccounts: int64(live),
+		DryRun:       req.DryRun,
+	}, nil
+}
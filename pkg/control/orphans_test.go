@@ -0,0 +1,139 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanCleaner(t *testing.T) {
+	sess := testutils.AWSSession(t)
+	api := s3.New(sess)
+
+	exists := func(bucket, key string) bool {
+		_, err := api.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		return err == nil
+	}
+
+	// Creates a bucket with routing objects for a live and deleted account,
+	// returning the bucket and the two keys.
+	setup := func(t *testing.T, oc *OrphanCleaner) (string, string) {
+		oc.Bucket = strings.ToLower("hzntest-" + pb.NewULID().SpecString())
+
+		_, err := api.CreateBucket(&s3.CreateBucketInput{
+			Bucket: aws.String(oc.Bucket),
+		})
+		require.NoError(t, err)
+
+		live := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+		gone := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+
+		err = dbx.Check(oc.DB.Create(&Account{ID: live.Key(), Namespace: live.Namespace}))
+		require.NoError(t, err)
+
+		liveKey := accountServicesPrefix + live.HashKey()
+		goneKey := accountServicesPrefix + gone.HashKey()
+
+		for _, key := range []string{liveKey, goneKey, "label_links"} {
+			_, err := api.PutObject(&s3.PutObjectInput{
+				Bucket: aws.String(oc.Bucket),
+				Key:    aws.String(key),
+				Body:   bytes.NewReader([]byte("routing")),
+			})
+			require.NoError(t, err)
+		}
+
+		return liveKey, goneKey
+	}
+
+	t.Run("deletes objects for accounts that no longer exist", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		oc := &OrphanCleaner{DB: db, Session: sess, GracePeriod: time.Millisecond}
+
+		liveKey, goneKey := setup(t, oc)
+		defer testutils.DeleteBucket(api, oc.Bucket)
+
+		// LastModified has a resolution of a second
+		time.Sleep(time.Second)
+
+		err := oc.CleanupOrphanedObjects(context.Background(), "cleanup-orphaned-objects", nil)
+		require.NoError(t, err)
+
+		assert.True(t, exists(oc.Bucket, liveKey))
+		assert.False(t, exists(oc.Bucket, goneKey))
+		assert.True(t, exists(oc.Bucket, "label_links"))
+	})
+
+	t.Run("keeps orphans within the grace period", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		oc := &OrphanCleaner{DB: db, Session: sess, GracePeriod: time.Hour}
+
+		_, goneKey := setup(t, oc)
+		defer testutils.DeleteBucket(api, oc.Bucket)
+
+		err := oc.CleanupOrphanedObjects(context.Background(), "cleanup-orphaned-objects", nil)
+		require.NoError(t, err)
+
+		assert.True(t, exists(oc.Bucket, goneKey))
+	})
+
+	t.Run("only logs orphans in dry run mode", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		oc := &OrphanCleaner{DB: db, Session: sess, GracePeriod: time.Millisecond, DryRun: true}
+
+		_, goneKey := setup(t, oc)
+		defer testutils.DeleteBucket(api, oc.Bucket)
+
+		time.Sleep(time.Second)
+
+		err := oc.CleanupOrphanedObjects(context.Background(), "cleanup-orphaned-objects", nil)
+		require.NoError(t, err)
+
+		assert.True(t, exists(oc.Bucket, goneKey))
+	})
+
+	t.Run("returns the orphans found", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		oc := &OrphanCleaner{DB: db, Session: sess, GracePeriod: time.Millisecond}
+
+		_, goneKey := setup(t, oc)
+		defer testutils.DeleteBucket(api, oc.Bucket)
+
+		time.Sleep(time.Second)
+
+		keys, live, err := oc.Cleanup(context.Background(), true)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{goneKey}, keys)
+		assert.Equal(t, 1, live)
+		assert.True(t, exists(oc.Bucket, goneKey))
+
+		keys, _, err = oc.Cleanup(context.Background(), false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{goneKey}, keys)
+		assert.False(t, exists(oc.Bucket, goneKey))
+	})
+}
@@ -0,0 +1,30 @@
+package control
+
+import (
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+// Collects the items of a listing whose stored records couldn't be
+// decoded. Strict requests fail on the first such item, best-effort ones
+// skip it, recording why, so that one corrupt record doesn't fail a whole
+// listing. Errors reading the listing itself aren't per item and always
+// fail the request.
+type itemErrors struct {
+	bestEffort bool
+	errs       []*pb.ItemError
+}
+
+// Record that item failed with err. The error is returned when the request
+// is strict, and should then be returned by the handler.
+func (e *itemErrors) add(item string, err error) error {
+	if !e.bestEffort {
+		return err
+	}
+
+	e.errs = append(e.errs, &pb.ItemError{
+		Item:  item,
+		Error: err.Error(),
+	})
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package control
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/http/pprof"
+)
+
+// PprofHandler returns a handler serving the net/http/pprof endpoints under
+// /debug/pprof/. Requests must present opsToken in the Authorization header,
+// the same way ops RPCs are authorized, otherwise they're rejected.
+func PprofHandler(opsToken string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		auth := req.Header.Get("Authorization")
+
+		if opsToken == "" || subtle.ConstantTimeCompare([]byte(auth), []byte(opsToken)) != 1 {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		mux.ServeHTTP(w, req)
+	})
+}
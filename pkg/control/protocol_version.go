@@ -0,0 +1,90 @@
+package control
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProtocolVersion is the version of the protocol spoken between hubs and
+// control. It's bumped when a change to the messages they exchange means a
+// hub and control on either side of it can't work together.
+const ProtocolVersion int32 = 1
+
+// Record the protocol version reported by a hub opening its activity stream,
+// and refuse the stream if it's below ServerConfig.MinHubProtocolVersion.
+func (s *Server) checkHubProtocolVersion(ctx context.Context, reg *pb.HubActivity_HubRegistration) error {
+	cfg := s.config()
+
+	L := s.logger(ctx)
+
+	version := reg.ProtocolVersion
+
+	s.m.IncrCounterWithLabels([]string{"hub", "protocol_version"}, 1, []metrics.Label{
+		{
+			Name:  "version",
+			Value: strconv.Itoa(int(version)),
+		},
+	})
+
+	if version != ProtocolVersion {
+		s.m.IncrCounter([]string{"hub", "version_skew"}, 1)
+
+		L.Warn("hub protocol version differs from control's",
+			"hub", reg.Hub.SpecString(),
+			"hub-version", version,
+			"control-version", ProtocolVersion,
+			"hub-newer", version > ProtocolVersion,
+		)
+	}
+
+	if version < cfg.MinHubProtocolVersion {
+		s.m.IncrCounter([]string{"hub", "version_rejected"}, 1)
+
+		L.Error("rejecting hub below the minimum supported protocol version",
+			"hub", reg.Hub.SpecString(),
+			"hub-version", version,
+			"min-version", cfg.MinHubProtocolVersion,
+		)
+
+		return status.Errorf(codes.FailedPrecondition,
+			"hub protocol version %d is below the minimum supported version %d, the hub must be upgraded",
+			version, cfg.MinHubProtocolVersion)
+	}
+
+	err := dbx.Check(s.db.Model(&Hub{}).
+		Where("instance_id = ?", reg.Hub.Bytes()).
+		Update("protocol_version", version),
+	)
+	if err != nil {
+		L.Error("error recording hub protocol version", "error", err, "hub", reg.Hub.SpecString())
+	}
+
+	return nil
+}
+
+func protocolVersionCounts(hubs []*pb.HubInfo) []*pb.ProtocolVersionCount {
+	counts := make(map[int32]int64)
+
+	for _, h := range hubs {
+		counts[h.ProtocolVersion]++
+	}
+
+	var out []*pb.ProtocolVersionCount
+
+	for version, n := range counts {
+		out = append(out, &pb.ProtocolVersionCount{Version: version, Hubs: n})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Version < out[j].Version
+	})
+
+	return out
+}
@@ -0,0 +1,67 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHubProtocolVersion(t *testing.T) {
+	t.Run("records versions and rejects those below the minimum", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+		s.cfg.MinHubProtocolVersion = 1
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		hubs := make([]*pb.ULID, 3)
+
+		for i := range hubs {
+			hubs[i] = pb.NewULID()
+
+			err := dbx.Check(db.Create(&Hub{
+				StableID:       pb.NewULID().Bytes(),
+				InstanceID:     hubs[i].Bytes(),
+				ConnectionInfo: []byte("[]"),
+				LastCheckin:    time.Now(),
+			}))
+			require.NoError(t, err)
+		}
+
+		ctx := context.Background()
+
+		err := s.checkHubProtocolVersion(ctx, &pb.HubActivity_HubRegistration{Hub: hubs[0], ProtocolVersion: ProtocolVersion})
+		require.NoError(t, err)
+
+		err = s.checkHubProtocolVersion(ctx, &pb.HubActivity_HubRegistration{Hub: hubs[1], ProtocolVersion: ProtocolVersion + 1})
+		require.NoError(t, err)
+
+		err = s.checkHubProtocolVersion(ctx, &pb.HubActivity_HubRegistration{Hub: hubs[2]})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		list, err := s.AllHubs(ctx, &pb.Noop{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []*pb.ProtocolVersionCount{
+			{Version: 0, Hubs: 1},
+			{Version: ProtocolVersion, Hubs: 1},
+			{Version: ProtocolVersion + 1, Hubs: 1},
+		}, list.ProtocolVersions)
+	})
+}
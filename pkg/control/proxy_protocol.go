@@ -0,0 +1,213 @@
+package control
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// How long a connection from a trusted proxy has to send its PROXY header.
+const proxyHeaderTimeout = 5 * time.Second
+
+// The signature that starts a version 2 PROXY header.
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// ProxyProtocolListener returns l with PROXY protocol, version 1 or 2,
+// headers read from the connections of peers within
+// ServerConfig.MgmtTrustedProxyCIDRs. Such a connection's RemoteAddr is the
+// client the header names, which is what the management ACL then checks.
+// Connections without a header are served as they are, and those of any
+// other peer are never inspected, so that clients can't claim an address.
+// Without ServerConfig.ProxyProtocol, l is returned unchanged.
+func (s *Server) ProxyProtocolListener(l net.Listener) net.Listener {
+	if !s.config().ProxyProtocol {
+		return l
+	}
+
+	return &proxyListener{Listener: l, s: s}
+}
+
+type proxyListener struct {
+	net.Listener
+	s *Server
+}
+
+func (l *proxyListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	tcp, ok := c.RemoteAddr().(*net.TCPAddr)
+	if !ok || !cidrsContain(l.s.config().MgmtTrustedProxyCIDRs, tcp.IP) {
+		return c, nil
+	}
+
+	// The header is read on first use rather than here, so that a slow
+	// proxy doesn't hold up Accept.
+	return &proxyConn{Conn: c, s: l.s, br: bufio.NewReader(c)}, nil
+}
+
+type proxyConn struct {
+	net.Conn
+	s  *Server
+	br *bufio.Reader
+
+	once   sync.Once
+	remote net.Addr
+	err    error
+}
+
+func (c *proxyConn) readHeader() {
+	c.once.Do(func() {
+		c.Conn.SetReadDeadline(time.Now().Add(proxyHeaderTimeout))
+		defer c.Conn.SetReadDeadline(time.Time{})
+
+		c.remote, c.err = readProxyHeader(c.br)
+		if c.err != nil {
+			c.s.L.Warn("rejecting connection with an invalid PROXY header",
+				"peer", c.Conn.RemoteAddr().String(), "error", c.err)
+			c.s.m.IncrCounter([]string{"proxy_protocol", "invalid"}, 1)
+
+			c.Conn.Close()
+		}
+	})
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.readHeader()
+
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	return c.br.Read(b)
+}
+
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.readHeader()
+
+	if c.remote != nil {
+		return c.remote
+	}
+
+	return c.Conn.RemoteAddr()
+}
+
+// Read a PROXY header from br, returning the client address it names. No
+// header, and headers that name no address, such as those of health checks
+// by the proxy itself, return nil.
+func readProxyHeader(br *bufio.Reader) (net.Addr, error) {
+	peek, err := br.Peek(len(proxyV2Signature))
+	if err != nil && len(peek) == 0 {
+		if err == io.EOF {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(peek, []byte("PROXY ")):
+		return readProxyV1(br)
+	case bytes.Equal(peek, proxyV2Signature):
+		return readProxyV2(br)
+	default:
+		return nil, nil
+	}
+}
+
+// The longest version 1 header, including the CRLF.
+const maxProxyV1Length = 107
+
+func readProxyV1(br *bufio.Reader) (net.Addr, error) {
+	var line []byte
+
+	for len(line) < maxProxyV1Length {
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		line = append(line, b)
+
+		if bytes.HasSuffix(line, []byte("\r\n")) {
+			return parseProxyV1(string(line[:len(line)-2]))
+		}
+	}
+
+	return nil, fmt.Errorf("PROXY header too long")
+}
+
+func parseProxyV1(line string) (net.Addr, error) {
+	parts := strings.Split(line, " ")
+
+	if len(parts) >= 2 && parts[1] == "UNKNOWN" {
+		return nil, nil
+	}
+
+	if len(parts) != 6 || (parts[1] != "TCP4" && parts[1] != "TCP6") {
+		return nil, fmt.Errorf("malformed PROXY header: %q", line)
+	}
+
+	ip := net.ParseIP(parts[2])
+	if ip == nil {
+		return nil, fmt.Errorf("invalid PROXY source address: %s", parts[2])
+	}
+
+	port, err := strconv.ParseUint(parts[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PROXY source port: %s", parts[4])
+	}
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+func readProxyV2(br *bufio.Reader) (net.Addr, error) {
+	hdr := make([]byte, 16)
+
+	_, err := io.ReadFull(br, hdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if hdr[12]>>4 != 2 {
+		return nil, fmt.Errorf("unsupported PROXY header version: %d", hdr[12]>>4)
+	}
+
+	body := make([]byte, binary.BigEndian.Uint16(hdr[14:]))
+
+	_, err = io.ReadFull(br, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// LOCAL connections come from the proxy itself.
+	if hdr[12]&0xf == 0 {
+		return nil, nil
+	}
+
+	switch hdr[13] {
+	case 0x11: // TCP over IPv4
+		if len(body) < 12 {
+			return nil, fmt.Errorf("short PROXY header")
+		}
+
+		return &net.TCPAddr{IP: net.IP(body[0:4]), Port: int(binary.BigEndian.Uint16(body[8:]))}, nil
+	case 0x21: // TCP over IPv6
+		if len(body) < 36 {
+			return nil, fmt.Errorf("short PROXY header")
+		}
+
+		return &net.TCPAddr{IP: net.IP(body[0:16]), Port: int(binary.BigEndian.Uint16(body[32:]))}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,127 @@
+package control
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyProtocol(t *testing.T) {
+	t.Run("reads version 1 headers", func(t *testing.T) {
+		br := bufio.NewReader(strings.NewReader("PROXY TCP4 192.0.2.7 10.0.0.1 51234 443\r\nrest"))
+
+		addr, err := readProxyHeader(br)
+		require.NoError(t, err)
+
+		assert.Equal(t, "192.0.2.7:51234", addr.String())
+
+		rest, err := ioutil.ReadAll(br)
+		require.NoError(t, err)
+
+		assert.Equal(t, "rest", string(rest))
+
+		addr, err = readProxyHeader(bufio.NewReader(strings.NewReader("PROXY UNKNOWN\r\nrest")))
+		require.NoError(t, err)
+
+		assert.Nil(t, addr)
+
+		_, err = readProxyHeader(bufio.NewReader(strings.NewReader("PROXY TCP4 nope 10.0.0.1 1 2\r\n")))
+		assert.Error(t, err)
+	})
+
+	t.Run("reads version 2 headers", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.Write(proxyV2Signature)
+		buf.Write([]byte{0x21, 0x11, 0, 12})
+		buf.Write(net.ParseIP("192.0.2.7").To4())
+		buf.Write(net.ParseIP("10.0.0.1").To4())
+		binary.Write(&buf, binary.BigEndian, uint16(51234))
+		binary.Write(&buf, binary.BigEndian, uint16(443))
+		buf.WriteString("rest")
+
+		br := bufio.NewReader(&buf)
+
+		addr, err := readProxyHeader(br)
+		require.NoError(t, err)
+
+		assert.Equal(t, "192.0.2.7:51234", addr.String())
+
+		rest, err := ioutil.ReadAll(br)
+		require.NoError(t, err)
+
+		assert.Equal(t, "rest", string(rest))
+	})
+
+	t.Run("leaves connections without a header alone", func(t *testing.T) {
+		br := bufio.NewReader(strings.NewReader("\x16\x03\x01 a tls client hello"))
+
+		addr, err := readProxyHeader(br)
+		require.NoError(t, err)
+
+		assert.Nil(t, addr)
+
+		rest, err := ioutil.ReadAll(br)
+		require.NoError(t, err)
+
+		assert.Equal(t, "\x16\x03\x01 a tls client hello", string(rest))
+	})
+
+	t.Run("only reads headers from trusted proxies", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.cfg.ProxyProtocol = true
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		li := s.ProxyProtocolListener(ln)
+		defer li.Close()
+
+		accept := func(trusted string) net.Conn {
+			s.cfg.MgmtTrustedProxyCIDRs, err = ParseCIDRList(trusted)
+			require.NoError(t, err)
+
+			c, err := net.Dial("tcp", ln.Addr().String())
+			require.NoError(t, err)
+
+			defer c.Close()
+
+			_, err = c.Write([]byte("PROXY TCP4 192.0.2.7 10.0.0.1 51234 443\r\nping"))
+			require.NoError(t, err)
+
+			sc, err := li.Accept()
+			require.NoError(t, err)
+
+			return sc
+		}
+
+		sc := accept("127.0.0.1")
+		defer sc.Close()
+
+		assert.Equal(t, "192.0.2.7:51234", sc.RemoteAddr().String())
+
+		data := make([]byte, 4)
+
+		_, err = io.ReadFull(sc, data)
+		require.NoError(t, err)
+
+		assert.Equal(t, "ping", string(data))
+
+		other := accept("10.0.0.0/8")
+		defer other.Close()
+
+		assert.Equal(t, "127.0.0.1", other.RemoteAddr().(*net.TCPAddr).IP.String())
+	})
+}
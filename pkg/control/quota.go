@@ -0,0 +1,141 @@
+package control
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+var (
+	// The fractions of a quota at which a warning is sent, used when
+	// ServerConfig.QuotaWarningThresholds is empty.
+	DefaultQuotaWarningThresholds = []float64{0.8, 0.95}
+
+	// The minimum time between two warnings for the same account, quota
+	// and threshold. This keeps usage hovering around a threshold from
+	// generating a warning on every change.
+	QuotaWarningDebounce = time.Hour
+)
+
+type quotaKey struct {
+	account string
+	quota   string
+}
+
+type quotaState struct {
+	level int
+	sent  map[int]time.Time
+}
+
+// Tracks which warning threshold each account's quota usage last reached.
+type quotaTracker struct {
+	mu     sync.Mutex
+	states map[quotaKey]*quotaState
+}
+
+// Record the current usage of quota by account, returning the threshold
+// crossed if a warning should be sent. Warnings are only sent when usage
+// rises into a higher threshold, and at most once per QuotaWarningDebounce
+// for each threshold.
+func (q *quotaTracker) observe(thresholds []float64, account, quota string, current, limit int64, now time.Time) (float64, bool) {
+	if limit <= 0 {
+		return 0, false
+	}
+
+	usage := float64(current) / float64(limit)
+
+	// level is the number of thresholds reached, 0 meaning none.
+	level := sort.Search(len(thresholds), func(i int) bool {
+		return thresholds[i] > usage
+	})
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.states == nil {
+		q.states = make(map[quotaKey]*quotaState)
+	}
+
+	key := quotaKey{account, quota}
+
+	st, ok := q.states[key]
+	if !ok {
+		st = &quotaState{sent: make(map[int]time.Time)}
+		q.states[key] = st
+	}
+
+	prev := st.level
+	st.level = level
+
+	if level <= prev {
+		return 0, false
+	}
+
+	if last, ok := st.sent[level]; ok && now.Sub(last) < QuotaWarningDebounce {
+		return 0, false
+	}
+
+	st.sent[level] = now
+
+	return thresholds[level-1], true
+}
+
+func (s *Server) quotaThresholds() []float64 {
+	if thresholds := s.config().QuotaWarningThresholds; len(thresholds) > 0 {
+		return thresholds
+	}
+
+	return DefaultQuotaWarningThresholds
+}
+
+// Check the usage of quota and send a warning webhook if it crossed one
+// of the warning thresholds.
+func (s *Server) checkQuota(account *pb.Account, quota string, current, limit int64) {
+	threshold, ok := s.quotas.observe(s.quotaThresholds(), account.SpecString(), quota, current, limit, time.Now())
+	if !ok {
+		return
+	}
+
+	s.L.Info("account approaching quota",
+		"account", account.SpecString(),
+		"quota", quota,
+		"current", current,
+		"limit", limit,
+		"threshold", threshold,
+	)
+
+	s.m.IncrCounter([]string{"quota", "warning"}, 1)
+
+	s.emitEvent(&WebhookEvent{
+		Type:      "quota-warning",
+		Namespace: account.Namespace,
+		Account:   account.AccountId.String(),
+		Data: map[string]interface{}{
+			"quota":     quota,
+			"current":   current,
+			"limit":     limit,
+			"threshold": threshold,
+		},
+	})
+}
+
+// Check the number of services account has against AccountServiceQuota.
+func (s *Server) checkServiceQuota(account *pb.Account) {
+	limit := s.config().AccountServiceQuota
+	if limit <= 0 {
+		return
+	}
+
+	var count int64
+
+	err := dbx.Check(s.db.Model(&Service{}).Where("account_id = ?", account.Key()).Count(&count))
+	if err != nil {
+		s.L.Error("error counting services for quota check", "account", account.SpecString(), "error", err)
+		return
+	}
+
+	s.checkQuota(account, "services", count, limit)
+}
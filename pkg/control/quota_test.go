@@ -0,0 +1,90 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuota(t *testing.T) {
+	thresholds := []float64{0.8, 0.95}
+
+	t.Run("warns as usage crosses each threshold", func(t *testing.T) {
+		var q quotaTracker
+
+		now := time.Now()
+
+		_, ok := q.observe(thresholds, "a", "services", 50, 100, now)
+		assert.False(t, ok)
+
+		th, ok := q.observe(thresholds, "a", "services", 80, 100, now)
+		require.True(t, ok)
+		assert.Equal(t, 0.8, th)
+
+		// Staying at the same level doesn't warn again
+		_, ok = q.observe(thresholds, "a", "services", 85, 100, now)
+		assert.False(t, ok)
+
+		th, ok = q.observe(thresholds, "a", "services", 96, 100, now)
+		require.True(t, ok)
+		assert.Equal(t, 0.95, th)
+
+		// Other accounts are tracked separately
+		_, ok = q.observe(thresholds, "b", "services", 90, 100, now)
+		assert.True(t, ok)
+	})
+
+	t.Run("debounces usage flapping around a threshold", func(t *testing.T) {
+		var q quotaTracker
+
+		now := time.Now()
+
+		_, ok := q.observe(thresholds, "a", "services", 80, 100, now)
+		require.True(t, ok)
+
+		_, ok = q.observe(thresholds, "a", "services", 79, 100, now)
+		assert.False(t, ok)
+
+		_, ok = q.observe(thresholds, "a", "services", 80, 100, now.Add(time.Minute))
+		assert.False(t, ok)
+
+		_, ok = q.observe(thresholds, "a", "services", 79, 100, now.Add(time.Minute))
+		assert.False(t, ok)
+
+		_, ok = q.observe(thresholds, "a", "services", 80, 100, now.Add(QuotaWarningDebounce+time.Minute))
+		assert.True(t, ok)
+	})
+
+	t.Run("posts events to the webhook", func(t *testing.T) {
+		events := make(chan *WebhookEvent, 1)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var ev WebhookEvent
+			json.NewDecoder(r.Body).Decode(&ev)
+			events <- &ev
+		}))
+		defer srv.Close()
+
+		err := postWebhook(context.Background(), srv.URL, &WebhookEvent{
+			Type: "quota-warning",
+			Data: map[string]interface{}{
+				"quota":   "services",
+				"current": 80,
+				"limit":   100,
+			},
+		})
+		require.NoError(t, err)
+
+		ev := <-events
+		assert.Equal(t, "quota-warning", ev.Type)
+		assert.Equal(t, "services", ev.Data["quota"])
+		assert.Equal(t, float64(80), ev.Data["current"])
+		assert.Equal(t, float64(100), ev.Data["limit"])
+	})
+}
@@ -0,0 +1,137 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+)
+
+// A ReadinessCheck returns an error when a dependency the server needs is
+// unusable, which causes /ready to report the server as not ready.
+type ReadinessCheck func(ctx context.Context) error
+
+var ReadinessCheckTimeout = 5 * time.Second
+
+// AddReadinessCheck registers check to be consulted by /ready under name.
+func (s *Server) AddReadinessCheck(name string, check ReadinessCheck) {
+	s.readyMu.Lock()
+	defer s.readyMu.Unlock()
+
+	if s.readyChecks == nil {
+		s.readyChecks = make(map[string]ReadinessCheck)
+	}
+
+	s.readyChecks[name] = check
+}
+
+// Run all the readiness checks, returning the failures by name.
+func (s *Server) checkReadiness(ctx context.Context) map[string]string {
+	s.readyMu.Lock()
+
+	var names []string
+	for name := range s.readyChecks {
+		names = append(names, name)
+	}
+
+	checks := make([]ReadinessCheck, 0, len(names))
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		checks = append(checks, s.readyChecks[name])
+	}
+
+	s.readyMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, ReadinessCheckTimeout)
+	defer cancel()
+
+	failures := make(map[string]string)
+
+	for i, check := range checks {
+		if err := check(ctx); err != nil {
+			failures[names[i]] = err.Error()
+		}
+	}
+
+	return failures
+}
+
+func (s *Server) httpReady(w http.ResponseWriter, req *http.Request) {
+	failures := s.checkReadiness(req.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if len(failures) > 0 {
+		s.L.Warn("readiness check failed", "failures", failures)
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ready":    false,
+			"failures": failures,
+		})
+
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"ready": true,
+	})
+}
+
+var VaultReadinessCacheTime = 10 * time.Second
+
+// VaultReadinessCheck returns a check that vault is reachable and that the
+// client's token is still valid, by looking up the token. The result is
+// cached for cacheFor, defaulting to VaultReadinessCacheTime, so frequent
+// probes don't load vault.
+func VaultReadinessCheck(vc *api.Client, cacheFor time.Duration) ReadinessCheck {
+	if cacheFor == 0 {
+		cacheFor = VaultReadinessCacheTime
+	}
+
+	var (
+		mu      sync.Mutex
+		checked time.Time
+		lastErr error
+	)
+
+	return func(ctx context.Context) error {
+		mu.Lock()
+		if !checked.IsZero() && time.Since(checked) < cacheFor {
+			err := lastErr
+			mu.Unlock()
+			return err
+		}
+		mu.Unlock()
+
+		// Not holding mu while talking to vault, so a hung vault only
+		// holds up the probes that actually ask it.
+		resp, err := vc.RawRequestWithContext(ctx, vc.NewRequest("GET", "/v1/auth/token/lookup-self"))
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if err != nil {
+			err = errors.Wrapf(err, "vault token lookup failed")
+		}
+
+		// A probe that gave up says nothing about vault.
+		if ctx.Err() != nil {
+			return err
+		}
+
+		mu.Lock()
+		checked = time.Now()
+		lastErr = err
+		mu.Unlock()
+
+		return err
+	}
+}
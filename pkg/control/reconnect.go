@@ -0,0 +1,83 @@
+package control
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+// The reconnect pacing advertised to hubs when ServerConfig doesn't set
+// it. Hubs connected to a control server that doesn't advertise a policy
+// use the same values.
+const (
+	DefaultReconnectInitialBackoff = time.Second
+	DefaultReconnectMaxBackoff     = time.Minute
+	DefaultReconnectJitter         = 30 * time.Second
+)
+
+// The reconnect policy to send to hubs in FetchConfig.
+func (s *Server) reconnectPolicy() *pb.ReconnectPolicy {
+	cfg := s.config()
+
+	return normalizeReconnectPolicy(&pb.ReconnectPolicy{
+		InitialBackoff: int64(cfg.ReconnectInitialBackoff),
+		MaxBackoff:     int64(cfg.ReconnectMaxBackoff),
+		Jitter:         int64(cfg.ReconnectJitter),
+	})
+}
+
+// Fill in defaults for any unset values in p.
+func normalizeReconnectPolicy(p *pb.ReconnectPolicy) *pb.ReconnectPolicy {
+	out := pb.ReconnectPolicy{
+		InitialBackoff: int64(DefaultReconnectInitialBackoff),
+		MaxBackoff:     int64(DefaultReconnectMaxBackoff),
+		Jitter:         int64(DefaultReconnectJitter),
+	}
+
+	if p != nil {
+		if p.InitialBackoff > 0 {
+			out.InitialBackoff = p.InitialBackoff
+		}
+
+		if p.MaxBackoff > 0 {
+			out.MaxBackoff = p.MaxBackoff
+		}
+
+		if p.Jitter > 0 {
+			out.Jitter = p.Jitter
+		}
+	}
+
+	if out.MaxBackoff < out.InitialBackoff {
+		out.MaxBackoff = out.InitialBackoff
+	}
+
+	return &out
+}
+
+// How long to wait before the given reconnect attempt, starting from 0.
+// The first attempt is spread over the jitter window so hubs that lost
+// their connection at the same time don't all return at once. Later
+// attempts back off exponentially, with up to half the delay randomized.
+func reconnectDelay(p *pb.ReconnectPolicy, attempt int, rng *rand.Rand) time.Duration {
+	p = normalizeReconnectPolicy(p)
+
+	if attempt == 0 {
+		return time.Duration(rng.Int63n(p.Jitter + 1))
+	}
+
+	delay := p.InitialBackoff
+
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	half := delay / 2
+
+	return time.Duration(half + rng.Int63n(half+1))
+}
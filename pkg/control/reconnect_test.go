@@ -0,0 +1,51 @@
+package control
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectPolicy(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	t.Run("fills in defaults", func(t *testing.T) {
+		s := &Server{}
+
+		p := s.reconnectPolicy()
+
+		assert.Equal(t, int64(DefaultReconnectInitialBackoff), p.InitialBackoff)
+		assert.Equal(t, int64(DefaultReconnectMaxBackoff), p.MaxBackoff)
+		assert.Equal(t, int64(DefaultReconnectJitter), p.Jitter)
+
+		s.cfg.ReconnectJitter = 5 * time.Minute
+
+		assert.Equal(t, int64(5*time.Minute), s.reconnectPolicy().Jitter)
+	})
+
+	t.Run("spreads the first attempt over the jitter window", func(t *testing.T) {
+		p := &pb.ReconnectPolicy{Jitter: int64(10 * time.Second)}
+
+		for i := 0; i < 100; i++ {
+			d := reconnectDelay(p, 0, rng)
+			assert.True(t, d >= 0 && d <= 10*time.Second, "delay out of range: %s", d)
+		}
+	})
+
+	t.Run("backs off exponentially up to the max", func(t *testing.T) {
+		p := &pb.ReconnectPolicy{
+			InitialBackoff: int64(time.Second),
+			MaxBackoff:     int64(8 * time.Second),
+		}
+
+		for attempt, max := range []time.Duration{1, 2, 4, 8, 8, 8} {
+			max *= time.Second
+
+			d := reconnectDelay(p, attempt+1, rng)
+			assert.True(t, d >= max/2 && d <= max, "attempt %d delay out of range: %s", attempt+1, d)
+		}
+	})
+}
@@ -0,0 +1,275 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+)
+
+// ConfigFile is the subset of the control server's configuration that can
+// be read from a file, and reloaded from it on SIGHUP. Fields left unset
+// keep their current value.
+type ConfigFile struct {
+	LogLevel string `json:"log_level"`
+
+	MaxFlowsPerHub    *int64 `json:"max_flows_per_hub"`
+	MaxStreamsPerPeer *int   `json:"max_streams_per_peer"`
+	StreamIdleTimeout string `json:"stream_idle_timeout"`
+
+	// Only seen by hubs when they next fetch their config.
+	FlowIdleTimeout string `json:"flow_idle_timeout"`
+
+	SlowRPCThreshold string `json:"slow_rpc_threshold"`
+
+	WebhookURL          *string `json:"webhook_url"`
+	AccountServiceQuota *int64  `json:"account_service_quota"`
+
+	// Given as percentages, eg. [80, 95]
+	QuotaWarningThresholds []float64 `json:"quota_warning_thresholds"`
+
+	// Keys that aren't one of the above. These are reported but otherwise
+	// ignored.
+	Ignored []string `json:"-"`
+}
+
+// Settings that are only read at startup. They're accepted in the file so
+// it can mirror the environment, but changing them requires a restart.
+var staticConfigKeys = map[string]bool{
+	"database_url":       true,
+	"vault_path":         true,
+	"vault_addr":         true,
+	"s3_bucket":          true,
+	"s3_region_buckets":  true,
+	"control_region":     true,
+	"hub_domain":         true,
+	"listen_addr":        true,
+	"listen_socket_mode": true,
+	"port":               true,
+	"register_token":     true,
+	"ops_token":          true,
+	"token_key_id":       true,
+	"migrations_path":    true,
+	"asn_db_path":        true,
+	"acme_account_url":   true,
+	"label_link_cycles":  true,
+	"token_issue_rate":   true,
+	"token_issue_burst":  true,
+
+	"agent_tls_min_version":         true,
+	"agent_require_client_cert":     true,
+	"agent_client_ca_file":          true,
+	"token_signing_algorithm":       true,
+	"load_max_job_backlog":          true,
+	"load_max_db_latency":           true,
+	"load_max_conns":                true,
+	"load_shed_rpcs":                true,
+	"hub_subdomain_certs":           true,
+	"hub_subdomain_cert_grace":      true,
+	"activity_summary":              true,
+	"database_replica_url":          true,
+	"workq_account_queues":          true,
+	"workq_max_per_account":         true,
+	"flow_assignment_retries":       true,
+	"flow_assignment_retry_backoff": true,
+	"proxy_protocol":                true,
+}
+
+// LoadConfigFile reads the JSON config file at path.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cf ConfigFile
+
+	err = json.Unmarshal(data, &cf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing config file %s", path)
+	}
+
+	var keys map[string]json.RawMessage
+
+	err = json.Unmarshal(data, &keys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing config file %s", path)
+	}
+
+	known := map[string]bool{}
+
+	t := reflect.TypeOf(cf)
+	for i := 0; i < t.NumField(); i++ {
+		if tag := t.Field(i).Tag.Get("json"); tag != "" && tag != "-" {
+			known[tag] = true
+		}
+	}
+
+	for key := range keys {
+		if !known[key] {
+			cf.Ignored = append(cf.Ignored, key)
+		}
+	}
+
+	sort.Strings(cf.Ignored)
+
+	if cf.LogLevel != "" && hclog.LevelFromString(cf.LogLevel) == hclog.NoLevel {
+		return nil, fmt.Errorf("invalid log_level: %s", cf.LogLevel)
+	}
+
+	return &cf, nil
+}
+
+// Apply sets the values present in cf on cfg.
+func (cf *ConfigFile) Apply(cfg *ServerConfig) error {
+	if cf.MaxFlowsPerHub != nil {
+		if *cf.MaxFlowsPerHub < 0 {
+			return fmt.Errorf("invalid max_flows_per_hub: %d", *cf.MaxFlowsPerHub)
+		}
+
+		cfg.MaxFlowsPerHub = *cf.MaxFlowsPerHub
+	}
+
+	if cf.MaxStreamsPerPeer != nil {
+		if *cf.MaxStreamsPerPeer < 0 {
+			return fmt.Errorf("invalid max_streams_per_peer: %d", *cf.MaxStreamsPerPeer)
+		}
+
+		cfg.MaxStreamsPerPeer = *cf.MaxStreamsPerPeer
+	}
+
+	if cf.StreamIdleTimeout != "" {
+		dur, err := time.ParseDuration(cf.StreamIdleTimeout)
+		if err != nil || dur < 0 {
+			return fmt.Errorf("invalid stream_idle_timeout: %s", cf.StreamIdleTimeout)
+		}
+
+		cfg.StreamIdleTimeout = dur
+	}
+
+	if cf.FlowIdleTimeout != "" {
+		dur, err := time.ParseDuration(cf.FlowIdleTimeout)
+		if err != nil || dur < 0 || (dur > 0 && dur < MinFlowIdleTimeout) {
+			return fmt.Errorf("invalid flow_idle_timeout: %s", cf.FlowIdleTimeout)
+		}
+
+		cfg.FlowIdleTimeout = dur
+	}
+
+	if cf.SlowRPCThreshold != "" {
+		dur, err := time.ParseDuration(cf.SlowRPCThreshold)
+		if err != nil || dur < 0 {
+			return fmt.Errorf("invalid slow_rpc_threshold: %s", cf.SlowRPCThreshold)
+		}
+
+		cfg.SlowRPCThreshold = dur
+	}
+
+	if cf.WebhookURL != nil {
+		cfg.WebhookURL = *cf.WebhookURL
+	}
+
+	if cf.AccountServiceQuota != nil {
+		if *cf.AccountServiceQuota < 0 {
+			return fmt.Errorf("invalid account_service_quota: %d", *cf.AccountServiceQuota)
+		}
+
+		cfg.AccountServiceQuota = *cf.AccountServiceQuota
+	}
+
+	if cf.QuotaWarningThresholds != nil {
+		var thresholds []float64
+
+		for _, pct := range cf.QuotaWarningThresholds {
+			if pct <= 0 || pct > 100 {
+				return fmt.Errorf("invalid quota_warning_thresholds: %v", cf.QuotaWarningThresholds)
+			}
+
+			thresholds = append(thresholds, pct/100)
+		}
+
+		sort.Float64s(thresholds)
+
+		cfg.QuotaWarningThresholds = thresholds
+	}
+
+	return nil
+}
+
+// A copy of the server's current configuration. Reload replaces s.cfg as a
+// whole, so every read outside of it goes through here.
+func (s *Server) config() ServerConfig {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+
+	return s.cfg
+}
+
+// Reload applies the reloadable settings in cf to the running server,
+// logging each one that changed. Static settings present in the file are
+// ignored with a warning. It returns the names of the settings that changed.
+func (s *Server) Reload(cf *ConfigFile) ([]string, error) {
+	L := s.L.Named("reload")
+
+	for _, key := range cf.Ignored {
+		if staticConfigKeys[key] {
+			L.Warn("ignoring setting that can't be changed without a restart", "setting", key)
+		} else {
+			L.Warn("ignoring unknown setting", "setting", key)
+		}
+	}
+
+	s.cfgMu.Lock()
+
+	next := s.cfg
+
+	err := cf.Apply(&next)
+	if err != nil {
+		s.cfgMu.Unlock()
+		return nil, err
+	}
+
+	var changed []string
+
+	check := func(name string, old, cur interface{}) {
+		if !reflect.DeepEqual(old, cur) {
+			L.Info("configuration changed", "setting", name, "old", old, "new", cur)
+			changed = append(changed, name)
+		}
+	}
+
+	check("max_flows_per_hub", s.cfg.MaxFlowsPerHub, next.MaxFlowsPerHub)
+	check("max_streams_per_peer", s.cfg.MaxStreamsPerPeer, next.MaxStreamsPerPeer)
+	check("stream_idle_timeout", s.cfg.StreamIdleTimeout, next.StreamIdleTimeout)
+	check("flow_idle_timeout", s.cfg.FlowIdleTimeout, next.FlowIdleTimeout)
+	check("slow_rpc_threshold", s.cfg.SlowRPCThreshold, next.SlowRPCThreshold)
+	check("webhook_url", s.cfg.WebhookURL, next.WebhookURL)
+	check("account_service_quota", s.cfg.AccountServiceQuota, next.AccountServiceQuota)
+	check("quota_warning_thresholds", s.cfg.QuotaWarningThresholds, next.QuotaWarningThresholds)
+
+	s.cfg = next
+
+	s.cfgMu.Unlock()
+
+	if len(changed) > 0 {
+		s.emitWatchEvent(&WebhookEvent{
+			Type: EventConfigChanged,
+			Data: map[string]interface{}{
+				"settings": changed,
+			},
+		})
+	}
+
+	if cf.LogLevel != "" {
+		level := hclog.LevelFromString(cf.LogLevel)
+		s.L.SetLevel(level)
+		L.Info("log level configured", "level", level)
+	}
+
+	return changed, nil
+}
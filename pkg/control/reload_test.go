@@ -0,0 +1,103 @@
+package control
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hzn")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeConfig := func(t *testing.T, body string) *ConfigFile {
+		path := filepath.Join(dir, "control.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(body), 0644))
+
+		cf, err := LoadConfigFile(path)
+		require.NoError(t, err)
+
+		return cf
+	}
+
+	t.Run("applies reloadable settings", func(t *testing.T) {
+		L := hclog.New(&hclog.LoggerOptions{Level: hclog.Info})
+
+		s := &Server{
+			L: L,
+			cfg: ServerConfig{
+				MaxFlowsPerHub:    10,
+				MaxStreamsPerPeer: 5,
+			},
+		}
+
+		cf := writeConfig(t, `{
+			"log_level": "debug",
+			"max_flows_per_hub": 20,
+			"max_streams_per_peer": 5,
+			"stream_idle_timeout": "5m",
+			"slow_rpc_threshold": "1s",
+			"quota_warning_thresholds": [95, 80]
+		}`)
+
+		changed, err := s.Reload(cf)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"max_flows_per_hub", "stream_idle_timeout", "slow_rpc_threshold", "quota_warning_thresholds"}, changed)
+
+		cfg := s.config()
+		assert.Equal(t, int64(20), cfg.MaxFlowsPerHub)
+		assert.Equal(t, 5, cfg.MaxStreamsPerPeer)
+		assert.Equal(t, 5*time.Minute, cfg.StreamIdleTimeout)
+		assert.Equal(t, time.Second, cfg.SlowRPCThreshold)
+		assert.Equal(t, []float64{0.8, 0.95}, cfg.QuotaWarningThresholds)
+
+		assert.True(t, L.IsDebug())
+	})
+
+	t.Run("ignores static and unknown settings", func(t *testing.T) {
+		s := &Server{
+			L:   hclog.L(),
+			cfg: ServerConfig{Bucket: "hzn"},
+		}
+
+		cf := writeConfig(t, `{"s3_bucket": "other", "frobnicate": true, "webhook_url": "http://example.com"}`)
+
+		assert.Equal(t, []string{"frobnicate", "s3_bucket"}, cf.Ignored)
+
+		changed, err := s.Reload(cf)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"webhook_url"}, changed)
+		assert.Equal(t, "hzn", s.config().Bucket)
+	})
+
+	t.Run("leaves the config alone if any value is invalid", func(t *testing.T) {
+		s := &Server{
+			L:   hclog.L(),
+			cfg: ServerConfig{MaxFlowsPerHub: 10},
+		}
+
+		cf := writeConfig(t, `{"max_flows_per_hub": 20, "stream_idle_timeout": "soon"}`)
+
+		_, err := s.Reload(cf)
+		require.Error(t, err)
+
+		assert.Equal(t, int64(10), s.config().MaxFlowsPerHub)
+	})
+
+	t.Run("rejects unknown log levels", func(t *testing.T) {
+		path := filepath.Join(dir, "control.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(`{"log_level": "loud"}`), 0644))
+
+		_, err := LoadConfigFile(path)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,152 @@
+package control
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// The header a request id is read from and echoed back in when
+// ServerConfig.RequestIDHeader is not set.
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// Incoming ids longer than this are replaced rather than trusted, so a
+// client can't bloat every log line of its calls.
+const maxRequestIDLen = 128
+
+type requestIDKey struct{}
+
+// The id of the request ctx belongs to, or "" outside of a request.
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("-_.:/+=", c):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns id if it's usable, otherwise a newly generated one.
+func ensureRequestID(id string) string {
+	if validRequestID(id) {
+		return id
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		// Only fails if the system's randomness source does, in which
+		// case an unidentified request is the least of the problems.
+		return ""
+	}
+
+	return id
+}
+
+func (s *Server) requestIDHeader() string {
+	cfg := s.config()
+
+	if cfg.RequestIDHeader != "" {
+		return cfg.RequestIDHeader
+	}
+
+	return DefaultRequestIDHeader
+}
+
+// Attach id to ctx, along with a logger that tags every line with it.
+func (s *Server) withRequestID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return hclog.WithContext(ctx, s.L.With("request-id", id))
+}
+
+// The logger for work done on behalf of the request ctx belongs to. Lines
+// logged through it carry the request id.
+func (s *Server) logger(ctx context.Context) hclog.Logger {
+	if requestID(ctx) == "" {
+		return s.L
+	}
+
+	return hclog.FromContext(ctx)
+}
+
+// Read the caller's request id from the incoming metadata, or generate
+// one, and send it back in the response headers.
+func (s *Server) grpcRequestID(ctx context.Context) context.Context {
+	var id string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(s.requestIDHeader()); len(vals) > 0 {
+			id = vals[0]
+		}
+	}
+
+	id = ensureRequestID(id)
+
+	grpc.SetHeader(ctx, metadata.Pairs(s.requestIDHeader(), id))
+
+	return s.withRequestID(ctx, id)
+}
+
+// UnaryRequestIDInterceptor tags unary calls with a request id. It should
+// run first so that everything after it can log the id.
+func (s *Server) UnaryRequestIDInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(s.grpcRequestID(ctx), req)
+}
+
+// A stream whose context has been replaced.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamRequestIDInterceptor tags streams with a request id. It should run
+// first so that everything after it can log the id.
+func (s *Server) StreamRequestIDInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &contextStream{
+		ServerStream: ss,
+		ctx:          s.grpcRequestID(ss.Context()),
+	})
+}
+
+// Tag HTTP requests with the caller's request id, or a generated one, and
+// echo it in the response.
+func (s *Server) requestIDHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := ensureRequestID(req.Header.Get(s.requestIDHeader()))
+
+		w.Header().Set(s.requestIDHeader(), id)
+
+		h.ServeHTTP(w, req.WithContext(s.withRequestID(req.Context(), id)))
+	})
+}
@@ -0,0 +1,120 @@
+package control
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	var s Server
+	s.L = hclog.L()
+
+	unary := func(ctx context.Context) context.Context {
+		var seen context.Context
+
+		_, err := s.UnaryRequestIDInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.ControlManagement/ListAccounts"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				seen = ctx
+				return nil, nil
+			})
+		require.NoError(t, err)
+
+		return seen
+	}
+
+	t.Run("uses the caller's id from grpc metadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "abc-123"))
+
+		assert.Equal(t, "abc-123", requestID(unary(ctx)))
+	})
+
+	t.Run("generates an id when absent or unusable", func(t *testing.T) {
+		id := requestID(unary(context.Background()))
+		assert.Len(t, id, 36)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "has spaces"))
+		assert.NotEqual(t, "has spaces", requestID(unary(ctx)))
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", strings.Repeat("a", 200)))
+		assert.Len(t, requestID(unary(ctx)), 36)
+	})
+
+	t.Run("echoes the id on http responses", func(t *testing.T) {
+		var seen string
+
+		h := s.requestIDHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			seen = requestID(req.Context())
+		}))
+
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		req.Header.Set("X-Request-ID", "from-client")
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, "from-client", seen)
+		assert.Equal(t, "from-client", w.Header().Get("X-Request-ID"))
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+
+		assert.NotEmpty(t, w.Header().Get("X-Request-ID"))
+		assert.Equal(t, w.Header().Get("X-Request-ID"), seen)
+	})
+
+	t.Run("honors a configured header", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.cfg.RequestIDHeader = "X-Correlation-ID"
+
+		h := s.requestIDHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		req.Header.Set("X-Correlation-ID", "corr")
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, "corr", w.Header().Get("X-Correlation-ID"))
+		assert.Empty(t, w.Header().Get("X-Request-ID"))
+	})
+
+	t.Run("records the id in the audit log", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		s.db = db
+
+		caller := &token.ValidToken{
+			Body: &pb.Token_Body{
+				Id: pb.NewULID(),
+				Account: &pb.Account{
+					Namespace: "/",
+					AccountId: pb.NewULID(),
+				},
+			},
+		}
+
+		ctx := s.withRequestID(context.Background(), "audit-req")
+
+		s.audit(ctx, caller, "kill-flow", "target", nil)
+
+		var rec AuditLog
+		require.NoError(t, dbx.Check(db.First(&rec)))
+
+		assert.Equal(t, "audit-req", rec.RequestID)
+	})
+}
@@ -0,0 +1,270 @@
+package control
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Read a routing object published to the bucket, decompressing it into msg.
+// msg is left empty if the object doesn't exist.
+func (s *Server) readRoutingObject(ctx context.Context, key string, msg proto.Unmarshaler) error {
+	out, err := s3.New(s.awsSess).GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil
+		}
+
+		return errors.Wrapf(err, "unable to read %s", key)
+	}
+
+	defer out.Body.Close()
+
+	data, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return err
+	}
+
+	data, err = zstdDecompress(data)
+	if err != nil {
+		return errors.Wrapf(err, "unable to decompress %s", key)
+	}
+
+	return msg.Unmarshal(data)
+}
+
+// Returns the messages in next that aren't in prev, and those in prev that
+// aren't in next, comparing them by their encoding.
+func diffMessages(prev, next []proto.Marshaler) (added, removed []proto.Marshaler, err error) {
+	index := func(msgs []proto.Marshaler) (map[string]bool, error) {
+		out := make(map[string]bool)
+
+		for _, m := range msgs {
+			data, err := m.Marshal()
+			if err != nil {
+				return nil, err
+			}
+
+			out[string(data)] = true
+		}
+
+		return out, nil
+	}
+
+	prevSet, err := index(prev)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextSet, err := index(next)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, m := range next {
+		data, _ := m.Marshal()
+		if !prevSet[string(data)] {
+			added = append(added, m)
+		}
+	}
+
+	for _, m := range prev {
+		data, _ := m.Marshal()
+		if !nextSet[string(data)] {
+			removed = append(removed, m)
+		}
+	}
+
+	return added, removed, nil
+}
+
+func routeMessages(routes []*pb.ServiceRoute) []proto.Marshaler {
+	out := make([]proto.Marshaler, len(routes))
+	for i, r := range routes {
+		out[i] = r
+	}
+
+	return out
+}
+
+func accountLinkMessages(links *pb.LabelLinks, account *pb.Account) []proto.Marshaler {
+	var out []proto.Marshaler
+
+	for _, ll := range links.LabelLinks {
+		if ll.Account.Equal(account) {
+			out = append(out, ll)
+		}
+	}
+
+	return out
+}
+
+// RebuildRoutingState recomputes the routing data published for an account,
+// its services and label links, from the database and compares it to what
+// was published. When they differ the rebuilt data is published in its
+// place. This repairs routing that has drifted from the database, such as
+// after an update that was only partially applied. With dry_run set the
+// differences are returned without publishing anything.
+func (s *Server) RebuildRoutingState(ctx context.Context, req *pb.RebuildRoutingStateRequest) (*pb.RebuildRoutingStateResponse, error) {
+	if !s.checkOpsAllowed(ctx) {
+		return nil, ErrBadAuthentication
+	}
+
+	if req.Account == nil || req.Account.AccountId == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "missing account")
+	}
+
+	err := s.resolveAlias(req.Account, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var ao Account
+
+	err = dbx.Check(s.db.First(&ao, req.Account.Key()))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(ErrInvalidRequest, "unknown account")
+		}
+
+		return nil, err
+	}
+
+	L := s.logger(ctx).With("account", req.Account.SpecString())
+
+	// Read the services and the account's settings from a single snapshot
+	// so that the rebuilt data is consistent even while services change.
+	tx, err := s.db.DB().BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rebuilt, err := s.accountRoutingSnapshot(ctx, tx, req.Account)
+
+	tx.Rollback()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var published pb.AccountServices
+
+	err = s.readRoutingObject(ctx, "account_services/"+req.Account.HashKey(), &published)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.RebuildRoutingStateResponse
+
+	added, removed, err := diffMessages(routeMessages(published.Services), routeMessages(rebuilt.Services))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range added {
+		resp.RoutesAdded = append(resp.RoutesAdded, m.(*pb.ServiceRoute))
+	}
+
+	for _, m := range removed {
+		resp.RoutesRemoved = append(resp.RoutesRemoved, m.(*pb.ServiceRoute))
+	}
+
+	resp.SettingsChanged = published.FlowIdleTimeout != rebuilt.FlowIdleTimeout ||
+		!published.TlsPolicy.Equal(rebuilt.TlsPolicy)
+
+	routingChanged := len(added) > 0 || len(removed) > 0 || resp.SettingsChanged
+
+	links, err := s.labelLinkSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishedLinks pb.LabelLinks
+
+	err = s.readRoutingObject(ctx, "label_links", &publishedLinks)
+	if err != nil {
+		return nil, err
+	}
+
+	added, removed, err = diffMessages(
+		accountLinkMessages(&publishedLinks, req.Account),
+		accountLinkMessages(links, req.Account),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range added {
+		resp.LabelLinksAdded = append(resp.LabelLinksAdded, m.(*pb.LabelLink))
+	}
+
+	for _, m := range removed {
+		resp.LabelLinksRemoved = append(resp.LabelLinksRemoved, m.(*pb.LabelLink))
+	}
+
+	linksChanged := len(added) > 0 || len(removed) > 0
+
+	resp.Changed = routingChanged || linksChanged
+	resp.DryRun = req.DryRun
+
+	if req.DryRun {
+		if resp.Changed {
+			L.Info("routing state has drifted from the database, not rebuilding in dry run",
+				"routes-added", len(resp.RoutesAdded),
+				"routes-removed", len(resp.RoutesRemoved),
+				"settings-changed", resp.SettingsChanged,
+				"label-links-added", len(resp.LabelLinksAdded),
+				"label-links-removed", len(resp.LabelLinksRemoved),
+			)
+		}
+
+		return &resp, nil
+	}
+
+	// Published from the current state rather than the snapshot, so that
+	// changes made since it was taken aren't undone.
+	if routingChanged {
+		err = s.updateAccountRouting(ctx, s.db.DB(), req.Account, "rebuild-routing-state")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if linksChanged {
+		err = s.updateLabelLinks(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Changed {
+		L.Warn("rebuilt routing state that had drifted from the database",
+			"routes-added", len(resp.RoutesAdded),
+			"routes-removed", len(resp.RoutesRemoved),
+			"settings-changed", resp.SettingsChanged,
+			"label-links-added", len(resp.LabelLinksAdded),
+			"label-links-removed", len(resp.LabelLinksRemoved),
+		)
+
+		s.m.IncrCounter([]string{"routing", "rebuild_repaired"}, 1)
+	} else {
+		L.Info("routing state matches the database, nothing to rebuild")
+	}
+
+	return &resp, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/horizon/internal/sqljson"
 	"github.com/hashicorp/horizon/pkg/dbx"
 	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/jinzhu/gorm"
@@ -19,15 +20,38 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (s *Server) calculateAccountRouting(ctx context.Context, gdb *sql.DB, account *pb.Account, action string) ([]byte, error) {
-	s.L.Debug("calculate account routing", "action", action, "account", account.SpecString())
+// The parts of *sql.DB that routing is calculated with, so that it can also
+// be calculated within a *sql.Tx.
+type routingQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (s *Server) calculateAccountRouting(ctx context.Context, gdb routingQueryer, account *pb.Account, action string) ([]byte, error) {
+	s.logger(ctx).Debug("calculate account routing", "action", action, "account", account.SpecString())
 
 	ts := time.Now()
 
 	defer func() {
-		s.L.Debug("calculate account routing ended", "action", action, "account", account.SpecString(), "elapse", time.Since(ts))
+		s.logger(ctx).Debug("calculate account routing ended", "action", action, "account", account.SpecString(), "elapse", time.Since(ts))
 	}()
 
+	accountServices, err := s.accountRoutingSnapshot(ctx, gdb, account)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := accountServices.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return zstdCompress(data)
+}
+
+// Read the routing data published for account from the services and
+// accounts tables.
+func (s *Server) accountRoutingSnapshot(ctx context.Context, gdb routingQueryer, account *pb.Account) (*pb.AccountServices, error) {
 	key := account.Key()
 
 	var lastId int64
@@ -63,6 +87,7 @@ func (s *Server) calculateAccountRouting(ctx context.Context, gdb *sql.DB, accou
 			cnt++
 			err = rows.Scan(&lastId, &hubId, &serviceId, &labels, &typ)
 			if err != nil {
+				rows.Close()
 				return nil, err
 			}
 
@@ -70,6 +95,7 @@ func (s *Server) calculateAccountRouting(ctx context.Context, gdb *sql.DB, accou
 
 			err = ls.Scan(labels)
 			if err != nil {
+				rows.Close()
 				return nil, err
 			}
 
@@ -81,26 +107,42 @@ func (s *Server) calculateAccountRouting(ctx context.Context, gdb *sql.DB, accou
 			})
 		}
 
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
 		if cnt == 0 {
 			break
 		}
 	}
 
-	data, err := accountServices.Marshal()
-	if err != nil {
+	var data sqljson.Data
+
+	err := gdb.QueryRowContext(ctx, "SELECT data FROM accounts WHERE id = $1", key).Scan(&data)
+	if err == nil {
+		var limits pb.Account_Limits
+		data.Get("limits", &limits)
+
+		accountServices.FlowIdleTimeout = limits.FlowIdleTimeout
+
+		var policy pb.TLSPolicy
+		if ok, _ := data.Get("tls_policy", &policy); ok {
+			accountServices.TlsPolicy = &policy
+		}
+	} else if err != sql.ErrNoRows {
 		return nil, err
 	}
 
-	return zstdCompress(data)
+	return &accountServices, nil
 }
 
-func (s *Server) updateAccountRouting(ctx context.Context, db *sql.DB, account *pb.Account, action string) error {
+func (s *Server) updateAccountRouting(ctx context.Context, db routingQueryer, account *pb.Account, action string) error {
 	ts := time.Now()
-	s.L.Debug("updating account routing", "action", action, "account", account.SpecString())
+	s.logger(ctx).Debug("updating account routing", "action", action, "account", account.SpecString())
 
 	defer func() {
 		s.m.MeasureSince([]string{"routing", "update_time"}, ts)
-		s.L.Debug("updating account routing ended", "action", action, "account", account.SpecString(), "elapse", time.Since(ts))
+		s.logger(ctx).Debug("updating account routing ended", "action", action, "account", account.SpecString(), "elapse", time.Since(ts))
 	}()
 
 	outData, err := s.calculateAccountRouting(ctx, db, account, "initial-update")
@@ -139,7 +181,7 @@ func (s *Server) updateAccountRouting(ctx context.Context, db *sql.DB, account *
 			return nil
 		}
 
-		s.L.Info("detected account locked, sleep and retry", "retries", retry)
+		s.logger(ctx).Info("detected account locked, sleep and retry", "retries", retry)
 		retry++
 
 		time.Sleep(time.Second)
@@ -195,52 +237,64 @@ func (s *Server) updateAccountRouting(ctx context.Context, db *sql.DB, account *
 	return nil
 }
 
-func (s *Server) updateLabelLinks(ctx context.Context) error {
+// Read the label links to publish from the database, oldest first, leaving
+// out those beyond ServerConfig.MaxLabelLinksPerAccount. AddLabelLink keeps
+// accounts under the limit, so this only drops links that were added before
+// it was lowered.
+func (s *Server) labelLinkSnapshot(ctx context.Context) (*pb.LabelLinks, error) {
 	lastId := 0
 
 	lls := make([]*LabelLink, 0, 100)
 
 	var out pb.LabelLinks
 
+	var (
+		max        = s.config().MaxLabelLinksPerAccount
+		perAccount = make(map[string]int)
+		dropped    = make(map[string]int)
+	)
+
 	for {
-		err := dbx.Check(s.db.Where("id > ?", lastId).Limit(100).Find(&lls))
+		err := dbx.Check(s.db.Where("id > ?", lastId).Order("id ASC").Limit(100).Find(&lls))
 		if err != nil {
 			if err == gorm.ErrRecordNotFound {
-				s.L.Info("end of label link loop cursor reached", "last-id", lastId)
+				s.logger(ctx).Info("end of label link loop cursor reached", "last-id", lastId)
 				break
 			}
-			s.L.Error("error returned from label_link cursor", "error", err)
+			s.logger(ctx).Error("error returned from label_link cursor", "error", err)
 		}
 
 		if len(lls) == 0 {
-			s.L.Info("label link cursor returned no new values, done", "last-id", lastId)
+			s.logger(ctx).Info("label link cursor returned no new values, done", "last-id", lastId)
 			break
 		}
 
 		for _, ll := range lls {
+			if max > 0 && perAccount[string(ll.AccountID)] >= max {
+				dropped[string(ll.AccountID)]++
+				continue
+			}
+
+			perAccount[string(ll.AccountID)]++
+
 			account, err := pb.AccountFromKey(ll.AccountID)
 			if err != nil {
-				s.L.Error("error parsing label-link account", "error", err)
-				return err
+				s.logger(ctx).Error("error parsing label-link account", "error", err)
+				return nil, err
 			}
 
 			var acc Account
 
 			err = dbx.Check(s.db.First(&acc, ll.AccountID))
 			if err != nil {
-				s.L.Error("error reading label-link account", "error", err, "acconut", string(ll.AccountID))
-				return err
+				s.logger(ctx).Error("error reading label-link account", "error", err, "acconut", string(ll.AccountID))
+				return nil, err
 			}
 
 			var pblimit pb.Account_Limits
 			acc.Data.Get("limits", &pblimit)
 
-			out.LabelLinks = append(out.LabelLinks, &pb.LabelLink{
-				Account: account,
-				Labels:  ExplodeLabels(ll.Labels),
-				Target:  ExplodeLabels(ll.Target),
-				Limits:  &pblimit,
-			})
+			out.LabelLinks = append(out.LabelLinks, ll.toPB(account, &pblimit))
 		}
 
 		lastId = lls[len(lls)-1].ID
@@ -248,6 +302,30 @@ func (s *Server) updateLabelLinks(ctx context.Context) error {
 		lls = lls[:0]
 	}
 
+	for id, n := range dropped {
+		account, err := pb.AccountFromKey([]byte(id))
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger(ctx).Warn("account has too many label links, not publishing the newest",
+			"account", account.SpecString(),
+			"published", max,
+			"dropped", n,
+		)
+
+		s.m.IncrCounter([]string{"label_links", "truncated"}, float32(n))
+	}
+
+	return &out, nil
+}
+
+func (s *Server) updateLabelLinks(ctx context.Context) error {
+	out, err := s.labelLinkSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
 	data, err := out.Marshal()
 	if err != nil {
 		return err
@@ -297,7 +375,7 @@ func (s *Server) updateLabelLinks(ctx context.Context) error {
 		return fmt.Errorf("corruption detected, wrong etag: %s / %s", hex.EncodeToString(sum), outet)
 	}
 
-	s.L.Info("updated label links", "etag", outet, "size", len(outData), "last-id", lastId)
+	s.logger(ctx).Info("updated label links", "etag", outet, "size", len(outData), "links", len(out.LabelLinks))
 
 	return nil
 }
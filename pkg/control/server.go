@@ -4,20 +4,22 @@ import (
 	"bytes"
 	"context"
 	"crypto/ed25519"
+	"crypto/tls"
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
-	"github.com/armon/go-metrics/datadog"
-	"github.com/armon/go-metrics/prometheus"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
@@ -25,22 +27,34 @@ import (
 	"github.com/hashicorp/horizon/pkg/dbx"
 	_ "github.com/hashicorp/horizon/pkg/grpc/lz4"
 	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/secrets"
 	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/hashicorp/horizon/pkg/workq"
 	"github.com/hashicorp/vault/api"
 	"github.com/jinzhu/gorm"
 	"github.com/lib/pq"
 	"github.com/oschwald/geoip2-golang"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type connectedHub struct {
 	xmit     chan *pb.CentralActivity
+	stableId *pb.ULID
+
 	messages *int64
 	bytes    *int64
 
 	activeAgents *int64
 	services     *int64
+	activeFlows  *int64
+
+	// The hub credential the stream was opened with, if any, and a func
+	// that closes the stream when it's revoked.
+	credentialId []byte
+	cancel       func()
 }
 
 // Returns a lock for the given id.
@@ -50,8 +64,10 @@ type LockManager interface {
 }
 
 type Server struct {
-	cfg ServerConfig
-	L   hclog.Logger
+	// Guards the settings in cfg that can be changed by Reload.
+	cfgMu sync.RWMutex
+	cfg   ServerConfig
+	L     hclog.Logger
 
 	bg     context.Context
 	cancel func()
@@ -61,7 +77,9 @@ type Server struct {
 	awsSess  *session.Session
 	kmsKeyId string
 	privKey  ed25519.PrivateKey
-	pubKey   ed25519.PublicKey
+
+	// The public half of the signing key, of signingAlg.
+	pubKey []byte
 
 	registerToken string
 	opsToken      string
@@ -71,24 +89,74 @@ type Server struct {
 	vaultClient *api.Client
 	vaultPath   string
 	keyId       string
+	signingAlg  token.Algorithm
+
+	secrets secrets.Backend
+
+	// Additional keys, by key id, that tokens are accepted from. Used
+	// to keep tokens signed by a previous key valid while rotating.
+	verifyKeys map[string]token.PublicKey
 
 	hubCert   []byte
 	hubKey    []byte
 	hubDomain string
 
+	// TLS certificates for the listener, by domain. The first domain passed
+	// to SetHubTLS is the default, used for unmatched SNI names.
+	tlsMu       sync.RWMutex
+	tlsCerts    map[string]*tls.Certificate
+	tlsFallback string
+
+	// Certificates naming a single hub subdomain, by that name. See
+	// SetSubdomainTLS.
+	subdomainTLS map[string]*subdomainCert
+
+	// The certificates above that are snake-oil, and how many handshakes
+	// they've been served in.
+	snakeOilCerts      map[*tls.Certificate]bool
+	snakeOilHandshakes int64
+
 	mu            sync.RWMutex
 	connectedHubs map[string]*connectedHub
 
+	flowsMu     sync.RWMutex
+	activeFlows map[string]*activeFlow
+
 	m *metrics.Metrics
 
 	msink metrics.MetricSink
 
 	flowTop *FlowTop
 
-	mux   *http.ServeMux
-	asnDB *geoip2.Reader
+	mux         *http.ServeMux
+	httpHandler http.Handler
+	asnMu       sync.RWMutex
+	asnDB       *geoip2.Reader
+	asnCache    asnCache
+
+	streamLimits streamLimiter
+	quotas       quotaTracker
+	tokenLimits  tokenRateLimiter
+
+	assignment AssignmentStrategy
+
+	readyMu     sync.Mutex
+	readyChecks map[string]ReadinessCheck
+
+	load loadMonitor
 
 	hubImageTag string
+
+	// Set once Drain has been called.
+	draining int32
+
+	rollups flowRollupBuffer
+
+	maintenance maintenanceMode
+
+	events eventBroker
+
+	sinkEvents sinkEventBuffer
 }
 
 type ServerConfig struct {
@@ -103,11 +171,25 @@ type ServerConfig struct {
 	VaultPath   string
 	KeyId       string
 
+	// Additional transit keys, as key id => vault path, that are accepted when
+	// validating tokens but never used to sign new ones. This allows tokens
+	// issued under a previous KeyId to keep working during a key rotation.
+	VerifyKeys map[string]string
+
+	// The algorithm new tokens are signed with. The key at VaultPath is
+	// created with it if it doesn't exist, and must be of it otherwise.
+	// VerifyKeys may be of any algorithm, so tokens signed before a switch
+	// keep validating. Defaults to ed25519.
+	TokenSigningAlgorithm token.Algorithm
+
 	AwsSession *session.Session
 	Bucket     string
 
 	ASNDB string
 
+	// How long ASN lookups are cached for. Defaults to DefaultASNCacheTTL.
+	ASNCacheTTL time.Duration
+
 	HubAccessKey string
 	HubSecretKey string
 
@@ -115,12 +197,257 @@ type ServerConfig struct {
 	// so they can act on it.
 	HubImageTag string
 
+	// Every metric is emitted to all of the sinks configured here, see
+	// newMetricsSinks. DataDogAddr and StatsDAddr are the host:port of a
+	// DogStatsD or plain StatsD agent to push to over UDP, alongside or,
+	// with DisablePrometheus, instead of the Prometheus /metrics endpoint.
 	DataDogAddr       string
+	StatsDAddr        string
 	DisablePrometheus bool
 
 	LockManager LockManager
+
+	// The maximum number of concurrent flows a hub should carry. Hubs at or
+	// above the limit are not advertised to agents until their flow count
+	// drops. A hub's own max_flows, when set, takes precedence. Zero means
+	// no limit.
+	MaxFlowsPerHub int64
+
+	// The most label links a single account may have, which bounds the cost
+	// of resolution on the hubs. AddLabelLink rejects links past it with
+	// ResourceExhausted; an account already over it, for instance after the
+	// limit was lowered, has its oldest links published and the rest left
+	// out with a warning. Zero means no limit.
+	MaxLabelLinksPerAccount int
+
+	// Compress HTTP responses for clients that send Accept-Encoding: gzip,
+	// leaving those smaller than HTTPGzipMinSize bytes as they are.
+	HTTPGzip        bool
+	HTTPGzipMinSize int
+
+	// Serve the pprof endpoints under /debug/pprof/, restricted to requests
+	// bearing the OpsToken.
+	EnablePprof bool
+
+	// The maximum number of concurrent streams a single peer host may have
+	// open. Zero means no limit.
+	MaxStreamsPerPeer int
+
+	// Streams that neither send nor receive a message within this window
+	// are closed, other than WatchEvents streams. Zero disables reaping.
+	StreamIdleTimeout time.Duration
+
+	// Hubs tear down flows that carry no traffic in either direction for
+	// this long, reporting them as ended with END_IDLE. An account's
+	// Limits.FlowIdleTimeout overrides it. Zero disables the timeout,
+	// otherwise it must be at least MinFlowIdleTimeout.
+	FlowIdleTimeout time.Duration
+
+	// How adding a label link that forms a cycle with the account's other
+	// links is handled. Rejected by default.
+	LabelLinkCycles LabelLinkCycleMode
+
+	// Unary calls taking longer than this are logged at warn, along with
+	// their method and account. Zero disables the logging.
+	SlowRPCThreshold time.Duration
+
+	// The lowest TLS version, as in crypto/tls, hubs accept agent
+	// connections for. Zero accepts any version the hub supports.
+	AgentTLSMinVersion uint16
+
+	// Whether agents must present a client certificate signed by
+	// AgentClientCA to connect to a hub.
+	AgentRequireClientCert bool
+
+	// PEM encoded CAs that hubs verify agent client certificates against.
+	// Accounts can only require client certificates when this is set.
+	AgentClientCA []byte
+
+	// Events, such as quota warnings and the lifecycle of accounts, services,
+	// hubs and flows, are posted to this URL when set.
+	WebhookURL string
+
+	// The number of services an account is expected to stay within. A
+	// quota-warning webhook is sent as an account crosses each of the
+	// QuotaWarningThresholds of it. Zero disables the check.
+	AccountServiceQuota int64
+
+	// Ascending fractions of a quota at which to warn. Defaults to
+	// DefaultQuotaWarningThresholds.
+	QuotaWarningThresholds []float64
+
+	// The largest request body, in bytes, accepted by the HTTP endpoints.
+	// Larger requests are rejected with a 413. Defaults to
+	// DefaultMaxRequestBodySize. gRPC requests are not affected.
+	MaxRequestBodySize int64
+
+	// The most concurrent connections accepted by the listener returned by
+	// LimitListener. Defaults to DefaultMaxConns, negative means no limit.
+	MaxConns int
+
+	// The thresholds past which the server considers itself overloaded:
+	// the jobs ready to run but not yet picked up, the latency of a trivial
+	// database query, and the connections open on the listeners from
+	// LimitListener. Past the connection threshold, which is the only one
+	// of this server alone, it reports not ready so load balancers shed
+	// traffic until it recovers. Zero leaves a signal unchecked. See
+	// MonitorLoad.
+	LoadMaxJobBacklog int
+	LoadMaxDBLatency  time.Duration
+	LoadMaxConns      int
+
+	// While past any of the thresholds, fail RPCs other than those hubs and
+	// operators call with Unavailable.
+	LoadShedRPCs bool
+
+	// Track the subdomains hubs are addressed by, for issuing each a
+	// certificate of its own. See HubSubdomains.
+	HubSubdomainCerts bool
+
+	// How long a hub's subdomain keeps the certificate issued for it once
+	// the hub is gone, so that a hub that's redeployed doesn't need a new
+	// one. DefaultHubSubdomainCertGrace if unset.
+	HubSubdomainCertGrace time.Duration
+
+	// Advertised to hubs to pace their reconnects after losing the
+	// connection to control, such as during a deploy. Hubs first wait a
+	// random delay of up to ReconnectJitter, then back off exponentially
+	// from ReconnectInitialBackoff to ReconnectMaxBackoff. Unset values use
+	// the DefaultReconnect values.
+	ReconnectInitialBackoff time.Duration
+	ReconnectMaxBackoff     time.Duration
+	ReconnectJitter         time.Duration
+
+	// Only accept hub credentials, issued with IssueHubCredential, on the
+	// hub facing services. HUB role tokens from IssueHubToken are rejected.
+	RequireHubCredentials bool
+
+	// Hubs reporting a protocol version below this are refused an activity
+	// stream, see ProtocolVersion. Zero accepts every hub, including those
+	// that predate reporting it.
+	MinHubProtocolVersion int32
+
+	// Decides which hubs are advertised to agents through discovery, and
+	// in which order. Defaults to advertising every hub with capacity, see
+	// NewAssignmentStrategy for the others.
+	AssignmentStrategy AssignmentStrategy
+
+	// How many times discovery retries finding a hub for an agent when
+	// every hub is full or none are registered, waiting
+	// AssignmentRetryBackoff before the first retry and twice as long
+	// before each after it. The agent is only told to come back later
+	// once the retries are exhausted, or after about 10 seconds of them
+	// however many are configured. Zero means no retries, and that no
+	// hubs registered is answered with an empty list rather than told to
+	// come back later. The backoff defaults to
+	// DefaultAssignmentRetryBackoff.
+	AssignmentRetries      int
+	AssignmentRetryBackoff time.Duration
+
+	// Accounts, as given in the account parameter of discovery requests,
+	// whose agents are always assigned hubs by account hash, so they keep
+	// preferring the same hubs while those have capacity, whatever the
+	// AssignmentStrategy. The assignments are served on /debug/hub-affinity.
+	HubAffinityAccounts []string
+
+	// The longest lifetime a newly issued token may have. Longer requests
+	// are clamped to it, as are tokens that would otherwise never expire.
+	// Zero means no limit.
+	MaxTokenTTL time.Duration
+
+	// Reject CreateToken requests for a lifetime longer than MaxTokenTTL
+	// rather than clamping them.
+	RejectLongTokenTTL bool
+
+	// The tokens per minute CreateToken and RequestServiceToken issue for a
+	// single account, or namespace for service tokens, allowing bursts of
+	// TokenIssueBurst. Requests beyond it fail with ResourceExhausted.
+	// Default to DefaultTokenIssueRate and DefaultTokenIssueBurst, a
+	// negative rate means no limit.
+	TokenIssueRate  int
+	TokenIssueBurst int
+
+	// Receives the same events as the webhook, delivered through workq.
+	// Defaults to NoopEventSink, which queues nothing.
+	EventSink EventSink
+
+	// Queue the jobs done on behalf of an account, such as delivering its
+	// events to the EventSink, on the account's own queue rather than the
+	// default one, so one account's backlog doesn't delay the others. The
+	// workers must then serve per-account queues, see
+	// workq.Worker.AccountQueues.
+	AccountJobQueues bool
+
+	// How long hubs are given to move their activity streams to another
+	// control server when this one is drained for shutdown. Defaults to
+	// DefaultDrainWindow.
+	DrainWindow time.Duration
+
+	// Restrict the management surface, every gRPC service but
+	// ControlServices and the HTTP debug endpoints, to peers within
+	// MgmtAllowCIDRs and outside of MgmtDenyCIDRs. Empty lists don't
+	// restrict anything.
+	MgmtAllowCIDRs []*net.IPNet
+	MgmtDenyCIDRs  []*net.IPNet
+
+	// The proxies in front of the server whose X-Real-IP and
+	// X-Forwarded-For headers name the caller checked against the lists
+	// above. The headers of any other peer are ignored.
+	MgmtTrustedProxyCIDRs []*net.IPNet
+
+	// Read PROXY protocol headers from the connections of the proxies in
+	// MgmtTrustedProxyCIDRs, for proxies that pass the caller's address
+	// that way rather than in headers. See ProxyProtocolListener.
+	ProxyProtocol bool
+
+	// Aggregate flow activity into the FlowRollupWindows and persist it,
+	// for CurrentFlowTop requests that name a window. RunFlowRollups must
+	// be running to write them.
+	FlowRollups bool
+
+	// Where the token signing keys are kept, and what signs tokens.
+	// VaultPath and the paths in VerifyKeys name keys within it. Defaults
+	// to the transit engine of VaultClient, which needn't be set otherwise.
+	Secrets secrets.Backend
+
+	// The header, and gRPC metadata key, that a caller's request id is read
+	// from and echoed back in. Calls without one are given a generated id.
+	// Defaults to DefaultRequestIDHeader.
+	RequestIDHeader string
+
+	// The handlers EnqueueJob accepts job types for. Defaults to
+	// workq.GlobalRegistry.
+	JobRegistry *workq.Registry
+
+	// How long routing objects of deleted accounts are kept before the
+	// CleanupOrphanedObjects RPC removes them. Defaults to
+	// DefaultOrphanGracePeriod.
+	OrphanGracePeriod time.Duration
+
+	// A connection for read-only requests that tolerate eventual
+	// consistency, such as one to a read replica. Requests that ask for a
+	// consistent read, or set ReadPrimaryMetadataKey, always use DB, as do
+	// all writes and the activity reader, which relies on notifications
+	// that aren't replicated. Defaults to DB.
+	ReadDB *gorm.DB
+
+	// How requests with fields or enum values this server doesn't know are
+	// handled, when served with UnknownFieldsStatsHandler. Callers can opt into
+	// strict handling of their own requests with UnknownFieldsMetadataKey.
+	// Defaults to UnknownFieldsLenient.
+	UnknownFields UnknownFieldMode
+
+	// Turn maintenance mode on at startup, rejecting the RPCs that change
+	// state with MaintenanceReason until SetMaintenanceMode turns it off.
+	// The mode is shared, so this applies to every control server.
+	MaintenanceMode   bool
+	MaintenanceReason string
 }
 
+// The shortest FlowIdleTimeout the server accepts. Hubs check flows for
+// traffic at a fraction of the timeout.
+const MinFlowIdleTimeout = time.Second
+
 func NewServer(cfg ServerConfig) (*Server, error) {
 	L := cfg.Logger
 	if L == nil {
@@ -131,30 +458,9 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 	mcfg.EnableHostname = false
 	mcfg.EnableRuntimeMetrics = false
 
-	var fanout metrics.FanoutSink
-
-	if !cfg.DisablePrometheus {
-		psink, err := prometheus.NewPrometheusSinkFrom(prometheus.PrometheusOpts{
-			Expiration: time.Hour,
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-		fanout = append(fanout, psink)
-	}
-
-	msink := metrics.NewInmemSink(time.Minute, time.Hour)
-	fanout = append(fanout, msink)
-
-	if cfg.DataDogAddr != "" {
-		L.Info("configured to send stats to datadog")
-		msink, err := datadog.NewDogStatsdSink(cfg.DataDogAddr, mcfg.HostName)
-		if err != nil {
-			return nil, err
-		}
-		fanout = append(fanout, msink)
+	fanout, msink, err := newMetricsSinks(L, cfg, mcfg.HostName)
+	if err != nil {
+		return nil, err
 	}
 
 	me, err := metrics.New(mcfg, fanout)
@@ -187,6 +493,10 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		hubImageTag = cfg.HubImageTag
 	}
 
+	if cfg.EventSink == nil {
+		cfg.EventSink = NoopEventSink{}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	s := &Server{
@@ -198,29 +508,53 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		vaultClient:   cfg.VaultClient,
 		vaultPath:     cfg.VaultPath,
 		keyId:         cfg.KeyId,
+		signingAlg:    cfg.TokenSigningAlgorithm,
+		secrets:       cfg.Secrets,
 		registerToken: cfg.RegisterToken,
 		opsToken:      cfg.OpsToken,
 		awsSess:       cfg.AwsSession,
 		bucket:        cfg.Bucket,
 
 		connectedHubs: make(map[string]*connectedHub),
+		activeFlows:   make(map[string]*activeFlow),
 		m:             me,
 		msink:         msink,
 		flowTop:       flowTop,
 		mux:           http.NewServeMux(),
 		hubImageTag:   hubImageTag,
+		assignment:    cfg.AssignmentStrategy,
 	}
 
 	L.Debug("setting up routes")
 
 	s.setupRoutes()
 
+	maxBody := cfg.MaxRequestBodySize
+	if maxBody <= 0 {
+		maxBody = DefaultMaxRequestBodySize
+	}
+
+	s.httpHandler = maxBodyHandler(s.mux, maxBody)
+	if cfg.HTTPGzip {
+		s.httpHandler = gzipHandler(s.httpHandler, cfg.HTTPGzipMinSize)
+	}
+
+	s.httpHandler = s.requestIDHandler(s.httpHandler)
+
+	if cfg.MaintenanceMode {
+		err = s.setMaintenanceMode(true, cfg.MaintenanceReason)
+		if err != nil {
+			return nil, err
+		}
+
+		L.Warn("starting in maintenance mode", "reason", s.maintenanceStatus().Reason)
+	}
+
 	if cfg.ASNDB != "" {
 		L.Debug("loading ASNDB")
 
-		r, err := geoip2.Open(cfg.ASNDB)
-		if err == nil {
-			s.asnDB = r
+		if err := s.openASNDB(); err != nil {
+			L.Error("error opening ASNDB", "path", cfg.ASNDB, "error", err)
 		}
 	}
 
@@ -230,20 +564,56 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		s.lockMgr = &inmemLockMgr{}
 	}
 
-	L.Debug("setting up vault access")
-	pub, err := token.SetupVault(s.vaultClient, s.vaultPath)
+	L.Debug("setting up token signing key")
+	pub, err := s.secretBackend().SigningKey(s.vaultPath, s.signingAlgorithm())
 	if err != nil {
 		return nil, err
 	}
 
-	s.pubKey = pub
+	s.pubKey = pub.Key
+
+	s.L.Info("configured token signing",
+		"key-id", s.keyId,
+		"algorithm", pub.Algorithm,
+		"pubkey", hex.EncodeToString(pub.Key),
+	)
+
+	for id, path := range cfg.VerifyKeys {
+		if id == s.keyId {
+			continue
+		}
+
+		pub, err := s.secretBackend().SigningKey(path, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "setting up verification key %s", id)
+		}
+
+		if s.verifyKeys == nil {
+			s.verifyKeys = make(map[string]token.PublicKey)
+		}
+
+		s.verifyKeys[id] = pub
 
-	s.L.Info("vault configured for token signing", "pubkey", hex.EncodeToString(pub))
+		s.L.Info("configured token verification",
+			"key-id", id,
+			"algorithm", pub.Algorithm,
+			"pubkey", hex.EncodeToString(pub.Key),
+		)
+	}
+
+	if _, legacy := s.legacyTokenKey(); legacy == nil {
+		s.L.Warn("no ed25519 token key configured, hubs that predate token key sets won't accept any tokens",
+			"algorithm", s.signingAlgorithm(),
+		)
+	}
 
 	if hubImageFile != "" {
 		go s.monitorImageFile(hubImageFile)
 	}
 
+	go s.RunEventQueue(s.bg)
+	go s.emitSnakeOilGauge(s.bg)
+
 	return s, nil
 }
 
@@ -272,26 +642,134 @@ func (s *Server) monitorImageFile(path string) {
 }
 
 func (s *Server) TokenPub() ed25519.PublicKey {
-	return s.pubKey
+	_, pub := s.legacyTokenKey()
+	return pub
+}
+
+// The key id and key of the ed25519 key given out in the fields that predate
+// token key sets, ConfigResponse.TokenPub and TokenInfo.PublicKey, which
+// older hubs and clients take to be an ed25519 key. That's the signing key if
+// tokens are signed with ed25519, otherwise the ed25519 verification key with
+// the lowest key id. nil if there's none.
+func (s *Server) legacyTokenKey() (string, ed25519.PublicKey) {
+	if s.signingAlgorithm() == token.AlgorithmEd25519 {
+		return s.keyId, s.pubKey
+	}
+
+	var ids []string
+
+	for id, pub := range s.verifyKeys {
+		if pub.Algorithm == "" || pub.Algorithm == token.AlgorithmEd25519 {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return "", nil
+	}
+
+	sort.Strings(ids)
+
+	return ids[0], ed25519.PublicKey(s.verifyKeys[ids[0]].Key)
 }
 
 // For management clients to be able valid horizon tokens themselves without having to ask
 // the control tier. This allows management clients to piggy back their authentication
-// off the horizon tokens as well.
+// off the horizon tokens as well. Only an ed25519 key is returned, as older
+// clients expect; ListTokenKeys returns keys of every algorithm.
 func (s *Server) GetTokenPublicKey(ctx context.Context, _ *pb.Noop) (*pb.TokenInfo, error) {
-	return &pb.TokenInfo{PublicKey: s.pubKey}, nil
+	id, pub := s.legacyTokenKey()
+	if pub == nil {
+		return &pb.TokenInfo{}, nil
+	}
+
+	return &pb.TokenInfo{
+		PublicKey: pub,
+		Algorithm: string(token.AlgorithmEd25519),
+		KeyId:     id,
+	}, nil
+}
+
+// The backend holding the signing keys. Servers built without one, as
+// the tests do, use vault directly.
+func (s *Server) secretBackend() secrets.Backend {
+	if s.secrets != nil {
+		return s.secrets
+	}
+
+	return secrets.NewVault(s.vaultClient)
+}
+
+// Signs token bodies with the key tokens are issued under.
+func (s *Server) signToken(data []byte) ([]byte, error) {
+	return s.secretBackend().Sign(s.vaultPath, data)
+}
+
+// The algorithm of the key tokens are issued under.
+func (s *Server) signingAlgorithm() token.Algorithm {
+	if s.signingAlg == "" {
+		return token.AlgorithmEd25519
+	}
+
+	return s.signingAlg
+}
+
+// Encode tc, signed by the key tokens are issued under.
+func (s *Server) encodeToken(tc *token.TokenCreator) (string, error) {
+	return tc.EncodeWithSigner(s.signToken, s.keyId, s.signingAlgorithm())
+}
+
+// The keys that tokens are validated against, indexed by key id.
+func (s *Server) tokenKeys() map[string]token.PublicKey {
+	keys := map[string]token.PublicKey{
+		s.keyId: {Algorithm: s.signingAlgorithm(), Key: s.pubKey},
+	}
+
+	for id, pub := range s.verifyKeys {
+		if _, ok := keys[id]; !ok {
+			keys[id] = pub
+		}
+	}
+
+	return keys
 }
 
-func (s *Server) SetHubTLS(cert, key []byte, domain string) {
-	s.hubCert = cert
-	s.hubKey = key
-	s.hubDomain = domain
+// Lists the key ids that tokens are currently accepted from, along with
+// which one is used to sign newly issued tokens.
+func (s *Server) ListTokenKeys(ctx context.Context, _ *pb.Noop) (*pb.ListTokenKeysResponse, error) {
+	_, err := s.checkMgmtAllowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pb.ListTokenKeysResponse
+
+	for id, pub := range s.tokenKeys() {
+		resp.Keys = append(resp.Keys, &pb.TokenKey{
+			KeyId:     id,
+			PublicKey: pub.Key,
+			Signing:   id == s.keyId,
+			Algorithm: string(pub.Algorithm),
+		})
+	}
+
+	sort.Slice(resp.Keys, func(i, j int) bool {
+		return resp.Keys[i].KeyId < resp.Keys[j].KeyId
+	})
+
+	return &resp, nil
 }
 
 type Account struct {
 	ID        []byte `gorm:"primary_key"`
 	Namespace string
 
+	// Optional caller assigned id, unique within the namespace.
+	ExternalID *string
+
+	// The key of the request that created the account, if provided.
+	IdempotencyKey *string
+
 	Data sqljson.Data
 
 	CreatedAt time.Time
@@ -328,7 +806,23 @@ func (s *Server) checkFromHub(ctx context.Context, action string) (*token.ValidT
 		return nil, ErrBadAuthentication
 	}
 
-	token, err := token.CheckTokenED25519(auth[0], s.pubKey)
+	// Hubs presenting a hub credential have no signed token to return.
+	if isHubCredential(auth[0]) {
+		cred, err := s.contextHubCredential(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger(ctx).Info("authentication from hub successful", "action", action, "credential", cred.Name)
+
+		return nil, nil
+	}
+
+	if s.config().RequireHubCredentials {
+		return nil, errors.Wrapf(ErrBadAuthentication, "hub credential required")
+	}
+
+	token, err := token.CheckTokenKeys(auth[0], s.tokenKeys())
 	if err != nil {
 		// s.L.Error("error checking token signature", "error", err, "token", auth[0], "pubkey", hex.EncodeToString(s.pubKey))
 		return nil, err
@@ -338,7 +832,7 @@ func (s *Server) checkFromHub(ctx context.Context, action string) (*token.ValidT
 		return nil, errors.Wrapf(ErrBadAuthentication, "role was: %s", token.Body.Role)
 	}
 
-	s.L.Info("authentication from hub successful", "action", action)
+	s.logger(ctx).Info("authentication from hub successful", "action", action)
 
 	return token, nil
 }
@@ -355,6 +849,23 @@ func (s *Server) AddService(ctx context.Context, service *pb.ServiceRequest) (*p
 
 	s.m.IncrCounter([]string{"service", "add"}, 1)
 
+	// Services may register without labels of their own and rely on the
+	// account defaults.
+	if len(service.Labels.GetLabels()) > 0 {
+		err = validateLabels("labels", service.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defaults, err := accountDefaultLabels(s.db, service.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Labels the service registered with win over the account defaults.
+	service.Labels = mergeDefaultLabels(service.Labels, defaults)
+
 	var so Service
 	so.AccountId = service.Account.Key()
 	so.HubId = service.Hub.Bytes()
@@ -388,6 +899,20 @@ func (s *Server) AddService(ctx context.Context, service *pb.ServiceRequest) (*p
 		return nil, err
 	}
 
+	s.checkServiceQuota(service.Account)
+
+	s.emitEvent(&WebhookEvent{
+		Type:      EventServiceAdded,
+		Namespace: service.Account.Namespace,
+		Account:   service.Account.AccountId.String(),
+		Data: map[string]interface{}{
+			"service": service.Id.SpecString(),
+			"hub":     service.Hub.SpecString(),
+			"type":    service.Type,
+			"labels":  service.Labels.SpecString(),
+		},
+	})
+
 	return &pb.ServiceResponse{}, nil
 }
 
@@ -409,21 +934,48 @@ func (s *Server) RemoveService(ctx context.Context, service *pb.ServiceRequest)
 		return nil, err
 	}
 
+	// Lets usage that dropped below a threshold warn again when it rises.
+	s.checkServiceQuota(service.Account)
+
+	s.emitEvent(&WebhookEvent{
+		Type:      EventServiceRemoved,
+		Namespace: service.Account.Namespace,
+		Account:   service.Account.AccountId.String(),
+		Data: map[string]interface{}{
+			"service": service.Id.SpecString(),
+			"hub":     service.Hub.SpecString(),
+		},
+	})
+
 	return &pb.ServiceResponse{}, nil
 }
 
+// ListServices lists the services registered under req.Account as given.
+// Aliases aren't resolved, since agents keep registering services under the
+// account their token names.
 func (s *Server) ListServices(ctx context.Context, req *pb.ListServicesRequest) (*pb.ListServicesResponse, error) {
 	var services []*Service
-	err := dbx.Check(s.db.Where("account_id = ?", req.Account.Key()).Find(&services))
+	err := dbx.Check(
+		s.listDB(ctx, req.ConsistentRead).Where("account_id = ?", req.Account.Key()).
+			Order("created_at ASC, id ASC").
+			Find(&services),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp pb.ListServicesResponse
+
+	ie := itemErrors{bestEffort: req.BestEffort}
+
 	for _, svc := range services {
 		var labelSet pb.LabelSet
 		if err := labelSet.Scan(svc.Labels); err != nil {
-			return nil, err
+			if err := ie.add(pb.ULIDFromBytes(svc.ServiceId).SpecString(), err); err != nil {
+				return nil, err
+			}
+
+			continue
 		}
 
 		resp.Services = append(resp.Services, &pb.Service{
@@ -434,6 +986,8 @@ func (s *Server) ListServices(ctx context.Context, req *pb.ListServicesRequest)
 		})
 	}
 
+	resp.Errors = ie.errs
+
 	return &resp, nil
 }
 
@@ -456,7 +1010,7 @@ func (s *Server) removeHubServices(ctx context.Context, db *gorm.DB, hubId *pb.U
 		accounts[string(service.AccountId)] = struct{}{}
 	}
 
-	s.L.Info("updating account routing", "num-accounts", len(accounts))
+	s.logger(ctx).Info("updating account routing", "num-accounts", len(accounts))
 
 	for key := range accounts {
 		acc, err := pb.AccountFromKey([]byte(key))
@@ -480,6 +1034,15 @@ type Hub struct {
 	ConnectionInfo []byte
 	LastCheckin    time.Time
 
+	// Overrides ServerConfig.MaxFlowsPerHub for this hub when set.
+	MaxFlows *int64
+
+	// As advertised by the hub in its last FetchConfig.
+	Capabilities pq.StringArray
+
+	// As reported by the hub when it last opened its activity stream.
+	ProtocolVersion int32
+
 	CreatedAt time.Time
 }
 
@@ -488,11 +1051,18 @@ func (h *Hub) StableIdULID() *pb.ULID {
 }
 
 func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.ConfigResponse, error) {
+	cfg := s.config()
+
 	_, err := s.checkFromHub(ctx, "fetch-config")
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.checkHubCredentialBinding(ctx, req.StableId)
+	if err != nil {
+		return nil, err
+	}
+
 	L := s.L
 
 	ts := time.Now()
@@ -515,7 +1085,7 @@ func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.Co
 	tx := s.db.Begin()
 
 	err = dbx.Check(
-		tx.Set("gorm:query_options", "FOR UPDATE").
+		tx.Set("gorm:query_option", "FOR UPDATE").
 			Where("stable_id = ?", req.StableId.Bytes()).
 			First(&hr),
 	)
@@ -526,6 +1096,7 @@ func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.Co
 
 		hr.ConnectionInfo = data
 		hr.LastCheckin = time.Now()
+		hr.Capabilities = req.Capabilities
 
 		err = dbx.Check(tx.Create(&hr))
 		if err != nil {
@@ -549,7 +1120,7 @@ func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.Co
 			go func() {
 				err := s.removeHubServices(s.bg, s.db, prev)
 				if err != nil {
-					s.L.Error("error removing old hub services", "error", err, "hub", req.StableId)
+					s.logger(ctx).Error("error removing old hub services", "error", err, "hub", req.StableId)
 				}
 			}()
 		}
@@ -560,6 +1131,7 @@ func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.Co
 					"connection_info": data,
 					"instance_id":     req.InstanceId.Bytes(),
 					"last_checkin":    time.Now(),
+					"capabilities":    pq.StringArray(req.Capabilities),
 				}),
 		)
 
@@ -569,19 +1141,48 @@ func (s *Server) FetchConfig(ctx context.Context, req *pb.ConfigRequest) (*pb.Co
 		}
 	}
 
+	if cfg.HubSubdomainCerts {
+		err = touchHubSubdomain(tx, req.StableId)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	err = dbx.Check(tx.Commit())
 	if err != nil {
 		return nil, err
 	}
 
+	tlsCert, tlsKey := s.hubMaterial(req.StableId)
+
 	resp := &pb.ConfigResponse{
-		TlsKey:      s.hubKey,
-		TlsCert:     s.hubCert,
-		TokenPub:    s.pubKey,
-		S3AccessKey: s.cfg.HubAccessKey,
-		S3SecretKey: s.cfg.HubSecretKey,
-		S3Bucket:    s.cfg.Bucket,
+		TlsKey:      tlsKey,
+		TlsCert:     tlsCert,
+		TokenPub:    s.TokenPub(),
+		S3AccessKey: cfg.HubAccessKey,
+		S3SecretKey: cfg.HubSecretKey,
+		S3Bucket:    cfg.Bucket,
 		ImageTag:    s.hubImageTag,
+		Reconnect:   s.reconnectPolicy(),
+
+		FlowIdleTimeout: int64(cfg.FlowIdleTimeout),
+
+		TlsPolicy:     s.defaultTLSPolicy(),
+		AgentClientCa: cfg.AgentClientCA,
+	}
+
+	if s.awsSess != nil {
+		resp.S3Region = aws.StringValue(s.awsSess.Config.Region)
+	}
+
+	for id, pub := range s.tokenKeys() {
+		resp.TokenKeys = append(resp.TokenKeys, &pb.TokenKey{
+			KeyId:     id,
+			PublicKey: pub.Key,
+			Signing:   id == s.keyId,
+			Algorithm: string(pub.Algorithm),
+		})
 	}
 
 	return resp, nil
@@ -593,23 +1194,36 @@ func (s *Server) HubDisconnect(ctx context.Context, req *pb.HubDisconnectRequest
 		return nil, err
 	}
 
+	err = s.checkHubCredentialBinding(ctx, req.StableId)
+	if err != nil {
+		return nil, err
+	}
+
 	s.m.IncrCounter([]string{"hub", "disconnect"}, 1)
 
-	s.L.Info("removing hub services", "id", req.StableId)
+	s.logger(ctx).Info("removing hub services", "id", req.StableId)
 
 	serr := s.removeHubServices(ctx, s.db, req.InstanceId)
 	if err != nil {
 		err = multierror.Append(err, serr)
 	}
 
-	s.L.Info("removing hub", "id", req.StableId)
+	s.logger(ctx).Info("removing hub", "id", req.StableId)
 
 	serr = dbx.Check(s.db.Where("stable_id = ?", req.StableId.Bytes()).Delete(&Hub{}))
 	if serr != nil {
 		err = multierror.Append(err, serr)
 	}
 
-	s.L.Info("hub cleaned up", "possible-error", err)
+	// Its subdomain cert is kept for HubSubdomainCertGrace from now.
+	if s.config().HubSubdomainCerts {
+		serr = touchHubSubdomain(s.db, req.StableId)
+		if serr != nil {
+			err = multierror.Append(err, serr)
+		}
+	}
+
+	s.logger(ctx).Info("hub cleaned up", "possible-error", err)
 
 	return &pb.Noop{}, err
 }
@@ -622,6 +1236,12 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 			mdiff += rec.Stream.NumMessages
 			bdiff += rec.Stream.NumBytes
 
+			// Hubs may report streams without an account.
+			var namespace, account, accountKey string
+			if acc := rec.Stream.Account; acc != nil && acc.AccountId != nil {
+				namespace, account, accountKey = acc.Namespace, acc.AccountId.String(), acc.SpecString()
+			}
+
 			labels := []metrics.Label{
 				{
 					Name:  "flow",
@@ -641,7 +1261,7 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 				},
 				{
 					Name:  "account",
-					Value: rec.Stream.Account.SpecString(),
+					Value: accountKey,
 				},
 			}
 
@@ -649,6 +1269,42 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 			s.m.IncrCounterWithLabels([]string{"stream", "bytes"}, float32(rec.Stream.NumBytes), labels)
 
 			s.flowTop.Add(rec.Stream)
+
+			if s.config().FlowRollups {
+				s.rollups.add(rec.Stream, time.Now())
+			}
+			started, totals := s.trackActiveFlow(ch, rec.Stream)
+			if started {
+				s.emitWatchEvent(&WebhookEvent{
+					Type:      EventFlowStarted,
+					Namespace: namespace,
+					Account:   account,
+					Data: map[string]interface{}{
+						"flow":    rec.Stream.FlowId.SpecString(),
+						"hub":     rec.Stream.HubId.SpecString(),
+						"agent":   rec.Stream.AgentId.SpecString(),
+						"service": rec.Stream.ServiceId.SpecString(),
+					},
+				})
+			}
+
+			if rec.Stream.EndedAt != nil {
+				recordFlowEnd(s.m, rec.Stream)
+
+				s.emitEvent(&WebhookEvent{
+					Type:      EventFlowEnded,
+					Namespace: namespace,
+					Account:   account,
+					Data: map[string]interface{}{
+						"flow":     rec.Stream.FlowId.SpecString(),
+						"hub":      rec.Stream.HubId.SpecString(),
+						"agent":    rec.Stream.AgentId.SpecString(),
+						"service":  rec.Stream.ServiceId.SpecString(),
+						"messages": totals.messages,
+						"bytes":    totals.bytes,
+					},
+				})
+			}
 		}
 
 		if rec.Agent != nil {
@@ -673,6 +1329,7 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 		if rec.HubStats != nil {
 			atomic.StoreInt64(ch.activeAgents, rec.HubStats.ActiveAgents)
 			atomic.StoreInt64(ch.services, rec.HubStats.Services)
+			atomic.StoreInt64(ch.activeFlows, rec.HubStats.ActiveFlows)
 
 			labels := []metrics.Label{
 				{
@@ -682,6 +1339,7 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 			}
 
 			s.m.SetGaugeWithLabels([]string{"agents", "active"}, float32(rec.HubStats.ActiveAgents), labels)
+			s.m.SetGaugeWithLabels([]string{"hub", "flows"}, float32(rec.HubStats.ActiveFlows), labels)
 		}
 	}
 
@@ -707,6 +1365,12 @@ func (s *Server) processFlows(ch *connectedHub, flows []*pb.FlowRecord) {
 }
 
 func (s *Server) StreamActivity(stream pb.ControlServices_StreamActivityServer) error {
+	// Hubs retry elsewhere, rather than open a stream they'd just be asked
+	// to move.
+	if atomic.LoadInt32(&s.draining) == 1 {
+		return status.Error(codes.Unavailable, errDraining.Error())
+	}
+
 	ctx := stream.Context()
 	_, err := s.checkFromHub(ctx, "stream-activity")
 	if err != nil {
@@ -715,34 +1379,71 @@ func (s *Server) StreamActivity(stream pb.ControlServices_StreamActivityServer)
 
 	msg, err := stream.Recv()
 	if err != nil {
-		s.L.Debug("acvitity stream request error on early read", "err", err)
+		s.logger(ctx).Debug("acvitity stream request error on early read", "err", err)
 		return err
 	}
 
 	if msg.HubReg == nil {
-		s.L.Debug("acvitity stream request did not contain a hub reg record")
+		s.logger(ctx).Debug("acvitity stream request did not contain a hub reg record")
 		return nil
 	}
 
 	key := msg.HubReg.Hub.SpecString()
 
-	s.L.Info("streaming activity to and from hub", "hub", key)
+	err = s.checkHubProtocolVersion(ctx, msg.HubReg)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkHubCredentialBinding(ctx, msg.HubReg.StableHub)
+	if err != nil {
+		return err
+	}
+
+	cred, err := s.contextHubCredential(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.logger(ctx).Info("streaming activity to and from hub", "hub", key)
 
 	ch := &connectedHub{
 		xmit:     make(chan *pb.CentralActivity),
+		stableId: msg.HubReg.StableHub,
 		messages: new(int64),
 		bytes:    new(int64),
 
 		activeAgents: new(int64),
 		services:     new(int64),
+		activeFlows:  new(int64),
+
+		cancel: cancel,
+	}
+
+	var recheck <-chan time.Time
+
+	if cred != nil {
+		ch.credentialId = cred.ID
+
+		ticker := time.NewTicker(hubCredentialRecheckInterval)
+		defer ticker.Stop()
+
+		recheck = ticker.C
 	}
 
 	s.mu.Lock()
 	s.connectedHubs[key] = ch
 	s.mu.Unlock()
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	s.emitEvent(&WebhookEvent{
+		Type: EventHubConnected,
+		Data: map[string]interface{}{
+			"hub": key,
+		},
+	})
 
 	go func() {
 		for {
@@ -756,12 +1457,21 @@ func (s *Server) StreamActivity(stream pb.ControlServices_StreamActivityServer)
 	}()
 
 	defer func() {
-		s.L.Debug("hub disconnecting", "hub", key)
+		s.logger(ctx).Debug("hub disconnecting", "hub", key)
 
 		s.mu.Lock()
 		delete(s.connectedHubs, key)
 		s.mu.Unlock()
 
+		s.removeHubFlows(ch)
+
+		s.emitEvent(&WebhookEvent{
+			Type: EventHubDisconnected,
+			Data: map[string]interface{}{
+				"hub": key,
+			},
+		})
+
 		// drain the xmit channel in the case that the sender saw
 		// us around but we're now exiting.
 	drain:
@@ -780,12 +1490,23 @@ func (s *Server) StreamActivity(stream pb.ControlServices_StreamActivityServer)
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-recheck:
+			revoked, err := s.hubCredentialRevoked(ch.credentialId)
+			if err != nil {
+				s.logger(ctx).Error("error checking hub credential", "error", err, "hub", key)
+				continue
+			}
+
+			if revoked {
+				s.logger(ctx).Info("closing activity stream of revoked hub credential", "hub", key)
+				return status.Error(codes.Unauthenticated, ErrBadAuthentication.Error())
+			}
 		case act, ok := <-ch.xmit:
 			if !ok {
 				return nil
 			}
 
-			s.L.Debug("sending data to hub", "hub", key, "activity", act.String())
+			s.logger(ctx).Debug("sending data to hub", "hub", key, "activity", act.String())
 
 			err = stream.Send(act)
 			if err != nil {
@@ -883,13 +1604,19 @@ func (s *Server) GetManagementToken(ctx context.Context, namespace string) (stri
 		}
 	}
 
+	dur, err := s.tokenValidDuration("management", 0)
+	if err != nil {
+		return "", err
+	}
+
 	var tc token.TokenCreator
 	tc.Role = pb.MANAGE
 	tc.Capabilities = map[pb.Capability]string{
 		pb.ACCESS: namespace,
 	}
+	tc.ValidDuration = dur
 
-	token, err := tc.EncodeED25519WithVault(s.vaultClient, s.vaultPath, s.keyId)
+	token, err := s.encodeToken(&tc)
 	if err != nil {
 		return "", err
 	}
@@ -932,13 +1659,19 @@ func (s *Server) Register(ctx context.Context, reg *pb.ControlRegister) (*pb.Con
 		return nil, err
 	}
 
+	dur, err := s.tokenValidDuration("management", 0)
+	if err != nil {
+		return nil, err
+	}
+
 	var tc token.TokenCreator
 	tc.Role = pb.MANAGE
 	tc.Capabilities = map[pb.Capability]string{
 		pb.ACCESS: rec.Namespace,
 	}
+	tc.ValidDuration = dur
 
-	token, err := tc.EncodeED25519WithVault(s.vaultClient, s.vaultPath, s.keyId)
+	token, err := s.encodeToken(&tc)
 	if err != nil {
 		return nil, err
 	}
@@ -962,10 +1695,16 @@ func (s *Server) IssueHubToken(ctx context.Context, _ *pb.Noop) (*pb.CreateToken
 		return nil, ErrBadAuthentication
 	}
 
+	dur, err := s.tokenValidDuration("hub", 0)
+	if err != nil {
+		return nil, err
+	}
+
 	var tc token.TokenCreator
 	tc.Role = pb.HUB
+	tc.ValidDuration = dur
 
-	token, err := tc.EncodeED25519WithVault(s.vaultClient, s.vaultPath, s.keyId)
+	token, err := s.encodeToken(&tc)
 	if err != nil {
 		return nil, err
 	}
@@ -974,6 +1713,11 @@ func (s *Server) IssueHubToken(ctx context.Context, _ *pb.Noop) (*pb.CreateToken
 }
 
 func (s *Server) checkMgmtAllowed(ctx context.Context) (*token.ValidToken, error) {
+	// Already validated by UnaryAuthInterceptor.
+	if ac := authorizedFrom(ctx); ac != nil && ac.mgmt != nil {
+		return ac.mgmt, nil
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, ErrBadAuthentication
@@ -985,7 +1729,7 @@ func (s *Server) checkMgmtAllowed(ctx context.Context) (*token.ValidToken, error
 		return nil, ErrBadAuthentication
 	}
 
-	token, err := token.CheckTokenED25519(auth[0], s.pubKey)
+	token, err := token.CheckTokenKeys(auth[0], s.tokenKeys())
 	if err != nil {
 		return nil, err
 	}
@@ -997,7 +1741,7 @@ func (s *Server) checkMgmtAllowed(ctx context.Context) (*token.ValidToken, error
 	return token, nil
 }
 
-func (s *Server) AddAccount(ctx context.Context, req *pb.AddAccountRequest) (*pb.Noop, error) {
+func (s *Server) AddAccount(ctx context.Context, req *pb.AddAccountRequest) (*pb.AddAccountResponse, error) {
 	L := s.L.Named("add-account")
 
 	L.Info("adding new account",
@@ -1030,20 +1774,115 @@ func (s *Server) AddAccount(ctx context.Context, req *pb.AddAccountRequest) (*pb
 	var ao Account
 	ao.ID = req.Account.Key()
 	ao.Namespace = req.Account.Namespace
+
+	if req.ExternalId != "" {
+		if !validExternalID(req.ExternalId) {
+			return nil, errors.Wrapf(ErrInvalidRequest, "invalid external id")
+		}
+
+		ao.ExternalID = &req.ExternalId
+	}
+
 	err = ao.Data.Set("limits", req.Limits)
 	if err != nil {
 		return nil, errors.Wrapf(ErrInvalidRequest, "error parsing limits: %s", err)
 	}
 
-	de := s.db.Create(&ao)
+	if req.IdempotencyKey != "" {
+		if len(req.IdempotencyKey) > MaxIdempotencyKeyLength {
+			return nil, errors.Wrapf(ErrInvalidRequest, "idempotency key too long")
+		}
+
+		ao.IdempotencyKey = &req.IdempotencyKey
+	}
+
+	// An account created under an alias's id could never be reached, the
+	// alias would be resolved in its place.
+	var alias AccountAlias
+
+	err = dbx.Check(s.db.Where("alias_id = ?", ao.ID).First(&alias))
+	if err == nil {
+		return nil, errors.Wrapf(ErrInvalidRequest, "account id is an alias of another account")
+	}
+
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	// Conflicting with an existing account means this is a retry of an
+	// earlier request, so the existing account is returned instead.
+	de := s.db.Set("gorm:insert_option", "ON CONFLICT DO NOTHING").Create(&ao)
 
 	err = dbx.Check(de)
 	if err != nil {
-		L.Error("error reading account information for labellink", "error", err)
+		L.Error("error creating account", "error", err)
 		return nil, err
 	}
 
-	return &pb.Noop{}, nil
+	if de.RowsAffected > 0 {
+		s.emitEvent(&WebhookEvent{
+			Type:      EventAccountCreated,
+			Namespace: req.Account.Namespace,
+			Account:   req.Account.AccountId.String(),
+		})
+
+		return &pb.AddAccountResponse{Account: req.Account, Created: true}, nil
+	}
+
+	existing, err := s.findExistingAccount(&ao)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a retry of this request gets the existing account back. Another
+	// account already holding the external id is a conflict.
+	retry := bytes.Equal(existing.ID, ao.ID) ||
+		(ao.IdempotencyKey != nil && existing.IdempotencyKey != nil && *existing.IdempotencyKey == *ao.IdempotencyKey)
+
+	if !retry {
+		return nil, status.Errorf(codes.AlreadyExists, "external id %s is already in use", req.ExternalId)
+	}
+
+	account, err := pb.AccountFromKey(existing.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	L.Info("account already exists, returning it", "account", account.SpecString())
+
+	return &pb.AddAccountResponse{Account: account}, nil
+}
+
+// Idempotency keys longer than this are rejected.
+const MaxIdempotencyKeyLength = 256
+
+// Find the account that prevented ao from being created, matching on id,
+// external id or idempotency key.
+func (s *Server) findExistingAccount(ao *Account) (*Account, error) {
+	q := s.db.Where("id = ?", ao.ID)
+
+	if ao.ExternalID != nil {
+		q = q.Or("namespace = ? AND external_id = ?", ao.Namespace, *ao.ExternalID)
+	}
+
+	if ao.IdempotencyKey != nil {
+		q = q.Or("namespace = ? AND idempotency_key = ?", ao.Namespace, *ao.IdempotencyKey)
+	}
+
+	var existing Account
+
+	err := dbx.Check(q.First(&existing))
+	if err != nil {
+		return nil, errors.Wrapf(err, "locating existing account")
+	}
+
+	// An account id is global, so it may exist in a namespace the caller
+	// doesn't have access to.
+	if existing.Namespace != ao.Namespace {
+		return nil, errors.Wrapf(ErrInvalidRequest, "account id already in use")
+	}
+
+	return &existing, nil
 }
 
 type LabelLink struct {
@@ -1055,6 +1894,9 @@ type LabelLink struct {
 	Labels string
 	Target string
 
+	// Set for weighted links, see pb.LabelLink.
+	Weight *int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -1068,24 +1910,26 @@ func (s *Server) AddLabelLink(ctx context.Context, req *pb.AddLabelLinkRequest)
 		"target", req.Target.SpecString(),
 	)
 
-	caller, err := s.checkMgmtAllowed(ctx)
+	caller, err := s.accountCallerFrom(ctx)
 	if err != nil {
 		L.Error("error checking mgmt token", "err", err)
 		return nil, err
 	}
 
-	if req.Account.Namespace == "" {
-		req.Account.Namespace = caller.Account().Namespace
+	err = validateLabels("labels", req.Labels)
+	if err != nil {
+		return nil, err
 	}
 
-	if !caller.AllowAccount(req.Account.Namespace) {
-		L.Error(
-			"rejected access to account based on caller namespace",
-			"caller-namespace", caller.Account().Namespace,
-			"requested-namespace", req.Account.Namespace,
-		)
+	err = validateLabels("target", req.Target)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	req.Account, err = s.resolveAccount(caller, req.Account, req.ExternalId)
+	if err != nil {
+		L.Error("rejected access to account", "error", err)
+		return nil, err
 	}
 
 	var ao Account
@@ -1105,9 +1949,18 @@ func (s *Server) AddLabelLink(ctx context.Context, req *pb.AddLabelLinkRequest)
 	llr.Labels = FlattenLabels(req.Labels)
 	llr.Target = FlattenLabels(req.Target)
 
-	err = dbx.Check(s.db.Create(&llr))
+	if req.Weighted {
+		if req.Weight > MaxLabelLinkWeight {
+			return nil, status.Errorf(codes.InvalidArgument, "weight %d exceeds the maximum of %d", req.Weight, MaxLabelLinkWeight)
+		}
+
+		weight := int64(req.Weight)
+		llr.Weight = &weight
+	}
+
+	err = s.saveLabelLink(ctx, &llr, req.OnConflict)
 	if err != nil {
-		L.Error("error creating label-link record", "error", err)
+		L.Error("error saving label-link record", "error", err)
 		return nil, err
 	}
 
@@ -1116,17 +1969,16 @@ func (s *Server) AddLabelLink(ctx context.Context, req *pb.AddLabelLinkRequest)
 	var pblimit pb.Account_Limits
 	ao.Data.Get("limits", &pblimit)
 
-	var out pb.LabelLinks
-	out.LabelLinks = []*pb.LabelLink{{
-		Account: req.Account,
-		Labels:  req.Labels,
-		Target:  req.Target,
-		Limits:  &pblimit,
-	}}
+	// Hubs replace the links they have for the labels with the ones
+	// broadcast, so every weighted link for them is sent along.
+	out, err := s.labelLinksFor(req.Account, req.Labels, &pblimit)
+	if err != nil {
+		return nil, err
+	}
 
 	L.Trace("broadcasting new label-link activity")
 	s.broadcastActivity(ctx, &pb.CentralActivity{
-		NewLabelLinks: &out,
+		NewLabelLinks: out,
 	})
 
 	L.Trace("running s3 update of label links in background")
@@ -1141,24 +1993,29 @@ func (s *Server) AddLabelLink(ctx context.Context, req *pb.AddLabelLinkRequest)
 }
 
 func (s *Server) RemoveLabelLink(ctx context.Context, req *pb.RemoveLabelLinkRequest) (*pb.Noop, error) {
-	caller, err := s.checkMgmtAllowed(ctx)
+	caller, err := s.accountCallerFrom(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !caller.AllowAccount(req.Account.Namespace) {
-		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	req.Account, err = s.resolveAccount(caller, req.Account, req.ExternalId)
+	if err != nil {
+		return nil, err
 	}
 
 	var llr LabelLink
 	llr.AccountID = req.Account.Key()
 	llr.Labels = FlattenLabels(req.Labels)
 
-	err = dbx.Check(s.db.
+	q := s.db.
 		Where("account_id = ?", llr.AccountID).
-		Where("labels = ?", FlattenLabels(req.Labels)).
-		Delete(&LabelLink{}),
-	)
+		Where("labels = ?", FlattenLabels(req.Labels))
+
+	if req.Target != nil {
+		q = q.Where("target = ?", FlattenLabels(req.Target))
+	}
+
+	err = dbx.Check(q.Delete(&LabelLink{}))
 
 	if err != nil {
 		return nil, err
@@ -1178,30 +2035,42 @@ func (s *Server) RemoveLabelLink(ctx context.Context, req *pb.RemoveLabelLinkReq
 var ErrInvalidRequest = errors.New("invalid request")
 
 func (s *Server) CreateToken(ctx context.Context, req *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
-	caller, err := s.checkMgmtAllowed(ctx)
+	caller, err := s.accountCallerFrom(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !caller.AllowAccount(req.Account.Namespace) {
-		return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested")
+	req.Account, err = s.resolveAccount(caller, req.Account, req.ExternalId)
+	if err != nil {
+		return nil, err
 	}
 
-	// If the caller is requesting access capability, make sure it's under the callers namespace
+	// If the caller is requesting access capability, make sure it's under the
+	// callers namespace. Account keys can't grant access to a namespace.
 	for _, cb := range req.Capabilities {
 		if cb.Capability == pb.ACCESS {
-			if !caller.AllowAccount(cb.Value) {
+			if caller.mgmt == nil || !caller.mgmt.AllowAccount(cb.Value) {
 				return nil, errors.Wrapf(ErrInvalidRequest, "invalid namespace requested in access capability")
 			}
 		}
 	}
 
+	err = s.checkTokenRate(ctx, "agent", req.Account.SpecString())
+	if err != nil {
+		return nil, err
+	}
+
 	var dur time.Duration
 
 	if req.ValidDuration != nil {
 		dur = req.ValidDuration.ToDuration()
 	}
 
+	dur, err = s.tokenValidDuration("agent", dur)
+	if err != nil {
+		return nil, err
+	}
+
 	var ao Account
 	ao.ID = req.Account.Key()
 	ao.Namespace = req.Account.Namespace
@@ -1221,7 +2090,7 @@ func (s *Server) CreateToken(ctx context.Context, req *pb.CreateTokenRequest) (*
 	tc.RawCapabilities = req.Capabilities
 	tc.ValidDuration = dur
 
-	token, err := tc.EncodeED25519WithVault(s.vaultClient, s.vaultPath, s.keyId)
+	token, err := s.encodeToken(&tc)
 	if err != nil {
 		return nil, err
 	}
@@ -1253,14 +2122,14 @@ func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 
 	if len(req.Marker) > 0 {
 		err = dbx.Check(
-			s.db.Where("id > ?", req.Marker).
+			s.listDB(ctx, req.ConsistentRead).Where("id > ?", req.Marker).
 				Where("namespace = ? OR starts_with(namespace, ?)", ns, ns+"/").
 				Limit(limit).Order("id ASC").
 				Find(&accounts),
 		)
 	} else {
 		err = dbx.Check(
-			s.db.
+			s.listDB(ctx, req.ConsistentRead).
 				Where("namespace = ? OR starts_with(namespace, ?)", ns, ns+"/").
 				Limit(limit).Order("id ASC").
 				Find(&accounts),
@@ -1280,22 +2149,30 @@ func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 
 	resp.NextMarker = accounts[len(accounts)-1].ID
 
-	for _, acc := range accounts {
-		acc, err := pb.AccountFromKey(acc.ID)
+	ie := itemErrors{bestEffort: req.BestEffort}
+
+	for _, ao := range accounts {
+		acc, err := pb.AccountFromKey(ao.ID)
 		if err != nil {
-			return nil, err
+			if err := ie.add(hex.EncodeToString(ao.ID), err); err != nil {
+				return nil, err
+			}
+
+			continue
 		}
 
 		resp.Accounts = append(resp.Accounts, acc)
 	}
 
+	resp.Errors = ie.errs
+
 	return &resp, nil
 }
 
 func (s *Server) AllHubs(ctx context.Context, _ *pb.Noop) (*pb.ListOfHubs, error) {
 	var hubs []*Hub
 
-	err := dbx.Check(s.db.Find(&hubs))
+	err := dbx.Check(s.db.Order("stable_id ASC").Find(&hubs))
 	if err != nil {
 		return nil, err
 	}
@@ -1310,12 +2187,22 @@ func (s *Server) AllHubs(ctx context.Context, _ *pb.Noop) (*pb.ListOfHubs, error
 			return nil, err
 		}
 
+		flows, _ := s.hubActiveFlows(h)
+
 		out.Hubs = append(out.Hubs, &pb.HubInfo{
-			Id:        pb.ULIDFromBytes(h.InstanceID),
-			Locations: locs,
+			Id:           pb.ULIDFromBytes(h.InstanceID),
+			Locations:    locs,
+			StableId:     h.StableIdULID(),
+			ActiveFlows:  flows,
+			MaxFlows:     s.hubMaxFlows(h),
+			Capabilities: h.Capabilities,
+
+			ProtocolVersion: h.ProtocolVersion,
 		})
 	}
 
+	out.ProtocolVersions = protocolVersionCounts(out.Hubs)
+
 	return &out, nil
 }
 
@@ -1325,6 +2212,11 @@ func (s *Server) RequestServiceToken(ctx context.Context, req *pb.ServiceTokenRe
 		return nil, err
 	}
 
+	err = s.checkTokenRate(ctx, "service", req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	var tc token.TokenCreator
 	tc.AccountId = pb.InternalAccount
 	tc.AccuntNamespace = req.Namespace
@@ -1338,7 +2230,12 @@ func (s *Server) RequestServiceToken(ctx context.Context, req *pb.ServiceTokenRe
 		},
 	}
 
-	token, err := tc.EncodeED25519WithVault(s.vaultClient, s.vaultPath, s.keyId)
+	tc.ValidDuration, err = s.tokenValidDuration("service", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.encodeToken(&tc)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package control
 
 import (
+	"context"
 	"encoding/json"
 	fmt "fmt"
 	"net"
@@ -12,47 +13,147 @@ import (
 	"github.com/hashicorp/horizon/pkg/pb"
 )
 
+// GetAllNetworkLocations returns the locations to advertise to any agent.
+// With no request whose context could bound the wait, the assignment is
+// made once rather than retried, and no hubs registered is an empty list.
 func (s *Server) GetAllNetworkLocations() ([]*pb.NetworkLocation, error) {
-	var hubs []*Hub
+	return networkLocations(s.assignHubs(&AssignmentRequest{}))
+}
+
+// GetNetworkLocationsFor returns the locations to advertise to the agent
+// making req, as ordered by the configured AssignmentStrategy. Agents are
+// told to keep that order unless the strategy is AssignAll, which leaves
+// the choice to them.
+func (s *Server) GetNetworkLocationsFor(req *http.Request) ([]*pb.NetworkLocation, bool, error) {
+	ar := s.assignmentRequest(req)
 
-	err := dbx.Check(s.db.Find(&hubs))
+	locs, err := s.assignNetworkLocations(req.Context(), ar)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return locs, s.strategyFor(ar).Name() != AssignAll, nil
+}
+
+func (s *Server) assignNetworkLocations(ctx context.Context, ar *AssignmentRequest) ([]*pb.NetworkLocation, error) {
+	return networkLocations(s.assignHubsWithRetry(ctx, ar))
+}
+
+// The locations of candidates, counting the assignment of the first.
+func networkLocations(strategy AssignmentStrategy, candidates []*HubCandidate, err error) ([]*pb.NetworkLocation, error) {
 	if err != nil {
 		return nil, err
 	}
 
+	if len(candidates) > 0 {
+		hubAssignments.WithLabelValues(strategy.Name()).Inc()
+	}
+
 	var locs []*pb.NetworkLocation
 
+	for _, c := range candidates {
+		locs = append(locs, c.Locations...)
+	}
+
+	return locs, nil
+}
+
+// The hubs to advertise for ar, most preferred first, and the strategy that
+// ordered them.
+func (s *Server) assignHubs(ar *AssignmentRequest) (AssignmentStrategy, []*HubCandidate, error) {
+	var hubs []*Hub
+
+	// A stable order keeps the strategies that preserve it, and the
+	// responses of ones that don't reorder, the same between requests.
+	err := dbx.Check(s.db.Order("stable_id ASC").Find(&hubs))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		candidates   []*HubCandidate
+		full         int
+		incompatible int
+	)
+
 	for _, h := range hubs {
+		// Spill over to the other hubs by not advertising full ones.
+		switch s.hubUnavailable(h) {
+		case "":
+		case "full":
+			full++
+			continue
+		default:
+			continue
+		}
+
 		var hl []*pb.NetworkLocation
 
 		err = json.Unmarshal(h.ConnectionInfo, &hl)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		for _, loc := range hl {
 			loc.Name = h.StableIdULID().String() + "." + s.hubDomain
 		}
 
-		locs = append(locs, hl...)
+		flows, _ := s.hubActiveFlows(h)
+
+		hc := &HubCandidate{
+			StableID:     h.StableIdULID(),
+			Locations:    hl,
+			ActiveFlows:  flows,
+			MaxFlows:     s.hubMaxFlows(h),
+			ASNs:         s.locationASNs(hl),
+			Capabilities: h.Capabilities,
+		}
+
+		// Hubs that can't handle what the agent needs are never
+		// advertised, whatever the strategy.
+		if !hc.Supports(ar.Capabilities) {
+			incompatible++
+			continue
+		}
+
+		candidates = append(candidates, hc)
 	}
 
-	return locs, nil
+	if len(candidates) == 0 && full > 0 {
+		return nil, nil, discovery.ErrNoCapacity
+	}
+
+	if len(candidates) == 0 && incompatible > 0 {
+		return nil, nil, discovery.ErrNoCompatibleHub
+	}
+
+	strategy := s.strategyFor(ar)
+
+	return strategy, strategy.Order(ar, candidates), nil
 }
 
 func (s *Server) setupRoutes() {
 	s.mux.HandleFunc("/healthz", s.httpHealthz)
+	s.mux.HandleFunc("/ready", s.httpReady)
+	s.mux.HandleFunc("/info", s.httpInfo)
 	s.mux.HandleFunc("/ip-info", s.httpIPInfo)
 	s.mux.HandleFunc("/ulid", s.genUlid)
 
 	var wk discovery.WellKnown
+	wk.L = s.L
 	wk.GetNetlocs = s
 
 	s.mux.Handle(discovery.HTTPPath, &wk)
+
+	s.mux.Handle("/debug/hub-affinity", s.mgmtACLHandler(http.HandlerFunc(s.httpHubAffinity)))
+
+	if s.config().EnablePprof {
+		s.mux.Handle("/debug/pprof/", s.mgmtACLHandler(PprofHandler(s.opsToken)))
+	}
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	s.mux.ServeHTTP(w, req)
+	s.httpHandler.ServeHTTP(w, req)
 }
 
 func (s *Server) httpHealthz(w http.ResponseWriter, req *http.Request) {
@@ -126,11 +227,9 @@ func (s *Server) httpIPInfo(w http.ResponseWriter, req *http.Request) {
 	var info ipInfo
 	info.IP = ip.String()
 
-	if s.asnDB != nil {
-		if asnInfo, err := s.asnDB.ASN(ip); err == nil {
-			info.ASN = fmt.Sprintf("AS%d", asnInfo.AutonomousSystemNumber)
-			info.ASNOrg = asnInfo.AutonomousSystemOrganization
-		}
+	if asnInfo, err := s.lookupASN(ip); err == nil {
+		info.ASN = fmt.Sprintf("AS%d", asnInfo.AutonomousSystemNumber)
+		info.ASNOrg = asnInfo.AutonomousSystemOrganization
 	}
 
 	json.NewEncoder(w).Encode(&info)
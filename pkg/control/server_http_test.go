@@ -1,14 +1,26 @@
 package control
 
 import (
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/api"
 	"github.com/oschwald/geoip2-golang"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,4 +59,299 @@ func TestServerHTTP(t *testing.T) {
 		assert.Equal(t, "AS13335", info.ASN)
 		assert.Equal(t, "CLOUDFLARENET", info.ASNOrg)
 	})
+
+	t.Run("compresses responses for clients accepting gzip", func(t *testing.T) {
+		h := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Write([]byte("hello horizon"))
+		}), 0)
+
+		req, err := http.NewRequest("GET", "/", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "hello horizon", w.Body.String())
+
+		req.Header.Set("Accept-Encoding", "deflate, gzip;q=0.8")
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+
+		r, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+
+		body, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, "hello horizon", string(body))
+
+		req.Header.Set("Accept-Encoding", "gzip;q=0")
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+	})
+
+	t.Run("leaves small, empty and HEAD responses uncompressed", func(t *testing.T) {
+		h := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/empty":
+				w.WriteHeader(http.StatusNoContent)
+			case "/cached":
+				w.WriteHeader(http.StatusNotModified)
+			case "/small":
+				w.Write([]byte("hello"))
+			default:
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(strings.Repeat("hello horizon ", 10)))
+			}
+		}), 64)
+
+		serve := func(method, path string) *httptest.ResponseRecorder {
+			req, err := http.NewRequest(method, path, nil)
+			require.NoError(t, err)
+
+			req.Header.Set("Accept-Encoding", "gzip")
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			return w
+		}
+
+		w := serve("GET", "/small")
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "hello", w.Body.String())
+
+		w = serve("GET", "/empty")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+
+		w = serve("GET", "/cached")
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+
+		w = serve("HEAD", "/large")
+		assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+
+		w = serve("GET", "/large")
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+
+		r, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+
+		body, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Repeat("hello horizon ", 10), string(body))
+	})
+
+	t.Run("only serves pprof to requests with the ops token", func(t *testing.T) {
+		h := PprofHandler("opsrocks")
+
+		req, err := http.NewRequest("GET", "/debug/pprof/", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req.Header.Set("Authorization", "opsrocks")
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("rejects request bodies over the size limit", func(t *testing.T) {
+		var read []byte
+
+		h := maxBodyHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var err error
+
+			read, err = ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			w.WriteHeader(http.StatusOK)
+		}), 8)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader("small")))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader("much too large")))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		// Bodies without a declared length are rejected the same way, and
+		// passed on intact when within the limit.
+		req := httptest.NewRequest("POST", "/", strings.NewReader("much too large"))
+		req.ContentLength = -1
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		req = httptest.NewRequest("POST", "/", strings.NewReader("chunked"))
+		req.ContentLength = -1
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "chunked", string(read))
+	})
+
+	t.Run("reports not ready when a readiness check fails", func(t *testing.T) {
+		s := Server{L: hclog.L()}
+
+		var vaultErr error
+
+		s.AddReadinessCheck("vault", func(ctx context.Context) error {
+			return vaultErr
+		})
+
+		w := httptest.NewRecorder()
+		s.httpReady(w, httptest.NewRequest("GET", "/ready", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		vaultErr = errors.New("permission denied")
+
+		w = httptest.NewRecorder()
+		s.httpReady(w, httptest.NewRequest("GET", "/ready", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), "permission denied")
+	})
+
+	t.Run("caches the vault readiness result", func(t *testing.T) {
+		var calls int
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		vc, err := api.NewClient(&api.Config{Address: srv.URL})
+		require.NoError(t, err)
+
+		vc.SetToken("expired")
+
+		check := VaultReadinessCheck(vc, time.Minute)
+
+		require.Error(t, check(context.Background()))
+		require.Error(t, check(context.Background()))
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("doesn't cache a vault check the caller gave up on", func(t *testing.T) {
+		var calls int32
+
+		release := make(chan struct{})
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				<-release
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data":{}}`))
+		}))
+		defer srv.Close()
+		defer close(release)
+
+		vc, err := api.NewClient(&api.Config{Address: srv.URL})
+		require.NoError(t, err)
+
+		vc.SetToken("token")
+
+		check := VaultReadinessCheck(vc, time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.Error(t, check(ctx))
+
+		require.NoError(t, check(context.Background()))
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("limits the connections accepted at once", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.cfg.MaxConns = 1
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		li := s.LimitListener(ln)
+		defer li.Close()
+
+		accepted := make(chan net.Conn, 2)
+
+		go func() {
+			for {
+				c, err := li.Accept()
+				if err != nil {
+					return
+				}
+
+				accepted <- c
+			}
+		}()
+
+		c, err := net.Dial("tcp", ln.Addr().String())
+		require.NoError(t, err)
+
+		defer c.Close()
+
+		first := <-accepted
+
+		// Connections over the limit are closed rather than left waiting.
+		over, err := net.Dial("tcp", ln.Addr().String())
+		require.NoError(t, err)
+
+		defer over.Close()
+
+		over.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+		_, err = over.Read(make([]byte, 1))
+		assert.Equal(t, io.EOF, err)
+
+		select {
+		case <-accepted:
+			t.Fatal("accepted a connection over the limit")
+		default:
+		}
+
+		require.NoError(t, first.Close())
+
+		c, err = net.Dial("tcp", ln.Addr().String())
+		require.NoError(t, err)
+
+		defer c.Close()
+
+		select {
+		case c := <-accepted:
+			c.Close()
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection not accepted once below the limit")
+		}
+	})
 }
@@ -2,8 +2,11 @@ package control
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -19,7 +22,9 @@ import (
 	"github.com/hashicorp/horizon/pkg/token"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type staticServerStream struct {
@@ -670,6 +675,199 @@ func TestServer(t *testing.T) {
 		require.Equal(t, 0, len(lls2.LabelLinks))
 	})
 
+	t.Run("can refer to accounts by external id", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		accountId := pb.NewULID()
+
+		added, err := s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: accountId,
+				Namespace: "/",
+			},
+			Limits:     &pb.Account_Limits{},
+			ExternalId: "tenant-1",
+		})
+		require.NoError(t, err)
+
+		assert.True(t, added.Created)
+
+		// External ids are unique within the namespace, so another account
+		// can't take one that's in use.
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: pb.NewULID(),
+				Namespace: "/",
+			},
+			Limits:     &pb.Account_Limits{},
+			ExternalId: "tenant-1",
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		// But retrying the original request returns the account.
+		added, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: accountId,
+				Namespace: "/",
+			},
+			Limits:     &pb.Account_Limits{},
+			ExternalId: "tenant-1",
+		})
+		require.NoError(t, err)
+
+		assert.False(t, added.Created)
+		assert.Equal(t, accountId, added.Account.AccountId)
+
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: pb.NewULID(),
+				Namespace: "/",
+			},
+			Limits:     &pb.Account_Limits{},
+			ExternalId: "not a valid id!",
+		})
+		require.Error(t, err)
+
+		resp, err := s.LookupAccount(ctx, &pb.LookupAccountRequest{
+			Namespace:  "/",
+			ExternalId: "tenant-1",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, accountId, resp.Account.AccountId)
+
+		_, err = s.LookupAccount(ctx, &pb.LookupAccountRequest{
+			Namespace:  "/",
+			ExternalId: "tenant-2",
+		})
+		require.Error(t, err)
+
+		_, err = s.CreateToken(ctx, &pb.CreateTokenRequest{
+			Account:    &pb.Account{Namespace: "/"},
+			ExternalId: "tenant-1",
+		})
+		require.NoError(t, err)
+
+		// A mismatched account id and external id is rejected
+		_, err = s.CreateToken(ctx, &pb.CreateTokenRequest{
+			Account: &pb.Account{
+				AccountId: pb.NewULID(),
+				Namespace: "/",
+			},
+			ExternalId: "tenant-1",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("returns the existing account when account creation is retried", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		accountId := pb.NewULID()
+
+		req := &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: accountId,
+				Namespace: "/",
+			},
+			Limits:         &pb.Account_Limits{},
+			IdempotencyKey: "req-1",
+		}
+
+		added, err := s.AddAccount(ctx, req)
+		require.NoError(t, err)
+
+		assert.True(t, added.Created)
+
+		// The same request again
+		added, err = s.AddAccount(ctx, req)
+		require.NoError(t, err)
+
+		assert.False(t, added.Created)
+		assert.Equal(t, accountId, added.Account.AccountId)
+
+		// A retry that generated a new account id
+		added, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: &pb.Account{
+				AccountId: pb.NewULID(),
+				Namespace: "/",
+			},
+			Limits:         &pb.Account_Limits{},
+			IdempotencyKey: "req-1",
+		})
+		require.NoError(t, err)
+
+		assert.False(t, added.Created)
+		assert.Equal(t, accountId, added.Account.AccountId)
+
+		var count int
+		require.NoError(t, dbx.Check(db.Model(&Account{}).Count(&count)))
+		assert.Equal(t, 1, count)
+	})
+
 	t.Run("can create and remove a service for an account", func(t *testing.T) {
 		db := testsql.TestPostgresDB(t, "hzn")
 		defer db.Close()
@@ -1128,4 +1326,1251 @@ func TestServer(t *testing.T) {
 		assert.InDelta(t, 6*time.Second, time.Since(ts), float64(time.Second))
 	})
 
+	t.Run("manages per account feature flags", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		account := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: account,
+			Limits:  &pb.Account_Limits{},
+		})
+		require.NoError(t, err)
+
+		ok, err := s.AccountHasFeature(account, "new-assignment")
+		require.NoError(t, err)
+
+		assert.False(t, ok)
+
+		_, err = s.SetAccountFeature(ctx, &pb.SetAccountFeatureRequest{
+			Account: account,
+			Name:    "new-assignment",
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		ok, err = s.AccountHasFeature(account, "new-assignment")
+		require.NoError(t, err)
+
+		assert.True(t, ok)
+
+		_, err = s.SetAccountFeature(ctx, &pb.SetAccountFeatureRequest{
+			Account: account,
+			Name:    "new-assignment",
+			Enabled: false,
+		})
+		require.NoError(t, err)
+
+		ok, err = s.AccountHasFeature(account, "new-assignment")
+		require.NoError(t, err)
+
+		assert.False(t, ok)
+
+		resp, err := s.GetAccountFeatures(ctx, &pb.GetAccountFeaturesRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Features, 1)
+		assert.Equal(t, "new-assignment", resp.Features[0].Name)
+		assert.False(t, resp.Features[0].Enabled)
+
+		_, err = s.SetAccountFeature(ctx, &pb.SetAccountFeatureRequest{
+			Account: account,
+			Name:    "Not Valid",
+			Enabled: true,
+		})
+		require.Error(t, err)
+
+		_, err = s.SetAccountFeature(ctx, &pb.SetAccountFeatureRequest{
+			Account: &pb.Account{AccountId: pb.NewULID(), Namespace: "/"},
+			Name:    "new-assignment",
+			Enabled: true,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("manages account scoped api keys", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		account := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		other := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		for _, a := range []*pb.Account{account, other} {
+			_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+				Account: a,
+				Limits:  &pb.Account_Limits{},
+			})
+			require.NoError(t, err)
+		}
+
+		created, err := s.CreateAccountKey(ctx, &pb.CreateAccountKeyRequest{
+			Account: account,
+			Name:    "ci",
+		})
+		require.NoError(t, err)
+
+		require.NotEmpty(t, created.Secret)
+
+		md3 := make(metadata.MD)
+		md3.Set("authorization", created.Secret)
+
+		keyCtx := metadata.NewIncomingContext(top, md3)
+
+		require.NoError(t, s.authorizeMethod(keyCtx, "/pb.ControlManagement/ListAccountKeys"))
+
+		// The key can manage its own account.
+		list, err := s.ListAccountKeys(keyCtx, &pb.ListAccountKeysRequest{
+			Account: &pb.Account{AccountId: account.AccountId},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, list.Keys, 1)
+		assert.Equal(t, "ci", list.Keys[0].Name)
+		assert.True(t, created.Key.Id.Equal(list.Keys[0].Id))
+		assert.NotNil(t, list.Keys[0].LastUsedAt)
+
+		// But not other accounts, nor the operator level RPCs.
+		_, err = s.ListAccountKeys(keyCtx, &pb.ListAccountKeysRequest{
+			Account: other,
+		})
+		require.Error(t, err)
+
+		require.Error(t, s.authorizeMethod(keyCtx, "/pb.ControlManagement/AddAccount"))
+
+		// The account scoped RPCs accept the key too, and the changes it
+		// makes are audited as made by the key.
+		authCtx, err := s.authorize(keyCtx, "/pb.ControlManagement/SetAccountDefaultLabels")
+		require.NoError(t, err)
+
+		_, err = s.SetAccountDefaultLabels(authCtx, &pb.SetAccountDefaultLabelsRequest{
+			Account: &pb.Account{AccountId: account.AccountId},
+			Labels:  pb.ParseLabelSet("env=test"),
+		})
+		require.NoError(t, err)
+
+		var rec AuditLog
+		require.NoError(t, dbx.Check(db.Where("action = ?", "set-account-default-labels").First(&rec)))
+
+		assert.Equal(t, created.Key.Id.Bytes(), rec.ActorID)
+
+		_, err = s.SetAccountDefaultLabels(keyCtx, &pb.SetAccountDefaultLabelsRequest{
+			Account: other,
+			Labels:  pb.ParseLabelSet("env=test"),
+		})
+		require.Error(t, err)
+
+		_, err = s.CreateToken(keyCtx, &pb.CreateTokenRequest{
+			Account: &pb.Account{AccountId: account.AccountId},
+			Capabilities: []pb.TokenCapability{
+				{Capability: pb.ACCESS, Value: "/"},
+			},
+		})
+		require.Error(t, err)
+
+		_, err = s.RevokeAccountKey(ctx, &pb.RevokeAccountKeyRequest{
+			Account: other,
+			KeyId:   created.Key.Id,
+		})
+		require.Error(t, err)
+
+		_, err = s.RevokeAccountKey(ctx, &pb.RevokeAccountKeyRequest{
+			Account: account,
+			KeyId:   created.Key.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = s.ListAccountKeys(keyCtx, &pb.ListAccountKeysRequest{
+			Account: account,
+		})
+		require.Error(t, err)
+
+		list, err = s.ListAccountKeys(ctx, &pb.ListAccountKeysRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, list.Keys, 1)
+		assert.NotNil(t, list.Keys[0].RevokedAt)
+
+		// A key with the right id but the wrong secret is rejected.
+		parts := strings.Split(created.Secret, ".")
+		forged := strings.Join([]string{parts[0], parts[1], strings.Repeat("00", secretKeySize)}, ".")
+
+		_, _, err = s.checkAccountKey(forged)
+		require.Error(t, err)
+	})
+
+	t.Run("errors or updates on a duplicate label link", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+		s.awsSess = sess
+		s.bucket = bucket
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		account := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: account,
+			Limits:  &pb.Account_Limits{},
+		})
+		require.NoError(t, err)
+
+		label := pb.ParseLabelSet(":hostname=foo.com")
+
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:  label,
+			Account: account,
+			Target:  pb.ParseLabelSet("service=emp,env=test"),
+		})
+		require.NoError(t, err)
+
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:  label,
+			Account: account,
+			Target:  pb.ParseLabelSet("service=emp,env=prod"),
+		})
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, ErrInvalidRequest))
+
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:     label,
+			Account:    account,
+			Target:     pb.ParseLabelSet("service=emp,env=prod"),
+			OnConflict: pb.LINK_CONFLICT_UPDATE,
+		})
+		require.NoError(t, err)
+
+		var lls []*LabelLink
+
+		err = dbx.Check(db.Where("account_id = ?", account.Key()).Find(&lls))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(lls))
+
+		assert.Equal(t, FlattenLabels(pb.ParseLabelSet("service=emp,env=prod")), lls[0].Target)
+
+		// Still invalid once normalized, spaces are only trimmed from the
+		// ends.
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:  pb.ParseLabelSet(":hostname=bar.com"),
+			Account: account,
+			Target: &pb.LabelSet{
+				Labels: []*pb.Label{{Name: "service", Value: "e mp"}},
+			},
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Case and surrounding spaces are normalized rather than rejected.
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:  pb.ParseLabelSet(":hostname=bar.com"),
+			Account: account,
+			Target: &pb.LabelSet{
+				Labels: []*pb.Label{{Name: " Service", Value: "EMP "}},
+			},
+		})
+		require.NoError(t, err)
+
+		var normalized LabelLink
+
+		err = dbx.Check(db.Where("account_id = ? AND labels = ?",
+			account.Key(), FlattenLabels(pb.ParseLabelSet(":hostname=bar.com"))).First(&normalized))
+		require.NoError(t, err)
+
+		assert.Equal(t, "service=emp", normalized.Target)
+
+		// Weights the database can't hold are rejected up front.
+		_, err = s.AddLabelLink(ctx, &pb.AddLabelLinkRequest{
+			Labels:   pb.ParseLabelSet(":hostname=heavy.com"),
+			Account:  account,
+			Target:   pb.ParseLabelSet("service=emp"),
+			Weighted: true,
+			Weight:   MaxLabelLinkWeight + 1,
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Give the background s3 update a chance to finish before the
+		// database goes away.
+		time.Sleep(2 * time.Second)
+	})
+
+	t.Run("authenticates hubs with revocable hub credentials", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+		s.opsToken = "ddeeff"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		withAuth := func(auth string) context.Context {
+			md := make(metadata.MD)
+			md.Set("authorization", auth)
+
+			return metadata.NewIncomingContext(top, md)
+		}
+
+		resp, err := s.IssueHubCredential(withAuth("aabbcc"), &pb.IssueHubCredentialRequest{
+			Name: "hub-1",
+		})
+		require.NoError(t, err)
+
+		hubCtx := withAuth(resp.Secret)
+
+		_, err = s.checkFromHub(hubCtx, "test")
+		require.NoError(t, err)
+
+		require.NoError(t, s.authorizeMethod(hubCtx, "/pb.ControlServices/FetchConfig"))
+
+		// Hub credentials don't grant management access.
+		require.Error(t, s.authorizeMethod(hubCtx, "/pb.ControlManagement/AddAccount"))
+
+		list, err := s.ListHubCredentials(withAuth("ddeeff"), &pb.Noop{})
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(list.Credentials))
+
+		assert.Equal(t, "hub-1", list.Credentials[0].Name)
+		assert.NotNil(t, list.Credentials[0].LastUsedAt)
+
+		ht, err := s.IssueHubToken(withAuth("aabbcc"), &pb.Noop{})
+		require.NoError(t, err)
+
+		_, err = s.checkFromHub(withAuth(ht.Token), "test")
+		require.NoError(t, err)
+
+		s.cfg.RequireHubCredentials = true
+
+		_, err = s.checkFromHub(withAuth(ht.Token), "test")
+		require.Error(t, err)
+
+		require.Error(t, s.authorizeMethod(withAuth(ht.Token), "/pb.ControlServices/FetchConfig"))
+
+		_, err = s.checkFromHub(hubCtx, "test")
+		require.NoError(t, err)
+
+		_, err = s.RevokeHubCredential(withAuth("ddeeff"), &pb.RevokeHubCredentialRequest{
+			CredentialId: resp.Credential.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = s.checkFromHub(hubCtx, "test")
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, ErrBadAuthentication))
+	})
+
+	t.Run("binds hub credentials to a single hub", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		top := context.Background()
+
+		withAuth := func(auth string) context.Context {
+			md := make(metadata.MD)
+			md.Set("authorization", auth)
+
+			return metadata.NewIncomingContext(top, md)
+		}
+
+		hub1 := pb.NewULID()
+		hub2 := pb.NewULID()
+
+		resp, err := s.IssueHubCredential(withAuth("aabbcc"), &pb.IssueHubCredentialRequest{
+			Name: "hub-1",
+		})
+		require.NoError(t, err)
+
+		// The first hub to present it claims it.
+		require.NoError(t, s.checkHubCredentialBinding(withAuth(resp.Secret), hub1))
+		require.NoError(t, s.checkHubCredentialBinding(withAuth(resp.Secret), hub1))
+
+		err = s.checkHubCredentialBinding(withAuth(resp.Secret), hub2)
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, ErrBadAuthentication))
+
+		resp, err = s.IssueHubCredential(withAuth("aabbcc"), &pb.IssueHubCredentialRequest{
+			Name:  "hub-2",
+			HubId: hub2,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, resp.Credential.HubId.Equal(hub2))
+
+		require.Error(t, s.checkHubCredentialBinding(withAuth(resp.Secret), hub1))
+		require.NoError(t, s.checkHubCredentialBinding(withAuth(resp.Secret), hub2))
+	})
+
+	t.Run("closes the streams of revoked hub credentials", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.registerToken = "aabbcc"
+		s.opsToken = "ddeeff"
+		s.connectedHubs = make(map[string]*connectedHub)
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		top := context.Background()
+
+		withAuth := func(auth string) context.Context {
+			md := make(metadata.MD)
+			md.Set("authorization", auth)
+
+			return metadata.NewIncomingContext(top, md)
+		}
+
+		resp, err := s.IssueHubCredential(withAuth("aabbcc"), &pb.IssueHubCredentialRequest{
+			Name: "hub-1",
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(top)
+		defer cancel()
+
+		other, otherCancel := context.WithCancel(top)
+		defer otherCancel()
+
+		s.connectedHubs["a"] = &connectedHub{credentialId: resp.Credential.Id.Bytes(), cancel: cancel}
+		s.connectedHubs["b"] = &connectedHub{cancel: otherCancel}
+
+		_, err = s.RevokeHubCredential(withAuth("ddeeff"), &pb.RevokeHubCredentialRequest{
+			CredentialId: resp.Credential.Id,
+		})
+		require.NoError(t, err)
+
+		assert.Error(t, ctx.Err())
+		assert.NoError(t, other.Err())
+
+		revoked, err := s.hubCredentialRevoked(resp.Credential.Id.Bytes())
+		require.NoError(t, err)
+
+		assert.True(t, revoked)
+	})
+
+	t.Run("enforces the maximum token lifetime", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+		s.cfg.MaxTokenTTL = 24 * time.Hour
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		mt, err := token.CheckTokenED25519(ct.Token, pub)
+		require.NoError(t, err)
+
+		require.NotNil(t, mt.Body.ValidUntil)
+		assert.True(t, mt.Body.ValidUntil.Time().Before(time.Now().Add(25*time.Hour)))
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		mctx := metadata.NewIncomingContext(top, md2)
+
+		createToken := func(dur time.Duration) (*pb.CreateTokenResponse, error) {
+			req := &pb.CreateTokenRequest{
+				Account: &pb.Account{
+					Namespace: "/",
+					AccountId: pb.NewULID(),
+				},
+				Capabilities: []pb.TokenCapability{
+					{
+						Capability: pb.SERVE,
+					},
+				},
+			}
+
+			if dur != 0 {
+				req.ValidDuration = pb.TimestampFromDuration(dur)
+			}
+
+			return s.CreateToken(mctx, req)
+		}
+
+		for _, dur := range []time.Duration{0, 6 * time.Hour, 90 * 24 * time.Hour} {
+			ctr, err := createToken(dur)
+			require.NoError(t, err)
+
+			ht, err := token.CheckTokenED25519(ctr.Token, pub)
+			require.NoError(t, err)
+
+			require.NotNil(t, ht.Body.ValidUntil)
+
+			until := ht.Body.ValidUntil.Time()
+			assert.True(t, until.Before(time.Now().Add(25*time.Hour)))
+
+			if dur == 6*time.Hour {
+				assert.True(t, until.Before(time.Now().Add(7*time.Hour)))
+			}
+		}
+
+		_, err = createToken(-time.Hour)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidRequest))
+		assert.Contains(t, err.Error(), "negative")
+
+		s.cfg.RejectLongTokenTTL = true
+
+		_, err = createToken(90 * 24 * time.Hour)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidRequest))
+		assert.Contains(t, err.Error(), "exceeds the maximum")
+
+		_, err = createToken(6 * time.Hour)
+		require.NoError(t, err)
+	})
+
+	t.Run("lists services and hubs in a stable order", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		account := &pb.Account{
+			Namespace: "/",
+			AccountId: pb.NewULID(),
+		}
+
+		err := dbx.Check(db.Create(&Account{ID: account.Key(), Namespace: "/"}))
+		require.NoError(t, err)
+
+		var serviceIds []*pb.ULID
+
+		for i := 0; i < 3; i++ {
+			id := pb.NewULID()
+			serviceIds = append(serviceIds, id)
+
+			err = dbx.Check(db.Create(&Service{
+				ServiceId: id.Bytes(),
+				HubId:     pb.NewULID().Bytes(),
+				AccountId: account.Key(),
+				Type:      "test",
+			}))
+			require.NoError(t, err)
+		}
+
+		hubIds := []*pb.ULID{pb.NewULID(), pb.NewULID(), pb.NewULID()}
+
+		sort.Slice(hubIds, func(i, j int) bool {
+			return hubIds[i].SpecString() < hubIds[j].SpecString()
+		})
+
+		// Stored in the reverse of the order they're listed in.
+		for i := len(hubIds) - 1; i >= 0; i-- {
+			err = dbx.Check(db.Create(&Hub{
+				StableID:       hubIds[i].Bytes(),
+				InstanceID:     pb.NewULID().Bytes(),
+				ConnectionInfo: []byte("[]"),
+				LastCheckin:    time.Now(),
+			}))
+			require.NoError(t, err)
+		}
+
+		for i := 0; i < 3; i++ {
+			resp, err := s.ListServices(context.Background(), &pb.ListServicesRequest{Account: account})
+			require.NoError(t, err)
+			require.Len(t, resp.Services, len(serviceIds))
+
+			for j, svc := range resp.Services {
+				assert.Equal(t, serviceIds[j], svc.Id)
+			}
+
+			hubs, err := s.AllHubs(context.Background(), &pb.Noop{})
+			require.NoError(t, err)
+			require.Len(t, hubs.Hubs, len(hubIds))
+
+			for j, h := range hubs.Hubs {
+				assert.Equal(t, hubIds[j], h.StableId)
+			}
+		}
+	})
+
+	t.Run("skips undecodable records when listing best effort", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/foo",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		mgmtCtx := metadata.NewIncomingContext(top, md2)
+
+		accountId := pb.NewULID()
+
+		_, err = s.CreateToken(mgmtCtx, &pb.CreateTokenRequest{
+			Account: &pb.Account{
+				Namespace: "/foo",
+				AccountId: accountId,
+			},
+		})
+		require.NoError(t, err)
+
+		// A key without the namespace separator can't be decoded.
+		err = dbx.Check(db.Create(&Account{ID: []byte("corrupt"), Namespace: "/foo"}))
+		require.NoError(t, err)
+
+		_, err = s.ListAccounts(mgmtCtx, &pb.ListAccountsRequest{})
+		require.Error(t, err)
+
+		list, err := s.ListAccounts(mgmtCtx, &pb.ListAccountsRequest{BestEffort: true})
+		require.NoError(t, err)
+
+		require.Len(t, list.Accounts, 1)
+		assert.Equal(t, accountId, list.Accounts[0].AccountId)
+
+		require.Len(t, list.Errors, 1)
+		assert.Equal(t, hex.EncodeToString([]byte("corrupt")), list.Errors[0].Item)
+		assert.Equal(t, pb.ErrInvalidAccount.Error(), list.Errors[0].Error)
+	})
+
+	t.Run("merges account default labels into registered services", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+		s.awsSess = sess
+		s.bucket = bucket
+		s.lockMgr = &inmemLockMgr{}
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		rctx := metadata.NewIncomingContext(top, md)
+
+		ct, err := s.Register(rctx, &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		account := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: account,
+			Limits:  &pb.Account_Limits{},
+		})
+		require.NoError(t, err)
+
+		_, err = s.SetAccountDefaultLabels(ctx, &pb.SetAccountDefaultLabelsRequest{
+			Account: account,
+			Labels:  pb.ParseLabelSet("env=dev,team=core"),
+		})
+		require.NoError(t, err)
+
+		resp, err := s.GetAccountDefaultLabels(ctx, &pb.GetAccountDefaultLabelsRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "env=dev,team=core", resp.Labels.SpecString())
+
+		ctr, err := s.IssueHubToken(rctx, &pb.Noop{})
+		require.NoError(t, err)
+
+		md3 := make(metadata.MD)
+		md3.Set("authorization", ctr.Token)
+
+		_, err = s.AddService(
+			metadata.NewIncomingContext(top, md3),
+			&pb.ServiceRequest{
+				Account: account,
+				Hub:     pb.NewULID(),
+				Id:      pb.NewULID(),
+				Type:    "test",
+				Labels:  pb.ParseLabelSet("service=www,env=prod"),
+			},
+		)
+		require.NoError(t, err)
+
+		var so Service
+		require.NoError(t, dbx.Check(db.First(&so)))
+
+		var ls pb.LabelSet
+		require.NoError(t, ls.Scan(so.Labels))
+
+		assert.Equal(t, "env=prod,service=www,team=core", ls.SpecString())
+
+		_, err = s.SetAccountDefaultLabels(ctx, &pb.SetAccountDefaultLabelsRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		resp, err = s.GetAccountDefaultLabels(ctx, &pb.GetAccountDefaultLabelsRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, resp.Labels)
+	})
+
+	t.Run("manages account tls policies", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+		s.awsSess = sess
+		s.bucket = bucket
+		s.lockMgr = &inmemLockMgr{}
+		s.cfg.AgentTLSMinVersion = tls.VersionTLS12
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		account := &pb.Account{
+			AccountId: pb.NewULID(),
+			Namespace: "/",
+		}
+
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: account,
+			Limits:  &pb.Account_Limits{},
+		})
+		require.NoError(t, err)
+
+		policy := &pb.TLSPolicy{
+			MinVersion:        tls.VersionTLS13,
+			RequireClientCert: true,
+		}
+
+		// No agent could meet it without a CA to check certificates against.
+		_, err = s.SetAccountTLSPolicy(ctx, &pb.SetAccountTLSPolicyRequest{
+			Account: account,
+			Policy:  policy,
+		})
+		require.Error(t, err)
+
+		s.cfg.AgentClientCA = []byte("ca")
+
+		_, err = s.SetAccountTLSPolicy(ctx, &pb.SetAccountTLSPolicyRequest{
+			Account: account,
+			Policy:  &pb.TLSPolicy{MinVersion: 0x0305},
+		})
+		require.Error(t, err)
+
+		_, err = s.SetAccountTLSPolicy(ctx, &pb.SetAccountTLSPolicyRequest{
+			Account: account,
+			Policy:  policy,
+		})
+		require.NoError(t, err)
+
+		resp, err := s.GetAccountTLSPolicy(ctx, &pb.GetAccountTLSPolicyRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, policy, resp.Policy)
+		assert.Equal(t, policy, resp.Effective)
+
+		_, err = s.SetAccountTLSPolicy(ctx, &pb.SetAccountTLSPolicyRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		resp, err = s.GetAccountTLSPolicy(ctx, &pb.GetAccountTLSPolicyRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, resp.Policy)
+		assert.Equal(t, &pb.TLSPolicy{MinVersion: tls.VersionTLS12}, resp.Effective)
+	})
+
+	t.Run("rebuilds routing state that has drifted", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.opsToken = "ddeeff"
+		s.awsSess = sess
+		s.bucket = bucket
+		s.lockMgr = &inmemLockMgr{}
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		md := make(metadata.MD)
+		md.Set("authorization", "ddeeff")
+
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		account := &pb.Account{
+			Namespace: "/",
+			AccountId: pb.NewULID(),
+		}
+
+		err := dbx.Check(db.Create(&Account{ID: account.Key(), Namespace: "/"}))
+		require.NoError(t, err)
+
+		_, err = s.RebuildRoutingState(ctx, &pb.RebuildRoutingStateRequest{
+			Account: &pb.Account{Namespace: "/", AccountId: pb.NewULID()},
+		})
+		require.Error(t, err)
+
+		// Stored without publishing the account's routing, as after a
+		// partial failure.
+		serviceId := pb.NewULID()
+
+		err = dbx.Check(db.Create(&Service{
+			ServiceId: serviceId.Bytes(),
+			HubId:     pb.NewULID().Bytes(),
+			AccountId: account.Key(),
+			Type:      "test",
+			Labels:    pb.ParseLabelSet("env=test").AsStringArray(),
+		}))
+		require.NoError(t, err)
+
+		resp, err := s.RebuildRoutingState(ctx, &pb.RebuildRoutingStateRequest{
+			Account: account,
+			DryRun:  true,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, resp.DryRun)
+		assert.True(t, resp.Changed)
+		require.Len(t, resp.RoutesAdded, 1)
+
+		// A dry run publishes nothing, so the drift is still there.
+		resp, err = s.RebuildRoutingState(ctx, &pb.RebuildRoutingStateRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.False(t, resp.DryRun)
+		assert.True(t, resp.Changed)
+		require.Len(t, resp.RoutesAdded, 1)
+		assert.Equal(t, serviceId, resp.RoutesAdded[0].Id)
+		assert.Empty(t, resp.RoutesRemoved)
+
+		resp, err = s.RebuildRoutingState(ctx, &pb.RebuildRoutingStateRequest{
+			Account: account,
+		})
+		require.NoError(t, err)
+
+		assert.False(t, resp.Changed)
+		assert.Empty(t, resp.RoutesAdded)
+	})
+
+	t.Run("resolves account aliases", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = L
+		s.db = db
+		s.vaultClient = vc
+		s.vaultPath = pb.NewULID().SpecString()
+		s.keyId = "k1"
+		s.registerToken = "aabbcc"
+
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		pub, err := token.SetupVault(vc, s.vaultPath)
+		require.NoError(t, err)
+
+		s.pubKey = pub
+
+		top := context.Background()
+
+		md := make(metadata.MD)
+		md.Set("authorization", "aabbcc")
+
+		ct, err := s.Register(metadata.NewIncomingContext(top, md), &pb.ControlRegister{
+			Namespace: "/",
+		})
+		require.NoError(t, err)
+
+		md2 := make(metadata.MD)
+		md2.Set("authorization", ct.Token)
+
+		ctx := metadata.NewIncomingContext(top, md2)
+
+		// Requests are resolved in place, so each is given its own copy.
+		ref := func(a *pb.Account) *pb.Account {
+			return &pb.Account{Namespace: a.Namespace, AccountId: a.AccountId}
+		}
+
+		addAccount := func() *pb.Account {
+			account := &pb.Account{
+				AccountId: pb.NewULID(),
+				Namespace: "/",
+			}
+
+			_, err := s.AddAccount(ctx, &pb.AddAccountRequest{
+				Account: ref(account),
+				Limits:  &pb.Account_Limits{},
+			})
+			require.NoError(t, err)
+
+			return account
+		}
+
+		target := addAccount()
+		renamed := &pb.Account{AccountId: pb.NewULID(), Namespace: "/"}
+
+		_, err = s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+			Account: ref(target),
+			Alias:   ref(renamed),
+		})
+		require.NoError(t, err)
+
+		// Adding it again is a no-op.
+		_, err = s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+			Account: ref(target),
+			Alias:   ref(renamed),
+		})
+		require.NoError(t, err)
+
+		_, err = s.SetAccountFeature(ctx, &pb.SetAccountFeatureRequest{
+			Account: ref(renamed),
+			Name:    "new-assignment",
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		ok, err := s.AccountHasFeature(target, "new-assignment")
+		require.NoError(t, err)
+
+		assert.True(t, ok)
+
+		// Aliases share the account ids, so one can't be created.
+		_, err = s.AddAccount(ctx, &pb.AddAccountRequest{
+			Account: ref(renamed),
+			Limits:  &pb.Account_Limits{},
+		})
+		require.Error(t, err)
+
+		t.Run("selects flows by the account they resolve to", func(t *testing.T) {
+			s.activeFlows = make(map[string]*activeFlow)
+
+			ch := &connectedHub{xmit: make(chan *pb.CentralActivity, 1)}
+			flow := pb.NewULID()
+
+			s.trackActiveFlow(ch, &pb.FlowStream{
+				FlowId:  flow,
+				HubId:   pb.NewULID(),
+				Account: ref(renamed),
+			})
+
+			for _, account := range []*pb.Account{target, renamed} {
+				resp, err := s.ListActiveFlows(ctx, &pb.ListActiveFlowsRequest{Account: ref(account)})
+				require.NoError(t, err)
+				require.Len(t, resp.Flows, 1)
+				assert.Equal(t, flow, resp.Flows[0].FlowId)
+			}
+
+			_, err := s.KillFlow(ctx, &pb.KillFlowRequest{FlowId: flow})
+			require.NoError(t, err)
+
+			ev := <-ch.xmit
+			assert.Equal(t, []*pb.ULID{flow}, ev.KillFlows)
+
+			_, err = s.KillFlow(ctx, &pb.KillFlowRequest{FlowId: pb.NewULID()})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run("merges accounts along with their aliases", func(t *testing.T) {
+			merged := target
+			into := addAccount()
+
+			_, err := s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+				Account: ref(into),
+				Alias:   ref(merged),
+			})
+			require.NoError(t, err)
+
+			resp, err := s.GetAccountFeatures(ctx, &pb.GetAccountFeaturesRequest{Account: ref(merged)})
+			require.NoError(t, err)
+
+			assert.Empty(t, resp.Features)
+
+			var alias AccountAlias
+
+			err = dbx.Check(db.Where("alias_id = ?", renamed.Key()).First(&alias))
+			require.NoError(t, err)
+
+			assert.Equal(t, into.Key(), alias.AccountID)
+
+			// Aliasing to an alias refers to the account it resolves to.
+			other := &pb.Account{AccountId: pb.NewULID(), Namespace: "/"}
+
+			_, err = s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+				Account: ref(renamed),
+				Alias:   ref(other),
+			})
+			require.NoError(t, err)
+
+			err = dbx.Check(db.Where("alias_id = ?", other.Key()).First(&alias))
+			require.NoError(t, err)
+
+			assert.Equal(t, into.Key(), alias.AccountID)
+
+			// Removing the alias makes the merged account's data reachable
+			// again.
+			_, err = s.RemoveAccountAlias(ctx, &pb.RemoveAccountAliasRequest{Alias: ref(merged)})
+			require.NoError(t, err)
+
+			resp, err = s.GetAccountFeatures(ctx, &pb.GetAccountFeaturesRequest{Account: ref(merged)})
+			require.NoError(t, err)
+
+			require.Len(t, resp.Features, 1)
+			assert.Equal(t, "new-assignment", resp.Features[0].Name)
+
+			_, err = s.RemoveAccountAlias(ctx, &pb.RemoveAccountAliasRequest{Alias: ref(merged)})
+			require.Error(t, err)
+		})
+
+		t.Run("rejects conflicting aliases", func(t *testing.T) {
+			a := addAccount()
+
+			_, err := s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+				Account: ref(a),
+				Alias:   ref(a),
+			})
+			require.Error(t, err)
+
+			// renamed already refers to another account
+			_, err = s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+				Account: ref(a),
+				Alias:   ref(renamed),
+			})
+			require.Error(t, err)
+
+			_, err = s.AddAccountAlias(ctx, &pb.AddAccountAliasRequest{
+				Account: &pb.Account{AccountId: pb.NewULID(), Namespace: "/"},
+				Alias:   &pb.Account{AccountId: pb.NewULID(), Namespace: "/"},
+			})
+			require.Error(t, err)
+		})
+	})
 }
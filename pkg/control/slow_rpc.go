@@ -0,0 +1,74 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Requests naming the account they act on.
+type accountRequest interface {
+	GetAccount() *pb.Account
+}
+
+// The account a call was made for, for logging. That's the account named
+// in the request if there is one, otherwise the account of the caller's
+// token. Empty when neither is known.
+func (s *Server) callAccount(ctx context.Context, req interface{}) string {
+	if ar, ok := req.(accountRequest); ok {
+		if account := ar.GetAccount(); account != nil {
+			return account.SpecString()
+		}
+	}
+
+	auth, ok := contextAuthorization(ctx)
+	if !ok {
+		return ""
+	}
+
+	vt, err := token.CheckTokenKeys(auth, s.tokenKeys())
+	if err != nil {
+		return ""
+	}
+
+	return vt.Account().SpecString()
+}
+
+// UnarySlowRPCInterceptor logs unary calls that take longer than
+// ServerConfig.SlowRPCThreshold, whatever their outcome. Streams are left
+// out since they're expected to stay open.
+func (s *Server) UnarySlowRPCInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ts := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	threshold := s.config().SlowRPCThreshold
+	if threshold <= 0 {
+		return resp, err
+	}
+
+	if dur := time.Since(ts); dur > threshold {
+		s.logger(ctx).Warn("slow rpc",
+			"method", info.FullMethod,
+			"duration", dur,
+			"account", s.callAccount(ctx, req),
+			"code", status.Code(err).String(),
+		)
+
+		s.m.IncrCounterWithLabels([]string{"rpc", "slow"}, 1, []metrics.Label{
+			{Name: "method", Value: info.FullMethod},
+		})
+	}
+
+	return resp, err
+}
@@ -0,0 +1,63 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestSlowRPC(t *testing.T) {
+	var buf bytes.Buffer
+
+	var s Server
+	s.L = hclog.New(&hclog.LoggerOptions{Output: &buf})
+	s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.ControlManagement/GetAccountFeatures"}
+
+	account := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+	req := &pb.GetAccountFeaturesRequest{Account: account}
+
+	call := func(delay time.Duration) {
+		_, err := s.UnarySlowRPCInterceptor(context.Background(), req, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				time.Sleep(delay)
+				return &pb.Noop{}, nil
+			})
+		require.NoError(t, err)
+	}
+
+	t.Run("logs nothing when disabled", func(t *testing.T) {
+		buf.Reset()
+
+		call(10 * time.Millisecond)
+
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("logs only the calls over the threshold", func(t *testing.T) {
+		s.cfg.SlowRPCThreshold = 5 * time.Millisecond
+
+		buf.Reset()
+
+		call(0)
+
+		assert.Empty(t, buf.String())
+
+		call(10 * time.Millisecond)
+
+		out := buf.String()
+
+		assert.Contains(t, out, "[WARN]  slow rpc")
+		assert.Contains(t, out, "method=/pb.ControlManagement/GetAccountFeatures")
+		assert.Contains(t, out, "account="+account.SpecString())
+	})
+}
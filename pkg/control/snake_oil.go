@@ -0,0 +1,130 @@
+package control
+
+import (
+	"context"
+	"crypto/tls"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/periodic"
+	"github.com/hashicorp/horizon/pkg/tlsmanage"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Handshakes served with a snake-oil certificate are logged on the first
+// and then every SnakeOilWarnEvery, so the warning keeps turning up in the
+// logs without flooding them.
+var SnakeOilWarnEvery int64 = 1000
+
+// Whether the PEM encoded cert is snake-oil: self-signed, so clients only
+// accept it by ignoring certificate errors. REQUIRE_REAL_TLS refuses such
+// certs outright, this is for noticing them when it isn't set.
+func isSnakeOil(cert []byte) bool {
+	info, err := tlsmanage.ParseCertInfo(cert)
+	return err == nil && info.SelfSigned
+}
+
+// Track whether tlsCert, replacing old, is snake-oil and update the gauge
+// of how many are being served. s.tlsMu must be held.
+func (s *Server) trackSnakeOil(old, tlsCert *tls.Certificate, cert []byte, name string) {
+	if s.snakeOilCerts == nil {
+		s.snakeOilCerts = make(map[*tls.Certificate]bool)
+	}
+
+	if old != nil {
+		delete(s.snakeOilCerts, old)
+	}
+
+	if tlsCert != nil && isSnakeOil(cert) {
+		s.L.Warn("serving a self-signed snake-oil certificate, clients won't trust it", "name", name)
+		s.snakeOilCerts[tlsCert] = true
+	}
+}
+
+func (s *Server) updateSnakeOilGauge() {
+	s.m.SetGauge([]string{"tls", "snake_oil"}, float32(len(s.snakeOilCerts)))
+}
+
+// How often the snake-oil gauge is set again when the certificates haven't
+// changed. The prometheus sink drops gauges that go an hour without being
+// set.
+var SnakeOilGaugeInterval = 10 * time.Minute
+
+// Set the snake-oil gauge every SnakeOilGaugeInterval until ctx is done.
+func (s *Server) emitSnakeOilGauge(ctx context.Context) {
+	periodic.Run(ctx, SnakeOilGaugeInterval, func() {
+		s.tlsMu.RLock()
+		defer s.tlsMu.RUnlock()
+
+		s.updateSnakeOilGauge()
+	})
+}
+
+// Count a handshake served cert, warning periodically if it's snake-oil.
+// s.tlsMu must be held for reading.
+func (s *Server) noteHandshake(cert *tls.Certificate, name string) {
+	if !s.snakeOilCerts[cert] {
+		return
+	}
+
+	s.m.IncrCounter([]string{"tls", "snake_oil_handshakes"}, 1)
+
+	n := atomic.AddInt64(&s.snakeOilHandshakes, 1)
+
+	if every := SnakeOilWarnEvery; n == 1 || (every > 0 && n%every == 0) {
+		s.L.Warn("served a self-signed snake-oil certificate, check the TLS configuration",
+			"server-name", name,
+			"handshakes", n,
+		)
+	}
+}
+
+// Hubs don't have a go-metrics instance of their own, so the snake-oil
+// metrics of the certificate they serve agents are registered with
+// prometheus directly, and served by the hub's /metrics endpoint.
+var (
+	hubSnakeOil = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "hub_tls_snake_oil",
+		Help: "Whether the certificate the hub serves agents is a self-signed snake-oil certificate.",
+	})
+
+	hubSnakeOilHandshakes = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "hub_tls_snake_oil_handshakes_total",
+		Help: "The number of handshakes the hub served a snake-oil certificate in.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(hubSnakeOil, hubSnakeOilHandshakes)
+}
+
+// Note that the hub now serves the PEM encoded cert. c.mu must be held.
+func (c *Client) trackSnakeOil(cert []byte) {
+	c.snakeOil = isSnakeOil(cert)
+
+	if c.snakeOil {
+		c.L.Warn("serving a self-signed snake-oil certificate, agents won't trust it")
+		hubSnakeOil.Set(1)
+	} else {
+		hubSnakeOil.Set(0)
+	}
+}
+
+// Count a handshake served the current cert, warning periodically if it's
+// snake-oil. c.mu must be held for reading.
+func (c *Client) noteHandshake(hello *tls.ClientHelloInfo) {
+	if !c.snakeOil {
+		return
+	}
+
+	hubSnakeOilHandshakes.Inc()
+
+	n := atomic.AddInt64(&c.snakeOilHandshakes, 1)
+
+	if every := SnakeOilWarnEvery; n == 1 || (every > 0 && n%every == 0) {
+		c.L.Warn("served a self-signed snake-oil certificate, check the TLS configuration",
+			"server-name", hello.ServerName,
+			"handshakes", n,
+		)
+	}
+}
@@ -0,0 +1,79 @@
+package control
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// How long each phase of the last start took, so a slow start can be
+// attributed to vault, the database, ACME issuance and so on.
+var (
+	startupPhaseDurations = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "control_startup_phase_seconds",
+			Help: "How long each phase of starting the control server took, by phase.",
+		},
+		[]string{"phase"},
+	)
+
+	startupDuration = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "control_startup_seconds",
+			Help: "How long the control server took to become ready to serve.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(startupPhaseDurations, startupDuration)
+}
+
+// A StartupTimer times the phases of starting the server, logging and
+// recording the duration of each as it ends.
+type StartupTimer struct {
+	L hclog.Logger
+
+	start      time.Time
+	phase      string
+	phaseStart time.Time
+}
+
+// NewStartupTimer starts timing the server's start.
+func NewStartupTimer(L hclog.Logger) *StartupTimer {
+	return &StartupTimer{L: L, start: time.Now()}
+}
+
+// Phase ends the current phase, if any, and begins the one named name.
+func (t *StartupTimer) Phase(name string) {
+	t.endPhase()
+
+	t.L.Debug("startup phase started", "phase", name)
+
+	t.phase = name
+	t.phaseStart = time.Now()
+}
+
+func (t *StartupTimer) endPhase() {
+	if t.phase == "" {
+		return
+	}
+
+	dur := time.Since(t.phaseStart)
+
+	t.L.Info("startup phase finished", "phase", t.phase, "duration", dur)
+	startupPhaseDurations.WithLabelValues(t.phase).Set(dur.Seconds())
+
+	t.phase = ""
+}
+
+// Ready ends the last phase and logs the total time taken to start.
+func (t *StartupTimer) Ready() {
+	t.endPhase()
+
+	total := time.Since(t.start)
+
+	t.L.Info("server ready", "startup-duration", total)
+	startupDuration.Set(total.Seconds())
+}
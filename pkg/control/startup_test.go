@@ -0,0 +1,33 @@
+package control
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartupTimer(t *testing.T) {
+	t.Run("logs and records each phase", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		st := NewStartupTimer(hclog.New(&hclog.LoggerOptions{Output: &buf}))
+
+		st.Phase("startup-test-vault")
+		st.Phase("startup-test-database")
+		st.Ready()
+
+		out := buf.String()
+
+		assert.Equal(t, 2, strings.Count(out, "startup phase finished"))
+		assert.Contains(t, out, "phase=startup-test-vault")
+		assert.Contains(t, out, "phase=startup-test-database")
+		assert.Equal(t, 1, strings.Count(out, "server ready"))
+
+		assert.True(t, testutil.ToFloat64(startupPhaseDurations.WithLabelValues("startup-test-vault")) >= 0)
+		assert.True(t, testutil.ToFloat64(startupDuration) > 0)
+	})
+}
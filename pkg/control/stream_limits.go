@@ -0,0 +1,199 @@
+package control
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Tracks the number of open streams per peer address so that a single
+// client can't exhaust the server by opening streams.
+type streamLimiter struct {
+	mu    sync.Mutex
+	peers map[string]int
+}
+
+func (l *streamLimiter) acquire(addr string, max int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.peers == nil {
+		l.peers = make(map[string]int)
+	}
+
+	if l.peers[addr] >= max {
+		return false
+	}
+
+	l.peers[addr]++
+	return true
+}
+
+func (l *streamLimiter) release(addr string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.peers[addr]--
+	if l.peers[addr] <= 0 {
+		delete(l.peers, addr)
+	}
+}
+
+// The host portion of the stream's peer address. Streams share a budget
+// across all the connections from the same host.
+func streamPeerHost(ss grpc.ServerStream) string {
+	p, ok := peer.FromContext(ss.Context())
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// Records the last time a message was sent or received on the stream. Its
+// context is canceled when the stream is reaped, which also ends a pending
+// RecvMsg, so the handler stops before the RPC returns.
+type idleTrackingStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+
+	// unix nanoseconds, accessed atomically
+	lastActivity int64
+}
+
+func (s *idleTrackingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *idleTrackingStream) touch() {
+	atomic.StoreInt64(&s.lastActivity, time.Now().UnixNano())
+}
+
+func (s *idleTrackingStream) idleSince() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActivity)))
+}
+
+func (s *idleTrackingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.touch()
+	}
+
+	return err
+}
+
+func (s *idleTrackingStream) RecvMsg(m interface{}) error {
+	errs := make(chan error, 1)
+
+	// The underlying RecvMsg only returns once the stream is torn down,
+	// which happens after the handler returns.
+	go func() {
+		errs <- s.ServerStream.RecvMsg(m)
+	}()
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			s.touch()
+		}
+
+		return err
+	case <-s.ctx.Done():
+		return errStreamIdle
+	}
+}
+
+var errStreamIdle = status.Error(codes.DeadlineExceeded, "stream closed after being idle")
+
+// The shortest interval idle streams are checked at, however short the
+// idle timeout.
+const minStreamIdleCheck = 10 * time.Millisecond
+
+// Stream RPCs that aren't reaped for being idle. WatchEvents streams only
+// carry the events that happen, which may be none for a long time.
+var idleExemptStreams = map[string]bool{
+	"/pb.ControlManagement/WatchEvents": true,
+}
+
+// StreamLimitInterceptor enforces ServerConfig.MaxStreamsPerPeer and
+// ServerConfig.StreamIdleTimeout on stream RPCs.
+func (s *Server) StreamLimitInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	cfg := s.config()
+
+	if max := cfg.MaxStreamsPerPeer; max > 0 {
+		addr := streamPeerHost(ss)
+
+		if !s.streamLimits.acquire(addr, max) {
+			s.L.Warn("rejecting stream, peer exceeded its stream limit",
+				"peer", addr, "method", info.FullMethod, "limit", max)
+			s.m.IncrCounter([]string{"stream", "rejected"}, 1)
+
+			return status.Errorf(codes.ResourceExhausted, "too many concurrent streams (limit %d)", max)
+		}
+
+		defer s.streamLimits.release(addr)
+	}
+
+	timeout := cfg.StreamIdleTimeout
+	if timeout <= 0 || idleExemptStreams[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+
+	is := &idleTrackingStream{ServerStream: ss, ctx: ctx}
+	is.touch()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- handler(srv, is)
+	}()
+
+	interval := timeout / 2
+	if interval < minStreamIdleCheck {
+		interval = minStreamIdleCheck
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			if is.idleSince() >= timeout {
+				s.L.Info("reaping idle stream",
+					"peer", streamPeerHost(ss), "method", info.FullMethod, "idle", is.idleSince())
+				s.m.IncrCounter([]string{"stream", "reaped"}, 1)
+
+				// Wait for the handler to stop, so the stream keeps its
+				// slot until then and isn't used after the RPC returns.
+				cancel()
+				<-done
+
+				return errStreamIdle
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package control
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type peerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (p *peerStream) Context() context.Context {
+	return p.ctx
+}
+
+func newPeerStream(addr string) *peerStream {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return &peerStream{
+		ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: tcp}),
+	}
+}
+
+func TestStreamLimits(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/test/Stream"}
+
+	newServer := func(cfg ServerConfig) *Server {
+		s := &Server{L: hclog.L(), cfg: cfg}
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+		return s
+	}
+
+	t.Run("rejects streams over the per peer limit", func(t *testing.T) {
+		s := newServer(ServerConfig{MaxStreamsPerPeer: 1})
+
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		blocking := func(srv interface{}, ss grpc.ServerStream) error {
+			close(started)
+			<-release
+			return nil
+		}
+
+		done := make(chan error)
+		go func() {
+			done <- s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1000"), info, blocking)
+		}()
+
+		<-started
+
+		noop := func(srv interface{}, ss grpc.ServerStream) error { return nil }
+
+		// Same host, different port
+		err := s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1001"), info, noop)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Other hosts have their own budget
+		err = s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.2:1000"), info, noop)
+		require.NoError(t, err)
+
+		close(release)
+		require.NoError(t, <-done)
+
+		// Once the first stream ends, the budget is available again
+		err = s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1001"), info, noop)
+		require.NoError(t, err)
+	})
+
+	t.Run("reaps idle streams", func(t *testing.T) {
+		s := newServer(ServerConfig{StreamIdleTimeout: 50 * time.Millisecond})
+
+		err := s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1000"), info,
+			func(srv interface{}, ss grpc.ServerStream) error {
+				<-ss.Context().Done()
+				return nil
+			})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+
+	t.Run("doesn't reap quiet event watches", func(t *testing.T) {
+		s := newServer(ServerConfig{StreamIdleTimeout: time.Nanosecond})
+
+		watch := &grpc.StreamServerInfo{FullMethod: "/pb.ControlManagement/WatchEvents"}
+
+		err := s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1000"), watch,
+			func(srv interface{}, ss grpc.ServerStream) error {
+				select {
+				case <-ss.Context().Done():
+					return ss.Context().Err()
+				case <-time.After(50 * time.Millisecond):
+					return nil
+				}
+			})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("holds the slot of a reaped stream until its handler stops", func(t *testing.T) {
+		s := newServer(ServerConfig{MaxStreamsPerPeer: 1, StreamIdleTimeout: time.Nanosecond})
+
+		var stopped bool
+
+		err := s.StreamLimitInterceptor(nil, newPeerStream("10.0.0.1:1000"), info,
+			func(srv interface{}, ss grpc.ServerStream) error {
+				<-ss.Context().Done()
+				time.Sleep(20 * time.Millisecond)
+				stopped = true
+				return nil
+			})
+
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.True(t, stopped)
+
+		assert.True(t, s.streamLimits.acquire("10.0.0.1", 1))
+	})
+}
@@ -0,0 +1,271 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+var ErrNoTLSCertificate = errors.New("no TLS certificate configured")
+
+// SetHubTLS registers the TLS material for domain. The material is served
+// to clients that request domain, or any direct subdomain of it, via SNI.
+// The first domain registered becomes the default: its material is served
+// for unmatched names and advertised to hubs in their config.
+func (s *Server) SetHubTLS(cert, key []byte, domain string) {
+	tlsCert, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		s.L.Error("unable to parse hub TLS material", "domain", domain, "error", err)
+		return
+	}
+
+	s.tlsMu.Lock()
+	defer s.tlsMu.Unlock()
+
+	if s.tlsCerts == nil {
+		s.tlsCerts = make(map[string]*tls.Certificate)
+	}
+
+	s.trackSnakeOil(s.tlsCerts[domain], &tlsCert, cert, domain)
+	s.updateSnakeOilGauge()
+
+	s.tlsCerts[domain] = &tlsCert
+
+	if s.tlsFallback == "" {
+		s.tlsFallback = domain
+	}
+
+	if domain == s.tlsFallback {
+		s.hubCert = cert
+		s.hubKey = key
+		s.hubDomain = domain
+	}
+}
+
+// GetCertificate selects the certificate matching the SNI name of the
+// connection, for use as tls.Config.GetCertificate. Names without a
+// matching domain get the default certificate.
+func (s *Server) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.tlsMu.RLock()
+	defer s.tlsMu.RUnlock()
+
+	name := strings.TrimSuffix(strings.ToLower(hello.ServerName), ".")
+
+	cert, err := s.selectCertificate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.noteHandshake(cert, name)
+
+	return cert, nil
+}
+
+// s.tlsMu must be held for reading.
+func (s *Server) selectCertificate(name string) (*tls.Certificate, error) {
+	if sc, ok := s.subdomainTLS[name]; ok {
+		return sc.tls, nil
+	}
+
+	if cert, ok := s.tlsCerts[name]; ok {
+		return cert, nil
+	}
+
+	// Hub certs are wildcards, so they cover the names one label below
+	// the domain.
+	if idx := strings.IndexByte(name, '.'); idx != -1 {
+		if cert, ok := s.tlsCerts[name[idx+1:]]; ok {
+			return cert, nil
+		}
+	}
+
+	if cert, ok := s.tlsCerts[s.tlsFallback]; ok {
+		return cert, nil
+	}
+
+	return nil, ErrNoTLSCertificate
+}
+
+// TLSMaterial is a PEM encoded certificate and its key.
+type TLSMaterial struct {
+	Cert []byte
+	Key  []byte
+}
+
+type subdomainCert struct {
+	TLSMaterial
+	tls *tls.Certificate
+}
+
+// The subdomain of the hub domain a hub is addressed by.
+func (s *Server) hubSubdomain(stableId *pb.ULID) string {
+	return strings.ToLower(stableId.String() + "." + s.hubDomain)
+}
+
+// How long a hub's subdomain keeps its certificate after the hub was last
+// seen, if ServerConfig.HubSubdomainCertGrace isn't set.
+const DefaultHubSubdomainCertGrace = 7 * 24 * time.Hour
+
+// Note that the hub stableId was seen now. The hub_subdomains rows outlive
+// the hubs rows, which HubDisconnect deletes, so that a hub that is only
+// gone for a while, such as while it's redeployed, keeps its certificate.
+func touchHubSubdomain(db *gorm.DB, stableId *pb.ULID) error {
+	return dbx.Check(db.Exec(
+		`INSERT INTO hub_subdomains (stable_id, last_seen_at) VALUES (?, now())
+		 ON CONFLICT (stable_id) DO UPDATE SET last_seen_at = now()`,
+		stableId.Bytes(),
+	))
+}
+
+// HubSubdomains returns the subdomain of every registered hub, and of those
+// seen within ServerConfig.HubSubdomainCertGrace, for issuing each a
+// certificate of its own where wildcard certs can't be used. Subdomains of
+// hubs gone for longer are forgotten.
+func (s *Server) HubSubdomains(ctx context.Context) ([]string, error) {
+	s.tlsMu.RLock()
+	domain := s.hubDomain
+	s.tlsMu.RUnlock()
+
+	if domain == "" {
+		return nil, errors.New("no hub domain configured")
+	}
+
+	grace := s.config().HubSubdomainCertGrace
+	if grace <= 0 {
+		grace = DefaultHubSubdomainCertGrace
+	}
+
+	err := dbx.Check(s.db.Exec(
+		"DELETE FROM hub_subdomains WHERE last_seen_at < ?", time.Now().Add(-grace),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Raw(
+		"SELECT stable_id FROM hubs UNION SELECT stable_id FROM hub_subdomains",
+	).Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var names []string
+
+	for rows.Next() {
+		var id []byte
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, s.hubSubdomain(pb.ULIDFromBytes(id)))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// SetSubdomainTLS replaces the material of the explicitly named hub
+// subdomains, by name. Each is only served for its exact name, ahead of
+// any domain registered with SetHubTLS, and is given to the hub addressed
+// by it in place of the default material. Material that doesn't parse is
+// logged and left out. Material that is new or has changed, such as a
+// renewed cert, is pushed to the hub it names if it's connected to this
+// server.
+func (s *Server) SetSubdomainTLS(material map[string]TLSMaterial) {
+	certs := make(map[string]*subdomainCert, len(material))
+
+	for name, m := range material {
+		tlsCert, err := tls.X509KeyPair(m.Cert, m.Key)
+		if err != nil {
+			s.L.Error("unable to parse subdomain TLS material", "name", name, "error", err)
+			continue
+		}
+
+		certs[strings.ToLower(name)] = &subdomainCert{TLSMaterial: m, tls: &tlsCert}
+	}
+
+	s.tlsMu.Lock()
+
+	for _, sc := range s.subdomainTLS {
+		s.trackSnakeOil(sc.tls, nil, nil, "")
+	}
+
+	for name, sc := range certs {
+		s.trackSnakeOil(nil, sc.tls, sc.Cert, name)
+	}
+
+	s.updateSnakeOilGauge()
+
+	prev := s.subdomainTLS
+	s.subdomainTLS = certs
+
+	s.tlsMu.Unlock()
+
+	s.pushSubdomainTLS(prev, certs)
+}
+
+// Send the hubs connected to this server whose subdomain's material differs
+// between prev and cur their new material, so they don't have to wait until
+// they next fetch their config.
+func (s *Server) pushSubdomainTLS(prev, cur map[string]*subdomainCert) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for key, ch := range s.connectedHubs {
+		if ch.stableId == nil {
+			continue
+		}
+
+		name := s.hubSubdomain(ch.stableId)
+
+		sc, ok := cur[name]
+		if !ok {
+			continue
+		}
+
+		if old, ok := prev[name]; ok && bytes.Equal(old.Cert, sc.Cert) && bytes.Equal(old.Key, sc.Key) {
+			continue
+		}
+
+		go func(key string, ch *connectedHub, sc *subdomainCert) {
+			select {
+			case ch.xmit <- &pb.CentralActivity{HubTls: &pb.HubTLS{Cert: sc.Cert, Key: sc.Key}}:
+				s.L.Info("sent hub its subdomain certificate", "hub", key)
+			case <-time.After(5 * time.Second):
+				s.L.Warn("timed out sending hub its subdomain certificate, it gets it with its next config", "hub", key)
+			}
+		}(key, ch, sc)
+	}
+}
+
+// The material given to the hub stableId: the cert naming its subdomain
+// if there is one, otherwise the default material.
+func (s *Server) hubMaterial(stableId *pb.ULID) ([]byte, []byte) {
+	s.tlsMu.RLock()
+	defer s.tlsMu.RUnlock()
+
+	if stableId != nil && len(s.subdomainTLS) > 0 {
+		if sc, ok := s.subdomainTLS[s.hubSubdomain(stableId)]; ok {
+			return sc.Cert, sc.Key
+		}
+	}
+
+	return s.hubCert, s.hubKey
+}
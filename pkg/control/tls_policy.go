@@ -0,0 +1,236 @@
+package control
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// ErrTLSPolicy is returned when an agent's connection doesn't meet the TLS
+// policy of its account.
+var ErrTLSPolicy = errors.New("connection violates tls policy")
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// ParseTLSVersion parses a TLS version such as "1.2" into its crypto/tls
+// value. An empty string is zero, accepting any version.
+func ParseTLSVersion(str string) (uint16, error) {
+	if str == "" {
+		return 0, nil
+	}
+
+	v, ok := tlsVersions[str]
+	if !ok {
+		return 0, fmt.Errorf("unknown tls version: %s", str)
+	}
+
+	return v, nil
+}
+
+func tlsVersionName(v uint32) string {
+	for name, ver := range tlsVersions {
+		if uint32(ver) == v {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("0x%04x", v)
+}
+
+func validTLSVersion(v uint32) bool {
+	if v == 0 {
+		return true
+	}
+
+	for _, ver := range tlsVersions {
+		if uint32(ver) == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the policy that satisfies both def and account, the highest
+// minimum version of the two and a client certificate if either requires
+// one, so an account can tighten the default but never loosen it.
+func mergeTLSPolicy(def, account *pb.TLSPolicy) *pb.TLSPolicy {
+	var out pb.TLSPolicy
+
+	for _, p := range []*pb.TLSPolicy{def, account} {
+		if p == nil {
+			continue
+		}
+
+		if p.MinVersion > out.MinVersion {
+			out.MinVersion = p.MinVersion
+		}
+
+		out.RequireClientCert = out.RequireClientCert || p.RequireClientCert
+	}
+
+	return &out
+}
+
+// CheckTLSPolicy returns an ErrTLSPolicy describing how a connection with
+// the given state violates policy, or nil if it doesn't. state is nil for
+// connections not using TLS, which only satisfy an empty policy.
+func CheckTLSPolicy(policy *pb.TLSPolicy, state *tls.ConnectionState) error {
+	if policy == nil || (policy.MinVersion == 0 && !policy.RequireClientCert) {
+		return nil
+	}
+
+	if state == nil {
+		return errors.Wrapf(ErrTLSPolicy, "tls is required")
+	}
+
+	if uint32(state.Version) < policy.MinVersion {
+		return errors.Wrapf(ErrTLSPolicy, "tls %s is below the minimum of %s",
+			tlsVersionName(uint32(state.Version)), tlsVersionName(policy.MinVersion))
+	}
+
+	if policy.RequireClientCert && len(state.VerifiedChains) == 0 {
+		if len(state.PeerCertificates) > 0 {
+			return errors.Wrapf(ErrTLSPolicy, "client certificate is not signed by the agent client ca")
+		}
+
+		return errors.Wrapf(ErrTLSPolicy, "client certificate is required")
+	}
+
+	return nil
+}
+
+func (s *Server) defaultTLSPolicy() *pb.TLSPolicy {
+	cfg := s.config()
+
+	return &pb.TLSPolicy{
+		MinVersion:        uint32(cfg.AgentTLSMinVersion),
+		RequireClientCert: cfg.AgentRequireClientCert,
+	}
+}
+
+// The TLS policy set for account, or nil when it has none.
+func accountTLSPolicy(db *gorm.DB, account *pb.Account) (*pb.TLSPolicy, error) {
+	var ao Account
+
+	err := dbx.Check(db.First(&ao, account.Key()))
+	if err != nil {
+		return nil, err
+	}
+
+	var policy pb.TLSPolicy
+
+	ok, err := ao.Data.Get("tls_policy", &policy)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (s *Server) SetAccountTLSPolicy(ctx context.Context, req *pb.SetAccountTLSPolicyRequest) (*pb.Noop, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkFeatureAccount(caller, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Policy != nil {
+		if !validTLSVersion(req.Policy.MinVersion) {
+			return nil, errors.Wrapf(ErrInvalidRequest, "unknown tls version: 0x%04x", req.Policy.MinVersion)
+		}
+
+		// Without a CA to verify them against no agent could connect.
+		if req.Policy.RequireClientCert && len(s.config().AgentClientCA) == 0 {
+			return nil, errors.Wrapf(ErrInvalidRequest, "client certificates can't be required without an agent client ca")
+		}
+	}
+
+	tx := s.db.Begin()
+
+	var ao Account
+
+	err = dbx.Check(tx.Set("gorm:query_option", "FOR UPDATE").First(&ao, req.Account.Key()))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if req.Policy == nil {
+		delete(ao.Data, "tls_policy")
+	} else {
+		err = ao.Data.Set("tls_policy", req.Policy)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = dbx.Check(tx.Model(&ao).Update("data", ao.Data))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = dbx.Check(tx.Commit())
+	if err != nil {
+		return nil, err
+	}
+
+	// Hubs read the policy from the account's routing data.
+	err = s.updateAccountRouting(ctx, s.db.DB(), req.Account, "set-tls-policy")
+	if err != nil {
+		return nil, err
+	}
+
+	effective := mergeTLSPolicy(s.defaultTLSPolicy(), req.Policy)
+
+	s.logger(ctx).Info("account tls policy set",
+		"account", req.Account.SpecString(),
+		"min-version", tlsVersionName(effective.MinVersion),
+		"require-client-cert", effective.RequireClientCert,
+	)
+
+	s.auditAccount(ctx, caller, "set-account-tls-policy", req.Account.SpecString(), map[string]interface{}{
+		"min_version":         req.Policy.GetMinVersion(),
+		"require_client_cert": req.Policy.GetRequireClientCert(),
+	})
+
+	return &pb.Noop{}, nil
+}
+
+func (s *Server) GetAccountTLSPolicy(ctx context.Context, req *pb.GetAccountTLSPolicyRequest) (*pb.GetAccountTLSPolicyResponse, error) {
+	caller, err := s.accountCallerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkFeatureAccount(caller, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := accountTLSPolicy(s.listDB(ctx, req.ConsistentRead), req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAccountTLSPolicyResponse{
+		Policy:    policy,
+		Effective: mergeTLSPolicy(s.defaultTLSPolicy(), policy),
+	}, nil
+}
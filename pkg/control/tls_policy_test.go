@@ -0,0 +1,88 @@
+package control
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSPolicy(t *testing.T) {
+	t.Run("accounts can only tighten the default", func(t *testing.T) {
+		def := &pb.TLSPolicy{MinVersion: tls.VersionTLS12}
+
+		assert.Equal(t, def, mergeTLSPolicy(def, nil))
+
+		assert.Equal(t, def, mergeTLSPolicy(def, &pb.TLSPolicy{MinVersion: tls.VersionTLS10}))
+
+		assert.Equal(t,
+			&pb.TLSPolicy{MinVersion: tls.VersionTLS13, RequireClientCert: true},
+			mergeTLSPolicy(def, &pb.TLSPolicy{MinVersion: tls.VersionTLS13, RequireClientCert: true}),
+		)
+
+		assert.Equal(t,
+			&pb.TLSPolicy{MinVersion: tls.VersionTLS12, RequireClientCert: true},
+			mergeTLSPolicy(&pb.TLSPolicy{RequireClientCert: true}, def),
+		)
+	})
+
+	t.Run("reports how a connection violates the policy", func(t *testing.T) {
+		tls12 := &tls.ConnectionState{Version: tls.VersionTLS12}
+
+		assert.NoError(t, CheckTLSPolicy(nil, nil))
+		assert.NoError(t, CheckTLSPolicy(&pb.TLSPolicy{}, nil))
+		assert.NoError(t, CheckTLSPolicy(&pb.TLSPolicy{MinVersion: tls.VersionTLS12}, tls12))
+
+		err := CheckTLSPolicy(&pb.TLSPolicy{MinVersion: tls.VersionTLS12}, nil)
+		require.Error(t, err)
+
+		assert.Equal(t, ErrTLSPolicy, errors.Cause(err))
+
+		err = CheckTLSPolicy(&pb.TLSPolicy{MinVersion: tls.VersionTLS13}, tls12)
+		require.Error(t, err)
+
+		assert.Equal(t, "tls 1.2 is below the minimum of 1.3: connection violates tls policy", err.Error())
+
+		err = CheckTLSPolicy(&pb.TLSPolicy{RequireClientCert: true}, tls12)
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "client certificate is required")
+
+		unverified := &tls.ConnectionState{
+			Version:          tls.VersionTLS12,
+			PeerCertificates: []*x509.Certificate{{}},
+		}
+
+		err = CheckTLSPolicy(&pb.TLSPolicy{RequireClientCert: true}, unverified)
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "not signed by the agent client ca")
+
+		verified := &tls.ConnectionState{
+			Version:          tls.VersionTLS12,
+			PeerCertificates: []*x509.Certificate{{}},
+			VerifiedChains:   [][]*x509.Certificate{{{}}},
+		}
+
+		assert.NoError(t, CheckTLSPolicy(&pb.TLSPolicy{RequireClientCert: true}, verified))
+	})
+
+	t.Run("parses tls versions", func(t *testing.T) {
+		v, err := ParseTLSVersion("1.3")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint16(tls.VersionTLS13), v)
+
+		v, err = ParseTLSVersion("")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint16(0), v)
+
+		_, err = ParseTLSVersion("1.4")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,231 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerTLS(t *testing.T) {
+	t.Run("selects certificates by SNI", func(t *testing.T) {
+		cert1, key1, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		cert2, key2, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		s := &Server{L: hclog.L()}
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		_, err = s.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+		assert.Equal(t, ErrNoTLSCertificate, err)
+
+		s.SetHubTLS(cert1, key1, "one.test")
+		s.SetHubTLS(cert2, key2, "two.test")
+
+		c1, err := tls.X509KeyPair(cert1, key1)
+		require.NoError(t, err)
+
+		c2, err := tls.X509KeyPair(cert2, key2)
+		require.NoError(t, err)
+
+		for name, expected := range map[string]tls.Certificate{
+			"one.test":      c1,
+			"hub.one.test":  c1,
+			"two.test":      c2,
+			"HUB.two.test.": c2,
+			"other.test":    c1,
+			"":              c1,
+		} {
+			cert, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: name})
+			require.NoError(t, err)
+
+			assert.Equal(t, expected.Certificate, cert.Certificate, "name: %s", name)
+		}
+
+		// The default domain's material is what hubs are given.
+		assert.Equal(t, cert1, s.hubCert)
+		assert.Equal(t, "one.test", s.hubDomain)
+	})
+
+	t.Run("serves explicitly named subdomains their own certificate", func(t *testing.T) {
+		hubCert, hubKey, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		subCert, subKey, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		s := &Server{L: hclog.L()}
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+		s.SetHubTLS(hubCert, hubKey, "hub.test")
+
+		hub := pb.NewULID()
+		name := s.hubSubdomain(hub)
+
+		s.SetSubdomainTLS(map[string]TLSMaterial{
+			name:       {Cert: subCert, Key: subKey},
+			"bad.test": {Cert: []byte("nope"), Key: []byte("nope")},
+		})
+
+		sc, err := tls.X509KeyPair(subCert, subKey)
+		require.NoError(t, err)
+
+		cert, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: strings.ToUpper(name)})
+		require.NoError(t, err)
+		assert.Equal(t, sc.Certificate, cert.Certificate)
+
+		tlsCert, _ := s.hubMaterial(hub)
+		assert.Equal(t, subCert, tlsCert)
+
+		tlsCert, _ = s.hubMaterial(pb.NewULID())
+		assert.Equal(t, hubCert, tlsCert)
+
+		assert.Len(t, s.subdomainTLS, 1)
+
+		// Replaced as a set, so subdomains that have gone away are dropped
+		s.SetSubdomainTLS(nil)
+
+		tlsCert, _ = s.hubMaterial(hub)
+		assert.Equal(t, hubCert, tlsCert)
+	})
+
+	t.Run("pushes new subdomain material to connected hubs", func(t *testing.T) {
+		hubCert, hubKey, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		subCert, subKey, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		s := &Server{L: hclog.L(), connectedHubs: make(map[string]*connectedHub)}
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+		s.SetHubTLS(hubCert, hubKey, "hub.test")
+
+		hub := pb.NewULID()
+
+		ch := &connectedHub{xmit: make(chan *pb.CentralActivity, 1), stableId: hub}
+		s.connectedHubs[pb.NewULID().SpecString()] = ch
+
+		material := map[string]TLSMaterial{
+			s.hubSubdomain(hub): {Cert: subCert, Key: subKey},
+		}
+
+		s.SetSubdomainTLS(material)
+
+		select {
+		case ev := <-ch.xmit:
+			require.NotNil(t, ev.HubTls)
+			assert.Equal(t, subCert, ev.HubTls.Cert)
+			assert.Equal(t, subKey, ev.HubTls.Key)
+		case <-time.After(time.Second):
+			t.Fatal("hub wasn't sent its subdomain certificate")
+		}
+
+		// Unchanged material isn't sent again.
+		s.SetSubdomainTLS(material)
+
+		select {
+		case <-ch.xmit:
+			t.Fatal("unchanged certificate was sent again")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("keeps the subdomains of gone hubs for the grace period", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		s := &Server{L: hclog.L(), db: db, hubDomain: "hub.test"}
+		s.cfg.HubSubdomainCertGrace = time.Hour
+
+		registered := pb.NewULID()
+		gone := pb.NewULID()
+		expired := pb.NewULID()
+
+		require.NoError(t, dbx.Check(db.Create(&Hub{StableID: registered.Bytes(), InstanceID: pb.NewULID().Bytes()})))
+
+		for _, id := range []*pb.ULID{gone, expired} {
+			require.NoError(t, touchHubSubdomain(db, id))
+		}
+
+		require.NoError(t, dbx.Check(db.Exec(
+			"UPDATE hub_subdomains SET last_seen_at = now() - interval '2 hours' WHERE stable_id = ?", expired.Bytes(),
+		)))
+
+		names, err := s.HubSubdomains(context.Background())
+		require.NoError(t, err)
+
+		assert.Contains(t, names, s.hubSubdomain(registered))
+		assert.Contains(t, names, s.hubSubdomain(gone))
+		assert.NotContains(t, names, s.hubSubdomain(expired))
+	})
+
+	t.Run("warns while serving snake-oil certificates", func(t *testing.T) {
+		defer func(every int64) { SnakeOilWarnEvery = every }(SnakeOilWarnEvery)
+		SnakeOilWarnEvery = 2
+
+		cert, key, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+
+		sink := metrics.NewInmemSink(time.Minute, time.Minute)
+
+		s := &Server{L: hclog.New(&hclog.LoggerOptions{Output: &buf})}
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), sink)
+		s.m.EnableHostname = false
+
+		// Replacing the material doesn't count the old cert twice.
+		s.SetHubTLS(cert, key, "hub.test")
+		s.SetHubTLS(cert, key, "hub.test")
+
+		for i := 0; i < 3; i++ {
+			_, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "hub.test"})
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 2, strings.Count(buf.String(), "served a self-signed snake-oil certificate"))
+
+		data := sink.Data()
+		require.Len(t, data, 1)
+
+		g, ok := data[0].Gauges["test.tls.snake_oil"]
+		require.True(t, ok, "gauges: %v", data[0].Gauges)
+		assert.Equal(t, float32(1), g.Value)
+
+		c, ok := data[0].Counters["test.tls.snake_oil_handshakes"]
+		require.True(t, ok, "counters: %v", data[0].Counters)
+		assert.Equal(t, 3, c.Count)
+	})
+
+	t.Run("warns while hubs serve snake-oil certificates", func(t *testing.T) {
+		defer func(every int64) { SnakeOilWarnEvery = every }(SnakeOilWarnEvery)
+		SnakeOilWarnEvery = 2
+
+		cert, _, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+
+		c := &Client{L: hclog.New(&hclog.LoggerOptions{Output: &buf})}
+		c.trackSnakeOil(cert)
+
+		for i := 0; i < 3; i++ {
+			c.noteHandshake(&tls.ClientHelloInfo{ServerName: "hub.test"})
+		}
+
+		assert.Equal(t, 1, strings.Count(buf.String(), "serving a self-signed snake-oil certificate"))
+		assert.Equal(t, 2, strings.Count(buf.String(), "served a self-signed snake-oil certificate"))
+	})
+}
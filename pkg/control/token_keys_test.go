@@ -0,0 +1,60 @@
+package control
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacyTokenKey(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("is the signing key when it's ed25519", func(t *testing.T) {
+		s := &Server{keyId: "k2", pubKey: edPub}
+
+		assert.Equal(t, edPub, s.TokenPub())
+
+		info, err := s.GetTokenPublicKey(context.Background(), &pb.Noop{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte(edPub), info.PublicKey)
+		assert.Equal(t, "k2", info.KeyId)
+	})
+
+	t.Run("is an ed25519 verification key otherwise", func(t *testing.T) {
+		s := &Server{
+			keyId:      "k2",
+			pubKey:     []byte("ecdsa der"),
+			signingAlg: token.AlgorithmECDSAP256,
+			verifyKeys: map[string]token.PublicKey{
+				"k0": {Algorithm: token.AlgorithmECDSAP256, Key: []byte("older ecdsa der")},
+				"k1": token.Ed25519Key(edPub),
+			},
+		}
+
+		assert.Equal(t, edPub, s.TokenPub())
+
+		info, err := s.GetTokenPublicKey(context.Background(), &pb.Noop{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte(edPub), info.PublicKey)
+		assert.Equal(t, "k1", info.KeyId)
+		assert.Equal(t, string(token.AlgorithmEd25519), info.Algorithm)
+
+		delete(s.verifyKeys, "k1")
+
+		assert.Nil(t, s.TokenPub())
+
+		info, err = s.GetTokenPublicKey(context.Background(), &pb.Noop{})
+		require.NoError(t, err)
+
+		assert.Empty(t, info.PublicKey)
+	})
+}
@@ -0,0 +1,126 @@
+package control
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The tokens per minute an account is issued, used when
+	// ServerConfig.TokenIssueRate is zero.
+	DefaultTokenIssueRate = 120
+
+	// The tokens an account can be issued at once, used when
+	// ServerConfig.TokenIssueBurst is zero.
+	DefaultTokenIssueBurst = 20
+)
+
+// Once this many accounts have a limiter, the limiters of accounts that
+// have since been refilled are dropped.
+const tokenLimiterSweepSize = 1024
+
+type tokenLimiter struct {
+	lim  *rate.Limiter
+	last time.Time
+}
+
+// Limits the rate at which tokens are issued for each account, separately
+// from any flow limits, as every token is signed through the secrets
+// backend.
+type tokenRateLimiter struct {
+	mu       sync.Mutex
+	accounts map[string]*tokenLimiter
+}
+
+// Reports whether a token may be issued for account now, given its rate,
+// per minute, and burst.
+func (l *tokenRateLimiter) allow(account string, perMinute, burst int, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.accounts == nil {
+		l.accounts = make(map[string]*tokenLimiter)
+	}
+
+	limit := rate.Limit(float64(perMinute) / 60)
+
+	tl, ok := l.accounts[account]
+	if !ok {
+		if len(l.accounts) >= tokenLimiterSweepSize {
+			l.sweep(limit, burst, now)
+		}
+
+		tl = &tokenLimiter{lim: rate.NewLimiter(limit, burst)}
+		l.accounts[account] = tl
+	}
+
+	tl.last = now
+
+	return tl.lim.AllowN(now, 1)
+}
+
+// Drop the limiters that have been idle long enough to refill, as they
+// would allow the same as a new one.
+func (l *tokenRateLimiter) sweep(limit rate.Limit, burst int, now time.Time) {
+	refill := time.Duration(float64(burst) / float64(limit) * float64(time.Second))
+
+	for account, tl := range l.accounts {
+		if now.Sub(tl.last) >= refill {
+			delete(l.accounts, account)
+		}
+	}
+}
+
+// The rate, per minute, and burst tokens are issued at for each account,
+// and false if issuance isn't limited.
+func (s *Server) tokenIssueLimits() (int, int, bool) {
+	cfg := s.config()
+
+	perMinute := cfg.TokenIssueRate
+	if perMinute == 0 {
+		perMinute = DefaultTokenIssueRate
+	}
+
+	burst := cfg.TokenIssueBurst
+	if burst <= 0 {
+		burst = DefaultTokenIssueBurst
+	}
+
+	return perMinute, burst, perMinute > 0
+}
+
+// Check that a token of the given kind may be issued for account, returning
+// ResourceExhausted once the account has exceeded its token issuance rate.
+// Each kind is limited separately, as the accounts of different kinds,
+// such as agent account keys and service namespaces, could otherwise share
+// a limiter.
+func (s *Server) checkTokenRate(ctx context.Context, kind, account string) error {
+	perMinute, burst, ok := s.tokenIssueLimits()
+	if !ok {
+		return nil
+	}
+
+	if s.tokenLimits.allow(kind+":"+account, perMinute, burst, time.Now()) {
+		return nil
+	}
+
+	s.m.IncrCounterWithLabels([]string{"tokens", "rate_limited"}, 1, []metrics.Label{
+		{Name: "kind", Value: kind},
+	})
+
+	s.logger(ctx).Warn("token issuance rate limit exceeded",
+		"kind", kind,
+		"account", account,
+		"rate", perMinute,
+		"burst", burst,
+	)
+
+	return status.Errorf(codes.ResourceExhausted,
+		"token issuance rate limit of %d per minute exceeded for account %s", perMinute, account)
+}
@@ -0,0 +1,77 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenRateLimit(t *testing.T) {
+	t.Run("limits each account separately", func(t *testing.T) {
+		var l tokenRateLimiter
+
+		now := time.Now()
+
+		for i := 0; i < 3; i++ {
+			assert.True(t, l.allow("a", 60, 3, now))
+		}
+
+		assert.False(t, l.allow("a", 60, 3, now))
+		assert.True(t, l.allow("b", 60, 3, now))
+
+		// Refills at one a second.
+		assert.True(t, l.allow("a", 60, 3, now.Add(time.Second)))
+		assert.False(t, l.allow("a", 60, 3, now.Add(time.Second)))
+	})
+
+	t.Run("drops limiters that have refilled", func(t *testing.T) {
+		var l tokenRateLimiter
+
+		now := time.Now()
+
+		for i := 0; i < tokenLimiterSweepSize-1; i++ {
+			l.allow(fmt.Sprint(i), 60, 3, now)
+		}
+
+		l.allow("recent", 60, 3, now.Add(59*time.Second))
+
+		assert.Len(t, l.accounts, tokenLimiterSweepSize)
+
+		l.allow("late", 60, 3, now.Add(time.Minute))
+
+		assert.Len(t, l.accounts, 2)
+	})
+
+	t.Run("returns ResourceExhausted past the limit", func(t *testing.T) {
+		var s Server
+		s.L = hclog.NewNullLogger()
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+		s.cfg.TokenIssueRate = 1
+		s.cfg.TokenIssueBurst = 2
+
+		ctx := context.Background()
+
+		require.NoError(t, s.checkTokenRate(ctx, "agent", "/a"))
+		require.NoError(t, s.checkTokenRate(ctx, "agent", "/a"))
+
+		err := s.checkTokenRate(ctx, "agent", "/a")
+		require.Error(t, err)
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Other kinds of account with the same name have their own limit.
+		require.NoError(t, s.checkTokenRate(ctx, "service", "/a"))
+
+		s.cfg.TokenIssueRate = -1
+
+		require.NoError(t, s.checkTokenRate(ctx, "agent", "/a"))
+	})
+}
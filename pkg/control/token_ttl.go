@@ -0,0 +1,46 @@
+package control
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Returns the lifetime to issue a token of the given kind with, given the
+// requested one. Zero requests a token that never expires. With
+// MaxTokenTTL set, longer requests are clamped to it, or rejected when
+// RejectLongTokenTTL is set. Tokens requested without a lifetime are
+// always clamped, so no token outlives the maximum. Negative lifetimes are
+// always rejected.
+func (s *Server) tokenValidDuration(kind string, requested time.Duration) (time.Duration, error) {
+	if requested < 0 {
+		return 0, errors.Wrapf(ErrInvalidRequest,
+			"requested token lifetime of %s is negative", requested)
+	}
+
+	cfg := s.config()
+
+	max := cfg.MaxTokenTTL
+
+	if max <= 0 || (requested > 0 && requested <= max) {
+		return requested, nil
+	}
+
+	if requested == 0 {
+		s.L.Debug("issuing token with the maximum lifetime", "kind", kind, "max", max)
+		return max, nil
+	}
+
+	if cfg.RejectLongTokenTTL {
+		return 0, errors.Wrapf(ErrInvalidRequest,
+			"requested token lifetime of %s exceeds the maximum of %s", requested, max)
+	}
+
+	s.L.Info("clamping requested token lifetime to the maximum",
+		"kind", kind,
+		"requested", requested,
+		"max", max,
+	)
+
+	return max, nil
+}
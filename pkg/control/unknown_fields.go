@@ -0,0 +1,395 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// How requests containing fields or enum values the server doesn't know
+// about, typically sent by a newer client, are handled. The generated
+// decoders silently drop them, so without a check a client can't tell
+// that part of its request was ignored.
+type UnknownFieldMode int
+
+const (
+	// Accept the request, logging and counting what was ignored. This
+	// keeps older servers usable by newer clients during a rollout.
+	UnknownFieldsLenient UnknownFieldMode = iota
+
+	// Reject the request with InvalidArgument.
+	UnknownFieldsStrict
+)
+
+func (m UnknownFieldMode) String() string {
+	switch m {
+	case UnknownFieldsLenient:
+		return "lenient"
+	case UnknownFieldsStrict:
+		return "strict"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseUnknownFieldMode parses "lenient" or "strict".
+func ParseUnknownFieldMode(str string) (UnknownFieldMode, error) {
+	switch str {
+	case "lenient":
+		return UnknownFieldsLenient, nil
+	case "strict":
+		return UnknownFieldsStrict, nil
+	default:
+		return 0, fmt.Errorf("unknown field mode must be lenient or strict: %s", str)
+	}
+}
+
+// Callers that want their own requests rejected rather than partially
+// applied, regardless of ServerConfig.UnknownFields, set this metadata key
+// to "strict".
+const UnknownFieldsMetadataKey = "hzn-unknown-fields"
+
+// Nested messages deeper than this are not checked.
+const maxUnknownFieldDepth = 32
+
+// What scanning needs to know about a field of a message type.
+type scannedField struct {
+	name string
+
+	// The values of the field's enum, if it is one.
+	enum   string
+	values map[int32]bool
+
+	// The message type of the field, or of its elements, if it's a message.
+	msg reflect.Type
+}
+
+// The fields of a message type by tag, computed once per type.
+type scannedMessage struct {
+	name   string
+	fields map[int]*scannedField
+}
+
+// The scannedMessage of each message type seen, by reflect.Type.
+var scannedMessages sync.Map
+
+func scannedMessageFor(t reflect.Type) *scannedMessage {
+	if sm, ok := scannedMessages.Load(t); ok {
+		return sm.(*scannedMessage)
+	}
+
+	sprops := proto.GetProperties(t)
+
+	sm := &scannedMessage{
+		name:   t.Name(),
+		fields: make(map[int]*scannedField),
+	}
+
+	add := func(p *proto.Properties, typ reflect.Type) {
+		f := &scannedField{name: p.OrigName}
+
+		if p.Enum != "" {
+			if values := proto.EnumValueMap(p.Enum); values != nil {
+				f.enum = p.Enum
+				f.values = make(map[int32]bool, len(values))
+
+				for _, v := range values {
+					f.values[v] = true
+				}
+			}
+		}
+
+		if typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		// Custom types are encoded as bytes rather than as messages.
+		if typ.Kind() == reflect.Struct && p.CustomType == "" {
+			f.msg = typ
+		}
+
+		sm.fields[p.Tag] = f
+	}
+
+	for i, p := range sprops.Prop {
+		if p.Tag > 0 {
+			add(p, t.Field(i).Type)
+		}
+	}
+
+	for _, op := range sprops.OneofTypes {
+		add(op.Prop, op.Type.Elem().Field(0).Type)
+	}
+
+	actual, _ := scannedMessages.LoadOrStore(t, sm)
+	return actual.(*scannedMessage)
+}
+
+// Lists the fields in the wire encoded data that the message type t has
+// no field for, and the enum fields holding values the enum doesn't
+// define. Malformed data is left for the real decoder to report.
+func unknownFields(t reflect.Type, data []byte) []string {
+	var found []string
+	scanUnknownFields(t, data, "", 0, &found)
+	return found
+}
+
+func scanUnknownFields(t reflect.Type, data []byte, path string, depth int, found *[]string) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || depth > maxUnknownFieldDepth {
+		return
+	}
+
+	sm := scannedMessageFor(t)
+
+	for len(data) > 0 {
+		key, n := proto.DecodeVarint(data)
+		if n == 0 {
+			return
+		}
+
+		data = data[n:]
+
+		tag, wire := int(key>>3), int(key&7)
+
+		f, known := sm.fields[tag]
+
+		if !known {
+			*found = append(*found, fmt.Sprintf("%s: unknown field %s%d", sm.name, path, tag))
+		}
+
+		switch wire {
+		case proto.WireVarint:
+			v, n := proto.DecodeVarint(data)
+			if n == 0 {
+				return
+			}
+
+			data = data[n:]
+
+			if known && f.values != nil {
+				checkEnumValue(f, int32(v), path, found)
+			}
+		case proto.WireFixed64:
+			if len(data) < 8 {
+				return
+			}
+
+			data = data[8:]
+		case proto.WireFixed32:
+			if len(data) < 4 {
+				return
+			}
+
+			data = data[4:]
+		case proto.WireBytes:
+			l, n := proto.DecodeVarint(data)
+			if n == 0 || uint64(len(data)-n) < l {
+				return
+			}
+
+			val := data[n : n+int(l)]
+			data = data[n+int(l):]
+
+			if !known {
+				continue
+			}
+
+			// Packed repeated enums.
+			if f.values != nil {
+				for len(val) > 0 {
+					v, n := proto.DecodeVarint(val)
+					if n == 0 {
+						break
+					}
+
+					val = val[n:]
+					checkEnumValue(f, int32(v), path, found)
+				}
+
+				continue
+			}
+
+			if f.msg != nil {
+				scanUnknownFields(f.msg, val, path+f.name+".", depth+1, found)
+			}
+		default:
+			// Groups aren't used by proto3, so the rest can't be scanned.
+			return
+		}
+	}
+}
+
+func checkEnumValue(f *scannedField, v int32, path string, found *[]string) {
+	if !f.values[v] {
+		*found = append(*found, fmt.Sprintf("%s%s: unknown %s value %d", path, f.name, f.enum, v))
+	}
+}
+
+// The unknown fields found in the last message received by an RPC, until
+// the interceptors pick them up. Each RPC has its own, in its context.
+type requestUnknownFields struct {
+	mu    sync.Mutex
+	msg   interface{}
+	found []string
+}
+
+func (r *requestUnknownFields) record(msg interface{}, found []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.msg = msg
+	r.found = found
+}
+
+func (r *requestUnknownFields) take(msg interface{}) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.msg != msg {
+		return nil
+	}
+
+	found := r.found
+
+	r.msg = nil
+	r.found = nil
+
+	return found
+}
+
+type requestUnknownFieldsKey struct{}
+
+// Scans each request message as it's received, with the bytes it was
+// decoded from, noting any unknown fields in the RPC's context for the
+// interceptors.
+type unknownFieldsStatsHandler struct{}
+
+func (unknownFieldsStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, requestUnknownFieldsKey{}, &requestUnknownFields{})
+}
+
+func (unknownFieldsStatsHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	in, ok := rs.(*stats.InPayload)
+	if !ok || in.Client {
+		return
+	}
+
+	rf, ok := ctx.Value(requestUnknownFieldsKey{}).(*requestUnknownFields)
+	if !ok {
+		return
+	}
+
+	if found := unknownFields(reflect.TypeOf(in.Payload), in.Data); len(found) > 0 {
+		rf.record(in.Payload, found)
+	}
+}
+
+func (unknownFieldsStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (unknownFieldsStatsHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+// UnknownFieldsStatsHandler returns the stats handler to serve gRPC with,
+// via grpc.StatsHandler, so that UnaryUnknownFieldsInterceptor and
+// StreamUnknownFieldsInterceptor can apply ServerConfig.UnknownFields.
+// All three must be installed together.
+func (s *Server) UnknownFieldsStatsHandler() stats.Handler {
+	return unknownFieldsStatsHandler{}
+}
+
+func (s *Server) checkUnknownFields(ctx context.Context, method string, msg interface{}) error {
+	rf, ok := ctx.Value(requestUnknownFieldsKey{}).(*requestUnknownFields)
+	if !ok {
+		return nil
+	}
+
+	found := rf.take(msg)
+	if len(found) == 0 {
+		return nil
+	}
+
+	s.m.IncrCounterWithLabels([]string{"grpc", "unknown_fields"}, 1, []metrics.Label{
+		{Name: "method", Value: method},
+	})
+
+	mode := s.config().UnknownFields
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(UnknownFieldsMetadataKey); len(vals) > 0 && vals[0] == "strict" {
+			mode = UnknownFieldsStrict
+		}
+	}
+
+	if mode == UnknownFieldsStrict {
+		return status.Errorf(codes.InvalidArgument, "request contains unsupported data: %s", strings.Join(found, "; "))
+	}
+
+	s.logger(ctx).Warn("ignoring unknown data in request", "method", method, "unknown", found)
+
+	return nil
+}
+
+// UnaryUnknownFieldsInterceptor applies ServerConfig.UnknownFields to
+// requests scanned by UnknownFieldsStatsHandler.
+func (s *Server) UnaryUnknownFieldsInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.checkUnknownFields(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Checks each message received on the stream for unknown fields.
+type unknownFieldsStream struct {
+	grpc.ServerStream
+	s      *Server
+	method string
+}
+
+func (u *unknownFieldsStream) RecvMsg(m interface{}) error {
+	err := u.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	return u.s.checkUnknownFields(u.Context(), u.method, m)
+}
+
+// StreamUnknownFieldsInterceptor applies ServerConfig.UnknownFields to the
+// messages of streams scanned by UnknownFieldsStatsHandler.
+func (s *Server) StreamUnknownFieldsInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &unknownFieldsStream{
+		ServerStream: ss,
+		s:            s,
+		method:       info.FullMethod,
+	})
+}
@@ -0,0 +1,141 @@
+package control
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/armon/go-metrics"
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// Appends a varint field numbered tag to data.
+func withVarintField(data []byte, tag int, v uint64) []byte {
+	data = append(data, proto.EncodeVarint(uint64(tag)<<3|proto.WireVarint)...)
+	return append(data, proto.EncodeVarint(v)...)
+}
+
+// Appends a length delimited field numbered tag to data.
+func withBytesField(data []byte, tag int, v []byte) []byte {
+	data = append(data, proto.EncodeVarint(uint64(tag)<<3|proto.WireBytes)...)
+	data = append(data, proto.EncodeVarint(uint64(len(v)))...)
+	return append(data, v...)
+}
+
+func TestUnknownFields(t *testing.T) {
+	account := &pb.Account{
+		Namespace: "/",
+		AccountId: pb.NewULID(),
+	}
+
+	req := &pb.ListServicesRequest{Account: account}
+
+	data, err := req.Marshal()
+	require.NoError(t, err)
+
+	reqType := reflect.TypeOf(req)
+
+	t.Run("finds nothing in messages the server knows", func(t *testing.T) {
+		assert.Empty(t, unknownFields(reqType, data))
+
+		// The fields of each type are only worked out once.
+		assert.True(t, scannedMessageFor(reqType.Elem()) == scannedMessageFor(reqType.Elem()))
+	})
+
+	t.Run("finds unknown top level fields", func(t *testing.T) {
+		found := unknownFields(reqType, withVarintField(data, 99, 1))
+		require.Len(t, found, 1)
+		assert.Contains(t, found[0], "99")
+	})
+
+	t.Run("finds unknown fields of nested messages", func(t *testing.T) {
+		acc, err := account.Marshal()
+		require.NoError(t, err)
+
+		nested := withBytesField(nil, 1, withVarintField(acc, 42, 7))
+
+		found := unknownFields(reqType, nested)
+		require.Len(t, found, 1)
+		assert.Contains(t, found[0], "account.42")
+	})
+
+	t.Run("finds undefined enum values", func(t *testing.T) {
+		body, err := (&pb.Token_Body{Role: pb.TokenRole(9)}).Marshal()
+		require.NoError(t, err)
+
+		found := unknownFields(reflect.TypeOf(&pb.Token_Body{}), body)
+		require.Len(t, found, 1)
+		assert.Contains(t, found[0], "role")
+	})
+
+	t.Run("applies the configured mode in the interceptor", func(t *testing.T) {
+		var s Server
+		s.L = hclog.L()
+		s.m, _ = metrics.New(metrics.DefaultConfig("test"), &metrics.BlackholeSink{})
+
+		h := s.UnknownFieldsStatsHandler()
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.ControlServices/ListServices"}
+
+		raw := withVarintField(data, 99, 1)
+
+		// Receives the request in a new RPC, returning the RPC's context.
+		receive := func(ctx context.Context, in *pb.ListServicesRequest) context.Context {
+			ctx = h.TagRPC(ctx, &stats.RPCTagInfo{FullMethodName: info.FullMethod})
+
+			require.NoError(t, in.Unmarshal(raw))
+			assert.True(t, account.Equal(in.Account))
+
+			h.HandleRPC(ctx, &stats.InPayload{Payload: in, Data: raw, Length: len(raw)})
+
+			return ctx
+		}
+
+		intercept := func(ctx context.Context, in *pb.ListServicesRequest) error {
+			_, err := s.UnaryUnknownFieldsInterceptor(ctx, in, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				})
+
+			return err
+		}
+
+		call := func(ctx context.Context) error {
+			var in pb.ListServicesRequest
+			return intercept(receive(ctx, &in), &in)
+		}
+
+		require.NoError(t, call(context.Background()))
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UnknownFieldsMetadataKey, "strict"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(call(ctx)))
+
+		s.cfg.UnknownFields = UnknownFieldsStrict
+		assert.Equal(t, codes.InvalidArgument, status.Code(call(context.Background())))
+
+		// The findings belong to the RPC that received the request.
+		var in pb.ListServicesRequest
+
+		receive(context.Background(), &in)
+
+		other := h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: info.FullMethod})
+		assert.NoError(t, intercept(other, &in))
+	})
+
+	t.Run("parses modes", func(t *testing.T) {
+		m, err := ParseUnknownFieldMode("strict")
+		require.NoError(t, err)
+		assert.Equal(t, UnknownFieldsStrict, m)
+
+		_, err = ParseUnknownFieldMode("loose")
+		assert.Error(t, err)
+	})
+}
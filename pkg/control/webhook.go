@@ -0,0 +1,78 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var WebhookTimeout = 10 * time.Second
+
+// A WebhookEvent is posted as JSON to ServerConfig.WebhookURL.
+type WebhookEvent struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	Namespace string `json:"namespace,omitempty"`
+	Account   string `json:"account,omitempty"`
+
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// Deliver ev to the configured webhook in the background. Delivery is best
+// effort, failures are logged.
+func (s *Server) sendWebhook(ev *WebhookEvent) {
+	url := s.config().WebhookURL
+	if url == "" {
+		return
+	}
+
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	go func() {
+		err := postWebhook(s.bg, url, ev)
+		if err != nil {
+			s.L.Error("error delivering webhook", "type", ev.Type, "error", err)
+			s.m.IncrCounter([]string{"webhook", "failed"}, 1)
+			return
+		}
+
+		s.m.IncrCounter([]string{"webhook", "delivered"}, 1)
+	}()
+}
+
+func postWebhook(ctx context.Context, url string, ev *WebhookEvent) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, WebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package control
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errLabelLinkExists = errors.Wrapf(ErrInvalidRequest, "label link already exists for labels")
+
+// The largest weight a label link may have, which is what the weight column
+// can hold.
+const MaxLabelLinkWeight = math.MaxInt32
+
+// Store llr according to mode. An unweighted link is the only link for its
+// labels, so it conflicts with any existing link for them and replaces all of
+// them on update. A weighted link conflicts with an unweighted link for its
+// labels, which it replaces on update, and with a weighted link to the same
+// target, whose weight it changes on update.
+func (s *Server) saveLabelLink(ctx context.Context, llr *LabelLink, mode pb.AddLabelLinkRequest_ConflictMode) error {
+	switch mode {
+	case pb.LINK_CONFLICT_ERROR, pb.LINK_CONFLICT_UPDATE:
+	default:
+		return errors.Wrapf(ErrInvalidRequest, "unknown conflict mode: %s", mode)
+	}
+
+	tx := s.db.Begin()
+
+	// The unique indexes don't keep a weighted and an unweighted link for
+	// the same labels apart, and the cycle check reads all of the account's
+	// links, so writers of an account's links take turns.
+	err := lockXact(tx, labelLinksLockKey(llr.AccountID))
+	if err == nil {
+		err = s.checkLabelLinkCycle(ctx, tx, llr, mode)
+	}
+
+	if err == nil {
+		err = saveLabelLink(tx, llr, mode)
+	}
+
+	if err == nil {
+		err = s.checkLabelLinkLimit(tx, llr.AccountID)
+	}
+
+	if err != nil {
+		tx.Rollback()
+
+		if dbx.IsUniqueViolation(err) {
+			return errLabelLinkExists
+		}
+
+		return err
+	}
+
+	return dbx.Check(tx.Commit())
+}
+
+// Save llr in tx, which must hold the account's label links lock.
+func saveLabelLink(tx *gorm.DB, llr *LabelLink, mode pb.AddLabelLinkRequest_ConflictMode) error {
+	if mode == pb.LINK_CONFLICT_ERROR {
+		// The links this one can't coexist with.
+		conflicts := tx.Model(&LabelLink{}).
+			Where("account_id = ?", llr.AccountID).
+			Where("labels = ?", llr.Labels)
+
+		if llr.Weight != nil {
+			conflicts = conflicts.Where("weight IS NULL OR target = ?", llr.Target)
+		}
+
+		var count int
+
+		err := dbx.Check(conflicts.Count(&count))
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return errLabelLinkExists
+		}
+
+		return dbx.Check(tx.Create(llr))
+	}
+
+	// Updates keep the row of a link that stays, so that it keeps its place
+	// under ServerConfig.MaxLabelLinksPerAccount.
+	if llr.Weight == nil {
+		err := dbx.Check(tx.
+			Where("account_id = ?", llr.AccountID).
+			Where("labels = ?", llr.Labels).
+			Where("weight IS NOT NULL").
+			Delete(&LabelLink{}),
+		)
+		if err != nil {
+			return err
+		}
+
+		return dbx.Check(tx.Exec(
+			`INSERT INTO label_links (account_id, labels, target) VALUES (?, ?, ?)
+			 ON CONFLICT (account_id, labels) WHERE weight IS NULL
+			 DO UPDATE SET target = EXCLUDED.target, updated_at = now()`,
+			llr.AccountID, llr.Labels, llr.Target,
+		))
+	}
+
+	err := dbx.Check(tx.
+		Where("account_id = ?", llr.AccountID).
+		Where("labels = ?", llr.Labels).
+		Where("weight IS NULL").
+		Delete(&LabelLink{}),
+	)
+	if err != nil {
+		return err
+	}
+
+	return dbx.Check(tx.Exec(
+		`INSERT INTO label_links (account_id, labels, target, weight) VALUES (?, ?, ?, ?)
+		 ON CONFLICT (account_id, labels, target)
+		 DO UPDATE SET weight = EXCLUDED.weight, updated_at = now()`,
+		llr.AccountID, llr.Labels, llr.Target, *llr.Weight,
+	))
+}
+
+// Fails with ResourceExhausted when the account has more label links in tx
+// than ServerConfig.MaxLabelLinksPerAccount, so that a save which adds a
+// link over the limit is rolled back rather than left unpublished.
+func (s *Server) checkLabelLinkLimit(tx *gorm.DB, accountID []byte) error {
+	max := s.config().MaxLabelLinksPerAccount
+	if max <= 0 {
+		return nil
+	}
+
+	var count int
+
+	err := dbx.Check(tx.Model(&LabelLink{}).Where("account_id = ?", accountID).Count(&count))
+	if err != nil {
+		return err
+	}
+
+	if count > max {
+		s.m.IncrCounter([]string{"label_links", "rejected"}, 1)
+		return status.Errorf(codes.ResourceExhausted, "account has too many label links (limit %d)", max)
+	}
+
+	return nil
+}
+
+// The advisory lock serializing writes to the label links of an account.
+func labelLinksLockKey(accountID []byte) string {
+	return "label-links:" + hex.EncodeToString(accountID)
+}
+
+// All the links of account for labels, as published to hubs.
+func (s *Server) labelLinksFor(account *pb.Account, labels *pb.LabelSet, limits *pb.Account_Limits) (*pb.LabelLinks, error) {
+	var lls []*LabelLink
+
+	err := dbx.Check(s.db.
+		Where("account_id = ?", account.Key()).
+		Where("labels = ?", FlattenLabels(labels)).
+		Order("id ASC").
+		Find(&lls),
+	)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	var out pb.LabelLinks
+
+	for _, ll := range lls {
+		out.LabelLinks = append(out.LabelLinks, ll.toPB(account, limits))
+	}
+
+	return &out, nil
+}
+
+func (ll *LabelLink) toPB(account *pb.Account, limits *pb.Account_Limits) *pb.LabelLink {
+	out := &pb.LabelLink{
+		Account: account,
+		Labels:  ExplodeLabels(ll.Labels),
+		Target:  ExplodeLabels(ll.Target),
+		Limits:  limits,
+	}
+
+	if ll.Weight != nil {
+		out.Weighted = true
+		out.Weight = uint32(*ll.Weight)
+	}
+
+	return out
+}
+
+// Select the link to route over from the links matching a flow's labels.
+// When there is a single unweighted link it's always selected. Otherwise
+// each link is selected with a probability of its weight divided by the sum
+// of the weights, an unweighted link counting as a weight of 1, using n to
+// draw a random number in [0, sum). Links with a weight of 0 are never
+// selected, so nil is returned when every link has one.
+//
+// The split holds only on average over many flows, as each flow is
+// selected independently and hubs don't coordinate with each other.
+func pickLabelLink(lls []*pb.LabelLink, n func(int64) int64) *pb.LabelLink {
+	if len(lls) == 1 && !lls[0].Weighted {
+		return lls[0]
+	}
+
+	var total int64
+
+	for _, ll := range lls {
+		total += labelLinkWeight(ll)
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	pick := n(total)
+
+	for _, ll := range lls {
+		w := labelLinkWeight(ll)
+		if pick < w {
+			return ll
+		}
+
+		pick -= w
+	}
+
+	return nil
+}
+
+func labelLinkWeight(ll *pb.LabelLink) int64 {
+	if !ll.Weighted {
+		return 1
+	}
+
+	return int64(ll.Weight)
+}
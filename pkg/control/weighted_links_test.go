@@ -0,0 +1,147 @@
+package control
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedLabelLinks(t *testing.T) {
+	account := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+	label := pb.ParseLabelSet(":hostname=www.test")
+
+	link := func(target string, weight uint32) *pb.LabelLink {
+		return &pb.LabelLink{
+			Account:  account,
+			Labels:   label,
+			Target:   pb.ParseLabelSet(target),
+			Weighted: true,
+			Weight:   weight,
+		}
+	}
+
+	t.Run("splits traffic by weight", func(t *testing.T) {
+		lls := []*pb.LabelLink{
+			link("service=www,env=prod", 90),
+			link("service=www,env=canary", 10),
+		}
+
+		rng := rand.New(rand.NewSource(1))
+
+		counts := make(map[string]int)
+
+		for i := 0; i < 10000; i++ {
+			ll := pickLabelLink(lls, rng.Int63n)
+			require.NotNil(t, ll)
+
+			counts[ll.Target.SpecString()]++
+		}
+
+		assert.InDelta(t, 9000, counts["env=prod,service=www"], 300)
+		assert.InDelta(t, 1000, counts["env=canary,service=www"], 300)
+	})
+
+	t.Run("never picks a zero weight link", func(t *testing.T) {
+		lls := []*pb.LabelLink{
+			link("service=www,env=prod", 0),
+			link("service=www,env=canary", 1),
+		}
+
+		for i := int64(0); i < 10; i++ {
+			ll := pickLabelLink(lls, func(n int64) int64 { return i % n })
+			assert.Equal(t, "env=canary,service=www", ll.Target.SpecString())
+		}
+
+		lls[1].Weight = 0
+
+		assert.Nil(t, pickLabelLink(lls, rand.Int63n))
+	})
+
+	t.Run("always picks a single unweighted link", func(t *testing.T) {
+		ll := &pb.LabelLink{Account: account, Labels: label, Target: pb.ParseLabelSet("service=www")}
+
+		assert.Equal(t, ll, pickLabelLink([]*pb.LabelLink{ll}, nil))
+	})
+
+	t.Run("broadcasts replace the recent links for their labels", func(t *testing.T) {
+		other := &pb.LabelLink{
+			Account: account,
+			Labels:  pb.ParseLabelSet(":hostname=api.test"),
+			Target:  pb.ParseLabelSet("service=api"),
+		}
+
+		recent := []*pb.LabelLink{link("service=www,env=prod", 100), other}
+
+		recent = mergeRecentLabelLinks(recent, []*pb.LabelLink{
+			link("service=www,env=prod", 90),
+			link("service=www,env=canary", 10),
+		})
+
+		require.Len(t, recent, 3)
+
+		assert.Equal(t, other, recent[0])
+		assert.Equal(t, uint32(90), recent[1].Weight)
+		assert.Equal(t, uint32(10), recent[2].Weight)
+	})
+
+	t.Run("stores weighted links next to each other", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "hzn")
+		defer db.Close()
+
+		var s Server
+		s.L = hclog.L()
+		s.db = db
+
+		err := dbx.Check(db.Create(&Account{ID: account.Key(), Namespace: account.Namespace}))
+		require.NoError(t, err)
+
+		weight := func(w int64) *int64 { return &w }
+
+		save := func(target string, w *int64, mode pb.AddLabelLinkRequest_ConflictMode) error {
+			return s.saveLabelLink(context.Background(), &LabelLink{
+				AccountID: account.Key(),
+				Labels:    FlattenLabels(label),
+				Target:    FlattenLabels(pb.ParseLabelSet(target)),
+				Weight:    w,
+			}, mode)
+		}
+
+		require.NoError(t, save("service=www,env=prod", weight(90), pb.LINK_CONFLICT_ERROR))
+		require.NoError(t, save("service=www,env=canary", weight(10), pb.LINK_CONFLICT_ERROR))
+
+		err = save("service=www,env=canary", weight(20), pb.LINK_CONFLICT_ERROR)
+		assert.Equal(t, errLabelLinkExists, err)
+
+		err = save("service=www,env=test", nil, pb.LINK_CONFLICT_ERROR)
+		assert.Equal(t, errLabelLinkExists, err)
+
+		require.NoError(t, save("service=www,env=canary", weight(20), pb.LINK_CONFLICT_UPDATE))
+
+		out, err := s.labelLinksFor(account, label, &pb.Account_Limits{})
+		require.NoError(t, err)
+
+		require.Len(t, out.LabelLinks, 2)
+
+		assert.True(t, out.LabelLinks[0].Weighted)
+		assert.Equal(t, uint32(90), out.LabelLinks[0].Weight)
+		assert.Equal(t, uint32(20), out.LabelLinks[1].Weight)
+
+		// An unweighted link replaces the split.
+		require.NoError(t, save("service=www,env=test", nil, pb.LINK_CONFLICT_UPDATE))
+
+		out, err = s.labelLinksFor(account, label, &pb.Account_Limits{})
+		require.NoError(t, err)
+
+		require.Len(t, out.LabelLinks, 1)
+
+		assert.False(t, out.LabelLinks[0].Weighted)
+		assert.Equal(t, "env=test,service=www", out.LabelLinks[0].Target.SpecString())
+	})
+}
@@ -0,0 +1,13 @@
+package control
+
+import (
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/jinzhu/gorm"
+)
+
+// Take a transaction scoped advisory lock on key, held until tx commits or
+// rolls back. Writes that check the existing rows before inserting take one
+// so that concurrent writers can't both pass the check.
+func lockXact(tx *gorm.DB, key string) error {
+	return dbx.Check(tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key))
+}
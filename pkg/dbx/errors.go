@@ -0,0 +1,28 @@
+package dbx
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// The postgres error code for an insert or update that violates a unique
+// constraint.
+const uniqueViolation = "23505"
+
+// IsUniqueViolation reports whether err, or any error it wraps, is postgres
+// rejecting a row that violates a unique constraint.
+func IsUniqueViolation(err error) bool {
+	switch v := errors.Cause(err).(type) {
+	case *pq.Error:
+		return v.Code == uniqueViolation
+	case *multierror.Error:
+		for _, e := range v.Errors {
+			if IsUniqueViolation(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
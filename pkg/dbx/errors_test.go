@@ -0,0 +1,23 @@
+package dbx
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueViolation(t *testing.T) {
+	t.Run("detects wrapped unique violations", func(t *testing.T) {
+		perr := &pq.Error{Code: "23505"}
+
+		assert.True(t, IsUniqueViolation(perr))
+		assert.True(t, IsUniqueViolation(errors.Wrapf(perr, "adding label link")))
+		assert.True(t, IsUniqueViolation(multierror.Append(nil, errors.New("other"), perr)))
+
+		assert.False(t, IsUniqueViolation(&pq.Error{Code: "57014"}))
+		assert.False(t, IsUniqueViolation(errors.New("other")))
+	})
+}
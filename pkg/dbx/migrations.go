@@ -0,0 +1,108 @@
+package dbx
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Statements that lose data when run, and so make a rollback one way.
+var destructiveRegexp = regexp.MustCompile(`(?i)\b(DROP\s+(TABLE|COLUMN|TYPE)|DELETE\s+FROM|TRUNCATE)\b`)
+
+// DestructiveStatements returns the statements in sql that drop tables,
+// columns or types, or delete rows.
+func DestructiveStatements(sql string) []string {
+	var out []string
+
+	for _, stmt := range strings.Split(sql, ";") {
+		stmt = strings.TrimSpace(stmt)
+		if destructiveRegexp.MatchString(stmt) {
+			out = append(out, stmt)
+		}
+	}
+
+	return out
+}
+
+// A DownMigration is the file that rolls back a single migration version.
+type DownMigration struct {
+	Version uint
+	Path    string
+}
+
+// DownMigrations returns the down migrations in dir, in the golang-migrate
+// layout of <version>_<name>.{up,down}.sql, for the n newest versions at
+// or below current, newest first. It fails if any of those versions has no
+// down migration, as rolling back past it would leave the schema in an
+// unknown state.
+func DownMigrations(dir string, current uint, n int) ([]DownMigration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	downs := map[uint]string{}
+
+	for _, path := range paths {
+		base := filepath.Base(path)
+
+		idx := strings.IndexByte(base, '_')
+		if idx == -1 {
+			continue
+		}
+
+		ver, err := strconv.ParseUint(base[:idx], 10, 64)
+		if err != nil || uint(ver) > current {
+			continue
+		}
+
+		if _, ok := downs[uint(ver)]; !ok {
+			downs[uint(ver)] = ""
+		}
+
+		if strings.HasSuffix(base, ".down.sql") {
+			downs[uint(ver)] = path
+		}
+	}
+
+	var versions []uint
+
+	for ver := range downs {
+		versions = append(versions, ver)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] > versions[j]
+	})
+
+	if len(versions) < n {
+		return nil, errors.Errorf("only %d migrations at or below version %d", len(versions), current)
+	}
+
+	var migs []DownMigration
+
+	for _, ver := range versions[:n] {
+		if downs[ver] == "" {
+			return nil, errors.Errorf("version %d has no down migration", ver)
+		}
+
+		migs = append(migs, DownMigration{Version: ver, Path: downs[ver]})
+	}
+
+	return migs, nil
+}
+
+// Destructive returns the destructive statements in the migration's file.
+func (d DownMigration) Destructive() ([]string, error) {
+	data, err := ioutil.ReadFile(d.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return DestructiveStatements(string(data)), nil
+}
@@ -0,0 +1,71 @@
+package dbx
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations(t *testing.T) {
+	t.Run("finds destructive statements", func(t *testing.T) {
+		sql := `ALTER TABLE hubs ADD COLUMN foo text;
+drop table if exists hubs;
+ALTER TABLE accounts DROP COLUMN data;
+DROP INDEX IF EXISTS hub_instance_id;
+DELETE FROM jobs WHERE status = 'dead';`
+
+		assert.Equal(t, []string{
+			"drop table if exists hubs",
+			"ALTER TABLE accounts DROP COLUMN data",
+			"DELETE FROM jobs WHERE status = 'dead'",
+		}, DestructiveStatements(sql))
+
+		assert.Empty(t, DestructiveStatements("DROP INDEX IF EXISTS hub_instance_id;"))
+	})
+
+	dir, err := ioutil.TempDir("", "hzn")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		"000001_create_hubs.up.sql",
+		"000001_create_hubs.down.sql",
+		"000002_add_index.up.sql",
+		"000003_add_column.up.sql",
+		"000003_add_column.down.sql",
+		"000004_create_jobs.up.sql",
+		"000004_create_jobs.down.sql",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0644))
+	}
+
+	t.Run("lists the newest down migrations first", func(t *testing.T) {
+		migs, err := DownMigrations(dir, 4, 2)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(migs))
+
+		assert.Equal(t, uint(4), migs[0].Version)
+		assert.Equal(t, filepath.Join(dir, "000004_create_jobs.down.sql"), migs[0].Path)
+		assert.Equal(t, uint(3), migs[1].Version)
+	})
+
+	t.Run("starts at the current version", func(t *testing.T) {
+		migs, err := DownMigrations(dir, 3, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(3), migs[0].Version)
+	})
+
+	t.Run("refuses to roll back past a missing down migration", func(t *testing.T) {
+		_, err := DownMigrations(dir, 4, 3)
+		require.Error(t, err)
+
+		_, err = DownMigrations(dir, 4, 5)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package dbx
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// The postgres error code for a statement canceled because it ran past
+// statement_timeout.
+const queryCanceled = "57014"
+
+// WithStatementTimeout returns a copy of connection string u that sets
+// statement_timeout for every connection opened with it. The setting is
+// passed as a startup parameter rather than via SET so that it applies to
+// the whole connection pool, not just the connection the SET happened to
+// run on. A zero duration returns u unchanged.
+func WithStatementTimeout(u string, d time.Duration) (string, error) {
+	if d <= 0 {
+		return u, nil
+	}
+
+	ms := strconv.FormatInt(int64(d/time.Millisecond), 10)
+
+	if !strings.HasPrefix(u, "postgres://") && !strings.HasPrefix(u, "postgresql://") {
+		return u + " statement_timeout=" + ms, nil
+	}
+
+	pu, err := url.Parse(u)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing database url")
+	}
+
+	q := pu.Query()
+	q.Set("statement_timeout", ms)
+	pu.RawQuery = q.Encode()
+
+	return pu.String(), nil
+}
+
+// IsStatementTimeout reports whether err, or any error it wraps, is
+// postgres canceling a statement that exceeded statement_timeout.
+func IsStatementTimeout(err error) bool {
+	switch v := errors.Cause(err).(type) {
+	case *pq.Error:
+		return v.Code == queryCanceled
+	case *multierror.Error:
+		for _, e := range v.Errors {
+			if IsStatementTimeout(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package dbx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementTimeout(t *testing.T) {
+	t.Run("adds the timeout to a url", func(t *testing.T) {
+		u, err := WithStatementTimeout("postgres://localhost/hzn?sslmode=disable", 5*time.Second)
+		require.NoError(t, err)
+
+		assert.Equal(t, "postgres://localhost/hzn?sslmode=disable&statement_timeout=5000", u)
+	})
+
+	t.Run("adds the timeout to a key/value string", func(t *testing.T) {
+		u, err := WithStatementTimeout("host=localhost dbname=hzn", 250*time.Millisecond)
+		require.NoError(t, err)
+
+		assert.Equal(t, "host=localhost dbname=hzn statement_timeout=250", u)
+	})
+
+	t.Run("leaves the url alone without a timeout", func(t *testing.T) {
+		u, err := WithStatementTimeout("postgres://localhost/hzn", 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, "postgres://localhost/hzn", u)
+	})
+
+	t.Run("detects wrapped timeout errors", func(t *testing.T) {
+		perr := &pq.Error{Code: "57014"}
+
+		assert.True(t, IsStatementTimeout(perr))
+		assert.True(t, IsStatementTimeout(errors.Wrapf(perr, "listing accounts")))
+		assert.True(t, IsStatementTimeout(multierror.Append(nil, errors.New("other"), perr)))
+
+		assert.False(t, IsStatementTimeout(&pq.Error{Code: "23505"}))
+		assert.False(t, IsStatementTimeout(errors.New("other")))
+	})
+}
@@ -20,6 +20,14 @@ import (
 type Client struct {
 	URL string
 
+	// Sent in the account query parameter of discovery requests, letting
+	// control keep the agents of an account on the same hubs. Optional.
+	Account string
+
+	// The capabilities the hubs handed out must advertise, sent as
+	// capability query parameters. Optional.
+	Capabilities []string
+
 	mu sync.Mutex
 
 	location []*pb.NetworkLocation
@@ -94,11 +102,17 @@ func (c *Client) Refresh(ctx context.Context) error {
 		},
 	}
 
-	resp, err := client.Get(c.URL)
+	resp, err := client.Get(c.requestURL())
 	if err != nil {
 		return err
 	}
 
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return ErrNoCapacity
+	}
+
 	var dd DiscoveryData
 
 	err = json.NewDecoder(resp.Body).Decode(&dd)
@@ -124,6 +138,33 @@ func (c *Client) Refresh(ctx context.Context) error {
 	return nil
 }
 
+// The discovery URL with the account and capabilities the hubs are
+// requested for.
+func (c *Client) requestURL() string {
+	if c.Account == "" && len(c.Capabilities) == 0 {
+		return c.URL
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return c.URL
+	}
+
+	q := u.Query()
+
+	if c.Account != "" {
+		q.Set("account", c.Account)
+	}
+
+	for _, capa := range c.Capabilities {
+		q.Add("capability", capa)
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
 func (c *Client) backgroundRefresh(ctx context.Context) {
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
@@ -181,6 +222,7 @@ func (c *Client) search(ctx context.Context) (chan *pb.NetworkLocation, error) {
 		Local:      c.location,
 		Remote:     c.lastData.Hubs,
 		PublicOnly: true,
+		KeepOrder:  c.lastData.Ordered,
 		Latency: func(addr string) error {
 			resp, err := client.Get(fmt.Sprintf("http://%s/__hzn/healthz", addr))
 			if err == nil {
@@ -7,14 +7,33 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
 )
 
 const HTTPPath = "/.well-known/horizon/hubs.json"
 
+// ErrNoCapacity is returned by GetAllNetworkLocations when there are hubs
+// but every one of them is at its flow limit, and by retrying
+// implementations when none are registered either. Clients should retry
+// later.
+var ErrNoCapacity = errors.New("no hub has capacity available")
+
+// ErrNoCompatibleHub is returned by GetNetworkLocationsFor when no hub
+// supports the capabilities the client requires.
+var ErrNoCompatibleHub = errors.New("no hub supports the required capabilities")
+
 type GetNetlocs interface {
 	GetAllNetworkLocations() ([]*pb.NetworkLocation, error)
 }
 
+// RequestNetlocs is implemented by a GetNetlocs that tailors the locations
+// it returns to the requesting client. WellKnown uses it when available.
+// ordered reports that the locations are most preferred first, and that
+// clients should try them in that order rather than by latency.
+type RequestNetlocs interface {
+	GetNetworkLocationsFor(req *http.Request) (locs []*pb.NetworkLocation, ordered bool, err error)
+}
+
 type WellKnown struct {
 	L          hclog.Logger
 	GetNetlocs GetNetlocs
@@ -23,10 +42,39 @@ type WellKnown struct {
 type DiscoveryData struct {
 	ServerTime time.Time             `json:"server_time"`
 	Hubs       []*pb.NetworkLocation `json:"hubs"`
+
+	// Set when Hubs are listed most preferred first. Clients then keep
+	// that order, only skipping hubs they can't reach, instead of
+	// reordering them by latency.
+	Ordered bool `json:"ordered,omitempty"`
 }
 
 func (wk *WellKnown) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	netlocs, err := wk.GetNetlocs.GetAllNetworkLocations()
+	var (
+		netlocs []*pb.NetworkLocation
+		ordered bool
+		err     error
+	)
+
+	if rn, ok := wk.GetNetlocs.(RequestNetlocs); ok {
+		netlocs, ordered, err = rn.GetNetworkLocationsFor(req)
+	} else {
+		netlocs, err = wk.GetNetlocs.GetAllNetworkLocations()
+	}
+
+	if err == ErrNoCapacity {
+		w.Header().Set("Retry-After", "10")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	// Hubs gain capabilities as they're upgraded, so this also passes.
+	if err == ErrNoCompatibleHub {
+		w.Header().Set("Retry-After", "60")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
 	if err != nil {
 		wk.L.Error("error getting network locations for well-known", "error", err)
 		http.Error(w, "unable to find network locations", http.StatusInternalServerError)
@@ -35,6 +83,7 @@ func (wk *WellKnown) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 	var dd DiscoveryData
 	dd.ServerTime = time.Now()
+	dd.Ordered = ordered
 
 	for _, loc := range netlocs {
 		if loc.IsPublic() {
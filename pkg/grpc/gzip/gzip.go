@@ -0,0 +1,114 @@
+// Package gzip provides a gzip grpc compressor that leaves small messages
+// uncompressed. Unlike the lz4 compressor it is not registered on import,
+// call Register to make it available.
+//
+// grpc-go servers reply using the compressor the client used for its
+// request, so only clients that opt into gzip get compressed responses.
+package gzip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the name registered for the gzip compressor.
+const Name = "gzip"
+
+// DefaultMinSize is the size, in bytes, below which messages are stored
+// rather than compressed.
+const DefaultMinSize = 1024
+
+var (
+	registerOnce sync.Once
+	minSize      int64 = DefaultMinSize
+)
+
+// Register registers the compressor with grpc. Messages smaller than min
+// bytes are written as stored gzip blocks, which any gzip reader accepts
+// but which skips the cost of compressing data that won't shrink much.
+func Register(min int) {
+	SetMinSize(min)
+
+	registerOnce.Do(func() {
+		encoding.RegisterCompressor(&compressor{})
+	})
+}
+
+// SetMinSize thread-safe sets the minimum size of compressed messages.
+func SetMinSize(min int) {
+	atomic.StoreInt64(&minSize, int64(min))
+}
+
+type compressor struct {
+	poolDecompressor sync.Pool
+}
+
+type writer struct {
+	bytes.Buffer
+	w io.Writer
+}
+
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &writer{w: w}, nil
+}
+
+// The message is buffered so that its size is known before picking the
+// compression level.
+func (z *writer) Close() error {
+	level := gzip.BestSpeed
+	if int64(z.Len()) < atomic.LoadInt64(&minSize) {
+		level = gzip.NoCompression
+	}
+
+	gw, err := gzip.NewWriterLevel(z.w, level)
+	if err != nil {
+		return err
+	}
+
+	_, err = z.WriteTo(gw)
+	if err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+type reader struct {
+	*gzip.Reader
+	pool *sync.Pool
+}
+
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	z, inPool := c.poolDecompressor.Get().(*reader)
+	if !inPool {
+		newZ, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return &reader{Reader: newZ, pool: &c.poolDecompressor}, nil
+	}
+
+	if err := z.Reset(r); err != nil {
+		c.poolDecompressor.Put(z)
+		return nil, err
+	}
+
+	return z, nil
+}
+
+func (z *reader) Read(p []byte) (n int, err error) {
+	if n, err = z.Reader.Read(p); err == io.EOF {
+		z.pool.Put(z)
+	}
+
+	return
+}
+
+func (c *compressor) Name() string {
+	return Name
+}
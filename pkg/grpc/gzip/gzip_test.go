@@ -0,0 +1,56 @@
+package gzip
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressor(t *testing.T) {
+	roundTrip := func(t *testing.T, data []byte) int {
+		var c compressor
+		var buf bytes.Buffer
+
+		w, err := c.Compress(&buf)
+		require.NoError(t, err)
+
+		_, err = w.Write(data)
+		require.NoError(t, err)
+
+		require.NoError(t, w.Close())
+
+		size := buf.Len()
+
+		r, err := c.Decompress(&buf)
+		require.NoError(t, err)
+
+		out, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, data, out)
+
+		return size
+	}
+
+	t.Run("compresses messages above the minimum size", func(t *testing.T) {
+		SetMinSize(100)
+		defer SetMinSize(DefaultMinSize)
+
+		data := []byte(strings.Repeat("horizon", 100))
+
+		assert.True(t, roundTrip(t, data) < len(data)/2)
+	})
+
+	t.Run("stores messages below the minimum size", func(t *testing.T) {
+		SetMinSize(10000)
+		defer SetMinSize(DefaultMinSize)
+
+		data := []byte(strings.Repeat("horizon", 100))
+
+		assert.True(t, roundTrip(t, data) > len(data))
+	})
+}
@@ -101,7 +101,11 @@ func (h *Hub) ConnectToService(
 
 	h.L.Trace("launching flow tracking goroutine for connect session", "id", flowId)
 
+	untrack := h.trackFlow(flowId)
+
 	go func() {
+		defer untrack()
+
 		start := time.Now()
 
 		var fs pb.FlowStream
@@ -127,6 +131,13 @@ func (h *Hub) ConnectToService(
 			case <-sub.Done():
 				exit = true
 				fs.EndedAt = pb.NewTimestamp(time.Now())
+				fs.EndReason = flowEndReason(ctx, nil)
+				switch {
+				case h.flowKilled(flowId):
+					fs.EndReason = pb.END_KILLED
+				case h.flowIdle(flowId):
+					fs.EndReason = pb.END_IDLE
+				}
 
 				h.L.Trace("closing connection session flow tracking", "id", flowId)
 
@@ -157,6 +168,17 @@ func (h *Hub) ConnectToService(
 
 	wrapped := wire.WithCloser(wctx, func() error { cancel(); return nil })
 
+	h.addFlowCloser(flowId, wrapped)
+
+	// Flows started here are held to the same idle timeout as the ones
+	// agents open.
+	if timeout := h.cc.FlowIdleTimeout(account); timeout > 0 {
+		go h.expireIdleFlow(sub, flowId, timeout, func() int64 {
+			ma, _ := wctx.Accounting()
+			return ma
+		})
+	}
+
 	return wrapped, nil
 }
 
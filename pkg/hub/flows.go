@@ -0,0 +1,125 @@
+package hub
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+type trackedFlow struct {
+	closers []io.Closer
+	killed  bool
+	idle    bool
+}
+
+// Track the resources of a flow so that it can be torn down by KillFlow.
+// The returned func stops tracking it.
+func (h *Hub) trackFlow(id *pb.ULID, closers ...io.Closer) func() {
+	key := id.SpecString()
+
+	h.flowMu.Lock()
+	h.flows[key] = &trackedFlow{closers: closers}
+	h.flowMu.Unlock()
+
+	return func() {
+		h.flowMu.Lock()
+		delete(h.flows, key)
+		h.flowMu.Unlock()
+	}
+}
+
+// Add another resource that must be closed to kill the flow.
+func (h *Hub) addFlowCloser(id *pb.ULID, c io.Closer) {
+	h.flowMu.Lock()
+	defer h.flowMu.Unlock()
+
+	if tf, ok := h.flows[id.SpecString()]; ok {
+		tf.closers = append(tf.closers, c)
+	}
+}
+
+func (h *Hub) flowKilled(id *pb.ULID) bool {
+	h.flowMu.Lock()
+	defer h.flowMu.Unlock()
+
+	tf, ok := h.flows[id.SpecString()]
+	return ok && tf.killed
+}
+
+func (h *Hub) flowIdle(id *pb.ULID) bool {
+	h.flowMu.Lock()
+	defer h.flowMu.Unlock()
+
+	tf, ok := h.flows[id.SpecString()]
+	return ok && tf.idle
+}
+
+// KillFlow tears down the flow by closing both sides of it. Returns false
+// if the flow is not running on this hub.
+func (h *Hub) KillFlow(id *pb.ULID) bool {
+	return h.closeFlow(id, "killing flow", func(tf *trackedFlow) { tf.killed = true })
+}
+
+func (h *Hub) closeFlow(id *pb.ULID, msg string, mark func(tf *trackedFlow)) bool {
+	h.flowMu.Lock()
+	tf, ok := h.flows[id.SpecString()]
+	if ok {
+		mark(tf)
+	}
+	h.flowMu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	h.L.Info(msg, "id", id)
+
+	for _, c := range tf.closers {
+		c.Close()
+	}
+
+	return true
+}
+
+// The shortest interval flows are checked for traffic at, however short
+// their idle timeout.
+const minIdleFlowCheck = 10 * time.Millisecond
+
+// Tear down the flow once the number of messages it has carried, as
+// reported by messages, hasn't changed for timeout. The flow is then
+// reported by flowIdle. Returns when the flow is torn down or ctx is done.
+func (h *Hub) expireIdleFlow(ctx context.Context, id *pb.ULID, timeout time.Duration, messages func() int64) {
+	interval := timeout / 4
+	if interval < minIdleFlowCheck {
+		interval = minIdleFlowCheck
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var (
+		last       = messages()
+		lastChange = time.Now()
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			cur := messages()
+			if cur != last {
+				last = cur
+				lastChange = now
+				continue
+			}
+
+			if now.Sub(lastChange) >= timeout {
+				h.closeFlow(id, "closing idle flow", func(tf *trackedFlow) { tf.idle = true })
+				return
+			}
+		}
+	}
+}
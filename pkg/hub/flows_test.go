@@ -0,0 +1,127 @@
+package hub
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type countCloser struct {
+	closed int
+}
+
+func (c *countCloser) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestFlows(t *testing.T) {
+	t.Run("kills a tracked flow by closing its resources", func(t *testing.T) {
+		h := &Hub{
+			L:     hclog.L(),
+			flows: make(map[string]*trackedFlow),
+		}
+
+		id := pb.NewULID()
+
+		var a, b countCloser
+
+		untrack := h.trackFlow(id, &a)
+		h.addFlowCloser(id, &b)
+
+		assert.False(t, h.flowKilled(id))
+		assert.False(t, h.KillFlow(pb.NewULID()))
+
+		assert.True(t, h.KillFlow(id))
+
+		assert.Equal(t, 1, a.closed)
+		assert.Equal(t, 1, b.closed)
+		assert.True(t, h.flowKilled(id))
+
+		untrack()
+
+		assert.False(t, h.KillFlow(id))
+	})
+
+	t.Run("tears down a flow once it goes idle", func(t *testing.T) {
+		h := &Hub{
+			L:     hclog.L(),
+			flows: make(map[string]*trackedFlow),
+		}
+
+		id := pb.NewULID()
+
+		var a countCloser
+
+		untrack := h.trackFlow(id, &a)
+		defer untrack()
+
+		var messages int64
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			h.expireIdleFlow(ctx, id, 40*time.Millisecond, func() int64 {
+				return atomic.LoadInt64(&messages)
+			})
+		}()
+
+		// Traffic keeps the flow alive past the timeout.
+		for i := 0; i < 8; i++ {
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt64(&messages, 1)
+		}
+
+		assert.False(t, h.flowIdle(id))
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("idle flow was not torn down")
+		}
+
+		assert.True(t, h.flowIdle(id))
+		assert.False(t, h.flowKilled(id))
+		assert.Equal(t, 1, a.closed)
+	})
+
+	t.Run("tears down flows with timeouts shorter than a tick", func(t *testing.T) {
+		h := &Hub{
+			L:     hclog.L(),
+			flows: make(map[string]*trackedFlow),
+		}
+
+		id := pb.NewULID()
+
+		var a countCloser
+
+		untrack := h.trackFlow(id, &a)
+		defer untrack()
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			h.expireIdleFlow(context.Background(), id, 3*time.Nanosecond, func() int64 {
+				return 0
+			})
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("idle flow was not torn down")
+		}
+
+		assert.True(t, h.flowIdle(id))
+	})
+}
@@ -56,8 +56,12 @@ type Hub struct {
 	mux *http.ServeMux
 	fe  *web.Frontend
 
-	activeAgents *int64
-	totalAgents  *int64
+	activeAgents  *int64
+	totalAgents   *int64
+	activeStreams *int64
+
+	flowMu sync.Mutex
+	flows  map[string]*trackedFlow
 
 	servicesPerAccount *lru.ARCCache
 }
@@ -75,14 +79,16 @@ func NewHub(L hclog.Logger, client *control.Client, feToken string) (*Hub, error
 	spa, _ := lru.NewARC(10000)
 
 	h := &Hub{
-		L:            L,
-		cfg:          cfg,
-		active:       make(map[string]*agentConnection),
-		cc:           client,
-		id:           client.Id(),
-		mux:          http.NewServeMux(),
-		activeAgents: new(int64),
-		totalAgents:  new(int64),
+		L:             L,
+		cfg:           cfg,
+		active:        make(map[string]*agentConnection),
+		cc:            client,
+		id:            client.Id(),
+		mux:           http.NewServeMux(),
+		activeAgents:  new(int64),
+		totalAgents:   new(int64),
+		activeStreams: new(int64),
+		flows:         make(map[string]*trackedFlow),
 
 		servicesPerAccount: spa,
 	}
@@ -99,6 +105,8 @@ func NewHub(L hclog.Logger, client *control.Client, feToken string) (*Hub, error
 
 	h.location = client.Locations()
 
+	client.SetFlowKiller(h.KillFlow)
+
 	return h, nil
 }
 
@@ -158,6 +166,7 @@ func (hub *Hub) sendStats(ctx context.Context) {
 					ActiveAgents: active,
 					TotalAgents:  atomic.LoadInt64(hub.totalAgents),
 					Services:     int64(hub.cc.NumLocalServices()),
+					ActiveFlows:  atomic.LoadInt64(hub.activeStreams),
 				},
 			})
 
@@ -194,6 +203,10 @@ func (hub *Hub) handleHZN(hs *http.Server, tlsConn *tls.Conn, h http.Handler) {
 }
 
 func (h *Hub) ValidateToken(stoken string) (*token.ValidToken, error) {
+	if keys := h.cc.TokenKeys(); len(keys) > 0 {
+		return token.CheckTokenKeys(stoken, keys)
+	}
+
 	return token.CheckTokenED25519(stoken, h.cc.TokenPub())
 }
 
@@ -321,6 +334,34 @@ func (h *Hub) handshake(ctx context.Context, conn net.Conn, fr *wire.FramingRead
 
 	id := pb.NewULID()
 
+	var tlsState *tls.ConnectionState
+
+	if tc, ok := conn.(*tls.Conn); ok {
+		state := tc.ConnectionState()
+		tlsState = &state
+	}
+
+	policy := h.cc.AccountTLSPolicy(vt.Account())
+
+	err = control.CheckTLSPolicy(policy, tlsState)
+	if err != nil {
+		h.L.Warn("rejected agent due to account tls policy",
+			"account", vt.Account().SpecString(),
+			"violation", err,
+			"session-id", preamble.SessionId,
+			"remote-addr", conn.RemoteAddr(),
+		)
+
+		wc.Status = "tls-policy-violation: " + err.Error()
+
+		_, werr := fw.WriteMarshal(1, &wc)
+		if werr != nil {
+			return nil, errors.Wrapf(werr, "error marshalling confirmation")
+		}
+
+		return nil, errors.Wrapf(err, "account: %s", vt.Account().SpecString())
+	}
+
 	if !h.checkTooManyServices(vt.Account(), len(preamble.Services)) {
 		h.L.Warn("rejected agent due to too many services per account",
 			"account", vt.Account().SpecString(),
@@ -555,6 +596,7 @@ func (h *Hub) handleConn(ctx context.Context, conn net.Conn) {
 
 		atomic.AddInt64(ai.ActiveStreams, 1)
 		atomic.AddInt64(ai.TotalStreams, 1)
+		atomic.AddInt64(h.activeStreams, 1)
 
 		h.sendAgentInfoFlow(ai)
 
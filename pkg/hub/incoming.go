@@ -30,6 +30,7 @@ func (h *Hub) handleAgentStream(ctx context.Context, ai *agentConn, stream *yamu
 	defer stream.Close()
 	defer func() {
 		atomic.AddInt64(ai.ActiveStreams, -1)
+		atomic.AddInt64(h.activeStreams, -1)
 		h.sendAgentInfoFlow(ai)
 	}()
 
@@ -106,7 +107,11 @@ func (h *Hub) handleAgentStream(ctx context.Context, ai *agentConn, stream *yamu
 		fs.Labels = req.Target
 		fs.StartedAt = pb.NewTimestamp(time.Now())
 
+		untrack := h.trackFlow(fs.FlowId, stream)
+
 		err = h.bridgeToTarget(ctx, ai, &fs, target, &req, wctx)
+		untrack()
+
 		if err != nil {
 			var resp pb.Response
 			resp.Error = err.Error()
@@ -172,6 +177,8 @@ func (h *Hub) bridgeToTarget(
 		return err
 	}
 
+	h.addFlowCloser(fs.FlowId, stream)
+
 	h.L.Trace("connecting to agent", "agent", ai.ID, "service", target.Id, "lz4", ac.useLZ4)
 
 	var (
@@ -377,6 +384,8 @@ func (h *Hub) forwardToTarget(
 		return err
 	}
 
+	h.addFlowCloser(fs.FlowId, session)
+
 	// We're allowing the target hub to do it's own lookup again rather than
 	// passing the service id we calculated here. The advantage is that things
 	// might have changed and the target has a better target (which would result
@@ -414,6 +423,8 @@ func (h *Hub) copyBetweenContexts(ctx context.Context, wctx, dsctx wire.Context,
 		h.L.Trace("finished context data copy", "id", ai.ID, "duration", time.Since(start))
 	}()
 
+	parent := ctx
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -456,7 +467,40 @@ func (h *Hub) copyBetweenContexts(ctx context.Context, wctx, dsctx wire.Context,
 		}
 	}()
 
-	return wctx.BridgeTo(dsctx)
+	if timeout := h.cc.FlowIdleTimeout(fs.Account); timeout > 0 {
+		go h.expireIdleFlow(ctx, fs.FlowId, timeout, func() int64 {
+			ma, _ := wctx.Accounting()
+			mb, _ := dsctx.Accounting()
+			return ma + mb
+		})
+	}
+
+	err := wctx.BridgeTo(dsctx)
+
+	// Set before the deferred cancel fires so the final flow update
+	// carries the reason.
+	fs.EndReason = flowEndReason(parent, err)
+	switch {
+	case h.flowKilled(fs.FlowId):
+		fs.EndReason = pb.END_KILLED
+	case h.flowIdle(fs.FlowId):
+		fs.EndReason = pb.END_IDLE
+	}
+
+	return err
+}
+
+func flowEndReason(ctx context.Context, err error) pb.FlowStream_EndReason {
+	switch {
+	case ctx.Err() == context.DeadlineExceeded:
+		return pb.END_TIMEOUT
+	case ctx.Err() != nil:
+		return pb.END_HUB_DRAIN
+	case err != nil && err != io.EOF:
+		return pb.END_ERROR
+	default:
+		return pb.END_NORMAL
+	}
 }
 
 type frameAccessor struct {
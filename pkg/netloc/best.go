@@ -15,6 +15,10 @@ type BestInput struct {
 	Remote     []*pb.NetworkLocation
 	PublicOnly bool
 
+	// Keep the order of Remote rather than preferring the locations
+	// closest to Local, or, in FindBestLive, the ones answering first.
+	KeepOrder bool
+
 	Latency func(addr string) error
 }
 
@@ -50,10 +54,12 @@ func FindBestLive(ctx context.Context, input *BestInput, locs chan *pb.NetworkLo
 		cards[i] = card
 	}
 
-	sort.Slice(best, func(i, j int) bool {
-		// j and i are flipped here so the results are sorted desc rather than asc
-		return cards[j] < cards[i]
-	})
+	if !input.KeepOrder {
+		sort.Slice(best, func(i, j int) bool {
+			// j and i are flipped here so the results are sorted desc rather than asc
+			return cards[j] < cards[i]
+		})
+	}
 
 	if input.Latency == nil {
 		if input.Count != 0 && input.Count > len(best) {
@@ -78,7 +84,8 @@ func FindBestLive(ctx context.Context, input *BestInput, locs chan *pb.NetworkLo
 			pos int
 		}
 
-		results := make(chan result)
+		// Buffered so that probes still running once we return don't block.
+		results := make(chan result, len(best))
 
 		for i, loc := range best {
 			go func(i int, loc *pb.NetworkLocation) {
@@ -98,6 +105,48 @@ func FindBestLive(ctx context.Context, input *BestInput, locs chan *pb.NetworkLo
 			}(i, loc)
 		}
 
+		if input.KeepOrder {
+			// A location is sent as soon as every one ahead of it has
+			// answered, and we return once Count have been sent, rather
+			// than waiting on the slowest probe.
+			done := make([]bool, len(best))
+			ok := make([]bool, len(best))
+
+			next, sent := 0, 0
+
+			for range best {
+				var res result
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case res = <-results:
+				}
+
+				done[res.pos] = true
+				ok[res.pos] = res.ok
+
+				for ; next < len(best) && done[next]; next++ {
+					if !ok[next] {
+						continue
+					}
+
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case locs <- best[next]:
+						sent++
+					}
+
+					if input.Count != 0 && sent >= input.Count {
+						return nil
+					}
+				}
+			}
+
+			return nil
+		}
+
 		for i := 0; i < len(best); i++ {
 			res := <-results
 			if input.Count == 0 || i < input.Count {
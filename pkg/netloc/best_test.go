@@ -1,6 +1,8 @@
 package netloc
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -109,4 +111,93 @@ func TestFindBest(t *testing.T) {
 
 		assert.Equal(t, "3.3.3.3", best[0].Addresses[0])
 	})
+
+	t.Run("keeps the order of the remote locations when asked", func(t *testing.T) {
+		lloc := []*pb.NetworkLocation{
+			{
+				Addresses: []string{"1.1.1.1"},
+				Labels:    pb.ParseLabelSet("dc=x"),
+			},
+		}
+
+		rloc := []*pb.NetworkLocation{
+			{
+				Addresses: []string{"2.2.2.2"},
+				Labels:    pb.ParseLabelSet("dc=y"),
+			},
+			{
+				Addresses: []string{"3.3.3.3"},
+				Labels:    pb.ParseLabelSet("dc=y"),
+			},
+			{
+				Addresses: []string{"4.4.4.4"},
+				Labels:    pb.ParseLabelSet("dc=x"),
+			},
+		}
+
+		ch := make(chan *pb.NetworkLocation, len(rloc))
+
+		err := FindBestLive(context.Background(), &BestInput{
+			Local:     lloc,
+			Remote:    rloc,
+			KeepOrder: true,
+			Latency: func(addr string) error {
+				switch addr {
+				case "2.2.2.2":
+					time.Sleep(100 * time.Millisecond)
+				case "3.3.3.3":
+					return errors.New("unreachable")
+				}
+
+				return nil
+			},
+		}, ch)
+		require.NoError(t, err)
+
+		var addrs []string
+
+		for loc := range ch {
+			addrs = append(addrs, loc.Addresses[0])
+		}
+
+		assert.Equal(t, []string{"2.2.2.2", "4.4.4.4"}, addrs)
+	})
+
+	t.Run("returns once the kept order is decided", func(t *testing.T) {
+		rloc := []*pb.NetworkLocation{
+			{Addresses: []string{"2.2.2.2"}},
+			{Addresses: []string{"3.3.3.3"}},
+		}
+
+		slow := make(chan struct{})
+		defer close(slow)
+
+		ch := make(chan *pb.NetworkLocation, len(rloc))
+
+		start := time.Now()
+
+		err := FindBestLive(context.Background(), &BestInput{
+			Count:     1,
+			Remote:    rloc,
+			KeepOrder: true,
+			Latency: func(addr string) error {
+				if addr == "3.3.3.3" {
+					<-slow
+				}
+
+				return nil
+			},
+		}, ch)
+		require.NoError(t, err)
+
+		assert.True(t, time.Since(start) < time.Second)
+
+		var addrs []string
+
+		for loc := range ch {
+			addrs = append(addrs, loc.Addresses[0])
+		}
+
+		assert.Equal(t, []string{"2.2.2.2"}, addrs)
+	})
 }
@@ -78,8 +78,9 @@ func (m *Account) GetAccountId() *ULID {
 }
 
 type Account_Limits struct {
-	HttpRequests float64 `protobuf:"fixed64,1,opt,name=http_requests,json=httpRequests,proto3" json:"http_requests,omitempty"`
-	Bandwidth    float64 `protobuf:"fixed64,2,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	HttpRequests    float64 `protobuf:"fixed64,1,opt,name=http_requests,json=httpRequests,proto3" json:"http_requests,omitempty"`
+	Bandwidth       float64 `protobuf:"fixed64,2,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	FlowIdleTimeout int64   `protobuf:"varint,3,opt,name=flow_idle_timeout,json=flowIdleTimeout,proto3" json:"flow_idle_timeout,omitempty"`
 }
 
 func (m *Account_Limits) Reset()      { *m = Account_Limits{} }
@@ -128,6 +129,13 @@ func (m *Account_Limits) GetBandwidth() float64 {
 	return 0
 }
 
+func (m *Account_Limits) GetFlowIdleTimeout() int64 {
+	if m != nil {
+		return m.FlowIdleTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "pb.Account")
 	proto.RegisterType((*Account_Limits)(nil), "pb.Account.Limits")
@@ -136,23 +144,25 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4c, 0x4e, 0xce,
-	0x2f, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2a, 0x48, 0x92, 0xe2, 0x2a,
-	0xcd, 0xc9, 0x4c, 0x81, 0xf0, 0xa5, 0xf8, 0x53, 0x52, 0xd3, 0x8a, 0xf5, 0xd3, 0xf3, 0xd3, 0xf3,
-	0x21, 0x02, 0x4a, 0x8b, 0x19, 0xb9, 0xd8, 0x1d, 0x21, 0x5a, 0x84, 0x64, 0xb8, 0x38, 0xf3, 0x12,
-	0x73, 0x53, 0x8b, 0x0b, 0x12, 0x93, 0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x10, 0x02,
-	0x42, 0xea, 0x5c, 0x5c, 0x50, 0xb3, 0xe3, 0x33, 0x53, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d,
-	0x38, 0xf4, 0x0a, 0x92, 0xf4, 0x42, 0x7d, 0x3c, 0x5d, 0x82, 0x38, 0xa1, 0x72, 0x9e, 0x29, 0x52,
-	0xde, 0x5c, 0x6c, 0x3e, 0x99, 0xb9, 0x99, 0x25, 0xc5, 0x42, 0xca, 0x5c, 0xbc, 0x19, 0x25, 0x25,
-	0x05, 0xf1, 0x45, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0xc5, 0x60, 0x43, 0x19, 0x83, 0x78, 0x40,
-	0x82, 0x41, 0x50, 0x31, 0x90, 0xad, 0x49, 0x89, 0x79, 0x29, 0xe5, 0x99, 0x29, 0x25, 0x19, 0x60,
-	0x63, 0x19, 0x83, 0x10, 0x02, 0x56, 0x2c, 0x0d, 0x77, 0x14, 0x18, 0x9c, 0x4c, 0x2e, 0x3c, 0x94,
-	0x63, 0xb8, 0xf1, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0xc6, 0x86, 0x47, 0x72, 0x8c, 0x2b, 0x1e,
-	0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f,
-	0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0xbd, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1a,
-	0x74, 0xb3, 0xdc, 0x14, 0x01, 0x00, 0x00,
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xd0, 0xbf, 0x4a, 0xc3, 0x50,
+	0x14, 0x06, 0xf0, 0x7b, 0x5a, 0xa9, 0xf6, 0x6a, 0x29, 0x66, 0x2a, 0x45, 0x0e, 0x41, 0x07, 0x8b,
+	0x43, 0x05, 0x75, 0x72, 0x53, 0x5c, 0x02, 0x9d, 0x2e, 0x3a, 0x87, 0x24, 0xf7, 0xb6, 0xbd, 0x90,
+	0xe4, 0xc6, 0xe6, 0x86, 0xac, 0x7d, 0x04, 0x1f, 0xc3, 0x47, 0x71, 0x33, 0x63, 0x07, 0x07, 0x73,
+	0xb3, 0x38, 0xf6, 0x11, 0x24, 0x7f, 0xa0, 0xe3, 0xf9, 0x7d, 0x9c, 0xef, 0xc0, 0xa1, 0x23, 0x2f,
+	0x08, 0x54, 0x16, 0xeb, 0x79, 0xb2, 0x51, 0x5a, 0x59, 0xbd, 0xc4, 0x9f, 0xd2, 0x2c, 0x94, 0xbc,
+	0x9d, 0xa7, 0x63, 0x2e, 0x96, 0xe9, 0xed, 0x4a, 0xad, 0x54, 0x0b, 0x97, 0xdf, 0x40, 0x8f, 0x9f,
+	0xda, 0x15, 0xeb, 0x82, 0x0e, 0x63, 0x2f, 0x12, 0x69, 0xe2, 0x05, 0x62, 0x02, 0x36, 0xcc, 0x86,
+	0xec, 0x00, 0xd6, 0x35, 0xa5, 0x5d, 0xb7, 0x2b, 0xf9, 0xa4, 0x67, 0xc3, 0xec, 0xf4, 0xee, 0x64,
+	0x9e, 0xf8, 0xf3, 0xb7, 0x85, 0xf3, 0xc2, 0x86, 0x5d, 0xe6, 0xf0, 0x69, 0x4e, 0x07, 0x0b, 0x19,
+	0x49, 0x9d, 0x5a, 0x57, 0x74, 0xb4, 0xd6, 0x3a, 0x71, 0x37, 0xe2, 0x3d, 0x13, 0xa9, 0x4e, 0x9b,
+	0x52, 0x60, 0x67, 0x35, 0xb2, 0xce, 0xea, 0xab, 0xbe, 0x17, 0xf3, 0x5c, 0x72, 0xbd, 0x6e, 0x6a,
+	0x81, 0x1d, 0xc0, 0xba, 0xa1, 0xe7, 0xcb, 0x50, 0xe5, 0xae, 0xe4, 0xa1, 0x70, 0xb5, 0x8c, 0x84,
+	0xca, 0xf4, 0xa4, 0x6f, 0xc3, 0xac, 0xcf, 0xc6, 0x75, 0xe0, 0xf0, 0x50, 0xbc, 0xb6, 0xfc, 0x78,
+	0xb4, 0xfd, 0xb1, 0xc9, 0xf3, 0x43, 0x51, 0x22, 0xd9, 0x95, 0x48, 0xf6, 0x25, 0xc2, 0xd6, 0x20,
+	0x7c, 0x1a, 0x84, 0x2f, 0x83, 0x50, 0x18, 0x84, 0x5f, 0x83, 0xf0, 0x67, 0x90, 0xec, 0x0d, 0xc2,
+	0x47, 0x85, 0xa4, 0xa8, 0x90, 0xec, 0x2a, 0x24, 0xfe, 0xa0, 0x79, 0xc7, 0xfd, 0xff, 0x00, 0x61,
+	0x43, 0x70, 0x1c, 0x40, 0x01, 0x00, 0x00,
 }
 
 func (this *Account) Equal(that interface{}) bool {
@@ -207,6 +217,9 @@ func (this *Account_Limits) Equal(that interface{}) bool {
 	if this.Bandwidth != that1.Bandwidth {
 		return false
 	}
+	if this.FlowIdleTimeout != that1.FlowIdleTimeout {
+		return false
+	}
 	return true
 }
 func (this *Account) GoString() string {
@@ -226,10 +239,11 @@ func (this *Account_Limits) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&pb.Account_Limits{")
 	s = append(s, "HttpRequests: "+fmt.Sprintf("%#v", this.HttpRequests)+",\n")
 	s = append(s, "Bandwidth: "+fmt.Sprintf("%#v", this.Bandwidth)+",\n")
+	s = append(s, "FlowIdleTimeout: "+fmt.Sprintf("%#v", this.FlowIdleTimeout)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -303,6 +317,11 @@ func (m *Account_Limits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FlowIdleTimeout != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.FlowIdleTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Bandwidth != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Bandwidth))))
@@ -358,6 +377,9 @@ func (m *Account_Limits) Size() (n int) {
 	if m.Bandwidth != 0 {
 		n += 9
 	}
+	if m.FlowIdleTimeout != 0 {
+		n += 1 + sovAccount(uint64(m.FlowIdleTimeout))
+	}
 	return n
 }
 
@@ -374,6 +396,7 @@ func (this *Account_Limits) String() string {
 	s := strings.Join([]string{`&Account_Limits{`,
 		`HttpRequests:` + fmt.Sprintf("%v", this.HttpRequests) + `,`,
 		`Bandwidth:` + fmt.Sprintf("%v", this.Bandwidth) + `,`,
+		`FlowIdleTimeout:` + fmt.Sprintf("%v", this.FlowIdleTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -558,6 +581,25 @@ func (m *Account_Limits) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Bandwidth = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowIdleTimeout", wireType)
+			}
+			m.FlowIdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowIdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -30,6 +31,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type AddLabelLinkRequest_ConflictMode int32
+
+const (
+	LINK_CONFLICT_ERROR  AddLabelLinkRequest_ConflictMode = 0
+	LINK_CONFLICT_UPDATE AddLabelLinkRequest_ConflictMode = 1
+)
+
+var AddLabelLinkRequest_ConflictMode_name = map[int32]string{
+	0: "LINK_CONFLICT_ERROR",
+	1: "LINK_CONFLICT_UPDATE",
+}
+
+var AddLabelLinkRequest_ConflictMode_value = map[string]int32{
+	"LINK_CONFLICT_ERROR":  0,
+	"LINK_CONFLICT_UPDATE": 1,
+}
+
+func (AddLabelLinkRequest_ConflictMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{32, 0}
+}
+
 type ServiceRequest struct {
 	Account  *Account  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Hub      *ULID     `protobuf:"bytes,2,opt,name=hub,proto3" json:"hub,omitempty"`
@@ -149,10 +171,12 @@ func (m *ServiceResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ServiceResponse proto.InternalMessageInfo
 
 type LabelLink struct {
-	Account *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Labels  *LabelSet       `protobuf:"bytes,2,opt,name=labels,proto3" json:"labels,omitempty"`
-	Target  *LabelSet       `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Limits  *Account_Limits `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Account  *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Labels   *LabelSet       `protobuf:"bytes,2,opt,name=labels,proto3" json:"labels,omitempty"`
+	Target   *LabelSet       `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Limits   *Account_Limits `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Weighted bool            `protobuf:"varint,5,opt,name=weighted,proto3" json:"weighted,omitempty"`
+	Weight   uint32          `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (m *LabelLink) Reset()      { *m = LabelLink{} }
@@ -215,6 +239,20 @@ func (m *LabelLink) GetLimits() *Account_Limits {
 	return nil
 }
 
+func (m *LabelLink) GetWeighted() bool {
+	if m != nil {
+		return m.Weighted
+	}
+	return false
+}
+
+func (m *LabelLink) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type LabelLinks struct {
 	LabelLinks []*LabelLink `protobuf:"bytes,1,rep,name=label_links,json=labelLinks,proto3" json:"label_links,omitempty"`
 }
@@ -326,8 +364,10 @@ func (m *ServiceRoute) GetLabels() *LabelSet {
 }
 
 type AccountServices struct {
-	Account  *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Services []*ServiceRoute `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	Account         *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Services        []*ServiceRoute `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	FlowIdleTimeout int64           `protobuf:"varint,3,opt,name=flow_idle_timeout,json=flowIdleTimeout,proto3" json:"flow_idle_timeout,omitempty"`
+	TlsPolicy       *TLSPolicy      `protobuf:"bytes,4,opt,name=tls_policy,json=tlsPolicy,proto3" json:"tls_policy,omitempty"`
 }
 
 func (m *AccountServices) Reset()      { *m = AccountServices{} }
@@ -376,6 +416,71 @@ func (m *AccountServices) GetServices() []*ServiceRoute {
 	return nil
 }
 
+func (m *AccountServices) GetFlowIdleTimeout() int64 {
+	if m != nil {
+		return m.FlowIdleTimeout
+	}
+	return 0
+}
+
+func (m *AccountServices) GetTlsPolicy() *TLSPolicy {
+	if m != nil {
+		return m.TlsPolicy
+	}
+	return nil
+}
+
+type TLSPolicy struct {
+	MinVersion        uint32 `protobuf:"varint,1,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	RequireClientCert bool   `protobuf:"varint,2,opt,name=require_client_cert,json=requireClientCert,proto3" json:"require_client_cert,omitempty"`
+}
+
+func (m *TLSPolicy) Reset()      { *m = TLSPolicy{} }
+func (*TLSPolicy) ProtoMessage() {}
+func (*TLSPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{6}
+}
+func (m *TLSPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSPolicy.Merge(m, src)
+}
+func (m *TLSPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSPolicy proto.InternalMessageInfo
+
+func (m *TLSPolicy) GetMinVersion() uint32 {
+	if m != nil {
+		return m.MinVersion
+	}
+	return 0
+}
+
+func (m *TLSPolicy) GetRequireClientCert() bool {
+	if m != nil {
+		return m.RequireClientCert
+	}
+	return false
+}
+
 type ActivityEntry struct {
 	RouteAdded   *AccountServices `protobuf:"bytes,1,opt,name=route_added,json=routeAdded,proto3" json:"route_added,omitempty"`
 	RouteRemoved *ULID            `protobuf:"bytes,2,opt,name=route_removed,json=routeRemoved,proto3" json:"route_removed,omitempty"`
@@ -384,7 +489,7 @@ type ActivityEntry struct {
 func (m *ActivityEntry) Reset()      { *m = ActivityEntry{} }
 func (*ActivityEntry) ProtoMessage() {}
 func (*ActivityEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{6}
+	return fileDescriptor_0c5120591600887d, []int{7}
 }
 func (m *ActivityEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,15 +533,16 @@ func (m *ActivityEntry) GetRouteRemoved() *ULID {
 }
 
 type ConfigRequest struct {
-	StableId   *ULID              `protobuf:"bytes,1,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
-	InstanceId *ULID              `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Locations  []*NetworkLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	StableId     *ULID              `protobuf:"bytes,1,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
+	InstanceId   *ULID              `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Locations    []*NetworkLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	Capabilities []string           `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (m *ConfigRequest) Reset()      { *m = ConfigRequest{} }
 func (*ConfigRequest) ProtoMessage() {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{7}
+	return fileDescriptor_0c5120591600887d, []int{8}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,20 +592,33 @@ func (m *ConfigRequest) GetLocations() []*NetworkLocation {
 	return nil
 }
 
+func (m *ConfigRequest) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type ConfigResponse struct {
-	TlsKey      []byte `protobuf:"bytes,1,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	TlsCert     []byte `protobuf:"bytes,2,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	TokenPub    []byte `protobuf:"bytes,3,opt,name=token_pub,json=tokenPub,proto3" json:"token_pub,omitempty"`
-	S3AccessKey string `protobuf:"bytes,4,opt,name=s3_access_key,json=s3AccessKey,proto3" json:"s3_access_key,omitempty"`
-	S3SecretKey string `protobuf:"bytes,5,opt,name=s3_secret_key,json=s3SecretKey,proto3" json:"s3_secret_key,omitempty"`
-	S3Bucket    string `protobuf:"bytes,6,opt,name=s3_bucket,json=s3Bucket,proto3" json:"s3_bucket,omitempty"`
-	ImageTag    string `protobuf:"bytes,7,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	TlsKey          []byte           `protobuf:"bytes,1,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	TlsCert         []byte           `protobuf:"bytes,2,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	TokenPub        []byte           `protobuf:"bytes,3,opt,name=token_pub,json=tokenPub,proto3" json:"token_pub,omitempty"`
+	S3AccessKey     string           `protobuf:"bytes,4,opt,name=s3_access_key,json=s3AccessKey,proto3" json:"s3_access_key,omitempty"`
+	S3SecretKey     string           `protobuf:"bytes,5,opt,name=s3_secret_key,json=s3SecretKey,proto3" json:"s3_secret_key,omitempty"`
+	S3Bucket        string           `protobuf:"bytes,6,opt,name=s3_bucket,json=s3Bucket,proto3" json:"s3_bucket,omitempty"`
+	ImageTag        string           `protobuf:"bytes,7,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	TokenKeys       []*TokenKey      `protobuf:"bytes,8,rep,name=token_keys,json=tokenKeys,proto3" json:"token_keys,omitempty"`
+	Reconnect       *ReconnectPolicy `protobuf:"bytes,9,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	FlowIdleTimeout int64            `protobuf:"varint,10,opt,name=flow_idle_timeout,json=flowIdleTimeout,proto3" json:"flow_idle_timeout,omitempty"`
+	TlsPolicy       *TLSPolicy       `protobuf:"bytes,11,opt,name=tls_policy,json=tlsPolicy,proto3" json:"tls_policy,omitempty"`
+	AgentClientCa   []byte           `protobuf:"bytes,12,opt,name=agent_client_ca,json=agentClientCa,proto3" json:"agent_client_ca,omitempty"`
+	S3Region        string           `protobuf:"bytes,13,opt,name=s3_region,json=s3Region,proto3" json:"s3_region,omitempty"`
 }
 
 func (m *ConfigResponse) Reset()      { *m = ConfigResponse{} }
 func (*ConfigResponse) ProtoMessage() {}
 func (*ConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{8}
+	return fileDescriptor_0c5120591600887d, []int{9}
 }
 func (m *ConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,6 +696,107 @@ func (m *ConfigResponse) GetImageTag() string {
 	return ""
 }
 
+func (m *ConfigResponse) GetTokenKeys() []*TokenKey {
+	if m != nil {
+		return m.TokenKeys
+	}
+	return nil
+}
+
+func (m *ConfigResponse) GetReconnect() *ReconnectPolicy {
+	if m != nil {
+		return m.Reconnect
+	}
+	return nil
+}
+
+func (m *ConfigResponse) GetFlowIdleTimeout() int64 {
+	if m != nil {
+		return m.FlowIdleTimeout
+	}
+	return 0
+}
+
+func (m *ConfigResponse) GetTlsPolicy() *TLSPolicy {
+	if m != nil {
+		return m.TlsPolicy
+	}
+	return nil
+}
+
+func (m *ConfigResponse) GetAgentClientCa() []byte {
+	if m != nil {
+		return m.AgentClientCa
+	}
+	return nil
+}
+
+func (m *ConfigResponse) GetS3Region() string {
+	if m != nil {
+		return m.S3Region
+	}
+	return ""
+}
+
+type ReconnectPolicy struct {
+	InitialBackoff int64 `protobuf:"varint,1,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     int64 `protobuf:"varint,2,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	Jitter         int64 `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+}
+
+func (m *ReconnectPolicy) Reset()      { *m = ReconnectPolicy{} }
+func (*ReconnectPolicy) ProtoMessage() {}
+func (*ReconnectPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{10}
+}
+func (m *ReconnectPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconnectPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconnectPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconnectPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconnectPolicy.Merge(m, src)
+}
+func (m *ReconnectPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconnectPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconnectPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconnectPolicy proto.InternalMessageInfo
+
+func (m *ReconnectPolicy) GetInitialBackoff() int64 {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return 0
+}
+
+func (m *ReconnectPolicy) GetMaxBackoff() int64 {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return 0
+}
+
+func (m *ReconnectPolicy) GetJitter() int64 {
+	if m != nil {
+		return m.Jitter
+	}
+	return 0
+}
+
 type HubChange struct {
 	OldId *ULID `protobuf:"bytes,1,opt,name=old_id,json=oldId,proto3" json:"old_id,omitempty"`
 	NewId *ULID `protobuf:"bytes,2,opt,name=new_id,json=newId,proto3" json:"new_id,omitempty"`
@@ -585,7 +805,7 @@ type HubChange struct {
 func (m *HubChange) Reset()      { *m = HubChange{} }
 func (*HubChange) ProtoMessage() {}
 func (*HubChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{9}
+	return fileDescriptor_0c5120591600887d, []int{11}
 }
 func (m *HubChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,17 +848,114 @@ func (m *HubChange) GetNewId() *ULID {
 	return nil
 }
 
+type MigrateStream struct {
+	Within int64 `protobuf:"varint,1,opt,name=within,proto3" json:"within,omitempty"`
+}
+
+func (m *MigrateStream) Reset()      { *m = MigrateStream{} }
+func (*MigrateStream) ProtoMessage() {}
+func (*MigrateStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{12}
+}
+func (m *MigrateStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateStream.Merge(m, src)
+}
+func (m *MigrateStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateStream proto.InternalMessageInfo
+
+func (m *MigrateStream) GetWithin() int64 {
+	if m != nil {
+		return m.Within
+	}
+	return 0
+}
+
+type HubTLS struct {
+	Cert []byte `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *HubTLS) Reset()      { *m = HubTLS{} }
+func (*HubTLS) ProtoMessage() {}
+func (*HubTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{13}
+}
+func (m *HubTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HubTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HubTLS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HubTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HubTLS.Merge(m, src)
+}
+func (m *HubTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *HubTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_HubTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HubTLS proto.InternalMessageInfo
+
+func (m *HubTLS) GetCert() []byte {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+func (m *HubTLS) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 type CentralActivity struct {
 	AccountServices []*AccountServices `protobuf:"bytes,1,rep,name=account_services,json=accountServices,proto3" json:"account_services,omitempty"`
 	RequestStats    bool               `protobuf:"varint,2,opt,name=request_stats,json=requestStats,proto3" json:"request_stats,omitempty"`
 	NewLabelLinks   *LabelLinks        `protobuf:"bytes,3,opt,name=new_label_links,json=newLabelLinks,proto3" json:"new_label_links,omitempty"`
 	HubChange       *HubChange         `protobuf:"bytes,4,opt,name=hub_change,json=hubChange,proto3" json:"hub_change,omitempty"`
+	KillFlows       []*ULID            `protobuf:"bytes,5,rep,name=kill_flows,json=killFlows,proto3" json:"kill_flows,omitempty"`
+	MigrateStream   *MigrateStream     `protobuf:"bytes,6,opt,name=migrate_stream,json=migrateStream,proto3" json:"migrate_stream,omitempty"`
+	HubTls          *HubTLS            `protobuf:"bytes,7,opt,name=hub_tls,json=hubTls,proto3" json:"hub_tls,omitempty"`
 }
 
 func (m *CentralActivity) Reset()      { *m = CentralActivity{} }
 func (*CentralActivity) ProtoMessage() {}
 func (*CentralActivity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{10}
+	return fileDescriptor_0c5120591600887d, []int{14}
 }
 func (m *CentralActivity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,6 +1012,27 @@ func (m *CentralActivity) GetHubChange() *HubChange {
 	return nil
 }
 
+func (m *CentralActivity) GetKillFlows() []*ULID {
+	if m != nil {
+		return m.KillFlows
+	}
+	return nil
+}
+
+func (m *CentralActivity) GetMigrateStream() *MigrateStream {
+	if m != nil {
+		return m.MigrateStream
+	}
+	return nil
+}
+
+func (m *CentralActivity) GetHubTls() *HubTLS {
+	if m != nil {
+		return m.HubTls
+	}
+	return nil
+}
+
 type HubActivity struct {
 	HubReg *HubActivity_HubRegistration `protobuf:"bytes,1,opt,name=hub_reg,json=hubReg,proto3" json:"hub_reg,omitempty"`
 	SentAt *Timestamp                   `protobuf:"bytes,2,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
@@ -705,7 +1043,7 @@ type HubActivity struct {
 func (m *HubActivity) Reset()      { *m = HubActivity{} }
 func (*HubActivity) ProtoMessage() {}
 func (*HubActivity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{11}
+	return fileDescriptor_0c5120591600887d, []int{15}
 }
 func (m *HubActivity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,15 +1101,16 @@ func (m *HubActivity) GetFlow() []*FlowRecord {
 }
 
 type HubActivity_HubRegistration struct {
-	Hub       *ULID              `protobuf:"bytes,1,opt,name=hub,proto3" json:"hub,omitempty"`
-	StableHub *ULID              `protobuf:"bytes,2,opt,name=stable_hub,json=stableHub,proto3" json:"stable_hub,omitempty"`
-	Locations []*NetworkLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	Hub             *ULID              `protobuf:"bytes,1,opt,name=hub,proto3" json:"hub,omitempty"`
+	StableHub       *ULID              `protobuf:"bytes,2,opt,name=stable_hub,json=stableHub,proto3" json:"stable_hub,omitempty"`
+	Locations       []*NetworkLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	ProtocolVersion int32              `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 }
 
 func (m *HubActivity_HubRegistration) Reset()      { *m = HubActivity_HubRegistration{} }
 func (*HubActivity_HubRegistration) ProtoMessage() {}
 func (*HubActivity_HubRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{11, 0}
+	return fileDescriptor_0c5120591600887d, []int{15, 0}
 }
 func (m *HubActivity_HubRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,6 +1160,13 @@ func (m *HubActivity_HubRegistration) GetLocations() []*NetworkLocation {
 	return nil
 }
 
+func (m *HubActivity_HubRegistration) GetProtocolVersion() int32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
 type HubActivity_HubStats struct {
 	AgentConnections     int64      `protobuf:"varint,1,opt,name=agent_connections,json=agentConnections,proto3" json:"agent_connections,omitempty"`
 	AccountsSeen         int64      `protobuf:"varint,2,opt,name=accounts_seen,json=accountsSeen,proto3" json:"accounts_seen,omitempty"`
@@ -833,7 +1179,7 @@ type HubActivity_HubStats struct {
 func (m *HubActivity_HubStats) Reset()      { *m = HubActivity_HubStats{} }
 func (*HubActivity_HubStats) ProtoMessage() {}
 func (*HubActivity_HubStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{11, 1}
+	return fileDescriptor_0c5120591600887d, []int{15, 1}
 }
 func (m *HubActivity_HubStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,14 +1251,19 @@ func (m *HubActivity_HubStats) GetLastAttemptedUpdate() *Timestamp {
 }
 
 type HubInfo struct {
-	Id        *ULID              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Locations []*NetworkLocation `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+	Id              *ULID              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Locations       []*NetworkLocation `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+	StableId        *ULID              `protobuf:"bytes,3,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
+	ActiveFlows     int64              `protobuf:"varint,4,opt,name=active_flows,json=activeFlows,proto3" json:"active_flows,omitempty"`
+	MaxFlows        int64              `protobuf:"varint,5,opt,name=max_flows,json=maxFlows,proto3" json:"max_flows,omitempty"`
+	Capabilities    []string           `protobuf:"bytes,6,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	ProtocolVersion int32              `protobuf:"varint,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 }
 
 func (m *HubInfo) Reset()      { *m = HubInfo{} }
 func (*HubInfo) ProtoMessage() {}
 func (*HubInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{12}
+	return fileDescriptor_0c5120591600887d, []int{16}
 }
 func (m *HubInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,24 +1306,60 @@ func (m *HubInfo) GetLocations() []*NetworkLocation {
 	return nil
 }
 
-type ListOfHubs struct {
-	Hubs []*HubInfo `protobuf:"bytes,1,rep,name=hubs,proto3" json:"hubs,omitempty"`
+func (m *HubInfo) GetStableId() *ULID {
+	if m != nil {
+		return m.StableId
+	}
+	return nil
 }
 
-func (m *ListOfHubs) Reset()      { *m = ListOfHubs{} }
-func (*ListOfHubs) ProtoMessage() {}
-func (*ListOfHubs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{13}
+func (m *HubInfo) GetActiveFlows() int64 {
+	if m != nil {
+		return m.ActiveFlows
+	}
+	return 0
 }
-func (m *ListOfHubs) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *HubInfo) GetMaxFlows() int64 {
+	if m != nil {
+		return m.MaxFlows
+	}
+	return 0
 }
-func (m *ListOfHubs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListOfHubs.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
+
+func (m *HubInfo) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *HubInfo) GetProtocolVersion() int32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+type ListOfHubs struct {
+	Hubs             []*HubInfo              `protobuf:"bytes,1,rep,name=hubs,proto3" json:"hubs,omitempty"`
+	ProtocolVersions []*ProtocolVersionCount `protobuf:"bytes,2,rep,name=protocol_versions,json=protocolVersions,proto3" json:"protocol_versions,omitempty"`
+}
+
+func (m *ListOfHubs) Reset()      { *m = ListOfHubs{} }
+func (*ListOfHubs) ProtoMessage() {}
+func (*ListOfHubs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{17}
+}
+func (m *ListOfHubs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOfHubs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOfHubs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -998,6 +1385,64 @@ func (m *ListOfHubs) GetHubs() []*HubInfo {
 	return nil
 }
 
+func (m *ListOfHubs) GetProtocolVersions() []*ProtocolVersionCount {
+	if m != nil {
+		return m.ProtocolVersions
+	}
+	return nil
+}
+
+type ProtocolVersionCount struct {
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hubs    int64 `protobuf:"varint,2,opt,name=hubs,proto3" json:"hubs,omitempty"`
+}
+
+func (m *ProtocolVersionCount) Reset()      { *m = ProtocolVersionCount{} }
+func (*ProtocolVersionCount) ProtoMessage() {}
+func (*ProtocolVersionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{18}
+}
+func (m *ProtocolVersionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolVersionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolVersionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolVersionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolVersionCount.Merge(m, src)
+}
+func (m *ProtocolVersionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolVersionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolVersionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolVersionCount proto.InternalMessageInfo
+
+func (m *ProtocolVersionCount) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ProtocolVersionCount) GetHubs() int64 {
+	if m != nil {
+		return m.Hubs
+	}
+	return 0
+}
+
 type HubSync struct {
 	Id       *ULID             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	StableId *ULID             `protobuf:"bytes,2,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
@@ -1007,7 +1452,7 @@ type HubSync struct {
 func (m *HubSync) Reset()      { *m = HubSync{} }
 func (*HubSync) ProtoMessage() {}
 func (*HubSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{14}
+	return fileDescriptor_0c5120591600887d, []int{19}
 }
 func (m *HubSync) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1509,7 @@ type HubSyncResponse struct {
 func (m *HubSyncResponse) Reset()      { *m = HubSyncResponse{} }
 func (*HubSyncResponse) ProtoMessage() {}
 func (*HubSyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{15}
+	return fileDescriptor_0c5120591600887d, []int{20}
 }
 func (m *HubSyncResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,7 +1554,7 @@ type HubRegisterRequest struct {
 func (m *HubRegisterRequest) Reset()      { *m = HubRegisterRequest{} }
 func (*HubRegisterRequest) ProtoMessage() {}
 func (*HubRegisterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{16}
+	return fileDescriptor_0c5120591600887d, []int{21}
 }
 func (m *HubRegisterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1611,7 @@ type HubRegisterResponse struct {
 func (m *HubRegisterResponse) Reset()      { *m = HubRegisterResponse{} }
 func (*HubRegisterResponse) ProtoMessage() {}
 func (*HubRegisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{17}
+	return fileDescriptor_0c5120591600887d, []int{22}
 }
 func (m *HubRegisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1655,7 @@ type HubDisconnectRequest struct {
 func (m *HubDisconnectRequest) Reset()      { *m = HubDisconnectRequest{} }
 func (*HubDisconnectRequest) ProtoMessage() {}
 func (*HubDisconnectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{18}
+	return fileDescriptor_0c5120591600887d, []int{23}
 }
 func (m *HubDisconnectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1705,7 @@ type ServiceTokenRequest struct {
 func (m *ServiceTokenRequest) Reset()      { *m = ServiceTokenRequest{} }
 func (*ServiceTokenRequest) ProtoMessage() {}
 func (*ServiceTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{19}
+	return fileDescriptor_0c5120591600887d, []int{24}
 }
 func (m *ServiceTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1303,7 +1748,7 @@ type ServiceTokenResponse struct {
 func (m *ServiceTokenResponse) Reset()      { *m = ServiceTokenResponse{} }
 func (*ServiceTokenResponse) ProtoMessage() {}
 func (*ServiceTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{20}
+	return fileDescriptor_0c5120591600887d, []int{25}
 }
 func (m *ServiceTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,14 +1784,67 @@ func (m *ServiceTokenResponse) GetToken() string {
 	return ""
 }
 
+type ItemError struct {
+	Item  string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ItemError) Reset()      { *m = ItemError{} }
+func (*ItemError) ProtoMessage() {}
+func (*ItemError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{26}
+}
+func (m *ItemError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemError.Merge(m, src)
+}
+func (m *ItemError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemError proto.InternalMessageInfo
+
+func (m *ItemError) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *ItemError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type ListServicesRequest struct {
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Account        *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	BestEffort     bool     `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	ConsistentRead bool     `protobuf:"varint,3,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
 }
 
 func (m *ListServicesRequest) Reset()      { *m = ListServicesRequest{} }
 func (*ListServicesRequest) ProtoMessage() {}
 func (*ListServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{21}
+	return fileDescriptor_0c5120591600887d, []int{27}
 }
 func (m *ListServicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1382,14 +1880,29 @@ func (m *ListServicesRequest) GetAccount() *Account {
 	return nil
 }
 
+func (m *ListServicesRequest) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *ListServicesRequest) GetConsistentRead() bool {
+	if m != nil {
+		return m.ConsistentRead
+	}
+	return false
+}
+
 type ListServicesResponse struct {
-	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Services []*Service   `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Errors   []*ItemError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
 func (m *ListServicesResponse) Reset()      { *m = ListServicesResponse{} }
 func (*ListServicesResponse) ProtoMessage() {}
 func (*ListServicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{22}
+	return fileDescriptor_0c5120591600887d, []int{28}
 }
 func (m *ListServicesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,6 +1938,13 @@ func (m *ListServicesResponse) GetServices() []*Service {
 	return nil
 }
 
+func (m *ListServicesResponse) GetErrors() []*ItemError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 type Service struct {
 	Id       *ULID     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Hub      *ULID     `protobuf:"bytes,2,opt,name=hub,proto3" json:"hub,omitempty"`
@@ -1436,7 +1956,7 @@ type Service struct {
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{23}
+	return fileDescriptor_0c5120591600887d, []int{29}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,14 +2021,16 @@ func (m *Service) GetMetadata() []*KVPair {
 }
 
 type AddAccountRequest struct {
-	Account *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Limits  *Account_Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	Account        *Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Limits         *Account_Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	ExternalId     string          `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	IdempotencyKey string          `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (m *AddAccountRequest) Reset()      { *m = AddAccountRequest{} }
 func (*AddAccountRequest) ProtoMessage() {}
 func (*AddAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{24}
+	return fileDescriptor_0c5120591600887d, []int{30}
 }
 func (m *AddAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,16 +2073,85 @@ func (m *AddAccountRequest) GetLimits() *Account_Limits {
 	return nil
 }
 
+func (m *AddAccountRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *AddAccountRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type AddAccountResponse struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Created bool     `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (m *AddAccountResponse) Reset()      { *m = AddAccountResponse{} }
+func (*AddAccountResponse) ProtoMessage() {}
+func (*AddAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{31}
+}
+func (m *AddAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAccountResponse.Merge(m, src)
+}
+func (m *AddAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAccountResponse proto.InternalMessageInfo
+
+func (m *AddAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AddAccountResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
 type AddLabelLinkRequest struct {
-	Labels  *LabelSet `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels,omitempty"`
-	Account *Account  `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Target  *LabelSet `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Labels     *LabelSet                        `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels,omitempty"`
+	Account    *Account                         `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Target     *LabelSet                        `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ExternalId string                           `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	OnConflict AddLabelLinkRequest_ConflictMode `protobuf:"varint,5,opt,name=on_conflict,json=onConflict,proto3,enum=pb.AddLabelLinkRequest_ConflictMode" json:"on_conflict,omitempty"`
+	Weighted   bool                             `protobuf:"varint,6,opt,name=weighted,proto3" json:"weighted,omitempty"`
+	Weight     uint32                           `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (m *AddLabelLinkRequest) Reset()      { *m = AddLabelLinkRequest{} }
 func (*AddLabelLinkRequest) ProtoMessage() {}
 func (*AddLabelLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{25}
+	return fileDescriptor_0c5120591600887d, []int{32}
 }
 func (m *AddLabelLinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,13 +2201,41 @@ func (m *AddLabelLinkRequest) GetTarget() *LabelSet {
 	return nil
 }
 
+func (m *AddLabelLinkRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *AddLabelLinkRequest) GetOnConflict() AddLabelLinkRequest_ConflictMode {
+	if m != nil {
+		return m.OnConflict
+	}
+	return LINK_CONFLICT_ERROR
+}
+
+func (m *AddLabelLinkRequest) GetWeighted() bool {
+	if m != nil {
+		return m.Weighted
+	}
+	return false
+}
+
+func (m *AddLabelLinkRequest) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type Noop struct {
 }
 
 func (m *Noop) Reset()      { *m = Noop{} }
 func (*Noop) ProtoMessage() {}
 func (*Noop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{26}
+	return fileDescriptor_0c5120591600887d, []int{33}
 }
 func (m *Noop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1646,14 +2265,16 @@ func (m *Noop) XXX_DiscardUnknown() {
 var xxx_messageInfo_Noop proto.InternalMessageInfo
 
 type RemoveLabelLinkRequest struct {
-	Labels  *LabelSet `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels,omitempty"`
-	Account *Account  `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Labels     *LabelSet `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels,omitempty"`
+	Account    *Account  `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ExternalId string    `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Target     *LabelSet `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
 }
 
 func (m *RemoveLabelLinkRequest) Reset()      { *m = RemoveLabelLinkRequest{} }
 func (*RemoveLabelLinkRequest) ProtoMessage() {}
 func (*RemoveLabelLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{27}
+	return fileDescriptor_0c5120591600887d, []int{34}
 }
 func (m *RemoveLabelLinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,16 +2317,31 @@ func (m *RemoveLabelLinkRequest) GetAccount() *Account {
 	return nil
 }
 
+func (m *RemoveLabelLinkRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *RemoveLabelLinkRequest) GetTarget() *LabelSet {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 type CreateTokenRequest struct {
 	Account       *Account          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Capabilities  []TokenCapability `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities"`
 	ValidDuration *Timestamp        `protobuf:"bytes,3,opt,name=valid_duration,json=validDuration,proto3" json:"valid_duration,omitempty"`
+	ExternalId    string            `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
 func (m *CreateTokenRequest) Reset()      { *m = CreateTokenRequest{} }
 func (*CreateTokenRequest) ProtoMessage() {}
 func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{28}
+	return fileDescriptor_0c5120591600887d, []int{35}
 }
 func (m *CreateTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,6 +2391,13 @@ func (m *CreateTokenRequest) GetValidDuration() *Timestamp {
 	return nil
 }
 
+func (m *CreateTokenRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
 type CreateTokenResponse struct {
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
@@ -1762,7 +2405,7 @@ type CreateTokenResponse struct {
 func (m *CreateTokenResponse) Reset()      { *m = CreateTokenResponse{} }
 func (*CreateTokenResponse) ProtoMessage() {}
 func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{29}
+	return fileDescriptor_0c5120591600887d, []int{36}
 }
 func (m *CreateTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1805,7 +2448,7 @@ type ControlRegister struct {
 func (m *ControlRegister) Reset()      { *m = ControlRegister{} }
 func (*ControlRegister) ProtoMessage() {}
 func (*ControlRegister) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{30}
+	return fileDescriptor_0c5120591600887d, []int{37}
 }
 func (m *ControlRegister) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2491,7 @@ type ControlToken struct {
 func (m *ControlToken) Reset()      { *m = ControlToken{} }
 func (*ControlToken) ProtoMessage() {}
 func (*ControlToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{31}
+	return fileDescriptor_0c5120591600887d, []int{38}
 }
 func (m *ControlToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1886,12 +2529,14 @@ func (m *ControlToken) GetToken() string {
 
 type TokenInfo struct {
 	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	KeyId     string `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 }
 
 func (m *TokenInfo) Reset()      { *m = TokenInfo{} }
 func (*TokenInfo) ProtoMessage() {}
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{32}
+	return fileDescriptor_0c5120591600887d, []int{39}
 }
 func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1927,22 +2572,38 @@ func (m *TokenInfo) GetPublicKey() []byte {
 	return nil
 }
 
-type ListAccountsRequest struct {
-	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Marker []byte `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
+func (m *TokenInfo) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
 }
 
-func (m *ListAccountsRequest) Reset()      { *m = ListAccountsRequest{} }
-func (*ListAccountsRequest) ProtoMessage() {}
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{33}
+func (m *TokenInfo) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
 }
-func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+
+type TokenKey struct {
+	KeyId     string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signing   bool   `protobuf:"varint,3,opt,name=signing,proto3" json:"signing,omitempty"`
+	Algorithm string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+}
+
+func (m *TokenKey) Reset()      { *m = TokenKey{} }
+func (*TokenKey) ProtoMessage() {}
+func (*TokenKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{40}
+}
+func (m *TokenKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1952,48 +2613,61 @@ func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+func (m *TokenKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenKey.Merge(m, src)
 }
-func (m *ListAccountsRequest) XXX_Size() int {
+func (m *TokenKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListAccountsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+func (m *TokenKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TokenKey proto.InternalMessageInfo
 
-func (m *ListAccountsRequest) GetLimit() int32 {
+func (m *TokenKey) GetKeyId() string {
 	if m != nil {
-		return m.Limit
+		return m.KeyId
 	}
-	return 0
+	return ""
 }
 
-func (m *ListAccountsRequest) GetMarker() []byte {
+func (m *TokenKey) GetPublicKey() []byte {
 	if m != nil {
-		return m.Marker
+		return m.PublicKey
 	}
 	return nil
 }
 
-type ListAccountsResponse struct {
-	Accounts   []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	NextMarker []byte     `protobuf:"bytes,2,opt,name=next_marker,json=nextMarker,proto3" json:"next_marker,omitempty"`
+func (m *TokenKey) GetSigning() bool {
+	if m != nil {
+		return m.Signing
+	}
+	return false
 }
 
-func (m *ListAccountsResponse) Reset()      { *m = ListAccountsResponse{} }
-func (*ListAccountsResponse) ProtoMessage() {}
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{34}
+func (m *TokenKey) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
 }
-func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+
+type ListTokenKeysResponse struct {
+	Keys []*TokenKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *ListTokenKeysResponse) Reset()      { *m = ListTokenKeysResponse{} }
+func (*ListTokenKeysResponse) ProtoMessage() {}
+func (*ListTokenKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{41}
+}
+func (m *ListTokenKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTokenKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTokenKeysResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2003,1288 +2677,2826 @@ func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+func (m *ListTokenKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokenKeysResponse.Merge(m, src)
 }
-func (m *ListAccountsResponse) XXX_Size() int {
+func (m *ListTokenKeysResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListAccountsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+func (m *ListTokenKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokenKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListTokenKeysResponse proto.InternalMessageInfo
 
-func (m *ListAccountsResponse) GetAccounts() []*Account {
+func (m *ListTokenKeysResponse) GetKeys() []*TokenKey {
 	if m != nil {
-		return m.Accounts
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *ListAccountsResponse) GetNextMarker() []byte {
-	if m != nil {
-		return m.NextMarker
-	}
-	return nil
+type SetHubMaxFlowsRequest struct {
+	StableId *ULID `protobuf:"bytes,1,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
+	MaxFlows int64 `protobuf:"varint,2,opt,name=max_flows,json=maxFlows,proto3" json:"max_flows,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*ServiceRequest)(nil), "pb.ServiceRequest")
-	proto.RegisterType((*ServiceResponse)(nil), "pb.ServiceResponse")
-	proto.RegisterType((*LabelLink)(nil), "pb.LabelLink")
-	proto.RegisterType((*LabelLinks)(nil), "pb.LabelLinks")
-	proto.RegisterType((*ServiceRoute)(nil), "pb.ServiceRoute")
-	proto.RegisterType((*AccountServices)(nil), "pb.AccountServices")
-	proto.RegisterType((*ActivityEntry)(nil), "pb.ActivityEntry")
-	proto.RegisterType((*ConfigRequest)(nil), "pb.ConfigRequest")
-	proto.RegisterType((*ConfigResponse)(nil), "pb.ConfigResponse")
-	proto.RegisterType((*HubChange)(nil), "pb.HubChange")
-	proto.RegisterType((*CentralActivity)(nil), "pb.CentralActivity")
-	proto.RegisterType((*HubActivity)(nil), "pb.HubActivity")
-	proto.RegisterType((*HubActivity_HubRegistration)(nil), "pb.HubActivity.HubRegistration")
-	proto.RegisterType((*HubActivity_HubStats)(nil), "pb.HubActivity.HubStats")
-	proto.RegisterType((*HubInfo)(nil), "pb.HubInfo")
-	proto.RegisterType((*ListOfHubs)(nil), "pb.ListOfHubs")
-	proto.RegisterType((*HubSync)(nil), "pb.HubSync")
-	proto.RegisterType((*HubSyncResponse)(nil), "pb.HubSyncResponse")
-	proto.RegisterType((*HubRegisterRequest)(nil), "pb.HubRegisterRequest")
-	proto.RegisterType((*HubRegisterResponse)(nil), "pb.HubRegisterResponse")
-	proto.RegisterType((*HubDisconnectRequest)(nil), "pb.HubDisconnectRequest")
-	proto.RegisterType((*ServiceTokenRequest)(nil), "pb.ServiceTokenRequest")
-	proto.RegisterType((*ServiceTokenResponse)(nil), "pb.ServiceTokenResponse")
-	proto.RegisterType((*ListServicesRequest)(nil), "pb.ListServicesRequest")
-	proto.RegisterType((*ListServicesResponse)(nil), "pb.ListServicesResponse")
-	proto.RegisterType((*Service)(nil), "pb.Service")
-	proto.RegisterType((*AddAccountRequest)(nil), "pb.AddAccountRequest")
-	proto.RegisterType((*AddLabelLinkRequest)(nil), "pb.AddLabelLinkRequest")
-	proto.RegisterType((*Noop)(nil), "pb.Noop")
-	proto.RegisterType((*RemoveLabelLinkRequest)(nil), "pb.RemoveLabelLinkRequest")
-	proto.RegisterType((*CreateTokenRequest)(nil), "pb.CreateTokenRequest")
-	proto.RegisterType((*CreateTokenResponse)(nil), "pb.CreateTokenResponse")
-	proto.RegisterType((*ControlRegister)(nil), "pb.ControlRegister")
-	proto.RegisterType((*ControlToken)(nil), "pb.ControlToken")
-	proto.RegisterType((*TokenInfo)(nil), "pb.TokenInfo")
-	proto.RegisterType((*ListAccountsRequest)(nil), "pb.ListAccountsRequest")
-	proto.RegisterType((*ListAccountsResponse)(nil), "pb.ListAccountsResponse")
+func (m *SetHubMaxFlowsRequest) Reset()      { *m = SetHubMaxFlowsRequest{} }
+func (*SetHubMaxFlowsRequest) ProtoMessage() {}
+func (*SetHubMaxFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{42}
+}
+func (m *SetHubMaxFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetHubMaxFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetHubMaxFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetHubMaxFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHubMaxFlowsRequest.Merge(m, src)
+}
+func (m *SetHubMaxFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetHubMaxFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHubMaxFlowsRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("control.proto", fileDescriptor_0c5120591600887d) }
+var xxx_messageInfo_SetHubMaxFlowsRequest proto.InternalMessageInfo
 
-var fileDescriptor_0c5120591600887d = []byte{
-	// 1880 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x93, 0x1b, 0x47,
-	0x15, 0xd7, 0x48, 0x2b, 0xad, 0xf4, 0x24, 0xad, 0xbc, 0xad, 0x8d, 0x2d, 0x14, 0xd0, 0x9a, 0x8e,
-	0x89, 0x4d, 0x62, 0xaf, 0xc3, 0xae, 0x31, 0x1f, 0x65, 0x3e, 0x64, 0x99, 0x64, 0x17, 0xaf, 0x43,
-	0x6a, 0xd6, 0xc9, 0x75, 0xe8, 0x99, 0xe9, 0x95, 0xa6, 0x76, 0x34, 0x23, 0xa6, 0x7b, 0x76, 0x11,
-	0x07, 0x8a, 0xe2, 0x04, 0x37, 0x0e, 0x5c, 0xe0, 0xc6, 0x8d, 0xe2, 0x94, 0x3f, 0x23, 0x37, 0x7c,
-	0xa2, 0x72, 0xa0, 0x28, 0xbc, 0xbe, 0x70, 0xcc, 0x9f, 0x40, 0xf5, 0xc7, 0x7c, 0x69, 0x65, 0xd9,
-	0x71, 0x95, 0xab, 0x72, 0x53, 0xbf, 0xf7, 0x7b, 0xaf, 0xdf, 0x7b, 0xfd, 0xbe, 0x46, 0xd0, 0x76,
-	0xc2, 0x80, 0x47, 0xa1, 0xbf, 0x33, 0x8b, 0x42, 0x1e, 0xa2, 0xf2, 0xcc, 0xee, 0x77, 0x5c, 0x7a,
-	0xcc, 0x6e, 0x8f, 0xc3, 0x71, 0xa8, 0x88, 0xfd, 0xfa, 0xc9, 0xa9, 0xfe, 0xd5, 0xf4, 0x89, 0x4d,
-	0x35, 0xb6, 0xdf, 0x26, 0x8e, 0x13, 0xc6, 0x01, 0xd7, 0x47, 0x88, 0x7d, 0xcf, 0x4d, 0x70, 0x3c,
-	0x3c, 0xa1, 0x81, 0x3e, 0x74, 0xb8, 0x37, 0xa5, 0x8c, 0x93, 0xe9, 0x2c, 0x41, 0x1e, 0xfb, 0xe1,
-	0x59, 0xa2, 0x24, 0xa0, 0xfc, 0x2c, 0x8c, 0x4e, 0xd4, 0x11, 0xff, 0xd3, 0x80, 0x8d, 0x23, 0x1a,
-	0x9d, 0x7a, 0x0e, 0x35, 0xe9, 0xaf, 0x62, 0xca, 0x38, 0xfa, 0x16, 0xac, 0xeb, 0x8b, 0x7a, 0xc6,
-	0x55, 0xe3, 0x46, 0x73, 0xb7, 0xb9, 0x33, 0xb3, 0x77, 0x86, 0x8a, 0x64, 0x26, 0x3c, 0xd4, 0x87,
-	0xca, 0x24, 0xb6, 0x7b, 0x65, 0x09, 0xa9, 0x0b, 0xc8, 0xc7, 0x87, 0x07, 0x0f, 0x4c, 0x41, 0x44,
-	0x3d, 0x28, 0x7b, 0x6e, 0xaf, 0xb2, 0xc0, 0x2a, 0x7b, 0x2e, 0x42, 0xb0, 0xc6, 0xe7, 0x33, 0xda,
-	0x5b, 0xbb, 0x6a, 0xdc, 0x68, 0x98, 0xf2, 0x37, 0xba, 0x06, 0x35, 0xe9, 0x26, 0xeb, 0x55, 0xa5,
-	0x44, 0x4b, 0x48, 0x1c, 0x0a, 0xca, 0x11, 0xe5, 0xa6, 0xe6, 0xa1, 0xb7, 0xa1, 0x3e, 0xa5, 0x9c,
-	0xb8, 0x84, 0x93, 0x5e, 0xed, 0x6a, 0xe5, 0x46, 0x73, 0x17, 0x04, 0xee, 0xe1, 0x27, 0x1f, 0x11,
-	0x2f, 0x32, 0x53, 0x1e, 0xde, 0x84, 0x4e, 0xea, 0x10, 0x9b, 0x85, 0x01, 0xa3, 0xf8, 0x1f, 0x06,
-	0x34, 0xa4, 0xbe, 0x43, 0x2f, 0x38, 0x79, 0x59, 0xff, 0x32, 0xab, 0xca, 0x2b, 0xac, 0xba, 0x06,
-	0x35, 0x4e, 0xa2, 0x31, 0xe5, 0xda, 0xdb, 0x05, 0x94, 0xe2, 0xa1, 0x77, 0xa0, 0xe6, 0x7b, 0x53,
-	0x8f, 0x33, 0xe9, 0x77, 0x73, 0x17, 0xe5, 0x6e, 0xdc, 0x39, 0x94, 0x1c, 0x53, 0x23, 0xf0, 0x3d,
-	0x80, 0xd4, 0x56, 0x86, 0x76, 0x40, 0xa5, 0x80, 0xe5, 0x8b, 0x63, 0xcf, 0x90, 0x8e, 0xb7, 0xd3,
-	0x4b, 0x04, 0xc8, 0x04, 0x3f, 0xc5, 0xe3, 0xdf, 0x42, 0x2b, 0xf1, 0x3e, 0x8c, 0x39, 0x4d, 0x5e,
-	0xc9, 0x78, 0xfe, 0x2b, 0x95, 0x57, 0xbc, 0x52, 0x65, 0xe9, 0x2b, 0xad, 0x3d, 0x3f, 0x1e, 0xf8,
-	0x18, 0x3a, 0xda, 0x2f, 0x6d, 0x06, 0x7b, 0xd9, 0x78, 0xdf, 0x84, 0x3a, 0xd3, 0x22, 0xbd, 0xb2,
-	0x74, 0xf3, 0x92, 0xc0, 0xe5, 0xbd, 0x31, 0x53, 0x04, 0xe6, 0xd0, 0x1e, 0x3a, 0xdc, 0x3b, 0xf5,
-	0xf8, 0xfc, 0x67, 0x01, 0x8f, 0xe6, 0xe8, 0x0e, 0x34, 0x23, 0x81, 0xb1, 0x88, 0xeb, 0x52, 0x57,
-	0xdf, 0xd4, 0xcd, 0xdd, 0x94, 0xd8, 0x63, 0x82, 0xc4, 0x0d, 0x05, 0x0c, 0xdd, 0x82, 0xb6, 0x92,
-	0x8a, 0xe8, 0x34, 0x3c, 0xa5, 0x17, 0xa3, 0xd1, 0x92, 0x6c, 0x53, 0x71, 0xf1, 0x9f, 0x0d, 0x68,
-	0x8f, 0xc2, 0xe0, 0xd8, 0x1b, 0x67, 0xc5, 0xd2, 0x60, 0x9c, 0xd8, 0x3e, 0xb5, 0x3c, 0xf7, 0x42,
-	0x94, 0xeb, 0x8a, 0x75, 0xe0, 0xa2, 0x6f, 0x43, 0xd3, 0x0b, 0x18, 0x27, 0x81, 0x23, 0x81, 0x8b,
-	0xb7, 0x40, 0xc2, 0x3c, 0x70, 0xd1, 0x77, 0xa0, 0xe1, 0x87, 0x0e, 0xe1, 0x5e, 0x18, 0xb0, 0x5e,
-	0x45, 0x06, 0x42, 0xba, 0xf1, 0xa1, 0xaa, 0xdb, 0x43, 0xcd, 0x33, 0x33, 0x14, 0x7e, 0x66, 0xc0,
-	0x46, 0x62, 0x96, 0x4a, 0x79, 0x74, 0x05, 0xd6, 0xb9, 0xcf, 0xac, 0x13, 0x3a, 0x97, 0x56, 0xb5,
-	0xcc, 0x1a, 0xf7, 0xd9, 0x43, 0x3a, 0x47, 0x5f, 0x83, 0xba, 0x60, 0x38, 0x34, 0xe2, 0xd2, 0x8c,
-	0x96, 0x29, 0x80, 0x23, 0x1a, 0x71, 0xf4, 0x26, 0x34, 0x64, 0x1b, 0xb1, 0x66, 0xb1, 0x2d, 0x9f,
-	0xbe, 0x65, 0xd6, 0x25, 0xe1, 0xa3, 0xd8, 0x46, 0x18, 0xda, 0x6c, 0xcf, 0x22, 0x8e, 0x43, 0x99,
-	0x52, 0xab, 0x2a, 0xb8, 0xc9, 0xf6, 0x86, 0x92, 0x26, 0x74, 0x2b, 0x0c, 0xa3, 0x4e, 0x44, 0xb9,
-	0xc4, 0x54, 0x13, 0xcc, 0x91, 0xa4, 0x09, 0xcc, 0x9b, 0xd0, 0x60, 0x7b, 0x96, 0x1d, 0x3b, 0x27,
-	0x94, 0xf7, 0x6a, 0x92, 0x5f, 0x67, 0x7b, 0xf7, 0xe5, 0x59, 0x30, 0xbd, 0x29, 0x19, 0x53, 0x8b,
-	0x93, 0x71, 0x6f, 0x5d, 0x31, 0x25, 0xe1, 0x31, 0x19, 0xe3, 0x47, 0xd0, 0xd8, 0x8f, 0xed, 0xd1,
-	0x84, 0x04, 0x63, 0x8a, 0xb6, 0xa1, 0x16, 0xfa, 0xee, 0xb2, 0xa0, 0x57, 0x43, 0xdf, 0x3d, 0x70,
-	0x05, 0x20, 0xa0, 0x67, 0xcb, 0x82, 0x5d, 0x0d, 0xe8, 0xd9, 0x81, 0x8b, 0xff, 0x6d, 0x40, 0x67,
-	0x44, 0x03, 0x1e, 0x11, 0x3f, 0xc9, 0x24, 0xf4, 0x63, 0xb8, 0xa4, 0xd3, 0xd1, 0x4a, 0x73, 0xd1,
-	0xc8, 0x9e, 0x60, 0x31, 0x93, 0x3a, 0x64, 0x21, 0xd5, 0xdf, 0x82, 0x76, 0xa4, 0x12, 0xc3, 0x62,
-	0x9c, 0x70, 0xd5, 0x3a, 0xea, 0x66, 0x4b, 0x13, 0x8f, 0x04, 0x0d, 0xdd, 0x85, 0x8e, 0xb0, 0x2c,
-	0x5f, 0xd6, 0xaa, 0x77, 0x6c, 0x14, 0xca, 0x9a, 0x99, 0xed, 0x80, 0x9e, 0xe5, 0x5a, 0xc1, 0x4d,
-	0x80, 0x49, 0x6c, 0x5b, 0x8e, 0x0c, 0x80, 0x2e, 0x42, 0xd9, 0x09, 0xd2, 0xa8, 0x98, 0x8d, 0x49,
-	0xf2, 0x13, 0xff, 0xbe, 0x0a, 0xcd, 0xfd, 0xd8, 0x4e, 0x5d, 0xfb, 0x3e, 0xac, 0x0b, 0xe9, 0x88,
-	0x8e, 0x75, 0xc4, 0xb6, 0xb5, 0x68, 0x82, 0x10, 0xbf, 0x4d, 0x3a, 0xf6, 0x18, 0x8f, 0x54, 0x82,
-	0xd5, 0x26, 0x92, 0x80, 0xde, 0x86, 0x75, 0x46, 0x03, 0x6e, 0x11, 0xae, 0x43, 0x29, 0x2f, 0x7d,
-	0x9c, 0xcc, 0x18, 0xb3, 0x26, 0xb8, 0x43, 0x8e, 0x76, 0xa0, 0xaa, 0x9c, 0x56, 0xde, 0xf4, 0x96,
-	0xe8, 0x97, 0x01, 0x30, 0x15, 0x0c, 0x61, 0x58, 0x13, 0x73, 0xa9, 0xb7, 0x26, 0x03, 0x2c, 0x9d,
-	0x7f, 0xdf, 0x0f, 0xcf, 0x4c, 0xea, 0x84, 0x91, 0x6b, 0x4a, 0x5e, 0xff, 0x8f, 0x06, 0x74, 0x16,
-	0xec, 0x5a, 0xd9, 0xd2, 0xae, 0x03, 0xe8, 0x72, 0x5c, 0x36, 0x9b, 0x74, 0xa9, 0xee, 0xc7, 0xf6,
-	0x2b, 0x54, 0x59, 0xff, 0xd3, 0x32, 0xd4, 0x13, 0x1f, 0xd0, 0xbb, 0xb0, 0x49, 0xc6, 0x22, 0x2a,
-	0x4e, 0x18, 0x04, 0xd4, 0x51, 0x7a, 0x84, 0x49, 0x15, 0xf3, 0x92, 0x64, 0x8c, 0x32, 0xba, 0x48,
-	0x0b, 0x9d, 0x29, 0xcc, 0x62, 0x94, 0x06, 0xd2, 0xb0, 0x8a, 0xd9, 0x4a, 0x88, 0x47, 0x94, 0x06,
-	0xe8, 0x3a, 0x74, 0x52, 0x90, 0x43, 0x9c, 0x09, 0x55, 0x03, 0xb4, 0x62, 0x6e, 0x24, 0xe4, 0x91,
-	0xa4, 0xa2, 0x6f, 0x42, 0x4b, 0xf1, 0x2d, 0x7b, 0xce, 0xa9, 0x6a, 0xc7, 0x15, 0xb3, 0xa9, 0x68,
-	0xf7, 0x05, 0x09, 0x8d, 0xe0, 0xb2, 0x4f, 0x44, 0x12, 0xc6, 0xb2, 0x36, 0x8f, 0x63, 0xdf, 0x8a,
-	0x67, 0x2e, 0xe1, 0x54, 0x4f, 0xd8, 0x85, 0x17, 0xdc, 0x12, 0xe0, 0xa3, 0x14, 0xfb, 0xb1, 0x84,
-	0xa2, 0x21, 0xbc, 0x21, 0x95, 0x10, 0xce, 0xe9, 0x74, 0xc6, 0xa9, 0x9b, 0xe8, 0xa8, 0x2d, 0xd3,
-	0xd1, 0x15, 0xd8, 0x61, 0x02, 0x55, 0x2a, 0xf0, 0x27, 0xb0, 0xbe, 0x1f, 0xdb, 0x07, 0xc1, 0x71,
-	0xa8, 0x87, 0x8d, 0xb1, 0x64, 0xd8, 0x14, 0x9e, 0xa2, 0xfc, 0x52, 0x0d, 0xef, 0x16, 0xc0, 0xa1,
-	0xc7, 0xf8, 0x2f, 0x8e, 0xf7, 0x63, 0x9b, 0xa1, 0x6d, 0x58, 0x9b, 0xc4, 0x76, 0x52, 0xa9, 0x4d,
-	0x9d, 0x77, 0xe2, 0x56, 0x53, 0x32, 0xf0, 0x6f, 0xa4, 0x19, 0x47, 0xf3, 0xc0, 0x59, 0x61, 0x46,
-	0xa1, 0x93, 0x97, 0x9f, 0xdb, 0xc9, 0x77, 0x72, 0x63, 0x4a, 0xe5, 0x0d, 0xca, 0x8f, 0x29, 0x55,
-	0xe8, 0xb9, 0x41, 0x75, 0x57, 0x26, 0xb0, 0xb8, 0x3b, 0xed, 0xcd, 0x6f, 0x41, 0x5b, 0xb3, 0xad,
-	0x6c, 0x2c, 0x56, 0xcc, 0x96, 0x26, 0x8e, 0x04, 0x0d, 0xff, 0xc5, 0x00, 0x94, 0x66, 0x3e, 0x8d,
-	0xbe, 0x52, 0xf3, 0xe6, 0x03, 0xe8, 0x16, 0x4c, 0xd3, 0x7e, 0xbd, 0x07, 0x2d, 0xbd, 0xdc, 0x5a,
-	0x62, 0x03, 0xd5, 0xe6, 0x2d, 0xe4, 0x49, 0x53, 0x43, 0x04, 0x05, 0x4f, 0x60, 0x6b, 0x3f, 0xb6,
-	0x1f, 0x78, 0x4c, 0x57, 0xd1, 0x6b, 0xf3, 0x12, 0xef, 0x41, 0x57, 0x3f, 0xd1, 0x63, 0x31, 0xd1,
-	0x92, 0x8b, 0xbe, 0x0e, 0x8d, 0x80, 0x4c, 0x29, 0x9b, 0x11, 0x47, 0xd9, 0xdb, 0x30, 0x33, 0x02,
-	0xbe, 0x09, 0x5b, 0x45, 0x21, 0xed, 0xe8, 0x16, 0x54, 0xe5, 0x5c, 0xd4, 0x12, 0xea, 0x80, 0xef,
-	0x41, 0x57, 0x24, 0x65, 0x3a, 0x1d, 0xbe, 0xd4, 0x3a, 0x8d, 0x7f, 0x02, 0x5b, 0x45, 0x69, 0x7d,
-	0xd7, 0xf5, 0x5c, 0xbe, 0xe5, 0x12, 0x3c, 0xc9, 0xb7, 0x2c, 0xd1, 0xfe, 0x66, 0xc0, 0xba, 0xa6,
-	0xae, 0xc8, 0xf2, 0x55, 0x5b, 0xfb, 0x2b, 0x6f, 0x7d, 0x85, 0xdd, 0xbc, 0xba, 0x62, 0x37, 0x3f,
-	0x86, 0xcd, 0xa1, 0xeb, 0x26, 0xbe, 0x7f, 0xb9, 0xef, 0x8d, 0x6c, 0x87, 0x2e, 0xbf, 0x70, 0x87,
-	0xfe, 0x83, 0x01, 0xdd, 0xa1, 0xeb, 0x66, 0x2b, 0xb2, 0xbe, 0x2a, 0xf3, 0xc6, 0x58, 0xe1, 0x4d,
-	0xce, 0xa0, 0xf2, 0xea, 0x0f, 0x84, 0x17, 0xaf, 0xfe, 0xb8, 0x06, 0x6b, 0x1f, 0x86, 0xe1, 0x0c,
-	0x53, 0xb8, 0xac, 0xb6, 0xc8, 0xd7, 0x6a, 0x14, 0xfe, 0xd4, 0x00, 0x34, 0x8a, 0x28, 0xe1, 0xc5,
-	0x3c, 0x7f, 0xc9, 0x18, 0xff, 0x48, 0x8c, 0x96, 0x19, 0xb1, 0x3d, 0xdf, 0xe3, 0x1e, 0x2d, 0x74,
-	0x63, 0xa9, 0x6e, 0x94, 0x30, 0xe7, 0xf7, 0xd7, 0x3e, 0xfb, 0xcf, 0x76, 0xc9, 0x2c, 0xc0, 0xd1,
-	0x1d, 0xd8, 0x38, 0x25, 0xbe, 0xe7, 0x5a, 0x6e, 0xac, 0x66, 0xb5, 0x8e, 0xcc, 0x42, 0x0b, 0x68,
-	0x4b, 0xd0, 0x03, 0x8d, 0xc1, 0xef, 0x42, 0xb7, 0x60, 0xf1, 0xca, 0x22, 0xbb, 0x0d, 0x9d, 0x91,
-	0x6a, 0x20, 0x49, 0xfb, 0x79, 0x41, 0x0d, 0x5f, 0x83, 0x96, 0x16, 0x90, 0xea, 0x9f, 0xa3, 0xf6,
-	0x1d, 0x68, 0x48, 0xb6, 0x1c, 0x55, 0xdf, 0x00, 0x98, 0xc5, 0xb6, 0xef, 0x39, 0xb9, 0xf5, 0xb9,
-	0xa1, 0x28, 0x0f, 0xe9, 0x1c, 0x8f, 0x54, 0x9d, 0xeb, 0xe0, 0xa5, 0x75, 0xbe, 0x05, 0x55, 0x99,
-	0x7d, 0x52, 0xa0, 0x6a, 0xaa, 0x03, 0xba, 0x0c, 0xb5, 0x29, 0x89, 0x4e, 0x68, 0xa4, 0x97, 0x6d,
-	0x7d, 0xc2, 0xbf, 0x54, 0xe5, 0x9e, 0x29, 0xc9, 0xca, 0x3d, 0x19, 0xf7, 0xf9, 0x72, 0x4f, 0x5e,
-	0x2a, 0x65, 0xa2, 0x6d, 0x68, 0x06, 0xf4, 0xd7, 0xdc, 0x2a, 0x68, 0x07, 0x41, 0x7a, 0x24, 0x29,
-	0xbb, 0x7f, 0x5d, 0x4b, 0x43, 0x95, 0xee, 0xa7, 0xdf, 0x03, 0x18, 0xba, 0x6e, 0xd2, 0x25, 0x96,
-	0x0c, 0xae, 0x7e, 0xb7, 0x40, 0xd3, 0xdf, 0xcf, 0x25, 0xf4, 0x43, 0x68, 0xab, 0xec, 0x7d, 0x05,
-	0xd9, 0x11, 0xb4, 0xf2, 0x9d, 0x0d, 0x5d, 0x91, 0xf9, 0x7d, 0xb1, 0x53, 0xf6, 0x7b, 0x17, 0x19,
-	0xa9, 0x92, 0xbb, 0xd0, 0x7c, 0x9f, 0x72, 0x67, 0xa2, 0x3e, 0x73, 0xd0, 0xa6, 0x80, 0x16, 0xbe,
-	0xc4, 0xfa, 0x28, 0x4f, 0x4a, 0xe5, 0xee, 0xc1, 0xc6, 0x11, 0x8f, 0x28, 0x99, 0xa6, 0x8b, 0x70,
-	0x67, 0x61, 0x2f, 0x55, 0x66, 0x2f, 0x7c, 0x09, 0xe0, 0xd2, 0x0d, 0xe3, 0x3d, 0x03, 0xdd, 0x82,
-	0x75, 0x31, 0xb9, 0xc5, 0xc2, 0x98, 0xac, 0x15, 0xe2, 0xac, 0x44, 0x16, 0xc6, 0x3a, 0x2e, 0xa1,
-	0xef, 0x42, 0xbb, 0x30, 0xce, 0x50, 0xb2, 0x03, 0x5f, 0x98, 0x70, 0x7d, 0xd9, 0x7a, 0x65, 0x63,
-	0x28, 0x89, 0xe2, 0x1c, 0xfa, 0xbe, 0x5c, 0x65, 0x52, 0x72, 0x7f, 0x23, 0x09, 0x86, 0x5a, 0x72,
-	0x70, 0x09, 0xfd, 0x1c, 0xba, 0x5a, 0x3a, 0x3f, 0x94, 0x54, 0x38, 0x97, 0xcc, 0x36, 0x15, 0xce,
-	0x65, 0xf3, 0x0b, 0x97, 0x76, 0xff, 0x55, 0x81, 0x4d, 0x9d, 0x1c, 0x8f, 0x48, 0x40, 0xc6, 0x74,
-	0x4a, 0x03, 0x8e, 0xf6, 0xa0, 0x9e, 0x56, 0x55, 0x57, 0x87, 0x33, 0x5f, 0x6a, 0xfd, 0x4b, 0x39,
-	0xa2, 0x54, 0x89, 0x4b, 0xe8, 0xb6, 0xcc, 0x29, 0x9d, 0xa0, 0xe8, 0x0d, 0x99, 0xad, 0x8b, 0x3d,
-	0xbe, 0xe0, 0xee, 0x1e, 0xb4, 0xf2, 0xbd, 0x59, 0x39, 0xb0, 0xa4, 0x5b, 0x17, 0x84, 0x7e, 0x00,
-	0x9d, 0x85, 0xf6, 0x89, 0xfa, 0x82, 0xbd, 0xbc, 0xa7, 0x16, 0x44, 0x7f, 0x0a, 0xcd, 0x5c, 0x7f,
-	0x41, 0x97, 0xa5, 0x0f, 0x17, 0x5a, 0x64, 0xff, 0xca, 0x05, 0x7a, 0xfa, 0xae, 0x77, 0xa0, 0x7d,
-	0xc0, 0x58, 0x2c, 0x3e, 0x1c, 0x94, 0x8e, 0xec, 0x99, 0x56, 0x48, 0xed, 0xc0, 0xe6, 0x07, 0x94,
-	0x3f, 0xd6, 0x1f, 0xd0, 0xaa, 0x79, 0xe4, 0x24, 0xdb, 0x69, 0x57, 0x15, 0x4d, 0x27, 0xab, 0x93,
-	0xa4, 0x25, 0x64, 0x75, 0xb2, 0xd0, 0x69, 0xb2, 0x3a, 0x59, 0xec, 0x1e, 0xb8, 0x74, 0xff, 0xce,
-	0x93, 0xa7, 0x83, 0xd2, 0xe7, 0x4f, 0x07, 0xa5, 0x2f, 0x9e, 0x0e, 0x8c, 0xdf, 0x9d, 0x0f, 0x8c,
-	0xbf, 0x9f, 0x0f, 0x8c, 0xcf, 0xce, 0x07, 0xc6, 0x93, 0xf3, 0x81, 0xf1, 0xdf, 0xf3, 0x81, 0xf1,
-	0xbf, 0xf3, 0x41, 0xe9, 0x8b, 0xf3, 0x81, 0xf1, 0xa7, 0x67, 0x83, 0xd2, 0x93, 0x67, 0x83, 0xd2,
-	0xe7, 0xcf, 0x06, 0x25, 0xbb, 0x26, 0xff, 0x0c, 0xdc, 0xfb, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x58, 0x5f, 0x88, 0x84, 0x9d, 0x14, 0x00, 0x00,
+func (m *SetHubMaxFlowsRequest) GetStableId() *ULID {
+	if m != nil {
+		return m.StableId
+	}
+	return nil
 }
 
-func (this *ServiceRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SetHubMaxFlowsRequest) GetMaxFlows() int64 {
+	if m != nil {
+		return m.MaxFlows
 	}
+	return 0
+}
 
-	that1, ok := that.(*ServiceRequest)
-	if !ok {
-		that2, ok := that.(ServiceRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListActiveFlowsRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	HubId   *ULID    `protobuf:"bytes,2,opt,name=hub_id,json=hubId,proto3" json:"hub_id,omitempty"`
+}
+
+func (m *ListActiveFlowsRequest) Reset()      { *m = ListActiveFlowsRequest{} }
+func (*ListActiveFlowsRequest) ProtoMessage() {}
+func (*ListActiveFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{43}
+}
+func (m *ListActiveFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListActiveFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListActiveFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Account.Equal(that1.Account) {
-		return false
-	}
-	if !this.Hub.Equal(that1.Hub) {
-		return false
-	}
-	if !this.Id.Equal(that1.Id) {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
+}
+func (m *ListActiveFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListActiveFlowsRequest.Merge(m, src)
+}
+func (m *ListActiveFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListActiveFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListActiveFlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListActiveFlowsRequest proto.InternalMessageInfo
+
+func (m *ListActiveFlowsRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return false
+	return nil
+}
+
+func (m *ListActiveFlowsRequest) GetHubId() *ULID {
+	if m != nil {
+		return m.HubId
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return false
+	return nil
+}
+
+type ListActiveFlowsResponse struct {
+	Flows []*FlowStream `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows,omitempty"`
+}
+
+func (m *ListActiveFlowsResponse) Reset()      { *m = ListActiveFlowsResponse{} }
+func (*ListActiveFlowsResponse) ProtoMessage() {}
+func (*ListActiveFlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{44}
+}
+func (m *ListActiveFlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListActiveFlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListActiveFlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ServiceResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ListActiveFlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListActiveFlowsResponse.Merge(m, src)
+}
+func (m *ListActiveFlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListActiveFlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListActiveFlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListActiveFlowsResponse proto.InternalMessageInfo
+
+func (m *ListActiveFlowsResponse) GetFlows() []*FlowStream {
+	if m != nil {
+		return m.Flows
 	}
+	return nil
+}
 
-	that1, ok := that.(*ServiceResponse)
-	if !ok {
-		that2, ok := that.(ServiceResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type KillFlowRequest struct {
+	FlowId *ULID `protobuf:"bytes,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+}
+
+func (m *KillFlowRequest) Reset()      { *m = KillFlowRequest{} }
+func (*KillFlowRequest) ProtoMessage() {}
+func (*KillFlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{45}
+}
+func (m *KillFlowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KillFlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KillFlowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *LabelLink) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *KillFlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KillFlowRequest.Merge(m, src)
+}
+func (m *KillFlowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *KillFlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KillFlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KillFlowRequest proto.InternalMessageInfo
+
+func (m *KillFlowRequest) GetFlowId() *ULID {
+	if m != nil {
+		return m.FlowId
 	}
+	return nil
+}
 
-	that1, ok := that.(*LabelLink)
-	if !ok {
-		that2, ok := that.(LabelLink)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type LookupAccountRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *LookupAccountRequest) Reset()      { *m = LookupAccountRequest{} }
+func (*LookupAccountRequest) ProtoMessage() {}
+func (*LookupAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{46}
+}
+func (m *LookupAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Account.Equal(that1.Account) {
-		return false
+}
+func (m *LookupAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupAccountRequest.Merge(m, src)
+}
+func (m *LookupAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupAccountRequest proto.InternalMessageInfo
+
+func (m *LookupAccountRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
+	return ""
+}
+
+func (m *LookupAccountRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	if !this.Target.Equal(that1.Target) {
-		return false
+	return ""
+}
+
+type LookupAccountResponse struct {
+	Account    *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ExternalId string   `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *LookupAccountResponse) Reset()      { *m = LookupAccountResponse{} }
+func (*LookupAccountResponse) ProtoMessage() {}
+func (*LookupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{47}
+}
+func (m *LookupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Limits.Equal(that1.Limits) {
-		return false
+}
+func (m *LookupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupAccountResponse.Merge(m, src)
+}
+func (m *LookupAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupAccountResponse proto.InternalMessageInfo
+
+func (m *LookupAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *LabelLinks) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *LookupAccountResponse) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
+	return ""
+}
 
-	that1, ok := that.(*LabelLinks)
-	if !ok {
-		that2, ok := that.(LabelLinks)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AddAccountAliasRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Alias   *Account `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (m *AddAccountAliasRequest) Reset()      { *m = AddAccountAliasRequest{} }
+func (*AddAccountAliasRequest) ProtoMessage() {}
+func (*AddAccountAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{48}
+}
+func (m *AddAccountAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddAccountAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddAccountAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *AddAccountAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAccountAliasRequest.Merge(m, src)
+}
+func (m *AddAccountAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddAccountAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAccountAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAccountAliasRequest proto.InternalMessageInfo
+
+func (m *AddAccountAliasRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	if len(this.LabelLinks) != len(that1.LabelLinks) {
-		return false
+	return nil
+}
+
+func (m *AddAccountAliasRequest) GetAlias() *Account {
+	if m != nil {
+		return m.Alias
 	}
-	for i := range this.LabelLinks {
-		if !this.LabelLinks[i].Equal(that1.LabelLinks[i]) {
-			return false
+	return nil
+}
+
+type RemoveAccountAliasRequest struct {
+	Alias *Account `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (m *RemoveAccountAliasRequest) Reset()      { *m = RemoveAccountAliasRequest{} }
+func (*RemoveAccountAliasRequest) ProtoMessage() {}
+func (*RemoveAccountAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{49}
+}
+func (m *RemoveAccountAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveAccountAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveAccountAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ServiceRoute) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RemoveAccountAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAccountAliasRequest.Merge(m, src)
+}
+func (m *RemoveAccountAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveAccountAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAccountAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveAccountAliasRequest proto.InternalMessageInfo
+
+func (m *RemoveAccountAliasRequest) GetAlias() *Account {
+	if m != nil {
+		return m.Alias
 	}
+	return nil
+}
 
-	that1, ok := that.(*ServiceRoute)
-	if !ok {
-		that2, ok := that.(ServiceRoute)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetAccountFeatureRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool     `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *SetAccountFeatureRequest) Reset()      { *m = SetAccountFeatureRequest{} }
+func (*SetAccountFeatureRequest) ProtoMessage() {}
+func (*SetAccountFeatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{50}
+}
+func (m *SetAccountFeatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAccountFeatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAccountFeatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Hub.Equal(that1.Hub) {
-		return false
-	}
-	if !this.Id.Equal(that1.Id) {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
+}
+func (m *SetAccountFeatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountFeatureRequest.Merge(m, src)
+}
+func (m *SetAccountFeatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAccountFeatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountFeatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountFeatureRequest proto.InternalMessageInfo
+
+func (m *SetAccountFeatureRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
+	return nil
+}
+
+func (m *SetAccountFeatureRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *AccountServices) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetAccountFeatureRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
+	return false
+}
 
-	that1, ok := that.(*AccountServices)
-	if !ok {
-		that2, ok := that.(AccountServices)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetAccountFeaturesRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *GetAccountFeaturesRequest) Reset()      { *m = GetAccountFeaturesRequest{} }
+func (*GetAccountFeaturesRequest) ProtoMessage() {}
+func (*GetAccountFeaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{51}
+}
+func (m *GetAccountFeaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountFeaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountFeaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Account.Equal(that1.Account) {
-		return false
-	}
-	if len(this.Services) != len(that1.Services) {
-		return false
+}
+func (m *GetAccountFeaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountFeaturesRequest.Merge(m, src)
+}
+func (m *GetAccountFeaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountFeaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountFeaturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountFeaturesRequest proto.InternalMessageInfo
+
+func (m *GetAccountFeaturesRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	for i := range this.Services {
-		if !this.Services[i].Equal(that1.Services[i]) {
-			return false
+	return nil
+}
+
+type AccountFeature struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *AccountFeature) Reset()      { *m = AccountFeature{} }
+func (*AccountFeature) ProtoMessage() {}
+func (*AccountFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{52}
+}
+func (m *AccountFeature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ActivityEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AccountFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeature.Merge(m, src)
+}
+func (m *AccountFeature) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeature.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ActivityEntry)
-	if !ok {
-		that2, ok := that.(ActivityEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_AccountFeature proto.InternalMessageInfo
+
+func (m *AccountFeature) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *AccountFeature) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if !this.RouteAdded.Equal(that1.RouteAdded) {
-		return false
-	}
-	if !this.RouteRemoved.Equal(that1.RouteRemoved) {
-		return false
-	}
-	return true
+	return false
 }
-func (this *ConfigRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ConfigRequest)
-	if !ok {
-		that2, ok := that.(ConfigRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StableId.Equal(that1.StableId) {
-		return false
-	}
-	if !this.InstanceId.Equal(that1.InstanceId) {
-		return false
-	}
-	if len(this.Locations) != len(that1.Locations) {
-		return false
-	}
-	for i := range this.Locations {
-		if !this.Locations[i].Equal(that1.Locations[i]) {
-			return false
+type GetAccountFeaturesResponse struct {
+	Features []*AccountFeature `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (m *GetAccountFeaturesResponse) Reset()      { *m = GetAccountFeaturesResponse{} }
+func (*GetAccountFeaturesResponse) ProtoMessage() {}
+func (*GetAccountFeaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{53}
+}
+func (m *GetAccountFeaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountFeaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountFeaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ConfigResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetAccountFeaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountFeaturesResponse.Merge(m, src)
+}
+func (m *GetAccountFeaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountFeaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountFeaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountFeaturesResponse proto.InternalMessageInfo
+
+func (m *GetAccountFeaturesResponse) GetFeatures() []*AccountFeature {
+	if m != nil {
+		return m.Features
 	}
+	return nil
+}
 
-	that1, ok := that.(*ConfigResponse)
-	if !ok {
-		that2, ok := that.(ConfigResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetAccountDefaultLabelsRequest struct {
+	Account *Account  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Labels  *LabelSet `protobuf:"bytes,2,opt,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (m *SetAccountDefaultLabelsRequest) Reset()      { *m = SetAccountDefaultLabelsRequest{} }
+func (*SetAccountDefaultLabelsRequest) ProtoMessage() {}
+func (*SetAccountDefaultLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{54}
+}
+func (m *SetAccountDefaultLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAccountDefaultLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAccountDefaultLabelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.TlsKey, that1.TlsKey) {
-		return false
-	}
-	if !bytes.Equal(this.TlsCert, that1.TlsCert) {
-		return false
-	}
-	if !bytes.Equal(this.TokenPub, that1.TokenPub) {
-		return false
-	}
-	if this.S3AccessKey != that1.S3AccessKey {
-		return false
-	}
-	if this.S3SecretKey != that1.S3SecretKey {
-		return false
-	}
-	if this.S3Bucket != that1.S3Bucket {
-		return false
-	}
-	if this.ImageTag != that1.ImageTag {
-		return false
+}
+func (m *SetAccountDefaultLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountDefaultLabelsRequest.Merge(m, src)
+}
+func (m *SetAccountDefaultLabelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAccountDefaultLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountDefaultLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountDefaultLabelsRequest proto.InternalMessageInfo
+
+func (m *SetAccountDefaultLabelsRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *HubChange) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetAccountDefaultLabelsRequest) GetLabels() *LabelSet {
+	if m != nil {
+		return m.Labels
 	}
+	return nil
+}
 
-	that1, ok := that.(*HubChange)
-	if !ok {
-		that2, ok := that.(HubChange)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetAccountDefaultLabelsRequest struct {
+	Account        *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ConsistentRead bool     `protobuf:"varint,2,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+}
+
+func (m *GetAccountDefaultLabelsRequest) Reset()      { *m = GetAccountDefaultLabelsRequest{} }
+func (*GetAccountDefaultLabelsRequest) ProtoMessage() {}
+func (*GetAccountDefaultLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{55}
+}
+func (m *GetAccountDefaultLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountDefaultLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountDefaultLabelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OldId.Equal(that1.OldId) {
-		return false
-	}
-	if !this.NewId.Equal(that1.NewId) {
-		return false
+}
+func (m *GetAccountDefaultLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountDefaultLabelsRequest.Merge(m, src)
+}
+func (m *GetAccountDefaultLabelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountDefaultLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountDefaultLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountDefaultLabelsRequest proto.InternalMessageInfo
+
+func (m *GetAccountDefaultLabelsRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *CentralActivity) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetAccountDefaultLabelsRequest) GetConsistentRead() bool {
+	if m != nil {
+		return m.ConsistentRead
 	}
+	return false
+}
 
-	that1, ok := that.(*CentralActivity)
-	if !ok {
-		that2, ok := that.(CentralActivity)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetAccountDefaultLabelsResponse struct {
+	Labels *LabelSet `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (m *GetAccountDefaultLabelsResponse) Reset()      { *m = GetAccountDefaultLabelsResponse{} }
+func (*GetAccountDefaultLabelsResponse) ProtoMessage() {}
+func (*GetAccountDefaultLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{56}
+}
+func (m *GetAccountDefaultLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountDefaultLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountDefaultLabelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.AccountServices) != len(that1.AccountServices) {
-		return false
+}
+func (m *GetAccountDefaultLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountDefaultLabelsResponse.Merge(m, src)
+}
+func (m *GetAccountDefaultLabelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountDefaultLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountDefaultLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountDefaultLabelsResponse proto.InternalMessageInfo
+
+func (m *GetAccountDefaultLabelsResponse) GetLabels() *LabelSet {
+	if m != nil {
+		return m.Labels
 	}
-	for i := range this.AccountServices {
-		if !this.AccountServices[i].Equal(that1.AccountServices[i]) {
-			return false
+	return nil
+}
+
+type SetAccountTLSPolicyRequest struct {
+	Account *Account   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Policy  *TLSPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *SetAccountTLSPolicyRequest) Reset()      { *m = SetAccountTLSPolicyRequest{} }
+func (*SetAccountTLSPolicyRequest) ProtoMessage() {}
+func (*SetAccountTLSPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{57}
+}
+func (m *SetAccountTLSPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAccountTLSPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAccountTLSPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.RequestStats != that1.RequestStats {
-		return false
-	}
-	if !this.NewLabelLinks.Equal(that1.NewLabelLinks) {
-		return false
-	}
-	if !this.HubChange.Equal(that1.HubChange) {
-		return false
+}
+func (m *SetAccountTLSPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountTLSPolicyRequest.Merge(m, src)
+}
+func (m *SetAccountTLSPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAccountTLSPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountTLSPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountTLSPolicyRequest proto.InternalMessageInfo
+
+func (m *SetAccountTLSPolicyRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *HubActivity) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetAccountTLSPolicyRequest) GetPolicy() *TLSPolicy {
+	if m != nil {
+		return m.Policy
 	}
+	return nil
+}
 
-	that1, ok := that.(*HubActivity)
-	if !ok {
-		that2, ok := that.(HubActivity)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetAccountTLSPolicyRequest struct {
+	Account        *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ConsistentRead bool     `protobuf:"varint,2,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+}
+
+func (m *GetAccountTLSPolicyRequest) Reset()      { *m = GetAccountTLSPolicyRequest{} }
+func (*GetAccountTLSPolicyRequest) ProtoMessage() {}
+func (*GetAccountTLSPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{58}
+}
+func (m *GetAccountTLSPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountTLSPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountTLSPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.HubReg.Equal(that1.HubReg) {
-		return false
-	}
-	if !this.SentAt.Equal(that1.SentAt) {
-		return false
-	}
-	if !this.Stats.Equal(that1.Stats) {
-		return false
-	}
-	if len(this.Flow) != len(that1.Flow) {
-		return false
-	}
-	for i := range this.Flow {
-		if !this.Flow[i].Equal(that1.Flow[i]) {
-			return false
-		}
+}
+func (m *GetAccountTLSPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTLSPolicyRequest.Merge(m, src)
+}
+func (m *GetAccountTLSPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountTLSPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTLSPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTLSPolicyRequest proto.InternalMessageInfo
+
+func (m *GetAccountTLSPolicyRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *HubActivity_HubRegistration) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetAccountTLSPolicyRequest) GetConsistentRead() bool {
+	if m != nil {
+		return m.ConsistentRead
 	}
+	return false
+}
 
-	that1, ok := that.(*HubActivity_HubRegistration)
-	if !ok {
-		that2, ok := that.(HubActivity_HubRegistration)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetAccountTLSPolicyResponse struct {
+	Policy    *TLSPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Effective *TLSPolicy `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"`
+}
+
+func (m *GetAccountTLSPolicyResponse) Reset()      { *m = GetAccountTLSPolicyResponse{} }
+func (*GetAccountTLSPolicyResponse) ProtoMessage() {}
+func (*GetAccountTLSPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{59}
+}
+func (m *GetAccountTLSPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountTLSPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountTLSPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Hub.Equal(that1.Hub) {
-		return false
-	}
-	if !this.StableHub.Equal(that1.StableHub) {
-		return false
+}
+func (m *GetAccountTLSPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTLSPolicyResponse.Merge(m, src)
+}
+func (m *GetAccountTLSPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountTLSPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTLSPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTLSPolicyResponse proto.InternalMessageInfo
+
+func (m *GetAccountTLSPolicyResponse) GetPolicy() *TLSPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	if len(this.Locations) != len(that1.Locations) {
-		return false
+	return nil
+}
+
+func (m *GetAccountTLSPolicyResponse) GetEffective() *TLSPolicy {
+	if m != nil {
+		return m.Effective
 	}
-	for i := range this.Locations {
-		if !this.Locations[i].Equal(that1.Locations[i]) {
-			return false
+	return nil
+}
+
+type PeriodicJobStatus struct {
+	Name            string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	JobType         string     `protobuf:"bytes,2,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Period          string     `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	LastRunAt       *Timestamp `protobuf:"bytes,4,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastStatus      string     `protobuf:"bytes,5,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	LastError       string     `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRun         *Timestamp `protobuf:"bytes,7,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	PendingAttempts int32      `protobuf:"varint,8,opt,name=pending_attempts,json=pendingAttempts,proto3" json:"pending_attempts,omitempty"`
+	RetryAt         *Timestamp `protobuf:"bytes,9,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+}
+
+func (m *PeriodicJobStatus) Reset()      { *m = PeriodicJobStatus{} }
+func (*PeriodicJobStatus) ProtoMessage() {}
+func (*PeriodicJobStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{60}
+}
+func (m *PeriodicJobStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicJobStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicJobStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *HubActivity_HubStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PeriodicJobStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicJobStatus.Merge(m, src)
+}
+func (m *PeriodicJobStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicJobStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicJobStatus.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*HubActivity_HubStats)
-	if !ok {
-		that2, ok := that.(HubActivity_HubStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_PeriodicJobStatus proto.InternalMessageInfo
+
+func (m *PeriodicJobStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PeriodicJobStatus) GetJobType() string {
+	if m != nil {
+		return m.JobType
 	}
-	if this.AgentConnections != that1.AgentConnections {
-		return false
+	return ""
+}
+
+func (m *PeriodicJobStatus) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	if this.AccountsSeen != that1.AccountsSeen {
-		return false
+	return ""
+}
+
+func (m *PeriodicJobStatus) GetLastRunAt() *Timestamp {
+	if m != nil {
+		return m.LastRunAt
 	}
-	if this.AccountsCached != that1.AccountsCached {
-		return false
+	return nil
+}
+
+func (m *PeriodicJobStatus) GetLastStatus() string {
+	if m != nil {
+		return m.LastStatus
 	}
-	if this.CachedBytes != that1.CachedBytes {
-		return false
+	return ""
+}
+
+func (m *PeriodicJobStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	if !this.LastSuccessfulUpdate.Equal(that1.LastSuccessfulUpdate) {
-		return false
+	return ""
+}
+
+func (m *PeriodicJobStatus) GetNextRun() *Timestamp {
+	if m != nil {
+		return m.NextRun
 	}
-	if !this.LastAttemptedUpdate.Equal(that1.LastAttemptedUpdate) {
-		return false
+	return nil
+}
+
+func (m *PeriodicJobStatus) GetPendingAttempts() int32 {
+	if m != nil {
+		return m.PendingAttempts
 	}
-	return true
+	return 0
 }
-func (this *HubInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PeriodicJobStatus) GetRetryAt() *Timestamp {
+	if m != nil {
+		return m.RetryAt
 	}
+	return nil
+}
 
-	that1, ok := that.(*HubInfo)
-	if !ok {
-		that2, ok := that.(HubInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MaintenanceStatus struct {
+	Jobs []*PeriodicJobStatus `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *MaintenanceStatus) Reset()      { *m = MaintenanceStatus{} }
+func (*MaintenanceStatus) ProtoMessage() {}
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{61}
+}
+func (m *MaintenanceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Id.Equal(that1.Id) {
-		return false
-	}
-	if len(this.Locations) != len(that1.Locations) {
-		return false
+}
+func (m *MaintenanceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatus.Merge(m, src)
+}
+func (m *MaintenanceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatus proto.InternalMessageInfo
+
+func (m *MaintenanceStatus) GetJobs() []*PeriodicJobStatus {
+	if m != nil {
+		return m.Jobs
 	}
-	for i := range this.Locations {
-		if !this.Locations[i].Equal(that1.Locations[i]) {
-			return false
+	return nil
+}
+
+type EnqueueJobRequest struct {
+	JobType  string   `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Payload  []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority int32    `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Account  *Account `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *EnqueueJobRequest) Reset()      { *m = EnqueueJobRequest{} }
+func (*EnqueueJobRequest) ProtoMessage() {}
+func (*EnqueueJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{62}
+}
+func (m *EnqueueJobRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnqueueJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnqueueJobRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ListOfHubs) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EnqueueJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueueJobRequest.Merge(m, src)
+}
+func (m *EnqueueJobRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnqueueJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueueJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueueJobRequest proto.InternalMessageInfo
+
+func (m *EnqueueJobRequest) GetJobType() string {
+	if m != nil {
+		return m.JobType
 	}
+	return ""
+}
 
-	that1, ok := that.(*ListOfHubs)
-	if !ok {
-		that2, ok := that.(ListOfHubs)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Hubs) != len(that1.Hubs) {
-		return false
-	}
-	for i := range this.Hubs {
-		if !this.Hubs[i].Equal(that1.Hubs[i]) {
-			return false
-		}
+func (m *EnqueueJobRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return true
+	return nil
 }
-func (this *HubSync) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*HubSync)
-	if !ok {
-		that2, ok := that.(HubSync)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Id.Equal(that1.Id) {
-		return false
+func (m *EnqueueJobRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if !this.StableId.Equal(that1.StableId) {
-		return false
+	return ""
+}
+
+func (m *EnqueueJobRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	if len(this.Services) != len(that1.Services) {
-		return false
+	return 0
+}
+
+func (m *EnqueueJobRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	for i := range this.Services {
-		if !this.Services[i].Equal(that1.Services[i]) {
-			return false
+	return nil
+}
+
+type EnqueueJobResponse struct {
+	JobId *ULID `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (m *EnqueueJobResponse) Reset()      { *m = EnqueueJobResponse{} }
+func (*EnqueueJobResponse) ProtoMessage() {}
+func (*EnqueueJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{63}
+}
+func (m *EnqueueJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnqueueJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnqueueJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *HubSyncResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EnqueueJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueueJobResponse.Merge(m, src)
+}
+func (m *EnqueueJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnqueueJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueueJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueueJobResponse proto.InternalMessageInfo
+
+func (m *EnqueueJobResponse) GetJobId() *ULID {
+	if m != nil {
+		return m.JobId
 	}
+	return nil
+}
 
-	that1, ok := that.(*HubSyncResponse)
-	if !ok {
-		that2, ok := that.(HubSyncResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetMaintenanceModeRequest struct {
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SetMaintenanceModeRequest) Reset()      { *m = SetMaintenanceModeRequest{} }
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{64}
+}
+func (m *SetMaintenanceModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMaintenanceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMaintenanceModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ServiceCount != that1.ServiceCount {
-		return false
+}
+func (m *SetMaintenanceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMaintenanceModeRequest.Merge(m, src)
+}
+func (m *SetMaintenanceModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMaintenanceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMaintenanceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMaintenanceModeRequest proto.InternalMessageInfo
+
+func (m *SetMaintenanceModeRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return true
+	return false
 }
-func (this *HubRegisterRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetMaintenanceModeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*HubRegisterRequest)
-	if !ok {
-		that2, ok := that.(HubRegisterRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MaintenanceMode struct {
+	Enabled bool       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason  string     `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since   *Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (m *MaintenanceMode) Reset()      { *m = MaintenanceMode{} }
+func (*MaintenanceMode) ProtoMessage() {}
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{65}
+}
+func (m *MaintenanceMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StableId.Equal(that1.StableId) {
-		return false
+}
+func (m *MaintenanceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceMode.Merge(m, src)
+}
+func (m *MaintenanceMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceMode proto.InternalMessageInfo
+
+func (m *MaintenanceMode) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if !this.InstanceId.Equal(that1.InstanceId) {
-		return false
+	return false
+}
+
+func (m *MaintenanceMode) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(this.Locations) != len(that1.Locations) {
-		return false
+	return ""
+}
+
+func (m *MaintenanceMode) GetSince() *Timestamp {
+	if m != nil {
+		return m.Since
 	}
-	for i := range this.Locations {
-		if !this.Locations[i].Equal(that1.Locations[i]) {
-			return false
+	return nil
+}
+
+type FlushASNCacheResponse struct {
+	Flushed int64 `protobuf:"varint,1,opt,name=flushed,proto3" json:"flushed,omitempty"`
+}
+
+func (m *FlushASNCacheResponse) Reset()      { *m = FlushASNCacheResponse{} }
+func (*FlushASNCacheResponse) ProtoMessage() {}
+func (*FlushASNCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{66}
+}
+func (m *FlushASNCacheResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushASNCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlushASNCacheResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *HubRegisterResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *FlushASNCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushASNCacheResponse.Merge(m, src)
+}
+func (m *FlushASNCacheResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushASNCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushASNCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushASNCacheResponse proto.InternalMessageInfo
+
+func (m *FlushASNCacheResponse) GetFlushed() int64 {
+	if m != nil {
+		return m.Flushed
 	}
+	return 0
+}
 
-	that1, ok := that.(*HubRegisterResponse)
-	if !ok {
-		that2, ok := that.(HubRegisterResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RebuildRoutingStateRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	DryRun  bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *RebuildRoutingStateRequest) Reset()      { *m = RebuildRoutingStateRequest{} }
+func (*RebuildRoutingStateRequest) ProtoMessage() {}
+func (*RebuildRoutingStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{67}
+}
+func (m *RebuildRoutingStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebuildRoutingStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebuildRoutingStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ControlTime.Equal(that1.ControlTime) {
-		return false
+}
+func (m *RebuildRoutingStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildRoutingStateRequest.Merge(m, src)
+}
+func (m *RebuildRoutingStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebuildRoutingStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildRoutingStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildRoutingStateRequest proto.InternalMessageInfo
+
+func (m *RebuildRoutingStateRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *HubDisconnectRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RebuildRoutingStateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
+	return false
+}
 
-	that1, ok := that.(*HubDisconnectRequest)
-	if !ok {
-		that2, ok := that.(HubDisconnectRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RebuildRoutingStateResponse struct {
+	RoutesAdded       []*ServiceRoute `protobuf:"bytes,1,rep,name=routes_added,json=routesAdded,proto3" json:"routes_added,omitempty"`
+	RoutesRemoved     []*ServiceRoute `protobuf:"bytes,2,rep,name=routes_removed,json=routesRemoved,proto3" json:"routes_removed,omitempty"`
+	SettingsChanged   bool            `protobuf:"varint,3,opt,name=settings_changed,json=settingsChanged,proto3" json:"settings_changed,omitempty"`
+	LabelLinksAdded   []*LabelLink    `protobuf:"bytes,4,rep,name=label_links_added,json=labelLinksAdded,proto3" json:"label_links_added,omitempty"`
+	LabelLinksRemoved []*LabelLink    `protobuf:"bytes,5,rep,name=label_links_removed,json=labelLinksRemoved,proto3" json:"label_links_removed,omitempty"`
+	Changed           bool            `protobuf:"varint,6,opt,name=changed,proto3" json:"changed,omitempty"`
+	DryRun            bool            `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *RebuildRoutingStateResponse) Reset()      { *m = RebuildRoutingStateResponse{} }
+func (*RebuildRoutingStateResponse) ProtoMessage() {}
+func (*RebuildRoutingStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{68}
+}
+func (m *RebuildRoutingStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebuildRoutingStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebuildRoutingStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StableId.Equal(that1.StableId) {
-		return false
+}
+func (m *RebuildRoutingStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildRoutingStateResponse.Merge(m, src)
+}
+func (m *RebuildRoutingStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebuildRoutingStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildRoutingStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildRoutingStateResponse proto.InternalMessageInfo
+
+func (m *RebuildRoutingStateResponse) GetRoutesAdded() []*ServiceRoute {
+	if m != nil {
+		return m.RoutesAdded
 	}
-	if !this.InstanceId.Equal(that1.InstanceId) {
-		return false
+	return nil
+}
+
+func (m *RebuildRoutingStateResponse) GetRoutesRemoved() []*ServiceRoute {
+	if m != nil {
+		return m.RoutesRemoved
 	}
-	return true
+	return nil
 }
-func (this *ServiceTokenRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RebuildRoutingStateResponse) GetSettingsChanged() bool {
+	if m != nil {
+		return m.SettingsChanged
 	}
+	return false
+}
 
-	that1, ok := that.(*ServiceTokenRequest)
-	if !ok {
-		that2, ok := that.(ServiceTokenRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RebuildRoutingStateResponse) GetLabelLinksAdded() []*LabelLink {
+	if m != nil {
+		return m.LabelLinksAdded
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *RebuildRoutingStateResponse) GetLabelLinksRemoved() []*LabelLink {
+	if m != nil {
+		return m.LabelLinksRemoved
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return nil
+}
+
+func (m *RebuildRoutingStateResponse) GetChanged() bool {
+	if m != nil {
+		return m.Changed
 	}
-	return true
+	return false
 }
-func (this *ServiceTokenResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RebuildRoutingStateResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
+	return false
+}
 
-	that1, ok := that.(*ServiceTokenResponse)
-	if !ok {
-		that2, ok := that.(ServiceTokenResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type WatchEventsRequest struct {
+	Cursor string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Types  []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (m *WatchEventsRequest) Reset()      { *m = WatchEventsRequest{} }
+func (*WatchEventsRequest) ProtoMessage() {}
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{69}
+}
+func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Token != that1.Token {
-		return false
+}
+func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+}
+func (m *WatchEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
+
+func (m *WatchEventsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return true
+	return ""
 }
-func (this *ListServicesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *WatchEventsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
 	}
+	return nil
+}
 
-	that1, ok := that.(*ListServicesRequest)
-	if !ok {
-		that2, ok := that.(ListServicesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ControlEvent struct {
+	Cursor    string     `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Type      string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Time      *Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Namespace string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Account   string     `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Data      []byte     `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ControlEvent) Reset()      { *m = ControlEvent{} }
+func (*ControlEvent) ProtoMessage() {}
+func (*ControlEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{70}
+}
+func (m *ControlEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControlEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControlEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ControlEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlEvent.Merge(m, src)
+}
+func (m *ControlEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControlEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlEvent proto.InternalMessageInfo
+
+func (m *ControlEvent) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	if !this.Account.Equal(that1.Account) {
-		return false
+	return ""
+}
+
+func (m *ControlEvent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return true
+	return ""
 }
-func (this *ListServicesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ControlEvent) GetTime() *Timestamp {
+	if m != nil {
+		return m.Time
 	}
+	return nil
+}
 
-	that1, ok := that.(*ListServicesResponse)
-	if !ok {
-		that2, ok := that.(ListServicesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ControlEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *ControlEvent) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	if len(this.Services) != len(that1.Services) {
-		return false
+	return ""
+}
+
+func (m *ControlEvent) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	for i := range this.Services {
-		if !this.Services[i].Equal(that1.Services[i]) {
-			return false
+	return nil
+}
+
+type CleanupOrphanedObjectsRequest struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *CleanupOrphanedObjectsRequest) Reset()      { *m = CleanupOrphanedObjectsRequest{} }
+func (*CleanupOrphanedObjectsRequest) ProtoMessage() {}
+func (*CleanupOrphanedObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{71}
+}
+func (m *CleanupOrphanedObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupOrphanedObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupOrphanedObjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Service) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CleanupOrphanedObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupOrphanedObjectsRequest.Merge(m, src)
+}
+func (m *CleanupOrphanedObjectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupOrphanedObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupOrphanedObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupOrphanedObjectsRequest proto.InternalMessageInfo
+
+func (m *CleanupOrphanedObjectsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
+	return false
+}
 
-	that1, ok := that.(*Service)
-	if !ok {
-		that2, ok := that.(Service)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CleanupOrphanedObjectsResponse struct {
+	Keys         []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	LiveAccounts int64    `protobuf:"varint,2,opt,name=live_accounts,json=liveAccounts,proto3" json:"live_accounts,omitempty"`
+	DryRun       bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *CleanupOrphanedObjectsResponse) Reset()      { *m = CleanupOrphanedObjectsResponse{} }
+func (*CleanupOrphanedObjectsResponse) ProtoMessage() {}
+func (*CleanupOrphanedObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{72}
+}
+func (m *CleanupOrphanedObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupOrphanedObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupOrphanedObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *CleanupOrphanedObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupOrphanedObjectsResponse.Merge(m, src)
+}
+func (m *CleanupOrphanedObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupOrphanedObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupOrphanedObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupOrphanedObjectsResponse proto.InternalMessageInfo
+
+func (m *CleanupOrphanedObjectsResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	if !this.Id.Equal(that1.Id) {
-		return false
-	}
-	if !this.Hub.Equal(that1.Hub) {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
+	return nil
+}
+
+func (m *CleanupOrphanedObjectsResponse) GetLiveAccounts() int64 {
+	if m != nil {
+		return m.LiveAccounts
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return false
+	return 0
+}
+
+func (m *CleanupOrphanedObjectsResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return false
+	return false
+}
+
+type AccountKey struct {
+	Id         *ULID      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account    *Account   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Name       string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt  *Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt *Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  *Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+}
+
+func (m *AccountKey) Reset()      { *m = AccountKey{} }
+func (*AccountKey) ProtoMessage() {}
+func (*AccountKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{73}
+}
+func (m *AccountKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *AddAccountRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *AccountKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountKey.Merge(m, src)
+}
+func (m *AccountKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountKey proto.InternalMessageInfo
+
+func (m *AccountKey) GetId() *ULID {
+	if m != nil {
+		return m.Id
 	}
+	return nil
+}
 
-	that1, ok := that.(*AddAccountRequest)
-	if !ok {
-		that2, ok := that.(AddAccountRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *AccountKey) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *AccountKey) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if !this.Account.Equal(that1.Account) {
-		return false
+	return ""
+}
+
+func (m *AccountKey) GetCreatedAt() *Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if !this.Limits.Equal(that1.Limits) {
-		return false
+	return nil
+}
+
+func (m *AccountKey) GetLastUsedAt() *Timestamp {
+	if m != nil {
+		return m.LastUsedAt
 	}
-	return true
+	return nil
 }
-func (this *AddLabelLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AccountKey) GetRevokedAt() *Timestamp {
+	if m != nil {
+		return m.RevokedAt
 	}
+	return nil
+}
 
-	that1, ok := that.(*AddLabelLinkRequest)
-	if !ok {
-		that2, ok := that.(AddLabelLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateAccountKeyRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *CreateAccountKeyRequest) Reset()      { *m = CreateAccountKeyRequest{} }
+func (*CreateAccountKeyRequest) ProtoMessage() {}
+func (*CreateAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{74}
+}
+func (m *CreateAccountKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccountKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccountKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
-	}
-	if !this.Account.Equal(that1.Account) {
-		return false
-	}
-	if !this.Target.Equal(that1.Target) {
-		return false
+}
+func (m *CreateAccountKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountKeyRequest.Merge(m, src)
+}
+func (m *CreateAccountKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccountKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountKeyRequest proto.InternalMessageInfo
+
+func (m *CreateAccountKeyRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *Noop) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateAccountKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*Noop)
-	if !ok {
-		that2, ok := that.(Noop)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateAccountKeyResponse struct {
+	Key    *AccountKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret string      `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *CreateAccountKeyResponse) Reset()      { *m = CreateAccountKeyResponse{} }
+func (*CreateAccountKeyResponse) ProtoMessage() {}
+func (*CreateAccountKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{75}
+}
+func (m *CreateAccountKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccountKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccountKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *CreateAccountKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountKeyResponse.Merge(m, src)
+}
+func (m *CreateAccountKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccountKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountKeyResponse proto.InternalMessageInfo
+
+func (m *CreateAccountKeyResponse) GetKey() *AccountKey {
+	if m != nil {
+		return m.Key
 	}
-	return true
+	return nil
 }
-func (this *RemoveLabelLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateAccountKeyResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
+	return ""
+}
 
-	that1, ok := that.(*RemoveLabelLinkRequest)
-	if !ok {
-		that2, ok := that.(RemoveLabelLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListAccountKeysRequest struct {
+	Account        *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	BestEffort     bool     `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	ConsistentRead bool     `protobuf:"varint,3,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+}
+
+func (m *ListAccountKeysRequest) Reset()      { *m = ListAccountKeysRequest{} }
+func (*ListAccountKeysRequest) ProtoMessage() {}
+func (*ListAccountKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{76}
+}
+func (m *ListAccountKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Labels.Equal(that1.Labels) {
-		return false
+}
+func (m *ListAccountKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountKeysRequest.Merge(m, src)
+}
+func (m *ListAccountKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountKeysRequest proto.InternalMessageInfo
+
+func (m *ListAccountKeysRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	if !this.Account.Equal(that1.Account) {
-		return false
+	return nil
+}
+
+func (m *ListAccountKeysRequest) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
 	}
-	return true
+	return false
 }
-func (this *CreateTokenRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListAccountKeysRequest) GetConsistentRead() bool {
+	if m != nil {
+		return m.ConsistentRead
 	}
+	return false
+}
 
-	that1, ok := that.(*CreateTokenRequest)
-	if !ok {
-		that2, ok := that.(CreateTokenRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListAccountKeysResponse struct {
+	Keys   []*AccountKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Errors []*ItemError  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *ListAccountKeysResponse) Reset()      { *m = ListAccountKeysResponse{} }
+func (*ListAccountKeysResponse) ProtoMessage() {}
+func (*ListAccountKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{77}
+}
+func (m *ListAccountKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Account.Equal(that1.Account) {
-		return false
+}
+func (m *ListAccountKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountKeysResponse.Merge(m, src)
+}
+func (m *ListAccountKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountKeysResponse proto.InternalMessageInfo
+
+func (m *ListAccountKeysResponse) GetKeys() []*AccountKey {
+	if m != nil {
+		return m.Keys
 	}
-	if len(this.Capabilities) != len(that1.Capabilities) {
-		return false
+	return nil
+}
+
+func (m *ListAccountKeysResponse) GetErrors() []*ItemError {
+	if m != nil {
+		return m.Errors
 	}
-	for i := range this.Capabilities {
-		if !this.Capabilities[i].Equal(&that1.Capabilities[i]) {
-			return false
+	return nil
+}
+
+type RevokeAccountKeyRequest struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	KeyId   *ULID    `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *RevokeAccountKeyRequest) Reset()      { *m = RevokeAccountKeyRequest{} }
+func (*RevokeAccountKeyRequest) ProtoMessage() {}
+func (*RevokeAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{78}
+}
+func (m *RevokeAccountKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeAccountKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAccountKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.ValidDuration.Equal(that1.ValidDuration) {
-		return false
+}
+func (m *RevokeAccountKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccountKeyRequest.Merge(m, src)
+}
+func (m *RevokeAccountKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeAccountKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccountKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccountKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAccountKeyRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
 	}
-	return true
+	return nil
 }
-func (this *CreateTokenResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RevokeAccountKeyRequest) GetKeyId() *ULID {
+	if m != nil {
+		return m.KeyId
 	}
+	return nil
+}
 
-	that1, ok := that.(*CreateTokenResponse)
-	if !ok {
-		that2, ok := that.(CreateTokenResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type HubCredential struct {
+	Id         *ULID      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt  *Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt *Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  *Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	HubId      *ULID      `protobuf:"bytes,6,opt,name=hub_id,json=hubId,proto3" json:"hub_id,omitempty"`
+}
+
+func (m *HubCredential) Reset()      { *m = HubCredential{} }
+func (*HubCredential) ProtoMessage() {}
+func (*HubCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{79}
+}
+func (m *HubCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HubCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HubCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Token != that1.Token {
-		return false
+}
+func (m *HubCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HubCredential.Merge(m, src)
+}
+func (m *HubCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *HubCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_HubCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HubCredential proto.InternalMessageInfo
+
+func (m *HubCredential) GetId() *ULID {
+	if m != nil {
+		return m.Id
 	}
-	return true
+	return nil
 }
-func (this *ControlRegister) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *HubCredential) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*ControlRegister)
-	if !ok {
-		that2, ok := that.(ControlRegister)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *HubCredential) GetCreatedAt() *Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *HubCredential) GetLastUsedAt() *Timestamp {
+	if m != nil {
+		return m.LastUsedAt
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return nil
+}
+
+func (m *HubCredential) GetRevokedAt() *Timestamp {
+	if m != nil {
+		return m.RevokedAt
 	}
-	return true
+	return nil
 }
-func (this *ControlToken) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *HubCredential) GetHubId() *ULID {
+	if m != nil {
+		return m.HubId
 	}
+	return nil
+}
 
-	that1, ok := that.(*ControlToken)
-	if !ok {
-		that2, ok := that.(ControlToken)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type IssueHubCredentialRequest struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HubId *ULID  `protobuf:"bytes,2,opt,name=hub_id,json=hubId,proto3" json:"hub_id,omitempty"`
+}
+
+func (m *IssueHubCredentialRequest) Reset()      { *m = IssueHubCredentialRequest{} }
+func (*IssueHubCredentialRequest) ProtoMessage() {}
+func (*IssueHubCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{80}
+}
+func (m *IssueHubCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueHubCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueHubCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Token != that1.Token {
-		return false
+}
+func (m *IssueHubCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueHubCredentialRequest.Merge(m, src)
+}
+func (m *IssueHubCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueHubCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueHubCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueHubCredentialRequest proto.InternalMessageInfo
+
+func (m *IssueHubCredentialRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *TokenInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *IssueHubCredentialRequest) GetHubId() *ULID {
+	if m != nil {
+		return m.HubId
 	}
+	return nil
+}
 
-	that1, ok := that.(*TokenInfo)
-	if !ok {
-		that2, ok := that.(TokenInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type IssueHubCredentialResponse struct {
+	Credential *HubCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	Secret     string         `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *IssueHubCredentialResponse) Reset()      { *m = IssueHubCredentialResponse{} }
+func (*IssueHubCredentialResponse) ProtoMessage() {}
+func (*IssueHubCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{81}
+}
+func (m *IssueHubCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueHubCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueHubCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
-		return false
+}
+func (m *IssueHubCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueHubCredentialResponse.Merge(m, src)
+}
+func (m *IssueHubCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueHubCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueHubCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueHubCredentialResponse proto.InternalMessageInfo
+
+func (m *IssueHubCredentialResponse) GetCredential() *HubCredential {
+	if m != nil {
+		return m.Credential
 	}
-	return true
+	return nil
 }
-func (this *ListAccountsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *IssueHubCredentialResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
+	return ""
+}
 
-	that1, ok := that.(*ListAccountsRequest)
-	if !ok {
-		that2, ok := that.(ListAccountsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListHubCredentialsResponse struct {
+	Credentials []*HubCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (m *ListHubCredentialsResponse) Reset()      { *m = ListHubCredentialsResponse{} }
+func (*ListHubCredentialsResponse) ProtoMessage() {}
+func (*ListHubCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{82}
+}
+func (m *ListHubCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListHubCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListHubCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Limit != that1.Limit {
-		return false
-	}
-	if !bytes.Equal(this.Marker, that1.Marker) {
-		return false
-	}
-	return true
 }
-func (this *ListAccountsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ListHubCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHubCredentialsResponse.Merge(m, src)
+}
+func (m *ListHubCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListHubCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHubCredentialsResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ListAccountsResponse)
+var xxx_messageInfo_ListHubCredentialsResponse proto.InternalMessageInfo
+
+func (m *ListHubCredentialsResponse) GetCredentials() []*HubCredential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+type RevokeHubCredentialRequest struct {
+	CredentialId *ULID `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+}
+
+func (m *RevokeHubCredentialRequest) Reset()      { *m = RevokeHubCredentialRequest{} }
+func (*RevokeHubCredentialRequest) ProtoMessage() {}
+func (*RevokeHubCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{83}
+}
+func (m *RevokeHubCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeHubCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeHubCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeHubCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeHubCredentialRequest.Merge(m, src)
+}
+func (m *RevokeHubCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeHubCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeHubCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeHubCredentialRequest proto.InternalMessageInfo
+
+func (m *RevokeHubCredentialRequest) GetCredentialId() *ULID {
+	if m != nil {
+		return m.CredentialId
+	}
+	return nil
+}
+
+type ListAccountsRequest struct {
+	Limit          int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Marker         []byte `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
+	BestEffort     bool   `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	ConsistentRead bool   `protobuf:"varint,4,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+}
+
+func (m *ListAccountsRequest) Reset()      { *m = ListAccountsRequest{} }
+func (*ListAccountsRequest) ProtoMessage() {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{84}
+}
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAccountsRequest) GetMarker() []byte {
+	if m != nil {
+		return m.Marker
+	}
+	return nil
+}
+
+func (m *ListAccountsRequest) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *ListAccountsRequest) GetConsistentRead() bool {
+	if m != nil {
+		return m.ConsistentRead
+	}
+	return false
+}
+
+type ListAccountsResponse struct {
+	Accounts   []*Account   `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	NextMarker []byte       `protobuf:"bytes,2,opt,name=next_marker,json=nextMarker,proto3" json:"next_marker,omitempty"`
+	Errors     []*ItemError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *ListAccountsResponse) Reset()      { *m = ListAccountsResponse{} }
+func (*ListAccountsResponse) ProtoMessage() {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{85}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetNextMarker() []byte {
+	if m != nil {
+		return m.NextMarker
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetErrors() []*ItemError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pb.AddLabelLinkRequest_ConflictMode", AddLabelLinkRequest_ConflictMode_name, AddLabelLinkRequest_ConflictMode_value)
+	proto.RegisterType((*ServiceRequest)(nil), "pb.ServiceRequest")
+	proto.RegisterType((*ServiceResponse)(nil), "pb.ServiceResponse")
+	proto.RegisterType((*LabelLink)(nil), "pb.LabelLink")
+	proto.RegisterType((*LabelLinks)(nil), "pb.LabelLinks")
+	proto.RegisterType((*ServiceRoute)(nil), "pb.ServiceRoute")
+	proto.RegisterType((*AccountServices)(nil), "pb.AccountServices")
+	proto.RegisterType((*TLSPolicy)(nil), "pb.TLSPolicy")
+	proto.RegisterType((*ActivityEntry)(nil), "pb.ActivityEntry")
+	proto.RegisterType((*ConfigRequest)(nil), "pb.ConfigRequest")
+	proto.RegisterType((*ConfigResponse)(nil), "pb.ConfigResponse")
+	proto.RegisterType((*ReconnectPolicy)(nil), "pb.ReconnectPolicy")
+	proto.RegisterType((*HubChange)(nil), "pb.HubChange")
+	proto.RegisterType((*MigrateStream)(nil), "pb.MigrateStream")
+	proto.RegisterType((*HubTLS)(nil), "pb.HubTLS")
+	proto.RegisterType((*CentralActivity)(nil), "pb.CentralActivity")
+	proto.RegisterType((*HubActivity)(nil), "pb.HubActivity")
+	proto.RegisterType((*HubActivity_HubRegistration)(nil), "pb.HubActivity.HubRegistration")
+	proto.RegisterType((*HubActivity_HubStats)(nil), "pb.HubActivity.HubStats")
+	proto.RegisterType((*HubInfo)(nil), "pb.HubInfo")
+	proto.RegisterType((*ListOfHubs)(nil), "pb.ListOfHubs")
+	proto.RegisterType((*ProtocolVersionCount)(nil), "pb.ProtocolVersionCount")
+	proto.RegisterType((*HubSync)(nil), "pb.HubSync")
+	proto.RegisterType((*HubSyncResponse)(nil), "pb.HubSyncResponse")
+	proto.RegisterType((*HubRegisterRequest)(nil), "pb.HubRegisterRequest")
+	proto.RegisterType((*HubRegisterResponse)(nil), "pb.HubRegisterResponse")
+	proto.RegisterType((*HubDisconnectRequest)(nil), "pb.HubDisconnectRequest")
+	proto.RegisterType((*ServiceTokenRequest)(nil), "pb.ServiceTokenRequest")
+	proto.RegisterType((*ServiceTokenResponse)(nil), "pb.ServiceTokenResponse")
+	proto.RegisterType((*ItemError)(nil), "pb.ItemError")
+	proto.RegisterType((*ListServicesRequest)(nil), "pb.ListServicesRequest")
+	proto.RegisterType((*ListServicesResponse)(nil), "pb.ListServicesResponse")
+	proto.RegisterType((*Service)(nil), "pb.Service")
+	proto.RegisterType((*AddAccountRequest)(nil), "pb.AddAccountRequest")
+	proto.RegisterType((*AddAccountResponse)(nil), "pb.AddAccountResponse")
+	proto.RegisterType((*AddLabelLinkRequest)(nil), "pb.AddLabelLinkRequest")
+	proto.RegisterType((*Noop)(nil), "pb.Noop")
+	proto.RegisterType((*RemoveLabelLinkRequest)(nil), "pb.RemoveLabelLinkRequest")
+	proto.RegisterType((*CreateTokenRequest)(nil), "pb.CreateTokenRequest")
+	proto.RegisterType((*CreateTokenResponse)(nil), "pb.CreateTokenResponse")
+	proto.RegisterType((*ControlRegister)(nil), "pb.ControlRegister")
+	proto.RegisterType((*ControlToken)(nil), "pb.ControlToken")
+	proto.RegisterType((*TokenInfo)(nil), "pb.TokenInfo")
+	proto.RegisterType((*TokenKey)(nil), "pb.TokenKey")
+	proto.RegisterType((*ListTokenKeysResponse)(nil), "pb.ListTokenKeysResponse")
+	proto.RegisterType((*SetHubMaxFlowsRequest)(nil), "pb.SetHubMaxFlowsRequest")
+	proto.RegisterType((*ListActiveFlowsRequest)(nil), "pb.ListActiveFlowsRequest")
+	proto.RegisterType((*ListActiveFlowsResponse)(nil), "pb.ListActiveFlowsResponse")
+	proto.RegisterType((*KillFlowRequest)(nil), "pb.KillFlowRequest")
+	proto.RegisterType((*LookupAccountRequest)(nil), "pb.LookupAccountRequest")
+	proto.RegisterType((*LookupAccountResponse)(nil), "pb.LookupAccountResponse")
+	proto.RegisterType((*AddAccountAliasRequest)(nil), "pb.AddAccountAliasRequest")
+	proto.RegisterType((*RemoveAccountAliasRequest)(nil), "pb.RemoveAccountAliasRequest")
+	proto.RegisterType((*SetAccountFeatureRequest)(nil), "pb.SetAccountFeatureRequest")
+	proto.RegisterType((*GetAccountFeaturesRequest)(nil), "pb.GetAccountFeaturesRequest")
+	proto.RegisterType((*AccountFeature)(nil), "pb.AccountFeature")
+	proto.RegisterType((*GetAccountFeaturesResponse)(nil), "pb.GetAccountFeaturesResponse")
+	proto.RegisterType((*SetAccountDefaultLabelsRequest)(nil), "pb.SetAccountDefaultLabelsRequest")
+	proto.RegisterType((*GetAccountDefaultLabelsRequest)(nil), "pb.GetAccountDefaultLabelsRequest")
+	proto.RegisterType((*GetAccountDefaultLabelsResponse)(nil), "pb.GetAccountDefaultLabelsResponse")
+	proto.RegisterType((*SetAccountTLSPolicyRequest)(nil), "pb.SetAccountTLSPolicyRequest")
+	proto.RegisterType((*GetAccountTLSPolicyRequest)(nil), "pb.GetAccountTLSPolicyRequest")
+	proto.RegisterType((*GetAccountTLSPolicyResponse)(nil), "pb.GetAccountTLSPolicyResponse")
+	proto.RegisterType((*PeriodicJobStatus)(nil), "pb.PeriodicJobStatus")
+	proto.RegisterType((*MaintenanceStatus)(nil), "pb.MaintenanceStatus")
+	proto.RegisterType((*EnqueueJobRequest)(nil), "pb.EnqueueJobRequest")
+	proto.RegisterType((*EnqueueJobResponse)(nil), "pb.EnqueueJobResponse")
+	proto.RegisterType((*SetMaintenanceModeRequest)(nil), "pb.SetMaintenanceModeRequest")
+	proto.RegisterType((*MaintenanceMode)(nil), "pb.MaintenanceMode")
+	proto.RegisterType((*FlushASNCacheResponse)(nil), "pb.FlushASNCacheResponse")
+	proto.RegisterType((*RebuildRoutingStateRequest)(nil), "pb.RebuildRoutingStateRequest")
+	proto.RegisterType((*RebuildRoutingStateResponse)(nil), "pb.RebuildRoutingStateResponse")
+	proto.RegisterType((*WatchEventsRequest)(nil), "pb.WatchEventsRequest")
+	proto.RegisterType((*ControlEvent)(nil), "pb.ControlEvent")
+	proto.RegisterType((*CleanupOrphanedObjectsRequest)(nil), "pb.CleanupOrphanedObjectsRequest")
+	proto.RegisterType((*CleanupOrphanedObjectsResponse)(nil), "pb.CleanupOrphanedObjectsResponse")
+	proto.RegisterType((*AccountKey)(nil), "pb.AccountKey")
+	proto.RegisterType((*CreateAccountKeyRequest)(nil), "pb.CreateAccountKeyRequest")
+	proto.RegisterType((*CreateAccountKeyResponse)(nil), "pb.CreateAccountKeyResponse")
+	proto.RegisterType((*ListAccountKeysRequest)(nil), "pb.ListAccountKeysRequest")
+	proto.RegisterType((*ListAccountKeysResponse)(nil), "pb.ListAccountKeysResponse")
+	proto.RegisterType((*RevokeAccountKeyRequest)(nil), "pb.RevokeAccountKeyRequest")
+	proto.RegisterType((*HubCredential)(nil), "pb.HubCredential")
+	proto.RegisterType((*IssueHubCredentialRequest)(nil), "pb.IssueHubCredentialRequest")
+	proto.RegisterType((*IssueHubCredentialResponse)(nil), "pb.IssueHubCredentialResponse")
+	proto.RegisterType((*ListHubCredentialsResponse)(nil), "pb.ListHubCredentialsResponse")
+	proto.RegisterType((*RevokeHubCredentialRequest)(nil), "pb.RevokeHubCredentialRequest")
+	proto.RegisterType((*ListAccountsRequest)(nil), "pb.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "pb.ListAccountsResponse")
+}
+
+func init() { proto.RegisterFile("control.proto", fileDescriptor_0c5120591600887d) }
+
+var fileDescriptor_0c5120591600887d = []byte{
+	// 4275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4d, 0x8f, 0x1b, 0x47,
+	0x76, 0x6c, 0x7e, 0xf3, 0x91, 0x1c, 0xce, 0xd4, 0x8c, 0x66, 0x28, 0xca, 0xe6, 0x48, 0x25, 0xd9,
+	0x92, 0x57, 0xf2, 0xd8, 0x96, 0x64, 0xaf, 0x9d, 0xac, 0xbd, 0x4b, 0x8d, 0xbe, 0xc6, 0x1a, 0x7d,
+	0xa4, 0x67, 0xb4, 0x59, 0x20, 0x0b, 0x30, 0x4d, 0x76, 0x0d, 0xd9, 0x9a, 0x66, 0x37, 0xdd, 0x5d,
+	0x2d, 0x89, 0x39, 0x04, 0x39, 0x25, 0x08, 0x90, 0x00, 0xb9, 0x26, 0xb7, 0xdc, 0x36, 0x40, 0x02,
+	0xec, 0x21, 0x87, 0x1c, 0x73, 0xf4, 0x2d, 0xce, 0x6d, 0x4f, 0x8b, 0x58, 0x0e, 0x90, 0x00, 0x01,
+	0x82, 0xfd, 0x09, 0x41, 0x7d, 0xf5, 0x17, 0x9b, 0xd4, 0x8c, 0x12, 0x23, 0x7b, 0xeb, 0x7a, 0xef,
+	0x55, 0xd5, 0xab, 0x57, 0xef, 0xab, 0xde, 0x6b, 0x68, 0x0e, 0x5d, 0x87, 0x7a, 0xae, 0xbd, 0x33,
+	0xf5, 0x5c, 0xea, 0xa2, 0xfc, 0x74, 0xd0, 0x69, 0x99, 0xe4, 0xc8, 0xff, 0x60, 0xe4, 0x8e, 0x5c,
+	0x01, 0xec, 0x54, 0x8f, 0x9f, 0xcb, 0xaf, 0xba, 0x6d, 0x0c, 0x88, 0xa4, 0xed, 0x34, 0x8d, 0xe1,
+	0xd0, 0x0d, 0x1c, 0x2a, 0x87, 0x10, 0xd8, 0x96, 0xa9, 0xe8, 0xa8, 0x7b, 0x4c, 0x1c, 0x39, 0x68,
+	0x51, 0x6b, 0x42, 0x7c, 0x6a, 0x4c, 0xa6, 0x8a, 0xf2, 0xc8, 0x76, 0x5f, 0xa8, 0x45, 0x1c, 0x42,
+	0x5f, 0xb8, 0xde, 0xb1, 0x18, 0xe2, 0x7f, 0xd1, 0x60, 0xe5, 0x80, 0x78, 0xcf, 0xad, 0x21, 0xd1,
+	0xc9, 0x57, 0x01, 0xf1, 0x29, 0x7a, 0x07, 0x2a, 0x72, 0xa3, 0xb6, 0x76, 0x5e, 0xbb, 0x52, 0xbf,
+	0x5e, 0xdf, 0x99, 0x0e, 0x76, 0x7a, 0x02, 0xa4, 0x2b, 0x1c, 0xea, 0x40, 0x61, 0x1c, 0x0c, 0xda,
+	0x79, 0x4e, 0x52, 0x65, 0x24, 0x4f, 0xf7, 0xf7, 0x6e, 0xeb, 0x0c, 0x88, 0xda, 0x90, 0xb7, 0xcc,
+	0x76, 0x21, 0x85, 0xca, 0x5b, 0x26, 0x42, 0x50, 0xa4, 0xb3, 0x29, 0x69, 0x17, 0xcf, 0x6b, 0x57,
+	0x6a, 0x3a, 0xff, 0x46, 0x97, 0xa0, 0xcc, 0x8f, 0xe9, 0xb7, 0x4b, 0x7c, 0x46, 0x83, 0xcd, 0xd8,
+	0x67, 0x90, 0x03, 0x42, 0x75, 0x89, 0x43, 0xef, 0x42, 0x75, 0x42, 0xa8, 0x61, 0x1a, 0xd4, 0x68,
+	0x97, 0xcf, 0x17, 0xae, 0xd4, 0xaf, 0x03, 0xa3, 0x7b, 0xf0, 0xd3, 0x27, 0x86, 0xe5, 0xe9, 0x21,
+	0x0e, 0xaf, 0x41, 0x2b, 0x3c, 0x90, 0x3f, 0x75, 0x1d, 0x9f, 0xe0, 0x5f, 0x6b, 0x50, 0xe3, 0xeb,
+	0xed, 0x5b, 0xce, 0xf1, 0x49, 0xcf, 0x17, 0x71, 0x95, 0x5f, 0xc2, 0xd5, 0x25, 0x28, 0x53, 0xc3,
+	0x1b, 0x11, 0xda, 0x2e, 0x64, 0x51, 0x09, 0x1c, 0xfa, 0x01, 0x94, 0x6d, 0x6b, 0x62, 0x51, 0x9f,
+	0x9f, 0xbb, 0x7e, 0x1d, 0xc5, 0x76, 0xdc, 0xd9, 0xe7, 0x18, 0x5d, 0x52, 0xa0, 0x0e, 0x54, 0x5f,
+	0x10, 0x6b, 0x34, 0xa6, 0xc4, 0xe4, 0xf2, 0xa8, 0xea, 0xe1, 0x18, 0x6d, 0x42, 0x59, 0x7c, 0xb7,
+	0xcb, 0xe7, 0xb5, 0x2b, 0x4d, 0x5d, 0x8e, 0xf0, 0x8f, 0x00, 0xc2, 0xf3, 0xf9, 0x68, 0x07, 0x84,
+	0xda, 0xf4, 0x6d, 0x36, 0x6c, 0x6b, 0x5c, 0x58, 0xcd, 0x90, 0x31, 0x46, 0xa4, 0x83, 0x1d, 0xd2,
+	0xe3, 0x3f, 0x86, 0x86, 0x92, 0x98, 0x1b, 0x50, 0xa2, 0x6e, 0x56, 0x5b, 0x7c, 0xb3, 0xf9, 0x25,
+	0x37, 0x5b, 0xc8, 0xbc, 0xd9, 0xe2, 0x62, 0x19, 0xe2, 0x7f, 0xd6, 0xa0, 0x25, 0x85, 0x21, 0xf9,
+	0xf0, 0x4f, 0x7a, 0x49, 0xd7, 0xa0, 0xea, 0xcb, 0x29, 0xed, 0x3c, 0x3f, 0xe7, 0x2a, 0xa3, 0x8b,
+	0x1f, 0x47, 0x0f, 0x29, 0xd0, 0x0f, 0x60, 0x8d, 0x59, 0x42, 0xdf, 0x32, 0x6d, 0xd2, 0x67, 0x46,
+	0xe2, 0x06, 0xe2, 0xde, 0x0a, 0x7a, 0x8b, 0x21, 0xf6, 0x4c, 0x9b, 0x1c, 0x0a, 0x30, 0xba, 0x06,
+	0x40, 0x6d, 0xbf, 0x3f, 0x75, 0x6d, 0x6b, 0x38, 0x93, 0xec, 0x73, 0x19, 0x1e, 0xee, 0x1f, 0x3c,
+	0xe1, 0x40, 0xbd, 0x46, 0x6d, 0x5f, 0x7c, 0xe2, 0x9f, 0x43, 0x2d, 0x84, 0xa3, 0x6d, 0xa8, 0x4f,
+	0x2c, 0xa7, 0xff, 0x9c, 0x78, 0xbe, 0xe5, 0x3a, 0x9c, 0xff, 0xa6, 0x0e, 0x13, 0xcb, 0xf9, 0xa9,
+	0x80, 0xa0, 0x1d, 0x58, 0xf7, 0xc8, 0x57, 0x81, 0xe5, 0x91, 0xfe, 0xd0, 0xb6, 0x88, 0x43, 0xfb,
+	0x43, 0xe2, 0x51, 0x2e, 0xd5, 0xaa, 0xbe, 0x26, 0x51, 0xbb, 0x1c, 0xb3, 0x4b, 0x3c, 0x8a, 0x29,
+	0x34, 0x7b, 0x43, 0x6a, 0x3d, 0xb7, 0xe8, 0xec, 0x8e, 0x43, 0xbd, 0x19, 0xba, 0x09, 0x75, 0x8f,
+	0x9d, 0xad, 0x6f, 0x98, 0x26, 0x31, 0xa5, 0x84, 0xd6, 0x63, 0x12, 0x52, 0x72, 0xd4, 0x81, 0xd3,
+	0xf5, 0x18, 0x19, 0x7a, 0x1f, 0x9a, 0x62, 0x96, 0x47, 0x26, 0xee, 0x73, 0x32, 0x7f, 0x8d, 0x0d,
+	0x8e, 0xd6, 0x05, 0x16, 0xff, 0x93, 0x06, 0xcd, 0x5d, 0xd7, 0x39, 0xb2, 0x46, 0x91, 0x67, 0xa8,
+	0xf9, 0xd4, 0x18, 0xd8, 0xa4, 0x6f, 0x99, 0x73, 0xea, 0x51, 0x15, 0xa8, 0x3d, 0x13, 0xbd, 0x07,
+	0x75, 0xcb, 0xf1, 0xa9, 0xe1, 0x0c, 0x39, 0x61, 0x7a, 0x17, 0x50, 0xc8, 0x3d, 0x13, 0x7d, 0x04,
+	0x35, 0xdb, 0x1d, 0x1a, 0xd4, 0x72, 0x1d, 0xbf, 0x5d, 0x38, 0x5f, 0x50, 0xc7, 0x78, 0x24, 0x9c,
+	0xd4, 0xbe, 0xc4, 0xe9, 0x11, 0x15, 0xc2, 0xd0, 0x18, 0x1a, 0x53, 0x63, 0x60, 0xd9, 0x16, 0xb5,
+	0x08, 0xd3, 0xac, 0xc2, 0x95, 0x9a, 0x9e, 0x80, 0xe1, 0x57, 0x05, 0x58, 0x51, 0xac, 0x0b, 0x1f,
+	0x80, 0xb6, 0xa0, 0xc2, 0xee, 0xf3, 0x98, 0xcc, 0x38, 0xe7, 0x0d, 0xbd, 0x4c, 0x6d, 0xff, 0x01,
+	0x99, 0xa1, 0xb3, 0x50, 0x65, 0x88, 0xf0, 0x06, 0x1a, 0x3a, 0x23, 0x64, 0x72, 0x47, 0xe7, 0xa0,
+	0xc6, 0xfd, 0x6a, 0x7f, 0x1a, 0x0c, 0xb8, 0x9e, 0x34, 0xf4, 0x2a, 0x07, 0x3c, 0x09, 0x06, 0x08,
+	0x43, 0xd3, 0xbf, 0xd1, 0x37, 0x86, 0x43, 0xe2, 0x8b, 0x65, 0x85, 0x4b, 0xab, 0xfb, 0x37, 0x7a,
+	0x1c, 0xc6, 0xd6, 0x16, 0x34, 0x3e, 0x19, 0x7a, 0x84, 0x72, 0x9a, 0x92, 0xa2, 0x39, 0xe0, 0x30,
+	0x46, 0x73, 0x0e, 0x6a, 0xfe, 0x8d, 0xfe, 0x20, 0x18, 0x1e, 0x13, 0x61, 0xd6, 0x35, 0xbd, 0xea,
+	0xdf, 0xb8, 0xc5, 0xc7, 0x0c, 0x69, 0x4d, 0x8c, 0x11, 0xe9, 0x53, 0x63, 0xd4, 0xae, 0x08, 0x24,
+	0x07, 0x1c, 0x1a, 0x23, 0x74, 0x15, 0x40, 0xb0, 0x77, 0x4c, 0x66, 0x7e, 0xbb, 0x7a, 0xbe, 0xa0,
+	0x2c, 0xec, 0x90, 0x41, 0x1f, 0x10, 0xa6, 0xa1, 0xf2, 0xcb, 0x67, 0x92, 0xf6, 0xc8, 0xd0, 0x75,
+	0x1c, 0x32, 0xa4, 0xed, 0x5a, 0xa4, 0x30, 0xba, 0x02, 0x2a, 0xa5, 0x0e, 0xa9, 0xb2, 0xcd, 0x05,
+	0x4e, 0x62, 0x2e, 0xf5, 0xe5, 0xe6, 0x82, 0xde, 0x85, 0x96, 0x31, 0xe2, 0x7a, 0x2f, 0xd5, 0xdf,
+	0x68, 0x37, 0xb8, 0x78, 0x9b, 0x1c, 0x2c, 0x55, 0xdf, 0x90, 0xb2, 0xf1, 0xc8, 0x88, 0xd9, 0x51,
+	0x53, 0xc9, 0x46, 0xe7, 0x63, 0xec, 0x43, 0x2b, 0xc5, 0x3c, 0xba, 0x0c, 0x2d, 0xcb, 0xb1, 0xa8,
+	0x65, 0xd8, 0xfd, 0x81, 0x31, 0x3c, 0x76, 0x8f, 0x8e, 0xf8, 0x65, 0x17, 0xf4, 0x15, 0x09, 0xbe,
+	0x25, 0xa0, 0xdc, 0x44, 0x8d, 0x97, 0x21, 0x51, 0x9e, 0x13, 0xc1, 0xc4, 0x78, 0xa9, 0x08, 0x36,
+	0xa1, 0xfc, 0xcc, 0xa2, 0x94, 0x78, 0xd2, 0x3f, 0xc8, 0x11, 0x7e, 0x08, 0xb5, 0xfb, 0xc1, 0x60,
+	0x77, 0x6c, 0x38, 0x23, 0x82, 0xb6, 0xa1, 0xec, 0xda, 0x66, 0x96, 0x31, 0x94, 0x5c, 0xdb, 0xdc,
+	0x33, 0x19, 0x81, 0x43, 0x5e, 0x64, 0x19, 0x41, 0xc9, 0x21, 0x2f, 0xf6, 0x4c, 0x7c, 0x19, 0x9a,
+	0x0f, 0xad, 0x91, 0x67, 0x50, 0x72, 0x40, 0x3d, 0x62, 0x4c, 0xb8, 0x87, 0xb7, 0xe8, 0xd8, 0x72,
+	0x24, 0xe3, 0x72, 0x84, 0x77, 0xa0, 0x7c, 0x3f, 0x18, 0x1c, 0xee, 0x1f, 0x30, 0x3f, 0xcb, 0x75,
+	0x55, 0x68, 0x31, 0xff, 0x46, 0xab, 0x50, 0x60, 0xda, 0x25, 0xd4, 0x97, 0x7d, 0xe2, 0x7f, 0xcf,
+	0x43, 0x6b, 0x97, 0x38, 0xd4, 0x33, 0x6c, 0xe5, 0x3a, 0xd0, 0x17, 0xb0, 0x2a, 0xfd, 0x66, 0x3f,
+	0x74, 0x9a, 0xda, 0xf9, 0xc2, 0x22, 0xd7, 0xd1, 0x32, 0x92, 0x00, 0x74, 0x11, 0x9a, 0x9e, 0xf0,
+	0x04, 0x7d, 0x9f, 0x1a, 0xd4, 0x97, 0x0e, 0xab, 0x21, 0x81, 0x07, 0x0c, 0x86, 0x3e, 0x81, 0x16,
+	0x3b, 0x72, 0x3c, 0x00, 0x89, 0xc8, 0xb8, 0x92, 0x08, 0x40, 0xbe, 0xde, 0x74, 0xc8, 0x8b, 0x68,
+	0xc8, 0x14, 0x68, 0x1c, 0x0c, 0xfa, 0x43, 0x2e, 0xd9, 0xb8, 0xbf, 0x0d, 0xc5, 0xad, 0xd7, 0xc6,
+	0xea, 0x13, 0x5d, 0x06, 0x38, 0xb6, 0x6c, 0xbb, 0xcf, 0xd4, 0x90, 0xa5, 0x0d, 0x85, 0x84, 0x70,
+	0x6b, 0x0c, 0x77, 0x97, 0xa1, 0xd0, 0xa7, 0xb0, 0x32, 0x11, 0x02, 0xee, 0xfb, 0x5c, 0xc2, 0xdc,
+	0xc4, 0xea, 0xd7, 0xd7, 0x18, 0x71, 0x42, 0xf4, 0x7a, 0x73, 0x12, 0x1f, 0xa2, 0x8b, 0x50, 0x61,
+	0x0c, 0x51, 0xdb, 0xe7, 0x86, 0x27, 0xd3, 0x0d, 0x71, 0x09, 0x7a, 0x79, 0x1c, 0x0c, 0x0e, 0x6d,
+	0x1f, 0xff, 0x43, 0x09, 0xea, 0xf7, 0x83, 0x41, 0x28, 0xe2, 0x4f, 0xc5, 0x24, 0x8f, 0x8c, 0xa4,
+	0x4a, 0x6c, 0xcb, 0x49, 0x8a, 0x82, 0x7d, 0x33, 0xfd, 0xf5, 0xa9, 0x27, 0x3c, 0x1b, 0x5b, 0x49,
+	0x27, 0x23, 0xf4, 0x2e, 0x54, 0x7c, 0x66, 0x0a, 0x06, 0x6d, 0xe7, 0xa3, 0xc3, 0x1f, 0xaa, 0x4c,
+	0x4e, 0x2f, 0x33, 0x6c, 0x8f, 0xa2, 0x1d, 0x28, 0x09, 0xe1, 0x0b, 0xa9, 0xb6, 0x33, 0xd6, 0xe7,
+	0x17, 0xa1, 0x0b, 0x32, 0x84, 0xa1, 0xc8, 0x84, 0xc4, 0xdd, 0xa4, 0xbc, 0x04, 0x26, 0x19, 0x66,
+	0x39, 0x9e, 0xa9, 0x73, 0x5c, 0xe7, 0x1f, 0x35, 0x68, 0xa5, 0xf8, 0x5a, 0x9a, 0x04, 0x5c, 0x06,
+	0x90, 0x71, 0x20, 0x2b, 0x03, 0x94, 0x31, 0xe2, 0x7e, 0x30, 0x78, 0x13, 0xf7, 0xfe, 0x1e, 0xac,
+	0xf2, 0xcc, 0x74, 0xe8, 0xda, 0x61, 0x04, 0x65, 0xda, 0x50, 0xd2, 0x5b, 0x0a, 0x2e, 0xc3, 0x68,
+	0xe7, 0x97, 0x79, 0xa8, 0xaa, 0xe3, 0xa2, 0xab, 0xb0, 0x26, 0x5d, 0x8a, 0xf0, 0x08, 0x7c, 0x4b,
+	0x61, 0x43, 0xab, 0xc2, 0xa9, 0x44, 0x70, 0xa6, 0xc9, 0x52, 0xb9, 0xfd, 0xbe, 0x4f, 0x88, 0x23,
+	0x1d, 0x40, 0x43, 0x01, 0x0f, 0x08, 0x71, 0x98, 0x33, 0x09, 0x89, 0x86, 0xc6, 0x70, 0x4c, 0x4c,
+	0xe9, 0x0b, 0x56, 0x14, 0x78, 0x97, 0x43, 0xd1, 0x05, 0x16, 0x91, 0xd8, 0x57, 0x7f, 0x30, 0xa3,
+	0x44, 0xe4, 0x3a, 0x05, 0xbd, 0x2e, 0x60, 0xb7, 0x18, 0x08, 0xed, 0xc2, 0xa6, 0x6d, 0x30, 0xbb,
+	0x09, 0x78, 0x6c, 0x38, 0x0a, 0xec, 0x7e, 0x30, 0x35, 0x0d, 0x4a, 0xda, 0xa5, 0xac, 0xcb, 0xde,
+	0x60, 0xc4, 0x07, 0x21, 0xed, 0x53, 0x4e, 0x8a, 0x7a, 0x70, 0x86, 0x2f, 0x62, 0x50, 0x4a, 0x26,
+	0x53, 0x4a, 0x4c, 0xb5, 0x46, 0x39, 0x6b, 0x8d, 0x75, 0x46, 0xdb, 0x53, 0xa4, 0x62, 0x09, 0xfc,
+	0x17, 0x79, 0xa8, 0xdc, 0x0f, 0x06, 0x7b, 0xce, 0x91, 0x2b, 0x53, 0x39, 0x2d, 0x23, 0x95, 0x4b,
+	0x5c, 0x5b, 0xfe, 0x44, 0xd7, 0x96, 0x48, 0x0d, 0x0a, 0x0b, 0x53, 0x83, 0x0b, 0xd0, 0x30, 0x98,
+	0xa6, 0x12, 0x69, 0xb9, 0x52, 0x54, 0x02, 0x26, 0x2c, 0xf6, 0x1c, 0xd4, 0x98, 0x6b, 0x56, 0x96,
+	0xcd, 0xf0, 0xd5, 0x89, 0xf1, 0x52, 0x20, 0xd3, 0xc1, 0xbf, 0x3c, 0x1f, 0xfc, 0x33, 0x35, 0xa8,
+	0x92, 0xa9, 0x41, 0x98, 0x02, 0xec, 0x5b, 0x3e, 0x7d, 0x7c, 0x74, 0x3f, 0x18, 0xf8, 0x68, 0x1b,
+	0x8a, 0xe3, 0x60, 0xa0, 0x7c, 0x62, 0x5d, 0x5a, 0x16, 0x93, 0x95, 0xce, 0x11, 0xe8, 0x0e, 0xac,
+	0xa5, 0x57, 0x56, 0xf2, 0xe1, 0x76, 0xf8, 0x24, 0xb9, 0xfc, 0x2e, 0xcf, 0x55, 0x57, 0x53, 0x9b,
+	0xfa, 0xf8, 0x36, 0x6c, 0x64, 0x51, 0xa2, 0x36, 0x54, 0xe2, 0x39, 0x63, 0x49, 0x57, 0x43, 0xe6,
+	0xf3, 0x39, 0x67, 0x42, 0x4d, 0xf9, 0x37, 0xfe, 0x23, 0x7e, 0x93, 0x07, 0x33, 0x67, 0xb8, 0xe4,
+	0x26, 0x13, 0xd7, 0x92, 0x5f, 0x78, 0x2d, 0x3b, 0xb1, 0x34, 0x5a, 0x98, 0x29, 0x8a, 0xa7, 0xd1,
+	0xc2, 0xbf, 0x47, 0x89, 0x34, 0xfe, 0x04, 0x5a, 0x72, 0xef, 0x30, 0xbf, 0xba, 0x08, 0x4d, 0x89,
+	0xee, 0x47, 0x69, 0x7b, 0x41, 0x6f, 0x48, 0x20, 0x3f, 0x21, 0xfe, 0x6b, 0x0d, 0x50, 0xe8, 0x68,
+	0x88, 0xf7, 0xdb, 0x94, 0x57, 0xe2, 0x7b, 0xb0, 0x9e, 0x60, 0x4d, 0x9e, 0xeb, 0x43, 0x68, 0xc8,
+	0x17, 0x3b, 0x4f, 0x81, 0xda, 0x5a, 0x96, 0xad, 0xd5, 0x25, 0x09, 0x83, 0xe0, 0x31, 0x6c, 0xdc,
+	0x0f, 0x06, 0xb7, 0x2d, 0x5f, 0x7a, 0xa2, 0xef, 0xed, 0x94, 0xf8, 0x06, 0xac, 0xcb, 0x2b, 0xe2,
+	0x19, 0x9f, 0xda, 0xe8, 0x2d, 0xa8, 0x39, 0xc6, 0x84, 0xf8, 0x53, 0x63, 0x28, 0xf8, 0xad, 0xe9,
+	0x11, 0x00, 0x5f, 0x83, 0x8d, 0xe4, 0x24, 0x79, 0xd0, 0x0d, 0x28, 0xf1, 0x6c, 0x51, 0xce, 0x10,
+	0x03, 0xfc, 0x31, 0xd4, 0xf6, 0x28, 0x99, 0xdc, 0xf1, 0x3c, 0xd7, 0x63, 0x6a, 0x68, 0x51, 0x32,
+	0x91, 0x14, 0xfc, 0x9b, 0x4d, 0x23, 0x0c, 0xc9, 0x19, 0xad, 0xe9, 0x62, 0x80, 0xff, 0x54, 0x83,
+	0x75, 0x66, 0x59, 0x61, 0x32, 0x71, 0xba, 0xda, 0xc2, 0x36, 0xd4, 0x07, 0x2c, 0xcd, 0x20, 0x47,
+	0x47, 0x6e, 0xf8, 0x30, 0x02, 0x06, 0xba, 0xc3, 0x21, 0xcc, 0x37, 0x0f, 0x5d, 0xc7, 0x67, 0x57,
+	0xe5, 0xd0, 0xbe, 0x47, 0x0c, 0xe1, 0x74, 0xaa, 0xfa, 0x4a, 0x04, 0xd6, 0x89, 0x61, 0xe2, 0x23,
+	0xd8, 0x48, 0xf2, 0x21, 0x4f, 0x7b, 0x39, 0xa6, 0xf1, 0x31, 0x7b, 0x57, 0x1a, 0x1f, 0x22, 0xd1,
+	0x3b, 0x50, 0xe6, 0x47, 0x52, 0x86, 0xce, 0x6f, 0x3e, 0x14, 0x89, 0x2e, 0x91, 0xf8, 0x6f, 0x35,
+	0xa8, 0xc8, 0xc9, 0x4b, 0xcc, 0x71, 0x59, 0xcd, 0xe4, 0x8d, 0xdf, 0xcf, 0x89, 0xca, 0x48, 0x69,
+	0x49, 0x65, 0xe4, 0x97, 0x1a, 0xac, 0xf5, 0x4c, 0x53, 0x49, 0xfb, 0x74, 0x57, 0x12, 0x95, 0x30,
+	0xf2, 0xaf, 0x2d, 0x61, 0x6c, 0x43, 0x9d, 0xbc, 0xa4, 0xc4, 0x73, 0x0c, 0x5b, 0x85, 0x83, 0x9a,
+	0x0e, 0x0a, 0xb4, 0x67, 0xf2, 0x3c, 0xdd, 0x24, 0x93, 0xa9, 0x4b, 0x89, 0x33, 0x9c, 0xc5, 0x5e,
+	0x4f, 0x2b, 0x31, 0xf0, 0x03, 0x32, 0xc3, 0x4f, 0x01, 0xc5, 0x39, 0x96, 0x97, 0x77, 0x42, 0x96,
+	0xdb, 0x50, 0x19, 0x7a, 0xc4, 0xa0, 0xf2, 0xa5, 0x5b, 0xd5, 0xd5, 0x10, 0xff, 0x47, 0x1e, 0xd6,
+	0x7b, 0xa6, 0x19, 0x95, 0x43, 0xa4, 0x2c, 0x22, 0x79, 0x6b, 0x4b, 0xe4, 0x1d, 0xdb, 0x3e, 0xbf,
+	0xbc, 0x80, 0x74, 0x82, 0xd2, 0x50, 0x4a, 0x56, 0xc5, 0x39, 0x59, 0xdd, 0x81, 0xba, 0xeb, 0xb0,
+	0xac, 0xe6, 0xc8, 0xb6, 0x86, 0x94, 0x47, 0xc4, 0x95, 0xeb, 0x97, 0xf8, 0x8e, 0xf3, 0x27, 0xd8,
+	0xd9, 0x95, 0x74, 0x0f, 0x5d, 0x93, 0xe8, 0xe0, 0x3a, 0x6a, 0x9c, 0x28, 0x2b, 0x95, 0x17, 0x96,
+	0x95, 0x2a, 0x89, 0xb2, 0x52, 0x0f, 0x1a, 0xf1, 0xf5, 0xd0, 0x16, 0xac, 0xef, 0xef, 0x3d, 0x7a,
+	0xd0, 0xdf, 0x7d, 0xfc, 0xe8, 0xee, 0xfe, 0xde, 0xee, 0x61, 0xff, 0x8e, 0xae, 0x3f, 0xd6, 0x57,
+	0x73, 0xa8, 0x0d, 0x1b, 0x49, 0xc4, 0xd3, 0x27, 0xb7, 0x7b, 0x87, 0x77, 0x56, 0x35, 0x5c, 0x86,
+	0xe2, 0x23, 0xd7, 0x9d, 0xe2, 0xbf, 0xd7, 0x60, 0x53, 0x14, 0x16, 0xbe, 0x5f, 0xa1, 0xbf, 0x56,
+	0xf5, 0xa2, 0x5b, 0x29, 0x2e, 0xbe, 0x15, 0xfc, 0xaf, 0x1a, 0xa0, 0x5d, 0xae, 0x2c, 0x09, 0xcf,
+	0x7a, 0x42, 0xc5, 0xfb, 0x3c, 0x95, 0xa5, 0xc4, 0x52, 0x28, 0xbe, 0xdc, 0xae, 0x42, 0xce, 0x6e,
+	0x15, 0xbf, 0xfe, 0xf5, 0x76, 0x2e, 0x95, 0xc0, 0xdc, 0x84, 0x95, 0xe7, 0x86, 0x6d, 0x99, 0x7d,
+	0x33, 0x10, 0xc9, 0xb8, 0x54, 0xa0, 0x54, 0xd0, 0x69, 0x72, 0xa2, 0xdb, 0x92, 0xe6, 0xb5, 0x8a,
+	0x84, 0xaf, 0xc2, 0x7a, 0xe2, 0x48, 0x4b, 0xfd, 0xfe, 0x07, 0xd0, 0xda, 0x15, 0x31, 0x4d, 0x45,
+	0xc4, 0xd7, 0x84, 0x95, 0x4b, 0xd0, 0x90, 0x13, 0xf8, 0xf2, 0x0b, 0x96, 0xed, 0x43, 0x8d, 0xa3,
+	0x79, 0x02, 0xfa, 0x36, 0xc0, 0x34, 0x18, 0xd8, 0xd6, 0x30, 0x56, 0x95, 0xa9, 0x09, 0x08, 0x2b,
+	0x8c, 0xbc, 0x05, 0x35, 0xc3, 0x1e, 0xb9, 0x9e, 0x45, 0xc7, 0x13, 0x19, 0x5d, 0x22, 0x00, 0x3a,
+	0x03, 0xe5, 0x63, 0x32, 0x8b, 0xee, 0xb8, 0x74, 0x4c, 0x66, 0x7b, 0x26, 0x7e, 0x09, 0x55, 0x55,
+	0xfd, 0x88, 0x91, 0x68, 0x31, 0x92, 0xd4, 0xb6, 0xf9, 0xf4, 0xb6, 0x6d, 0xa8, 0xf8, 0xd6, 0xc8,
+	0xb1, 0x9c, 0x91, 0x0c, 0x29, 0x6a, 0x98, 0x64, 0xa8, 0x98, 0x62, 0x08, 0x7f, 0x06, 0x67, 0x58,
+	0xa4, 0x51, 0xbb, 0x47, 0xa1, 0xe6, 0x3c, 0x14, 0x79, 0x81, 0x46, 0xcb, 0x28, 0xd0, 0x70, 0x0c,
+	0xfe, 0x03, 0x38, 0x73, 0x40, 0xe8, 0xfd, 0x60, 0xf0, 0x50, 0xe6, 0xb9, 0xa7, 0x4c, 0x19, 0x12,
+	0x29, 0x73, 0x3e, 0x99, 0x32, 0xe3, 0x3f, 0x84, 0x4d, 0xc6, 0x57, 0x2f, 0x4a, 0xb1, 0x4f, 0x1d,
+	0x8c, 0xd9, 0x1b, 0x35, 0xb3, 0x88, 0x31, 0x0e, 0x06, 0x7b, 0x26, 0xfe, 0x31, 0x6c, 0xcd, 0xed,
+	0x20, 0xcf, 0x7e, 0x09, 0x4a, 0x82, 0x2b, 0x2d, 0xf9, 0xfc, 0x94, 0x4f, 0x6e, 0x81, 0xc4, 0x37,
+	0xa1, 0xf5, 0x40, 0xbe, 0xd8, 0x15, 0x6f, 0x17, 0xa0, 0x22, 0x6b, 0x4f, 0x73, 0xe7, 0x2e, 0x8b,
+	0xda, 0x13, 0x7e, 0x0a, 0x1b, 0xfb, 0xae, 0x7b, 0x1c, 0x4c, 0x53, 0x01, 0x6d, 0xa9, 0x9e, 0xa6,
+	0xcd, 0x24, 0x3f, 0x67, 0x26, 0x7d, 0x38, 0x93, 0x5a, 0xf6, 0x74, 0x51, 0xe7, 0xb5, 0x1b, 0x0c,
+	0x60, 0x33, 0x8a, 0x69, 0x3d, 0xdb, 0x32, 0x4e, 0x7b, 0x21, 0x17, 0xa0, 0x64, 0xb0, 0x69, 0x59,
+	0x8e, 0x50, 0x60, 0xf0, 0x17, 0x70, 0x56, 0x78, 0xdb, 0xac, 0x6d, 0xc2, 0xf9, 0xda, 0xc2, 0xf9,
+	0x2e, 0xb4, 0x0f, 0x08, 0x95, 0xc0, 0xbb, 0xc4, 0xa0, 0x81, 0x77, 0xda, 0xfe, 0x10, 0x82, 0x22,
+	0x93, 0xba, 0x14, 0x00, 0xff, 0x66, 0xb6, 0x45, 0x1c, 0xa6, 0xb4, 0x2a, 0x5d, 0x53, 0x43, 0x7c,
+	0x0b, 0xce, 0xde, 0x4b, 0x6f, 0x78, 0x4a, 0xb9, 0xe0, 0x2f, 0x60, 0x25, 0xb9, 0x40, 0xc8, 0x83,
+	0x96, 0xcd, 0x43, 0x3e, 0xc9, 0xc3, 0x3e, 0x74, 0xb2, 0x78, 0x90, 0xd7, 0xbf, 0x03, 0xd5, 0x23,
+	0x09, 0x93, 0xda, 0x1c, 0x4f, 0x81, 0x94, 0x8c, 0x42, 0x1a, 0x3c, 0x81, 0x6e, 0x24, 0xc2, 0xdb,
+	0xe4, 0xc8, 0x08, 0x6c, 0xca, 0xe3, 0xcc, 0x69, 0xaf, 0xfb, 0x44, 0x8d, 0x28, 0x3c, 0x85, 0xee,
+	0xbd, 0xff, 0x93, 0xed, 0x32, 0x52, 0xeb, 0x7c, 0x66, 0x6a, 0x7d, 0x0f, 0xb6, 0x17, 0xee, 0x18,
+	0x9a, 0xff, 0x09, 0x42, 0x3b, 0x7e, 0x06, 0x9d, 0x48, 0x52, 0x51, 0xbd, 0xf8, 0x74, 0x6c, 0xbf,
+	0x03, 0x65, 0x59, 0x7c, 0xce, 0x67, 0x15, 0x9f, 0x25, 0x12, 0xdb, 0xf1, 0x3b, 0x7e, 0xd3, 0xbd,
+	0x4e, 0x2c, 0xa2, 0xaf, 0xe0, 0x5c, 0xe6, 0x6e, 0xa1, 0x47, 0x51, 0x3c, 0x6b, 0x4b, 0x78, 0x46,
+	0x57, 0xa1, 0x46, 0x8e, 0x8e, 0x08, 0x77, 0xaf, 0xd9, 0xa7, 0x8b, 0xf0, 0xf8, 0xeb, 0x3c, 0xac,
+	0x3d, 0x21, 0x9e, 0xe5, 0x9a, 0xd6, 0xf0, 0x4b, 0x97, 0x17, 0xc7, 0x02, 0x3f, 0xd3, 0x10, 0xce,
+	0x42, 0xf5, 0x99, 0x3b, 0xe8, 0xf3, 0x47, 0x87, 0x30, 0xd2, 0xca, 0x33, 0x77, 0x70, 0xc8, 0xde,
+	0x1d, 0x9b, 0x50, 0x9e, 0xf2, 0x35, 0x64, 0x70, 0x95, 0x23, 0xf4, 0x3e, 0xeb, 0x2c, 0xfa, 0xb4,
+	0xef, 0x05, 0x0e, 0x2b, 0x54, 0x16, 0xb3, 0xd2, 0x92, 0x1a, 0xa3, 0xd0, 0x03, 0xa7, 0xc7, 0x5d,
+	0x21, 0x27, 0xf7, 0x39, 0x13, 0xb2, 0xf9, 0x01, 0x0c, 0x24, 0xd9, 0x7a, 0x1b, 0xf8, 0xa8, 0x2f,
+	0x5e, 0x90, 0xa2, 0xf9, 0xc1, 0xe7, 0x8b, 0xf7, 0xe6, 0x15, 0xa8, 0x3a, 0xe4, 0x25, 0xdf, 0xae,
+	0x5d, 0xc9, 0xda, 0xab, 0xc2, 0xd0, 0x7a, 0xe0, 0xf0, 0x9a, 0x0f, 0x71, 0x4c, 0xcb, 0x19, 0xa9,
+	0xea, 0x18, 0x6b, 0x88, 0x88, 0x9a, 0x8f, 0x80, 0xcb, 0x4a, 0x98, 0xcf, 0x16, 0xf5, 0x08, 0xf5,
+	0x66, 0x7d, 0x43, 0xf5, 0x41, 0xd2, 0x8b, 0x72, 0x74, 0x8f, 0xf9, 0x93, 0xb5, 0x87, 0x86, 0xe5,
+	0x50, 0xe2, 0xb0, 0xf7, 0xb6, 0x64, 0xf9, 0x3d, 0x28, 0x3e, 0x73, 0xc3, 0x22, 0xd1, 0x19, 0x5e,
+	0xf6, 0x49, 0x8b, 0x5b, 0xe7, 0x24, 0xec, 0x4d, 0xb8, 0x76, 0xc7, 0xf9, 0x2a, 0x20, 0x01, 0xf9,
+	0xd2, 0x1d, 0x28, 0x1d, 0x8b, 0x8b, 0x5d, 0x4b, 0x8a, 0xbd, 0x0d, 0x95, 0xa9, 0x31, 0xb3, 0x5d,
+	0xa9, 0x4f, 0x0d, 0x5d, 0x0d, 0x59, 0x36, 0xc5, 0xd7, 0x51, 0xc9, 0x0e, 0x1f, 0xb0, 0x9c, 0x7e,
+	0xea, 0x59, 0x2c, 0xff, 0x98, 0xc9, 0x1a, 0x69, 0x38, 0x8e, 0xab, 0x72, 0x69, 0x89, 0xcf, 0xfc,
+	0x18, 0x50, 0x9c, 0x45, 0xa9, 0x98, 0xdb, 0x50, 0x66, 0x3c, 0x66, 0x35, 0x36, 0x9e, 0xb9, 0x2c,
+	0xe4, 0x3f, 0x84, 0xb3, 0x07, 0x84, 0xc6, 0xa4, 0xc3, 0xdf, 0x1b, 0xf2, 0x84, 0x31, 0x0f, 0xab,
+	0x25, 0x3c, 0x2c, 0xd3, 0x2b, 0x8f, 0x18, 0xbe, 0xeb, 0x48, 0x85, 0x93, 0x23, 0x3c, 0x86, 0x56,
+	0x6a, 0xad, 0xd3, 0x2f, 0x82, 0x2e, 0x42, 0xc9, 0xb7, 0x9c, 0x21, 0xc9, 0xce, 0x96, 0x05, 0x0e,
+	0x7f, 0x04, 0x67, 0xee, 0xda, 0x81, 0x3f, 0xee, 0x1d, 0x3c, 0xe2, 0xd5, 0xdb, 0xf0, 0xc8, 0x6d,
+	0x96, 0x70, 0x04, 0xfe, 0x58, 0xee, 0x57, 0xd0, 0xd5, 0x10, 0xff, 0x1c, 0x3a, 0x3a, 0x19, 0x04,
+	0x96, 0x6d, 0xea, 0x6e, 0x40, 0x2d, 0x67, 0xc4, 0x2e, 0xf9, 0xb4, 0xd1, 0x70, 0x0b, 0x2a, 0xa6,
+	0x37, 0xe3, 0x9a, 0x2c, 0x5c, 0x45, 0xd9, 0xf4, 0x66, 0x7a, 0xe0, 0xe0, 0xef, 0xf2, 0x70, 0x2e,
+	0x73, 0x79, 0xc9, 0xd7, 0x0d, 0x10, 0x5d, 0x59, 0x3f, 0xec, 0xf5, 0x66, 0x77, 0xb9, 0x45, 0x43,
+	0xd8, 0x17, 0x9d, 0xde, 0x1f, 0xc2, 0x8a, 0x9c, 0x14, 0xb5, 0x7a, 0xb3, 0xa7, 0x89, 0x8e, 0xb0,
+	0x2f, 0x7b, 0xbe, 0xcc, 0x8e, 0x7c, 0x42, 0x19, 0x17, 0xbe, 0x6c, 0xc5, 0xa8, 0x48, 0xdd, 0x52,
+	0x70, 0xd1, 0x81, 0x31, 0xd1, 0x67, 0xb0, 0x16, 0x6b, 0xf2, 0x48, 0xee, 0x8a, 0x59, 0xff, 0x1a,
+	0xb4, 0xa2, 0x7f, 0x0d, 0x04, 0x7b, 0x9f, 0xc3, 0x7a, 0x7c, 0xaa, 0xe2, 0xb1, 0x94, 0x35, 0x79,
+	0x2d, 0x9a, 0xac, 0x98, 0x64, 0xef, 0x7a, 0xc9, 0x5b, 0x59, 0xbe, 0xeb, 0x25, 0x4f, 0x31, 0x29,
+	0x57, 0x12, 0x52, 0xbe, 0x05, 0xe8, 0xf7, 0x0d, 0x3a, 0x1c, 0xdf, 0x79, 0x4e, 0x1c, 0x1a, 0x46,
+	0xc4, 0x4d, 0x28, 0x0f, 0x03, 0xcf, 0x77, 0x3d, 0x69, 0x88, 0x72, 0xc4, 0xdf, 0x2e, 0xb3, 0xa9,
+	0x7c, 0xb8, 0xd5, 0x74, 0x31, 0xc0, 0x7f, 0xa7, 0x85, 0x4f, 0x1c, 0xbe, 0xcc, 0xc2, 0xe9, 0xaa,
+	0x92, 0x93, 0x8f, 0x55, 0x72, 0x2e, 0x40, 0x91, 0x97, 0x0f, 0x33, 0x75, 0x93, 0xa3, 0x92, 0x79,
+	0x6b, 0x31, 0x9d, 0xb7, 0xb6, 0x93, 0xf6, 0x5c, 0x4b, 0x24, 0x5a, 0xf2, 0xa7, 0x18, 0xde, 0x10,
+	0x64, 0xdf, 0xf8, 0x53, 0x78, 0x7b, 0xd7, 0x26, 0x86, 0x13, 0x4c, 0x1f, 0x7b, 0xd3, 0xb1, 0xe1,
+	0x10, 0xf3, 0xf1, 0xe0, 0x19, 0x19, 0x46, 0x47, 0x8f, 0x49, 0x4a, 0x4b, 0x48, 0xca, 0x83, 0xee,
+	0xa2, 0x99, 0x52, 0x23, 0x51, 0xec, 0x3d, 0x53, 0x13, 0x2f, 0x18, 0x56, 0xfd, 0xb5, 0x59, 0x55,
+	0x5f, 0xf2, 0xa4, 0x5e, 0x21, 0x0d, 0x06, 0x94, 0xb6, 0xe0, 0xc7, 0xf7, 0x2c, 0x24, 0xf6, 0xfc,
+	0x6f, 0x0d, 0x40, 0x52, 0x89, 0x17, 0xd8, 0xe2, 0x72, 0xf6, 0x89, 0x8a, 0x00, 0x2a, 0xda, 0x15,
+	0x62, 0xd1, 0xee, 0x1a, 0x80, 0xac, 0xfe, 0x2c, 0x8e, 0x5c, 0x92, 0xa0, 0x47, 0xd1, 0x07, 0xd0,
+	0xe0, 0x81, 0x29, 0xf0, 0x05, 0x7d, 0x66, 0x97, 0x86, 0xc7, 0xae, 0xa7, 0x3e, 0x9f, 0x70, 0x0d,
+	0xc0, 0x23, 0xcf, 0xdd, 0x63, 0x41, 0x9e, 0xd9, 0x90, 0xa9, 0x49, 0x82, 0x1e, 0xc5, 0x87, 0xb0,
+	0x25, 0x9e, 0xe2, 0xd1, 0xa9, 0xff, 0xf7, 0xd9, 0x35, 0x3e, 0x84, 0xf6, 0xfc, 0xaa, 0xe1, 0x23,
+	0xb4, 0xa0, 0x1e, 0xd9, 0xf2, 0x19, 0x16, 0x23, 0x62, 0x28, 0xa6, 0xcd, 0xe2, 0x47, 0x05, 0xe5,
+	0x56, 0xc5, 0x08, 0xff, 0xb9, 0xa6, 0x1e, 0x90, 0x8a, 0xfe, 0xff, 0xaf, 0x9a, 0x6b, 0xaa, 0x97,
+	0x66, 0x8c, 0x15, 0x79, 0x40, 0x9c, 0x78, 0x65, 0xa7, 0x4f, 0xc8, 0x71, 0x27, 0xad, 0xe5, 0x1a,
+	0xb0, 0xa5, 0xf3, 0xab, 0x7a, 0xe3, 0xdb, 0xd9, 0x0e, 0x2b, 0x0f, 0x73, 0x4f, 0x66, 0x51, 0xa6,
+	0xf8, 0x2f, 0x0d, 0x9a, 0xac, 0xb1, 0xed, 0x11, 0x93, 0x38, 0xd4, 0x32, 0xec, 0x25, 0x4a, 0x9f,
+	0xf5, 0x90, 0x4a, 0x6a, 0x73, 0xe1, 0x94, 0xda, 0x5c, 0x3c, 0x9d, 0x36, 0x97, 0x96, 0x6b, 0x73,
+	0xac, 0x40, 0x50, 0xce, 0x2e, 0x10, 0x3c, 0x81, 0xb3, 0x7b, 0xbe, 0x1f, 0x90, 0xc4, 0x89, 0x95,
+	0x48, 0xb3, 0x52, 0xd3, 0xd7, 0x96, 0x1c, 0x46, 0xd0, 0xc9, 0x5a, 0x51, 0xea, 0xc2, 0x47, 0x5c,
+	0x3a, 0x12, 0xda, 0xd6, 0xa2, 0x86, 0x7f, 0x92, 0x3c, 0x46, 0xb4, 0x50, 0xfb, 0x7f, 0x0f, 0x3a,
+	0x4c, 0xe3, 0x12, 0x13, 0xfd, 0x58, 0x70, 0xae, 0x47, 0x6b, 0x28, 0xdd, 0xcb, 0xd8, 0x29, 0x4e,
+	0x85, 0x1f, 0xb0, 0x7c, 0x82, 0xc9, 0x2e, 0x53, 0x1c, 0xef, 0x43, 0x33, 0x22, 0xce, 0xca, 0xc0,
+	0x1a, 0x11, 0x7a, 0xcf, 0xc4, 0x7f, 0x29, 0x1b, 0x2d, 0xca, 0xc9, 0xaa, 0x65, 0x36, 0xa0, 0xc4,
+	0x8b, 0xf1, 0xb2, 0x93, 0x28, 0x06, 0xec, 0x94, 0x13, 0xc3, 0x3b, 0x26, 0x9e, 0xcc, 0x2f, 0xe5,
+	0x28, 0x6d, 0xa1, 0x85, 0x93, 0x58, 0x68, 0x31, 0xd3, 0x42, 0xff, 0x4c, 0x83, 0x8d, 0x24, 0x3f,
+	0x51, 0xc3, 0x25, 0x0c, 0x0e, 0xb1, 0x86, 0x8b, 0x32, 0x9d, 0x10, 0xc9, 0x78, 0xe1, 0x49, 0x7f,
+	0x82, 0x51, 0x60, 0xa0, 0x87, 0x82, 0xd9, 0xc8, 0x8a, 0x0b, 0x4b, 0xac, 0xf8, 0xfa, 0xdf, 0x14,
+	0xc3, 0x12, 0x66, 0xf8, 0x07, 0xcb, 0x0f, 0x01, 0x7a, 0xa6, 0x29, 0x87, 0x28, 0xa3, 0xc7, 0xd9,
+	0x59, 0x4f, 0xc0, 0xe4, 0xff, 0xa3, 0x39, 0xf4, 0x3b, 0xd0, 0x14, 0xd9, 0xc7, 0x1b, 0xcc, 0xdd,
+	0x85, 0x46, 0xbc, 0x05, 0x85, 0xb6, 0x78, 0x82, 0x33, 0xdf, 0x1c, 0xeb, 0xb4, 0xe7, 0x11, 0xe1,
+	0x22, 0x9f, 0x40, 0xfd, 0x2e, 0xa1, 0xc3, 0xb1, 0xf8, 0xab, 0x0d, 0x71, 0x1d, 0x4b, 0xfc, 0x9c,
+	0xd7, 0x41, 0x71, 0x50, 0x38, 0xef, 0x47, 0xb0, 0x22, 0x6a, 0x6d, 0xe1, 0x2f, 0x2a, 0xad, 0xd4,
+	0x1f, 0x23, 0x82, 0xed, 0xd4, 0xbf, 0x42, 0x38, 0x77, 0x45, 0xfb, 0x50, 0x43, 0xef, 0x43, 0x85,
+	0x35, 0x79, 0xd9, 0xaf, 0x1c, 0xaa, 0x1d, 0xce, 0xc6, 0x9d, 0xf5, 0xd8, 0x20, 0xb6, 0xd9, 0xc7,
+	0xd0, 0x4c, 0x74, 0x3e, 0x91, 0xfa, 0x3b, 0x65, 0xae, 0x19, 0xda, 0xe1, 0xfa, 0xcc, 0x3b, 0x03,
+	0x39, 0xe6, 0x54, 0x7b, 0xb6, 0xcd, 0x5b, 0xf0, 0x21, 0xb8, 0xb3, 0xa2, 0x84, 0x21, 0x9a, 0xf3,
+	0x38, 0x87, 0xbe, 0x84, 0x75, 0x39, 0x3b, 0xde, 0xbf, 0x14, 0xe2, 0xcc, 0x68, 0x83, 0x76, 0xda,
+	0xf3, 0x08, 0xc5, 0xe9, 0xf5, 0x5f, 0x20, 0x58, 0x93, 0xca, 0xf1, 0xd0, 0x70, 0x8c, 0x11, 0x99,
+	0xb0, 0x84, 0xee, 0x06, 0x54, 0xc3, 0x6a, 0xf7, 0xba, 0x14, 0x67, 0xbc, 0x04, 0xde, 0x59, 0x8d,
+	0x01, 0xf9, 0x92, 0x38, 0x87, 0x3e, 0xe7, 0x3a, 0x25, 0xf5, 0x18, 0x9d, 0x91, 0x8d, 0x99, 0x64,
+	0x4d, 0xb2, 0xb3, 0x99, 0x06, 0x87, 0x32, 0xbb, 0x01, 0x8d, 0x78, 0x1f, 0x47, 0x1c, 0x27, 0xa3,
+	0xb3, 0x93, 0x90, 0xd8, 0x67, 0xd0, 0x12, 0xea, 0x18, 0xcd, 0xeb, 0xec, 0xf0, 0x9f, 0xf9, 0xb2,
+	0x3a, 0x2c, 0x89, 0xa9, 0x3f, 0x81, 0x7a, 0xac, 0x0b, 0x80, 0x38, 0x63, 0xf3, 0x9d, 0x8e, 0xce,
+	0xd6, 0x1c, 0x3c, 0xe4, 0xf8, 0x26, 0x34, 0x95, 0xef, 0x15, 0x6b, 0x44, 0x97, 0xb6, 0x64, 0xd6,
+	0x0e, 0xac, 0xdd, 0x23, 0xa2, 0x3a, 0xfe, 0x24, 0xac, 0xb5, 0x47, 0x33, 0x9b, 0x61, 0x59, 0x9c,
+	0xb5, 0x06, 0x22, 0xab, 0x89, 0x92, 0x47, 0xa5, 0x0f, 0x29, 0x4f, 0xd7, 0x69, 0xcf, 0x23, 0x62,
+	0x56, 0xd3, 0x4c, 0xd4, 0xe4, 0x63, 0x1b, 0x9e, 0x55, 0xd3, 0xe6, 0x0a, 0xf6, 0x38, 0xc7, 0xde,
+	0x4f, 0xc9, 0x82, 0x3c, 0x3a, 0x2b, 0x94, 0x29, 0xa3, 0x48, 0x9f, 0x90, 0xee, 0x3e, 0xb4, 0x52,
+	0xa5, 0x70, 0x71, 0x31, 0xd9, 0x15, 0xf8, 0xce, 0xb9, 0x4c, 0x5c, 0xc8, 0xc6, 0x55, 0xa8, 0xaa,
+	0xba, 0xb8, 0xd0, 0xc7, 0x54, 0x95, 0x3c, 0xb1, 0xf5, 0x5d, 0x68, 0x26, 0xea, 0xd6, 0xc2, 0xf8,
+	0xb2, 0x2a, 0xe4, 0x9d, 0xb3, 0x19, 0x98, 0x70, 0xd3, 0xcf, 0xa0, 0x95, 0x2a, 0x4f, 0x8b, 0x23,
+	0x64, 0xd7, 0xac, 0x13, 0x2c, 0xfc, 0x18, 0xd0, 0x7c, 0xd5, 0x19, 0xbd, 0x1d, 0x69, 0xe6, 0xeb,
+	0x16, 0xf8, 0x1c, 0xd6, 0xe6, 0xca, 0xce, 0xe8, 0x2d, 0x29, 0xfa, 0xcc, 0x6a, 0x74, 0x62, 0xfa,
+	0x53, 0x40, 0xf3, 0x05, 0x5c, 0xb1, 0xff, 0xc2, 0xe2, 0x72, 0xa7, 0xbb, 0x08, 0x1d, 0x93, 0xc8,
+	0xc6, 0xbd, 0x44, 0xb1, 0x43, 0x96, 0x82, 0x22, 0x65, 0xe2, 0x56, 0x3f, 0x47, 0x80, 0x73, 0xe8,
+	0x31, 0xac, 0xa6, 0x53, 0x72, 0x74, 0x2e, 0x32, 0x92, 0xb9, 0x04, 0xb3, 0xf3, 0x56, 0x36, 0x32,
+	0xe4, 0x25, 0x54, 0x30, 0x85, 0x4b, 0x28, 0x58, 0x3a, 0x43, 0xef, 0x9c, 0xcb, 0xc4, 0x85, 0xab,
+	0xfd, 0x2e, 0xac, 0xa6, 0x33, 0x5d, 0xc1, 0xde, 0x82, 0xfc, 0x37, 0x2d, 0xed, 0xf9, 0x1c, 0x4c,
+	0x48, 0x7b, 0x61, 0xb6, 0xd7, 0xe9, 0x2e, 0x42, 0x87, 0x3c, 0xfd, 0x04, 0xd0, 0x7c, 0xc6, 0x15,
+	0x93, 0x75, 0x57, 0x1d, 0x29, 0x3b, 0x27, 0xc3, 0x39, 0xd4, 0x83, 0x75, 0xc1, 0x7f, 0x92, 0xb3,
+	0x6e, 0x74, 0xb0, 0x4c, 0xd6, 0x92, 0x8a, 0x08, 0x51, 0x59, 0x4c, 0x38, 0xf5, 0xb9, 0x4a, 0x5e,
+	0x67, 0x33, 0x0d, 0x8e, 0xfb, 0x9d, 0x44, 0x95, 0x29, 0xed, 0x77, 0x32, 0x4b, 0x50, 0x3c, 0xc4,
+	0xa1, 0xf9, 0xb2, 0x9a, 0x10, 0xe9, 0xc2, 0x72, 0x9b, 0x08, 0xc6, 0x29, 0x1c, 0xce, 0xa1, 0x3d,
+	0xd8, 0x5a, 0xd0, 0x7f, 0x40, 0x38, 0x69, 0x51, 0x59, 0xdd, 0x82, 0x84, 0x34, 0x4c, 0xd8, 0xba,
+	0xb7, 0x6c, 0xa9, 0xe5, 0x8d, 0x87, 0xce, 0xc5, 0xa5, 0x34, 0xf1, 0x6b, 0xcb, 0x68, 0x03, 0x88,
+	0x6b, 0x5b, 0xdc, 0x1f, 0x48, 0x30, 0xfa, 0x33, 0x58, 0xbf, 0xb7, 0x68, 0x89, 0xc5, 0x65, 0xff,
+	0xce, 0xf6, 0x42, 0x7c, 0xc8, 0xdc, 0xcf, 0x60, 0x3d, 0xa3, 0x4a, 0xa7, 0x74, 0x6a, 0x51, 0x75,
+	0xb0, 0xb3, 0xbd, 0x10, 0x1f, 0xae, 0x6c, 0xc0, 0x66, 0x76, 0xc1, 0x05, 0x5d, 0xe0, 0xbe, 0x60,
+	0x59, 0x19, 0xa7, 0x83, 0x97, 0x91, 0xc4, 0xcc, 0xbc, 0x1e, 0xab, 0x7e, 0x89, 0x98, 0x3f, 0x5f,
+	0x0e, 0x4b, 0x64, 0x37, 0x1c, 0x83, 0x73, 0x1f, 0x6a, 0xb7, 0x6e, 0x7e, 0xf3, 0x6d, 0x37, 0xf7,
+	0xab, 0x6f, 0xbb, 0xb9, 0xdf, 0x7c, 0xdb, 0xd5, 0xfe, 0xe4, 0x55, 0x57, 0xfb, 0xc5, 0xab, 0xae,
+	0xf6, 0xf5, 0xab, 0xae, 0xf6, 0xcd, 0xab, 0xae, 0xf6, 0x6f, 0xaf, 0xba, 0xda, 0x7f, 0xbe, 0xea,
+	0xe6, 0x7e, 0xf3, 0xaa, 0xab, 0xfd, 0xd5, 0x77, 0xdd, 0xdc, 0x37, 0xdf, 0x75, 0x73, 0xbf, 0xfa,
+	0xae, 0x9b, 0x1b, 0x94, 0xf9, 0x5f, 0x8f, 0x37, 0xfe, 0x67, 0x00, 0x06, 0xec, 0x4f, 0xf3, 0xef,
+	0x36, 0x00, 0x00,
+}
+
+func (x AddLabelLinkRequest_ConflictMode) String() string {
+	s, ok := AddLabelLinkRequest_ConflictMode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ServiceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceRequest)
 	if !ok {
-		that2, ok := that.(ListAccountsResponse)
+		that2, ok := that.(ServiceRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3296,4337 +5508,20596 @@ func (this *ListAccountsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Accounts) != len(that1.Accounts) {
+	if !this.Account.Equal(that1.Account) {
 		return false
 	}
-	for i := range this.Accounts {
-		if !this.Accounts[i].Equal(that1.Accounts[i]) {
-			return false
-		}
+	if !this.Hub.Equal(that1.Hub) {
+		return false
 	}
-	if !bytes.Equal(this.NextMarker, that1.NextMarker) {
+	if !this.Id.Equal(that1.Id) {
 		return false
 	}
-	return true
-}
-func (this *ServiceRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&pb.ServiceRequest{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	if !this.Labels.Equal(that1.Labels) {
+		return false
 	}
-	if this.Hub != nil {
-		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
 	}
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
+		}
 	}
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+	return true
+}
+func (this *ServiceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+
+	that1, ok := that.(*ServiceResponse)
+	if !ok {
+		that2, ok := that.(ServiceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *ServiceResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&pb.ServiceResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LabelLink) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LabelLink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&pb.LabelLink{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+
+	that1, ok := that.(*LabelLink)
+	if !ok {
+		that2, ok := that.(LabelLink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Target != nil {
-		s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	if this.Limits != nil {
-		s = append(s, "Limits: "+fmt.Sprintf("%#v", this.Limits)+",\n")
+	if !this.Labels.Equal(that1.Labels) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LabelLinks) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Target.Equal(that1.Target) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.LabelLinks{")
-	if this.LabelLinks != nil {
-		s = append(s, "LabelLinks: "+fmt.Sprintf("%#v", this.LabelLinks)+",\n")
+	if !this.Limits.Equal(that1.Limits) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ServiceRoute) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Weighted != that1.Weighted {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&pb.ServiceRoute{")
-	if this.Hub != nil {
-		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
+	if this.Weight != that1.Weight {
+		return false
 	}
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	return true
+}
+func (this *LabelLinks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+
+	that1, ok := that.(*LabelLinks)
+	if !ok {
+		that2, ok := that.(LabelLinks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AccountServices) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.AccountServices{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	if len(this.LabelLinks) != len(that1.LabelLinks) {
+		return false
 	}
-	if this.Services != nil {
-		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
+	for i := range this.LabelLinks {
+		if !this.LabelLinks[i].Equal(that1.LabelLinks[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ActivityEntry) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ServiceRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.ActivityEntry{")
-	if this.RouteAdded != nil {
-		s = append(s, "RouteAdded: "+fmt.Sprintf("%#v", this.RouteAdded)+",\n")
+
+	that1, ok := that.(*ServiceRoute)
+	if !ok {
+		that2, ok := that.(ServiceRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.RouteRemoved != nil {
-		s = append(s, "RouteRemoved: "+fmt.Sprintf("%#v", this.RouteRemoved)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ConfigRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Hub.Equal(that1.Hub) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.ConfigRequest{")
-	if this.StableId != nil {
-		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
+	if !this.Id.Equal(that1.Id) {
+		return false
 	}
-	if this.InstanceId != nil {
-		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
+	if this.Type != that1.Type {
+		return false
 	}
-	if this.Locations != nil {
-		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
+	if !this.Labels.Equal(that1.Labels) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ConfigResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AccountServices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&pb.ConfigResponse{")
-	s = append(s, "TlsKey: "+fmt.Sprintf("%#v", this.TlsKey)+",\n")
-	s = append(s, "TlsCert: "+fmt.Sprintf("%#v", this.TlsCert)+",\n")
-	s = append(s, "TokenPub: "+fmt.Sprintf("%#v", this.TokenPub)+",\n")
-	s = append(s, "S3AccessKey: "+fmt.Sprintf("%#v", this.S3AccessKey)+",\n")
-	s = append(s, "S3SecretKey: "+fmt.Sprintf("%#v", this.S3SecretKey)+",\n")
-	s = append(s, "S3Bucket: "+fmt.Sprintf("%#v", this.S3Bucket)+",\n")
-	s = append(s, "ImageTag: "+fmt.Sprintf("%#v", this.ImageTag)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubChange) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AccountServices)
+	if !ok {
+		that2, ok := that.(AccountServices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.HubChange{")
-	if this.OldId != nil {
-		s = append(s, "OldId: "+fmt.Sprintf("%#v", this.OldId)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.NewId != nil {
-		s = append(s, "NewId: "+fmt.Sprintf("%#v", this.NewId)+",\n")
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CentralActivity) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Services) != len(that1.Services) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&pb.CentralActivity{")
-	if this.AccountServices != nil {
-		s = append(s, "AccountServices: "+fmt.Sprintf("%#v", this.AccountServices)+",\n")
+	for i := range this.Services {
+		if !this.Services[i].Equal(that1.Services[i]) {
+			return false
+		}
 	}
-	s = append(s, "RequestStats: "+fmt.Sprintf("%#v", this.RequestStats)+",\n")
-	if this.NewLabelLinks != nil {
-		s = append(s, "NewLabelLinks: "+fmt.Sprintf("%#v", this.NewLabelLinks)+",\n")
+	if this.FlowIdleTimeout != that1.FlowIdleTimeout {
+		return false
 	}
-	if this.HubChange != nil {
-		s = append(s, "HubChange: "+fmt.Sprintf("%#v", this.HubChange)+",\n")
+	if !this.TlsPolicy.Equal(that1.TlsPolicy) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *HubActivity) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *TLSPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&pb.HubActivity{")
-	if this.HubReg != nil {
-		s = append(s, "HubReg: "+fmt.Sprintf("%#v", this.HubReg)+",\n")
+
+	that1, ok := that.(*TLSPolicy)
+	if !ok {
+		that2, ok := that.(TLSPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.SentAt != nil {
-		s = append(s, "SentAt: "+fmt.Sprintf("%#v", this.SentAt)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Stats != nil {
-		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	if this.MinVersion != that1.MinVersion {
+		return false
 	}
-	if this.Flow != nil {
-		s = append(s, "Flow: "+fmt.Sprintf("%#v", this.Flow)+",\n")
+	if this.RequireClientCert != that1.RequireClientCert {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *HubActivity_HubRegistration) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ActivityEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.HubActivity_HubRegistration{")
-	if this.Hub != nil {
-		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
+
+	that1, ok := that.(*ActivityEntry)
+	if !ok {
+		that2, ok := that.(ActivityEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.StableHub != nil {
-		s = append(s, "StableHub: "+fmt.Sprintf("%#v", this.StableHub)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Locations != nil {
-		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
+	if !this.RouteAdded.Equal(that1.RouteAdded) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.RouteRemoved.Equal(that1.RouteRemoved) {
+		return false
+	}
+	return true
 }
-func (this *HubActivity_HubStats) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&pb.HubActivity_HubStats{")
-	s = append(s, "AgentConnections: "+fmt.Sprintf("%#v", this.AgentConnections)+",\n")
-	s = append(s, "AccountsSeen: "+fmt.Sprintf("%#v", this.AccountsSeen)+",\n")
-	s = append(s, "AccountsCached: "+fmt.Sprintf("%#v", this.AccountsCached)+",\n")
-	s = append(s, "CachedBytes: "+fmt.Sprintf("%#v", this.CachedBytes)+",\n")
-	if this.LastSuccessfulUpdate != nil {
-		s = append(s, "LastSuccessfulUpdate: "+fmt.Sprintf("%#v", this.LastSuccessfulUpdate)+",\n")
+
+	that1, ok := that.(*ConfigRequest)
+	if !ok {
+		that2, ok := that.(ConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.LastAttemptedUpdate != nil {
-		s = append(s, "LastAttemptedUpdate: "+fmt.Sprintf("%#v", this.LastAttemptedUpdate)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.StableId.Equal(that1.StableId) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.HubInfo{")
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if !this.InstanceId.Equal(that1.InstanceId) {
+		return false
 	}
-	if this.Locations != nil {
-		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
+	if len(this.Locations) != len(that1.Locations) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListOfHubs) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Locations {
+		if !this.Locations[i].Equal(that1.Locations[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ListOfHubs{")
-	if this.Hubs != nil {
-		s = append(s, "Hubs: "+fmt.Sprintf("%#v", this.Hubs)+",\n")
+	if len(this.Capabilities) != len(that1.Capabilities) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	for i := range this.Capabilities {
+		if this.Capabilities[i] != that1.Capabilities[i] {
+			return false
+		}
+	}
+	return true
 }
-func (this *HubSync) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.HubSync{")
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+
+	that1, ok := that.(*ConfigResponse)
+	if !ok {
+		that2, ok := that.(ConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.StableId != nil {
-		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Services != nil {
-		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
+	if !bytes.Equal(this.TlsKey, that1.TlsKey) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubSyncResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.TlsCert, that1.TlsCert) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.HubSyncResponse{")
-	s = append(s, "ServiceCount: "+fmt.Sprintf("%#v", this.ServiceCount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubRegisterRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.TokenPub, that1.TokenPub) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.HubRegisterRequest{")
-	if this.StableId != nil {
-		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
+	if this.S3AccessKey != that1.S3AccessKey {
+		return false
 	}
-	if this.InstanceId != nil {
-		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
+	if this.S3SecretKey != that1.S3SecretKey {
+		return false
 	}
-	if this.Locations != nil {
-		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
+	if this.S3Bucket != that1.S3Bucket {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubRegisterResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ImageTag != that1.ImageTag {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.HubRegisterResponse{")
-	if this.ControlTime != nil {
-		s = append(s, "ControlTime: "+fmt.Sprintf("%#v", this.ControlTime)+",\n")
+	if len(this.TokenKeys) != len(that1.TokenKeys) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HubDisconnectRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.TokenKeys {
+		if !this.TokenKeys[i].Equal(that1.TokenKeys[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.HubDisconnectRequest{")
-	if this.StableId != nil {
-		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
+	if !this.Reconnect.Equal(that1.Reconnect) {
+		return false
 	}
-	if this.InstanceId != nil {
-		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
+	if this.FlowIdleTimeout != that1.FlowIdleTimeout {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ServiceTokenRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.TlsPolicy.Equal(that1.TlsPolicy) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ServiceTokenRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ServiceTokenResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.AgentClientCa, that1.AgentClientCa) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ServiceTokenResponse{")
-	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.S3Region != that1.S3Region {
+		return false
+	}
+	return true
 }
-func (this *ListServicesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ReconnectPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ListServicesRequest{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+
+	that1, ok := that.(*ReconnectPolicy)
+	if !ok {
+		that2, ok := that.(ReconnectPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListServicesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ListServicesResponse{")
-	if this.Services != nil {
-		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
+	if this.InitialBackoff != that1.InitialBackoff {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.MaxBackoff != that1.MaxBackoff {
+		return false
+	}
+	if this.Jitter != that1.Jitter {
+		return false
+	}
+	return true
 }
-func (this *Service) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *HubChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&pb.Service{")
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+
+	that1, ok := that.(*HubChange)
+	if !ok {
+		that2, ok := that.(HubChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Hub != nil {
-		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+	if !this.OldId.Equal(that1.OldId) {
+		return false
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if !this.NewId.Equal(that1.NewId) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AddAccountRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MigrateStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.AddAccountRequest{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+
+	that1, ok := that.(*MigrateStream)
+	if !ok {
+		that2, ok := that.(MigrateStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Limits != nil {
-		s = append(s, "Limits: "+fmt.Sprintf("%#v", this.Limits)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.Within != that1.Within {
+		return false
+	}
+	return true
 }
-func (this *AddLabelLinkRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *HubTLS) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.AddLabelLinkRequest{")
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+
+	that1, ok := that.(*HubTLS)
+	if !ok {
+		that2, ok := that.(HubTLS)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Target != nil {
-		s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	if !bytes.Equal(this.Cert, that1.Cert) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Noop) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&pb.Noop{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RemoveLabelLinkRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.RemoveLabelLinkRequest{")
-	if this.Labels != nil {
-		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+func (this *CentralActivity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+
+	that1, ok := that.(*CentralActivity)
+	if !ok {
+		that2, ok := that.(CentralActivity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CreateTokenRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.CreateTokenRequest{")
-	if this.Account != nil {
-		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	if len(this.AccountServices) != len(that1.AccountServices) {
+		return false
 	}
-	if this.Capabilities != nil {
-		vs := make([]TokenCapability, len(this.Capabilities))
-		for i := range vs {
-			vs[i] = this.Capabilities[i]
+	for i := range this.AccountServices {
+		if !this.AccountServices[i].Equal(that1.AccountServices[i]) {
+			return false
 		}
-		s = append(s, "Capabilities: "+fmt.Sprintf("%#v", vs)+",\n")
-	}
-	if this.ValidDuration != nil {
-		s = append(s, "ValidDuration: "+fmt.Sprintf("%#v", this.ValidDuration)+",\n")
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CreateTokenResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.RequestStats != that1.RequestStats {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.CreateTokenResponse{")
-	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ControlRegister) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.NewLabelLinks.Equal(that1.NewLabelLinks) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ControlRegister{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ControlToken) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.HubChange.Equal(that1.HubChange) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ControlToken{")
-	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TokenInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.KillFlows) != len(that1.KillFlows) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.TokenInfo{")
-	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListAccountsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.KillFlows {
+		if !this.KillFlows[i].Equal(that1.KillFlows[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.ListAccountsRequest{")
-	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
-	s = append(s, "Marker: "+fmt.Sprintf("%#v", this.Marker)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListAccountsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.MigrateStream.Equal(that1.MigrateStream) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.ListAccountsResponse{")
-	if this.Accounts != nil {
-		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	if !this.HubTls.Equal(that1.HubTls) {
+		return false
 	}
-	s = append(s, "NextMarker: "+fmt.Sprintf("%#v", this.NextMarker)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func valueToGoStringControl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *HubActivity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
 
-// ControlServicesClient is the client API for ControlServices service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControlServicesClient interface {
-	AddService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
-	RemoveService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
-	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
-	FetchConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
-	StreamActivity(ctx context.Context, opts ...grpc.CallOption) (ControlServices_StreamActivityClient, error)
-	SyncHub(ctx context.Context, in *HubSync, opts ...grpc.CallOption) (*HubSyncResponse, error)
-	HubDisconnect(ctx context.Context, in *HubDisconnectRequest, opts ...grpc.CallOption) (*Noop, error)
-	AllHubs(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListOfHubs, error)
-	RequestServiceToken(ctx context.Context, in *ServiceTokenRequest, opts ...grpc.CallOption) (*ServiceTokenResponse, error)
-}
-
-type controlServicesClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewControlServicesClient(cc *grpc.ClientConn) ControlServicesClient {
-	return &controlServicesClient{cc}
-}
-
-func (c *controlServicesClient) AddService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
-	out := new(ServiceResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/AddService", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*HubActivity)
+	if !ok {
+		that2, ok := that.(HubActivity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *controlServicesClient) RemoveService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
-	out := new(ServiceResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/RemoveService", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlServicesClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
-	out := new(ListServicesResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/ListServices", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.HubReg.Equal(that1.HubReg) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlServicesClient) FetchConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
-	out := new(ConfigResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/FetchConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.SentAt.Equal(that1.SentAt) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlServicesClient) StreamActivity(ctx context.Context, opts ...grpc.CallOption) (ControlServices_StreamActivityClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ControlServices_serviceDesc.Streams[0], "/pb.ControlServices/StreamActivity", opts...)
-	if err != nil {
-		return nil, err
+	if !this.Stats.Equal(that1.Stats) {
+		return false
 	}
-	x := &controlServicesStreamActivityClient{stream}
-	return x, nil
-}
-
-type ControlServices_StreamActivityClient interface {
-	Send(*HubActivity) error
-	Recv() (*CentralActivity, error)
-	grpc.ClientStream
-}
-
-type controlServicesStreamActivityClient struct {
-	grpc.ClientStream
-}
-
-func (x *controlServicesStreamActivityClient) Send(m *HubActivity) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *controlServicesStreamActivityClient) Recv() (*CentralActivity, error) {
-	m := new(CentralActivity)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if len(this.Flow) != len(that1.Flow) {
+		return false
 	}
-	return m, nil
-}
-
-func (c *controlServicesClient) SyncHub(ctx context.Context, in *HubSync, opts ...grpc.CallOption) (*HubSyncResponse, error) {
-	out := new(HubSyncResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/SyncHub", in, out, opts...)
-	if err != nil {
-		return nil, err
+	for i := range this.Flow {
+		if !this.Flow[i].Equal(that1.Flow[i]) {
+			return false
+		}
 	}
-	return out, nil
+	return true
 }
-
-func (c *controlServicesClient) HubDisconnect(ctx context.Context, in *HubDisconnectRequest, opts ...grpc.CallOption) (*Noop, error) {
-	out := new(Noop)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/HubDisconnect", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *HubActivity_HubRegistration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *controlServicesClient) AllHubs(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListOfHubs, error) {
-	out := new(ListOfHubs)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/AllHubs", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*HubActivity_HubRegistration)
+	if !ok {
+		that2, ok := that.(HubActivity_HubRegistration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *controlServicesClient) RequestServiceToken(ctx context.Context, in *ServiceTokenRequest, opts ...grpc.CallOption) (*ServiceTokenResponse, error) {
-	out := new(ServiceTokenResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlServices/RequestServiceToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-// ControlServicesServer is the server API for ControlServices service.
-type ControlServicesServer interface {
-	AddService(context.Context, *ServiceRequest) (*ServiceResponse, error)
-	RemoveService(context.Context, *ServiceRequest) (*ServiceResponse, error)
-	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
-	FetchConfig(context.Context, *ConfigRequest) (*ConfigResponse, error)
-	StreamActivity(ControlServices_StreamActivityServer) error
-	SyncHub(context.Context, *HubSync) (*HubSyncResponse, error)
-	HubDisconnect(context.Context, *HubDisconnectRequest) (*Noop, error)
-	AllHubs(context.Context, *Noop) (*ListOfHubs, error)
-	RequestServiceToken(context.Context, *ServiceTokenRequest) (*ServiceTokenResponse, error)
-}
-
-// UnimplementedControlServicesServer can be embedded to have forward compatible implementations.
-type UnimplementedControlServicesServer struct {
-}
-
-func (*UnimplementedControlServicesServer) AddService(ctx context.Context, req *ServiceRequest) (*ServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
-}
-func (*UnimplementedControlServicesServer) RemoveService(ctx context.Context, req *ServiceRequest) (*ServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
-}
-func (*UnimplementedControlServicesServer) ListServices(ctx context.Context, req *ListServicesRequest) (*ListServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
-}
-func (*UnimplementedControlServicesServer) FetchConfig(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchConfig not implemented")
-}
-func (*UnimplementedControlServicesServer) StreamActivity(srv ControlServices_StreamActivityServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamActivity not implemented")
-}
-func (*UnimplementedControlServicesServer) SyncHub(ctx context.Context, req *HubSync) (*HubSyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncHub not implemented")
-}
-func (*UnimplementedControlServicesServer) HubDisconnect(ctx context.Context, req *HubDisconnectRequest) (*Noop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HubDisconnect not implemented")
-}
-func (*UnimplementedControlServicesServer) AllHubs(ctx context.Context, req *Noop) (*ListOfHubs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllHubs not implemented")
-}
-func (*UnimplementedControlServicesServer) RequestServiceToken(ctx context.Context, req *ServiceTokenRequest) (*ServiceTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestServiceToken not implemented")
-}
-
-func RegisterControlServicesServer(s *grpc.Server, srv ControlServicesServer) {
-	s.RegisterService(&_ControlServices_serviceDesc, srv)
-}
-
-func _ControlServices_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if !this.Hub.Equal(that1.Hub) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).AddService(ctx, in)
+	if !this.StableHub.Equal(that1.StableHub) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/AddService",
+	if len(this.Locations) != len(that1.Locations) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).AddService(ctx, req.(*ServiceRequest))
+	for i := range this.Locations {
+		if !this.Locations[i].Equal(that1.Locations[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	return true
 }
+func (this *HubActivity_HubStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlServices_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*HubActivity_HubStats)
+	if !ok {
+		that2, ok := that.(HubActivity_HubStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).RemoveService(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/RemoveService",
+	if this.AgentConnections != that1.AgentConnections {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).RemoveService(ctx, req.(*ServiceRequest))
+	if this.AccountsSeen != that1.AccountsSeen {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ControlServices_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListServicesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.AccountsCached != that1.AccountsCached {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).ListServices(ctx, in)
+	if this.CachedBytes != that1.CachedBytes {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/ListServices",
+	if !this.LastSuccessfulUpdate.Equal(that1.LastSuccessfulUpdate) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).ListServices(ctx, req.(*ListServicesRequest))
+	if !this.LastAttemptedUpdate.Equal(that1.LastAttemptedUpdate) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *HubInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlServices_FetchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*HubInfo)
+	if !ok {
+		that2, ok := that.(HubInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).FetchConfig(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/FetchConfig",
+	if !this.Id.Equal(that1.Id) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).FetchConfig(ctx, req.(*ConfigRequest))
+	if len(this.Locations) != len(that1.Locations) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ControlServices_StreamActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ControlServicesServer).StreamActivity(&controlServicesStreamActivityServer{stream})
-}
-
-type ControlServices_StreamActivityServer interface {
-	Send(*CentralActivity) error
-	Recv() (*HubActivity, error)
-	grpc.ServerStream
-}
-
-type controlServicesStreamActivityServer struct {
-	grpc.ServerStream
-}
-
-func (x *controlServicesStreamActivityServer) Send(m *CentralActivity) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *controlServicesStreamActivityServer) Recv() (*HubActivity, error) {
-	m := new(HubActivity)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	for i := range this.Locations {
+		if !this.Locations[i].Equal(that1.Locations[i]) {
+			return false
+		}
 	}
-	return m, nil
+	if !this.StableId.Equal(that1.StableId) {
+		return false
+	}
+	if this.ActiveFlows != that1.ActiveFlows {
+		return false
+	}
+	if this.MaxFlows != that1.MaxFlows {
+		return false
+	}
+	if len(this.Capabilities) != len(that1.Capabilities) {
+		return false
+	}
+	for i := range this.Capabilities {
+		if this.Capabilities[i] != that1.Capabilities[i] {
+			return false
+		}
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	return true
 }
+func (this *ListOfHubs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlServices_SyncHub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HubSync)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ListOfHubs)
+	if !ok {
+		that2, ok := that.(ListOfHubs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).SyncHub(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/SyncHub",
+	if len(this.Hubs) != len(that1.Hubs) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).SyncHub(ctx, req.(*HubSync))
+	for i := range this.Hubs {
+		if !this.Hubs[i].Equal(that1.Hubs[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if len(this.ProtocolVersions) != len(that1.ProtocolVersions) {
+		return false
+	}
+	for i := range this.ProtocolVersions {
+		if !this.ProtocolVersions[i].Equal(that1.ProtocolVersions[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ProtocolVersionCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlServices_HubDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HubDisconnectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ProtocolVersionCount)
+	if !ok {
+		that2, ok := that.(ProtocolVersionCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).HubDisconnect(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/HubDisconnect",
+	if this.Version != that1.Version {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).HubDisconnect(ctx, req.(*HubDisconnectRequest))
+	if this.Hubs != that1.Hubs {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *HubSync) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlServices_AllHubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Noop)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*HubSync)
+	if !ok {
+		that2, ok := that.(HubSync)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).AllHubs(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/AllHubs",
+	if !this.Id.Equal(that1.Id) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).AllHubs(ctx, req.(*Noop))
+	if !this.StableId.Equal(that1.StableId) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if len(this.Services) != len(that1.Services) {
+		return false
+	}
+	for i := range this.Services {
+		if !this.Services[i].Equal(that1.Services[i]) {
+			return false
+		}
+	}
+	return true
 }
-
-func _ControlServices_RequestServiceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *HubSyncResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(ControlServicesServer).RequestServiceToken(ctx, in)
+
+	that1, ok := that.(*HubSyncResponse)
+	if !ok {
+		that2, ok := that.(HubSyncResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlServices/RequestServiceToken",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServicesServer).RequestServiceToken(ctx, req.(*ServiceTokenRequest))
+	if this.ServiceCount != that1.ServiceCount {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *HubRegisterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _ControlServices_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.ControlServices",
-	HandlerType: (*ControlServicesServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddService",
-			Handler:    _ControlServices_AddService_Handler,
-		},
-		{
-			MethodName: "RemoveService",
-			Handler:    _ControlServices_RemoveService_Handler,
-		},
-		{
-			MethodName: "ListServices",
-			Handler:    _ControlServices_ListServices_Handler,
-		},
-		{
-			MethodName: "FetchConfig",
-			Handler:    _ControlServices_FetchConfig_Handler,
-		},
-		{
-			MethodName: "SyncHub",
-			Handler:    _ControlServices_SyncHub_Handler,
-		},
-		{
-			MethodName: "HubDisconnect",
-			Handler:    _ControlServices_HubDisconnect_Handler,
-		},
-		{
-			MethodName: "AllHubs",
-			Handler:    _ControlServices_AllHubs_Handler,
-		},
-		{
-			MethodName: "RequestServiceToken",
-			Handler:    _ControlServices_RequestServiceToken_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamActivity",
-			Handler:       _ControlServices_StreamActivity_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "control.proto",
-}
-
-// ControlManagementClient is the client API for ControlManagement service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControlManagementClient interface {
-	Register(ctx context.Context, in *ControlRegister, opts ...grpc.CallOption) (*ControlToken, error)
-	AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*Noop, error)
-	AddLabelLink(ctx context.Context, in *AddLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error)
-	RemoveLabelLink(ctx context.Context, in *RemoveLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error)
-	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
-	IssueHubToken(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*CreateTokenResponse, error)
-	GetTokenPublicKey(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*TokenInfo, error)
-	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-}
-
-type controlManagementClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewControlManagementClient(cc *grpc.ClientConn) ControlManagementClient {
-	return &controlManagementClient{cc}
-}
-
-func (c *controlManagementClient) Register(ctx context.Context, in *ControlRegister, opts ...grpc.CallOption) (*ControlToken, error) {
-	out := new(ControlToken)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *controlManagementClient) AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*Noop, error) {
-	out := new(Noop)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/AddAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*HubRegisterRequest)
+	if !ok {
+		that2, ok := that.(HubRegisterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *controlManagementClient) AddLabelLink(ctx context.Context, in *AddLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error) {
-	out := new(Noop)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/AddLabelLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlManagementClient) RemoveLabelLink(ctx context.Context, in *RemoveLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error) {
-	out := new(Noop)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RemoveLabelLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.StableId.Equal(that1.StableId) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlManagementClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
-	out := new(CreateTokenResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/CreateToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.InstanceId.Equal(that1.InstanceId) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlManagementClient) IssueHubToken(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
-	out := new(CreateTokenResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/IssueHubToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if len(this.Locations) != len(that1.Locations) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *controlManagementClient) GetTokenPublicKey(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*TokenInfo, error) {
-	out := new(TokenInfo)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetTokenPublicKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+	for i := range this.Locations {
+		if !this.Locations[i].Equal(that1.Locations[i]) {
+			return false
+		}
 	}
-	return out, nil
+	return true
 }
-
-func (c *controlManagementClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *HubRegisterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// ControlManagementServer is the server API for ControlManagement service.
-type ControlManagementServer interface {
-	Register(context.Context, *ControlRegister) (*ControlToken, error)
-	AddAccount(context.Context, *AddAccountRequest) (*Noop, error)
-	AddLabelLink(context.Context, *AddLabelLinkRequest) (*Noop, error)
-	RemoveLabelLink(context.Context, *RemoveLabelLinkRequest) (*Noop, error)
-	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
-	IssueHubToken(context.Context, *Noop) (*CreateTokenResponse, error)
-	GetTokenPublicKey(context.Context, *Noop) (*TokenInfo, error)
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-}
-
-// UnimplementedControlManagementServer can be embedded to have forward compatible implementations.
-type UnimplementedControlManagementServer struct {
-}
-
-func (*UnimplementedControlManagementServer) Register(ctx context.Context, req *ControlRegister) (*ControlToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
-}
-func (*UnimplementedControlManagementServer) AddAccount(ctx context.Context, req *AddAccountRequest) (*Noop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccount not implemented")
-}
-func (*UnimplementedControlManagementServer) AddLabelLink(ctx context.Context, req *AddLabelLinkRequest) (*Noop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLabelLink not implemented")
-}
-func (*UnimplementedControlManagementServer) RemoveLabelLink(ctx context.Context, req *RemoveLabelLinkRequest) (*Noop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLabelLink not implemented")
-}
-func (*UnimplementedControlManagementServer) CreateToken(ctx context.Context, req *CreateTokenRequest) (*CreateTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
-}
-func (*UnimplementedControlManagementServer) IssueHubToken(ctx context.Context, req *Noop) (*CreateTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssueHubToken not implemented")
-}
-func (*UnimplementedControlManagementServer) GetTokenPublicKey(ctx context.Context, req *Noop) (*TokenInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTokenPublicKey not implemented")
-}
-func (*UnimplementedControlManagementServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
-}
-
-func RegisterControlManagementServer(s *grpc.Server, srv ControlManagementServer) {
-	s.RegisterService(&_ControlManagement_serviceDesc, srv)
-}
 
-func _ControlManagement_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ControlRegister)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).Register(ctx, in)
+	that1, ok := that.(*HubRegisterResponse)
+	if !ok {
+		that2, ok := that.(HubRegisterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/Register",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).Register(ctx, req.(*ControlRegister))
+	if !this.ControlTime.Equal(that1.ControlTime) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *HubDisconnectRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlManagement_AddAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*HubDisconnectRequest)
+	if !ok {
+		that2, ok := that.(HubDisconnectRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).AddAccount(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/AddAccount",
+	if !this.StableId.Equal(that1.StableId) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).AddAccount(ctx, req.(*AddAccountRequest))
+	if !this.InstanceId.Equal(that1.InstanceId) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _ControlManagement_AddLabelLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLabelLinkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).AddLabelLink(ctx, in)
+func (this *ServiceTokenRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/AddLabelLink",
+
+	that1, ok := that.(*ServiceTokenRequest)
+	if !ok {
+		that2, ok := that.(ServiceTokenRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).AddLabelLink(ctx, req.(*AddLabelLinkRequest))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	return true
 }
-
-func _ControlManagement_RemoveLabelLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLabelLinkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ServiceTokenResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).RemoveLabelLink(ctx, in)
+
+	that1, ok := that.(*ServiceTokenResponse)
+	if !ok {
+		that2, ok := that.(ServiceTokenResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/RemoveLabelLink",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).RemoveLabelLink(ctx, req.(*RemoveLabelLinkRequest))
+	if this.Token != that1.Token {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ItemError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlManagement_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ItemError)
+	if !ok {
+		that2, ok := that.(ItemError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).CreateToken(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/CreateToken",
+	if this.Item != that1.Item {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	if this.Error != that1.Error {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ListServicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlManagement_IssueHubToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Noop)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ListServicesRequest)
+	if !ok {
+		that2, ok := that.(ListServicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).IssueHubToken(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/IssueHubToken",
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).IssueHubToken(ctx, req.(*Noop))
+	if this.BestEffort != that1.BestEffort {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.ConsistentRead != that1.ConsistentRead {
+		return false
+	}
+	return true
 }
+func (this *ListServicesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlManagement_GetTokenPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Noop)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ListServicesResponse)
+	if !ok {
+		that2, ok := that.(ListServicesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).GetTokenPublicKey(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/GetTokenPublicKey",
+	if len(this.Services) != len(that1.Services) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).GetTokenPublicKey(ctx, req.(*Noop))
+	for i := range this.Services {
+		if !this.Services[i].Equal(that1.Services[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Service) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ControlManagement_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*Service)
+	if !ok {
+		that2, ok := that.(Service)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ControlManagementServer).ListAccounts(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ControlManagement/ListAccounts",
+	if !this.Id.Equal(that1.Id) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlManagementServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	if !this.Hub.Equal(that1.Hub) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _ControlManagement_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.ControlManagement",
-	HandlerType: (*ControlManagementServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Register",
-			Handler:    _ControlManagement_Register_Handler,
-		},
-		{
-			MethodName: "AddAccount",
-			Handler:    _ControlManagement_AddAccount_Handler,
-		},
-		{
-			MethodName: "AddLabelLink",
-			Handler:    _ControlManagement_AddLabelLink_Handler,
-		},
-		{
-			MethodName: "RemoveLabelLink",
-			Handler:    _ControlManagement_RemoveLabelLink_Handler,
-		},
-		{
-			MethodName: "CreateToken",
-			Handler:    _ControlManagement_CreateToken_Handler,
-		},
-		{
-			MethodName: "IssueHubToken",
-			Handler:    _ControlManagement_IssueHubToken_Handler,
-		},
-		{
-			MethodName: "GetTokenPublicKey",
-			Handler:    _ControlManagement_GetTokenPublicKey_Handler,
-		},
-		{
-			MethodName: "ListAccounts",
-			Handler:    _ControlManagement_ListAccounts_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "control.proto",
-}
-
-func (m *ServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Type != that1.Type {
+		return false
 	}
-	return dAtA[:n], nil
+	if !this.Labels.Equal(that1.Labels) {
+		return false
+	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *AddAccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	that1, ok := that.(*AddAccountRequest)
+	if !ok {
+		that2, ok := that.(AddAccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x22
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if !this.Limits.Equal(that1.Limits) {
+		return false
 	}
-	if m.Hub != nil {
-		{
-			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.ExternalId != that1.ExternalId {
+		return false
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddAccountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *LabelLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*AddAccountResponse)
+	if !ok {
+		that2, ok := that.(AddAccountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LabelLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	return true
 }
+func (this *AddLabelLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *LabelLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limits != nil {
-		{
-			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*AddLabelLinkRequest)
+	if !ok {
+		that2, ok := that.(AddLabelLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.Target != nil {
-		{
-			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if !this.Labels.Equal(that1.Labels) {
+		return false
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Target.Equal(that1.Target) {
+		return false
+	}
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
+	if this.OnConflict != that1.OnConflict {
+		return false
+	}
+	if this.Weighted != that1.Weighted {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	return true
 }
+func (this *Noop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *LabelLinks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Noop)
+	if !ok {
+		that2, ok := that.(Noop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *RemoveLabelLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *LabelLinks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LabelLinks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LabelLinks) > 0 {
-		for iNdEx := len(m.LabelLinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LabelLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*RemoveLabelLinkRequest)
+	if !ok {
+		that2, ok := that.(RemoveLabelLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ServiceRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Labels.Equal(that1.Labels) {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
+	if !this.Target.Equal(that1.Target) {
+		return false
+	}
+	return true
 }
+func (this *CreateTokenRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ServiceRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*CreateTokenRequest)
+	if !ok {
+		that2, ok := that.(CreateTokenRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	if m.Hub != nil {
-		{
-			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if len(this.Capabilities) != len(that1.Capabilities) {
+		return false
+	}
+	for i := range this.Capabilities {
+		if !this.Capabilities[i].Equal(&that1.Capabilities[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AccountServices) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.ValidDuration.Equal(that1.ValidDuration) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccountServices) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
+	return true
 }
+func (this *CreateTokenResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AccountServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*CreateTokenResponse)
+	if !ok {
+		that2, ok := that.(CreateTokenResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ActivityEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Token != that1.Token {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ActivityEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ControlRegister) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ActivityEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RouteRemoved != nil {
-		{
-			size, err := m.RouteRemoved.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*ControlRegister)
+	if !ok {
+		that2, ok := that.(ControlRegister)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.RouteAdded != nil {
-		{
-			size, err := m.RouteAdded.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	return true
 }
+func (this *ControlToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ControlToken)
+	if !ok {
+		that2, ok := that.(ControlToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
 }
+func (this *TokenInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*TokenInfo)
+	if !ok {
+		that2, ok := that.(TokenInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.InstanceId != nil {
-		{
-			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StableId != nil {
-		{
-			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Algorithm != that1.Algorithm {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.KeyId != that1.KeyId {
+		return false
+	}
+	return true
 }
-
-func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ImageTag) > 0 {
-		i -= len(m.ImageTag)
-		copy(dAtA[i:], m.ImageTag)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.ImageTag)))
-		i--
-		dAtA[i] = 0x3a
+func (this *TokenKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.S3Bucket) > 0 {
-		i -= len(m.S3Bucket)
-		copy(dAtA[i:], m.S3Bucket)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.S3Bucket)))
-		i--
-		dAtA[i] = 0x32
+
+	that1, ok := that.(*TokenKey)
+	if !ok {
+		that2, ok := that.(TokenKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.S3SecretKey) > 0 {
-		i -= len(m.S3SecretKey)
-		copy(dAtA[i:], m.S3SecretKey)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.S3SecretKey)))
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.S3AccessKey) > 0 {
-		i -= len(m.S3AccessKey)
-		copy(dAtA[i:], m.S3AccessKey)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.S3AccessKey)))
-		i--
-		dAtA[i] = 0x22
+	if this.KeyId != that1.KeyId {
+		return false
 	}
-	if len(m.TokenPub) > 0 {
-		i -= len(m.TokenPub)
-		copy(dAtA[i:], m.TokenPub)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.TokenPub)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
 	}
-	if len(m.TlsCert) > 0 {
-		i -= len(m.TlsCert)
-		copy(dAtA[i:], m.TlsCert)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.TlsCert)))
-		i--
-		dAtA[i] = 0x12
+	if this.Signing != that1.Signing {
+		return false
 	}
-	if len(m.TlsKey) > 0 {
-		i -= len(m.TlsKey)
-		copy(dAtA[i:], m.TlsKey)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.TlsKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Algorithm != that1.Algorithm {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *HubChange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListTokenKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubChange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *HubChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewId != nil {
-		{
-			size, err := m.NewId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*ListTokenKeysResponse)
+	if !ok {
+		that2, ok := that.(ListTokenKeysResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.OldId != nil {
-		{
-			size, err := m.OldId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !this.Keys[i].Equal(that1.Keys[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *SetHubMaxFlowsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CentralActivity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SetHubMaxFlowsRequest)
+	if !ok {
+		that2, ok := that.(SetHubMaxFlowsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StableId.Equal(that1.StableId) {
+		return false
+	}
+	if this.MaxFlows != that1.MaxFlows {
+		return false
+	}
+	return true
 }
+func (this *ListActiveFlowsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CentralActivity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CentralActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HubChange != nil {
-		{
-			size, err := m.HubChange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*ListActiveFlowsRequest)
+	if !ok {
+		that2, ok := that.(ListActiveFlowsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.NewLabelLinks != nil {
-		{
-			size, err := m.NewLabelLinks.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.RequestStats {
-		i--
-		if m.RequestStats {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	if len(m.AccountServices) > 0 {
-		for iNdEx := len(m.AccountServices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if !this.HubId.Equal(that1.HubId) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *HubActivity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListActiveFlowsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubActivity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *HubActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Flow) > 0 {
-		for iNdEx := len(m.Flow) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Flow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*ListActiveFlowsResponse)
+	if !ok {
+		that2, ok := that.(ListActiveFlowsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SentAt != nil {
-		{
-			size, err := m.SentAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if len(this.Flows) != len(that1.Flows) {
+		return false
 	}
-	if m.HubReg != nil {
-		{
-			size, err := m.HubReg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	for i := range this.Flows {
+		if !this.Flows[i].Equal(that1.Flows[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *KillFlowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubActivity_HubRegistration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*KillFlowRequest)
+	if !ok {
+		that2, ok := that.(KillFlowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FlowId.Equal(that1.FlowId) {
+		return false
+	}
+	return true
 }
+func (this *LookupAccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubActivity_HubRegistration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*LookupAccountRequest)
+	if !ok {
+		that2, ok := that.(LookupAccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
+	return true
 }
+func (this *LookupAccountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubActivity_HubRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*LookupAccountResponse)
+	if !ok {
+		that2, ok := that.(LookupAccountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.StableHub != nil {
-		{
-			size, err := m.StableHub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Hub != nil {
-		{
-			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
+	return true
 }
+func (this *AddAccountAliasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubActivity_HubStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*AddAccountAliasRequest)
+	if !ok {
+		that2, ok := that.(AddAccountAliasRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubActivity_HubStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if !this.Alias.Equal(that1.Alias) {
+		return false
+	}
+	return true
 }
+func (this *RemoveAccountAliasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubActivity_HubStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastAttemptedUpdate != nil {
-		{
-			size, err := m.LastAttemptedUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*RemoveAccountAliasRequest)
+	if !ok {
+		that2, ok := that.(RemoveAccountAliasRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.LastSuccessfulUpdate != nil {
-		{
-			size, err := m.LastSuccessfulUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Alias.Equal(that1.Alias) {
+		return false
+	}
+	return true
+}
+func (this *SetAccountFeatureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetAccountFeatureRequest)
+	if !ok {
+		that2, ok := that.(SetAccountFeatureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.CachedBytes != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.CachedBytes))
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AccountsCached != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.AccountsCached))
-		i--
-		dAtA[i] = 0x18
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	if m.AccountsSeen != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.AccountsSeen))
-		i--
-		dAtA[i] = 0x10
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.AgentConnections != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.AgentConnections))
-		i--
-		dAtA[i] = 0x8
+	if this.Enabled != that1.Enabled {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *GetAccountFeaturesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetAccountFeaturesRequest)
+	if !ok {
+		that2, ok := that.(GetAccountFeaturesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	return true
 }
+func (this *AccountFeature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*AccountFeature)
+	if !ok {
+		that2, ok := that.(AccountFeature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
 }
+func (this *GetAccountFeaturesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*GetAccountFeaturesResponse)
+	if !ok {
+		that2, ok := that.(GetAccountFeaturesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Features) != len(that1.Features) {
+		return false
+	}
+	for i := range this.Features {
+		if !this.Features[i].Equal(that1.Features[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ListOfHubs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SetAccountDefaultLabelsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListOfHubs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ListOfHubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hubs) > 0 {
-		for iNdEx := len(m.Hubs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*SetAccountDefaultLabelsRequest)
+	if !ok {
+		that2, ok := that.(SetAccountDefaultLabelsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HubSync) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubSync) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if !this.Labels.Equal(that1.Labels) {
+		return false
+	}
+	return true
 }
+func (this *GetAccountDefaultLabelsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*GetAccountDefaultLabelsRequest)
+	if !ok {
+		that2, ok := that.(GetAccountDefaultLabelsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.StableId != nil {
-		{
-			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HubSyncResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ConsistentRead != that1.ConsistentRead {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubSyncResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *HubSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceCount != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.ServiceCount))
-		i--
-		dAtA[i] = 0x8
+func (this *GetAccountDefaultLabelsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *HubRegisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetAccountDefaultLabelsResponse)
+	if !ok {
+		that2, ok := that.(GetAccountDefaultLabelsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Labels.Equal(that1.Labels) {
+		return false
+	}
+	return true
 }
+func (this *SetAccountTLSPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*SetAccountTLSPolicyRequest)
+	if !ok {
+		that2, ok := that.(SetAccountTLSPolicyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.InstanceId != nil {
-		{
-			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StableId != nil {
-		{
-			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HubRegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Policy.Equal(that1.Policy) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *GetAccountTLSPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ControlTime != nil {
-		{
-			size, err := m.ControlTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*GetAccountTLSPolicyRequest)
+	if !ok {
+		that2, ok := that.(GetAccountTLSPolicyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HubDisconnectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HubDisconnectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.ConsistentRead != that1.ConsistentRead {
+		return false
+	}
+	return true
 }
+func (this *GetAccountTLSPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *HubDisconnectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstanceId != nil {
-		{
-			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*GetAccountTLSPolicyResponse)
+	if !ok {
+		that2, ok := that.(GetAccountTLSPolicyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.StableId != nil {
-		{
-			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ServiceTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Policy.Equal(that1.Policy) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ServiceTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Effective.Equal(that1.Effective) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ServiceTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PeriodicJobStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ServiceTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*PeriodicJobStatus)
+	if !ok {
+		that2, ok := that.(PeriodicJobStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListServicesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListServicesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.JobType != that1.JobType {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if !this.LastRunAt.Equal(that1.LastRunAt) {
+		return false
+	}
+	if this.LastStatus != that1.LastStatus {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if !this.NextRun.Equal(that1.NextRun) {
+		return false
+	}
+	if this.PendingAttempts != that1.PendingAttempts {
+		return false
+	}
+	if !this.RetryAt.Equal(that1.RetryAt) {
+		return false
+	}
+	return true
 }
+func (this *MaintenanceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*MaintenanceStatus)
+	if !ok {
+		that2, ok := that.(MaintenanceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListServicesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if len(this.Jobs) != len(that1.Jobs) {
+		return false
+	}
+	for i := range this.Jobs {
+		if !this.Jobs[i].Equal(that1.Jobs[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *EnqueueJobRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListServicesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*EnqueueJobRequest)
+	if !ok {
+		that2, ok := that.(EnqueueJobRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JobType != that1.JobType {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.Queue != that1.Queue {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	return true
 }
+func (this *EnqueueJobResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*EnqueueJobResponse)
+	if !ok {
+		that2, ok := that.(EnqueueJobResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JobId.Equal(that1.JobId) {
+		return false
+	}
+	return true
 }
+func (this *SetMaintenanceModeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SetMaintenanceModeRequest)
+	if !ok {
+		that2, ok := that.(SetMaintenanceModeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
 }
+func (this *MaintenanceMode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	that1, ok := that.(*MaintenanceMode)
+	if !ok {
+		that2, ok := that.(MaintenanceMode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Enabled != that1.Enabled {
+		return false
 	}
-	if m.Hub != nil {
-		{
-			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.Reason != that1.Reason {
+		return false
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Since.Equal(that1.Since) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *FlushASNCacheResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*FlushASNCacheResponse)
+	if !ok {
+		that2, ok := that.(FlushASNCacheResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Flushed != that1.Flushed {
+		return false
+	}
+	return true
 }
+func (this *RebuildRoutingStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RebuildRoutingStateRequest)
+	if !ok {
+		that2, ok := that.(RebuildRoutingStateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
 }
+func (this *RebuildRoutingStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limits != nil {
-		{
-			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*RebuildRoutingStateResponse)
+	if !ok {
+		that2, ok := that.(RebuildRoutingStateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RoutesAdded) != len(that1.RoutesAdded) {
+		return false
+	}
+	for i := range this.RoutesAdded {
+		if !this.RoutesAdded[i].Equal(that1.RoutesAdded[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddLabelLinkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.RoutesRemoved) != len(that1.RoutesRemoved) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddLabelLinkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddLabelLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Target != nil {
-		{
-			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	for i := range this.RoutesRemoved {
+		if !this.RoutesRemoved[i].Equal(that1.RoutesRemoved[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if this.SettingsChanged != that1.SettingsChanged {
+		return false
+	}
+	if len(this.LabelLinksAdded) != len(that1.LabelLinksAdded) {
+		return false
+	}
+	for i := range this.LabelLinksAdded {
+		if !this.LabelLinksAdded[i].Equal(that1.LabelLinksAdded[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if len(this.LabelLinksRemoved) != len(that1.LabelLinksRemoved) {
+		return false
+	}
+	for i := range this.LabelLinksRemoved {
+		if !this.LabelLinksRemoved[i].Equal(that1.LabelLinksRemoved[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Noop) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Changed != that1.Changed {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Noop) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Noop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
 }
+func (this *WatchEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveLabelLinkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveLabelLinkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveLabelLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*WatchEventsRequest)
+	if !ok {
+		that2, ok := that.(WatchEventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Labels != nil {
-		{
-			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cursor != that1.Cursor {
+		return false
+	}
+	if len(this.Types) != len(that1.Types) {
+		return false
+	}
+	for i := range this.Types {
+		if this.Types[i] != that1.Types[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *ControlEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ControlEvent)
+	if !ok {
+		that2, ok := that.(ControlEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cursor != that1.Cursor {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
+func (this *CleanupOrphanedObjectsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CleanupOrphanedObjectsRequest)
+	if !ok {
+		that2, ok := that.(CleanupOrphanedObjectsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
 }
+func (this *CleanupOrphanedObjectsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidDuration != nil {
-		{
-			size, err := m.ValidDuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	that1, ok := that.(*CleanupOrphanedObjectsResponse)
+	if !ok {
+		that2, ok := that.(CleanupOrphanedObjectsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Capabilities) > 0 {
-		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Capabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintControl(dAtA, i, uint64(size))
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if this.Keys[i] != that1.Keys[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.LiveAccounts != that1.LiveAccounts {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
 }
+func (this *AccountKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*AccountKey)
+	if !ok {
+		that2, ok := that.(AccountKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Id.Equal(that1.Id) {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.LastUsedAt.Equal(that1.LastUsedAt) {
+		return false
+	}
+	if !this.RevokedAt.Equal(that1.RevokedAt) {
+		return false
+	}
+	return true
 }
+func (this *CreateAccountKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ControlRegister) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CreateAccountKeyRequest)
+	if !ok {
+		that2, ok := that.(CreateAccountKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Account.Equal(that1.Account) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
 }
+func (this *CreateAccountKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ControlRegister) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ControlRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*CreateAccountKeyResponse)
+	if !ok {
+		that2, ok := that.(CreateAccountKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ControlToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ControlToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ControlToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Key.Equal(that1.Key) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Secret != that1.Secret {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+func (this *ListAccountKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListAccountKeysRequest)
+	if !ok {
+		that2, ok := that.(ListAccountKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Marker) > 0 {
-		i -= len(m.Marker)
-		copy(dAtA[i:], m.Marker)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Marker)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Limit != 0 {
-		i = encodeVarintControl(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BestEffort != that1.BestEffort {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ConsistentRead != that1.ConsistentRead {
+		return false
+	}
+	return true
 }
+func (this *ListAccountKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextMarker) > 0 {
-		i -= len(m.NextMarker)
-		copy(dAtA[i:], m.NextMarker)
-		i = encodeVarintControl(dAtA, i, uint64(len(m.NextMarker)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*ListAccountKeysResponse)
+	if !ok {
+		that2, ok := that.(ListAccountKeysResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintControl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !this.Keys[i].Equal(that1.Keys[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintControl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovControl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(this.Errors) != len(that1.Errors) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (m *ServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RevokeAccountKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+
+	that1, ok := that.(*RevokeAccountKeyRequest)
+	if !ok {
+		that2, ok := that.(RevokeAccountKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Hub != nil {
-		l = m.Hub.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if !this.Account.Equal(that1.Account) {
+		return false
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if !this.KeyId.Equal(that1.KeyId) {
+		return false
 	}
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	return true
+}
+func (this *HubCredential) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
+
+	that1, ok := that.(*HubCredential)
+	if !ok {
+		that2, ok := that.(HubCredential)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *LabelLink) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Id.Equal(that1.Id) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	if m.Target != nil {
-		l = m.Target.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if !this.LastUsedAt.Equal(that1.LastUsedAt) {
+		return false
 	}
-	if m.Limits != nil {
-		l = m.Limits.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if !this.RevokedAt.Equal(that1.RevokedAt) {
+		return false
 	}
-	return n
+	if !this.HubId.Equal(that1.HubId) {
+		return false
+	}
+	return true
 }
-
-func (m *LabelLinks) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *IssueHubCredentialRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.LabelLinks) > 0 {
-		for _, e := range m.LabelLinks {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
+
+	that1, ok := that.(*IssueHubCredentialRequest)
+	if !ok {
+		that2, ok := that.(IssueHubCredentialRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.HubId.Equal(that1.HubId) {
+		return false
+	}
+	return true
 }
-
-func (m *ServiceRoute) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *IssueHubCredentialResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Hub != nil {
-		l = m.Hub.Size()
-		n += 1 + l + sovControl(uint64(l))
+
+	that1, ok := that.(*IssueHubCredentialResponse)
+	if !ok {
+		that2, ok := that.(IssueHubCredentialResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if !this.Credential.Equal(that1.Credential) {
+		return false
 	}
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Secret != that1.Secret {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ListHubCredentialsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AccountServices) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ListHubCredentialsResponse)
+	if !ok {
+		that2, ok := that.(ListHubCredentialsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
+	if len(this.Credentials) != len(that1.Credentials) {
+		return false
+	}
+	for i := range this.Credentials {
+		if !this.Credentials[i].Equal(that1.Credentials[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *RevokeHubCredentialRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ActivityEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RevokeHubCredentialRequest)
+	if !ok {
+		that2, ok := that.(RevokeHubCredentialRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.RouteAdded != nil {
-		l = m.RouteAdded.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.RouteRemoved != nil {
-		l = m.RouteRemoved.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if !this.CredentialId.Equal(that1.CredentialId) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ListAccountsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ListAccountsRequest)
+	if !ok {
+		that2, ok := that.(ListAccountsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.StableId != nil {
-		l = m.StableId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.InstanceId != nil {
-		l = m.InstanceId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Limit != that1.Limit {
+		return false
 	}
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if !bytes.Equal(this.Marker, that1.Marker) {
+		return false
 	}
-	return n
+	if this.BestEffort != that1.BestEffort {
+		return false
+	}
+	if this.ConsistentRead != that1.ConsistentRead {
+		return false
+	}
+	return true
 }
-
-func (m *ConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListAccountsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.TlsKey)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+
+	that1, ok := that.(*ListAccountsResponse)
+	if !ok {
+		that2, ok := that.(ListAccountsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.TlsCert)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.TokenPub)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
 	}
-	l = len(m.S3AccessKey)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	for i := range this.Accounts {
+		if !this.Accounts[i].Equal(that1.Accounts[i]) {
+			return false
+		}
 	}
-	l = len(m.S3SecretKey)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if !bytes.Equal(this.NextMarker, that1.NextMarker) {
+		return false
 	}
-	l = len(m.S3Bucket)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if len(this.Errors) != len(that1.Errors) {
+		return false
 	}
-	l = len(m.ImageTag)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
 	}
-	return n
+	return true
 }
-
-func (m *HubChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OldId != nil {
-		l = m.OldId.Size()
-		n += 1 + l + sovControl(uint64(l))
+func (this *ServiceRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.NewId != nil {
-		l = m.NewId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.ServiceRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	return n
-}
-
-func (m *CentralActivity) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Hub != nil {
+		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
 	}
-	var l int
-	_ = l
-	if len(m.AccountServices) > 0 {
-		for _, e := range m.AccountServices {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	if m.RequestStats {
-		n += 2
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	if m.NewLabelLinks != nil {
-		l = m.NewLabelLinks.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	if m.HubChange != nil {
-		l = m.HubChange.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ServiceResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&pb.ServiceResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubActivity) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LabelLink) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.HubReg != nil {
-		l = m.HubReg.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.LabelLink{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	if m.SentAt != nil {
-		l = m.SentAt.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Target != nil {
+		s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
 	}
-	if len(m.Flow) > 0 {
-		for _, e := range m.Flow {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if this.Limits != nil {
+		s = append(s, "Limits: "+fmt.Sprintf("%#v", this.Limits)+",\n")
 	}
-	return n
+	s = append(s, "Weighted: "+fmt.Sprintf("%#v", this.Weighted)+",\n")
+	s = append(s, "Weight: "+fmt.Sprintf("%#v", this.Weight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubActivity_HubRegistration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Hub != nil {
-		l = m.Hub.Size()
-		n += 1 + l + sovControl(uint64(l))
-	}
-	if m.StableHub != nil {
-		l = m.StableHub.Size()
-		n += 1 + l + sovControl(uint64(l))
+func (this *LabelLinks) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.LabelLinks{")
+	if this.LabelLinks != nil {
+		s = append(s, "LabelLinks: "+fmt.Sprintf("%#v", this.LabelLinks)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubActivity_HubStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AgentConnections != 0 {
-		n += 1 + sovControl(uint64(m.AgentConnections))
+func (this *ServiceRoute) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.AccountsSeen != 0 {
-		n += 1 + sovControl(uint64(m.AccountsSeen))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.ServiceRoute{")
+	if this.Hub != nil {
+		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
 	}
-	if m.AccountsCached != 0 {
-		n += 1 + sovControl(uint64(m.AccountsCached))
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	if m.CachedBytes != 0 {
-		n += 1 + sovControl(uint64(m.CachedBytes))
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	if m.LastSuccessfulUpdate != nil {
-		l = m.LastSuccessfulUpdate.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountServices) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastAttemptedUpdate != nil {
-		l = m.LastAttemptedUpdate.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.AccountServices{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	return n
-}
-
-func (m *HubInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Services != nil {
+		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
 	}
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s = append(s, "FlowIdleTimeout: "+fmt.Sprintf("%#v", this.FlowIdleTimeout)+",\n")
+	if this.TlsPolicy != nil {
+		s = append(s, "TlsPolicy: "+fmt.Sprintf("%#v", this.TlsPolicy)+",\n")
 	}
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.TLSPolicy{")
+	s = append(s, "MinVersion: "+fmt.Sprintf("%#v", this.MinVersion)+",\n")
+	s = append(s, "RequireClientCert: "+fmt.Sprintf("%#v", this.RequireClientCert)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListOfHubs) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ActivityEntry) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.Hubs) > 0 {
-		for _, e := range m.Hubs {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ActivityEntry{")
+	if this.RouteAdded != nil {
+		s = append(s, "RouteAdded: "+fmt.Sprintf("%#v", this.RouteAdded)+",\n")
 	}
-	return n
+	if this.RouteRemoved != nil {
+		s = append(s, "RouteRemoved: "+fmt.Sprintf("%#v", this.RouteRemoved)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubSync) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.ConfigRequest{")
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
 	}
-	if m.StableId != nil {
-		l = m.StableId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.InstanceId != nil {
+		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
 	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if this.Locations != nil {
+		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
 	}
-	return n
+	s = append(s, "Capabilities: "+fmt.Sprintf("%#v", this.Capabilities)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubSyncResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceCount != 0 {
-		n += 1 + sovControl(uint64(m.ServiceCount))
-	}
-	return n
-}
-
-func (m *HubRegisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.StableId != nil {
-		l = m.StableId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 17)
+	s = append(s, "&pb.ConfigResponse{")
+	s = append(s, "TlsKey: "+fmt.Sprintf("%#v", this.TlsKey)+",\n")
+	s = append(s, "TlsCert: "+fmt.Sprintf("%#v", this.TlsCert)+",\n")
+	s = append(s, "TokenPub: "+fmt.Sprintf("%#v", this.TokenPub)+",\n")
+	s = append(s, "S3AccessKey: "+fmt.Sprintf("%#v", this.S3AccessKey)+",\n")
+	s = append(s, "S3SecretKey: "+fmt.Sprintf("%#v", this.S3SecretKey)+",\n")
+	s = append(s, "S3Bucket: "+fmt.Sprintf("%#v", this.S3Bucket)+",\n")
+	s = append(s, "ImageTag: "+fmt.Sprintf("%#v", this.ImageTag)+",\n")
+	if this.TokenKeys != nil {
+		s = append(s, "TokenKeys: "+fmt.Sprintf("%#v", this.TokenKeys)+",\n")
 	}
-	if m.InstanceId != nil {
-		l = m.InstanceId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Reconnect != nil {
+		s = append(s, "Reconnect: "+fmt.Sprintf("%#v", this.Reconnect)+",\n")
 	}
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s = append(s, "FlowIdleTimeout: "+fmt.Sprintf("%#v", this.FlowIdleTimeout)+",\n")
+	if this.TlsPolicy != nil {
+		s = append(s, "TlsPolicy: "+fmt.Sprintf("%#v", this.TlsPolicy)+",\n")
 	}
-	return n
+	s = append(s, "AgentClientCa: "+fmt.Sprintf("%#v", this.AgentClientCa)+",\n")
+	s = append(s, "S3Region: "+fmt.Sprintf("%#v", this.S3Region)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubRegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ControlTime != nil {
-		l = m.ControlTime.Size()
-		n += 1 + l + sovControl(uint64(l))
+func (this *ReconnectPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.ReconnectPolicy{")
+	s = append(s, "InitialBackoff: "+fmt.Sprintf("%#v", this.InitialBackoff)+",\n")
+	s = append(s, "MaxBackoff: "+fmt.Sprintf("%#v", this.MaxBackoff)+",\n")
+	s = append(s, "Jitter: "+fmt.Sprintf("%#v", this.Jitter)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *HubDisconnectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HubChange) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.StableId != nil {
-		l = m.StableId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.HubChange{")
+	if this.OldId != nil {
+		s = append(s, "OldId: "+fmt.Sprintf("%#v", this.OldId)+",\n")
 	}
-	if m.InstanceId != nil {
-		l = m.InstanceId.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.NewId != nil {
+		s = append(s, "NewId: "+fmt.Sprintf("%#v", this.NewId)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ServiceTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MigrateStream) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.MigrateStream{")
+	s = append(s, "Within: "+fmt.Sprintf("%#v", this.Within)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HubTLS) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.HubTLS{")
+	s = append(s, "Cert: "+fmt.Sprintf("%#v", this.Cert)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ServiceTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CentralActivity) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 11)
+	s = append(s, "&pb.CentralActivity{")
+	if this.AccountServices != nil {
+		s = append(s, "AccountServices: "+fmt.Sprintf("%#v", this.AccountServices)+",\n")
 	}
-	return n
-}
-
-func (m *ListServicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	s = append(s, "RequestStats: "+fmt.Sprintf("%#v", this.RequestStats)+",\n")
+	if this.NewLabelLinks != nil {
+		s = append(s, "NewLabelLinks: "+fmt.Sprintf("%#v", this.NewLabelLinks)+",\n")
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.HubChange != nil {
+		s = append(s, "HubChange: "+fmt.Sprintf("%#v", this.HubChange)+",\n")
 	}
-	return n
-}
-
-func (m *ListServicesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.KillFlows != nil {
+		s = append(s, "KillFlows: "+fmt.Sprintf("%#v", this.KillFlows)+",\n")
 	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if this.MigrateStream != nil {
+		s = append(s, "MigrateStream: "+fmt.Sprintf("%#v", this.MigrateStream)+",\n")
 	}
-	return n
-}
-
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.HubTls != nil {
+		s = append(s, "HubTls: "+fmt.Sprintf("%#v", this.HubTls)+",\n")
 	}
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HubActivity) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Hub != nil {
-		l = m.Hub.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.HubActivity{")
+	if this.HubReg != nil {
+		s = append(s, "HubReg: "+fmt.Sprintf("%#v", this.HubReg)+",\n")
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if this.SentAt != nil {
+		s = append(s, "SentAt: "+fmt.Sprintf("%#v", this.SentAt)+",\n")
 	}
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	if this.Flow != nil {
+		s = append(s, "Flow: "+fmt.Sprintf("%#v", this.Flow)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HubActivity_HubRegistration) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.HubActivity_HubRegistration{")
+	if this.Hub != nil {
+		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
 	}
-	if m.Limits != nil {
-		l = m.Limits.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.StableHub != nil {
+		s = append(s, "StableHub: "+fmt.Sprintf("%#v", this.StableHub)+",\n")
 	}
-	return n
-}
-
-func (m *AddLabelLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Locations != nil {
+		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
 	}
-	var l int
-	_ = l
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HubActivity_HubStats) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.HubActivity_HubStats{")
+	s = append(s, "AgentConnections: "+fmt.Sprintf("%#v", this.AgentConnections)+",\n")
+	s = append(s, "AccountsSeen: "+fmt.Sprintf("%#v", this.AccountsSeen)+",\n")
+	s = append(s, "AccountsCached: "+fmt.Sprintf("%#v", this.AccountsCached)+",\n")
+	s = append(s, "CachedBytes: "+fmt.Sprintf("%#v", this.CachedBytes)+",\n")
+	if this.LastSuccessfulUpdate != nil {
+		s = append(s, "LastSuccessfulUpdate: "+fmt.Sprintf("%#v", this.LastSuccessfulUpdate)+",\n")
 	}
-	if m.Target != nil {
-		l = m.Target.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.LastAttemptedUpdate != nil {
+		s = append(s, "LastAttemptedUpdate: "+fmt.Sprintf("%#v", this.LastAttemptedUpdate)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Noop) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HubInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *RemoveLabelLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	s := make([]string, 0, 11)
+	s = append(s, "&pb.HubInfo{")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	var l int
-	_ = l
-	if m.Labels != nil {
-		l = m.Labels.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.Locations != nil {
+		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
 	}
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
 	}
-	return n
+	s = append(s, "ActiveFlows: "+fmt.Sprintf("%#v", this.ActiveFlows)+",\n")
+	s = append(s, "MaxFlows: "+fmt.Sprintf("%#v", this.MaxFlows)+",\n")
+	s = append(s, "Capabilities: "+fmt.Sprintf("%#v", this.Capabilities)+",\n")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovControl(uint64(l))
+func (this *ListOfHubs) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Capabilities) > 0 {
-		for _, e := range m.Capabilities {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ListOfHubs{")
+	if this.Hubs != nil {
+		s = append(s, "Hubs: "+fmt.Sprintf("%#v", this.Hubs)+",\n")
 	}
-	if m.ValidDuration != nil {
-		l = m.ValidDuration.Size()
-		n += 1 + l + sovControl(uint64(l))
+	if this.ProtocolVersions != nil {
+		s = append(s, "ProtocolVersions: "+fmt.Sprintf("%#v", this.ProtocolVersions)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CreateTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+func (this *ProtocolVersionCount) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ProtocolVersionCount{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Hubs: "+fmt.Sprintf("%#v", this.Hubs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ControlRegister) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HubSync) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.HubSync{")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	return n
-}
-
-func (m *ControlToken) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
 	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if this.Services != nil {
+		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+func (this *HubSyncResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.HubSyncResponse{")
+	s = append(s, "ServiceCount: "+fmt.Sprintf("%#v", this.ServiceCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *HubRegisterRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovControl(uint64(m.Limit))
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.HubRegisterRequest{")
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
 	}
-	l = len(m.Marker)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	if this.InstanceId != nil {
+		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
 	}
-	return n
-}
-
-func (m *ListAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Locations != nil {
+		s = append(s, "Locations: "+fmt.Sprintf("%#v", this.Locations)+",\n")
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HubRegisterResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.NextMarker)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.HubRegisterResponse{")
+	if this.ControlTime != nil {
+		s = append(s, "ControlTime: "+fmt.Sprintf("%#v", this.ControlTime)+",\n")
 	}
-	return n
-}
-
-func sovControl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozControl(x uint64) (n int) {
-	return sovControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ServiceRequest) String() string {
+func (this *HubDisconnectRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForMetadata := "[]*KVPair{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(fmt.Sprintf("%v", f), "KVPair", "KVPair", 1) + ","
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.HubDisconnectRequest{")
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&ServiceRequest{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
-		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.InstanceId != nil {
+		s = append(s, "InstanceId: "+fmt.Sprintf("%#v", this.InstanceId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ServiceResponse) String() string {
+func (this *ServiceTokenRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ServiceResponse{`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ServiceTokenRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *LabelLink) String() string {
+func (this *ServiceTokenResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&LabelLink{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`Target:` + strings.Replace(fmt.Sprintf("%v", this.Target), "LabelSet", "LabelSet", 1) + `,`,
-		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "Account_Limits", "Account_Limits", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ServiceTokenResponse{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *LabelLinks) String() string {
+func (this *ItemError) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForLabelLinks := "[]*LabelLink{"
-	for _, f := range this.LabelLinks {
-		repeatedStringForLabelLinks += strings.Replace(f.String(), "LabelLink", "LabelLink", 1) + ","
-	}
-	repeatedStringForLabelLinks += "}"
-	s := strings.Join([]string{`&LabelLinks{`,
-		`LabelLinks:` + repeatedStringForLabelLinks + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ItemError{")
+	s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ServiceRoute) String() string {
+func (this *ListServicesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ServiceRoute{`,
-		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
-		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.ListServicesRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "BestEffort: "+fmt.Sprintf("%#v", this.BestEffort)+",\n")
+	s = append(s, "ConsistentRead: "+fmt.Sprintf("%#v", this.ConsistentRead)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *AccountServices) String() string {
+func (this *ListServicesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForServices := "[]*ServiceRoute{"
-	for _, f := range this.Services {
-		repeatedStringForServices += strings.Replace(f.String(), "ServiceRoute", "ServiceRoute", 1) + ","
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ListServicesResponse{")
+	if this.Services != nil {
+		s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
 	}
-	repeatedStringForServices += "}"
-	s := strings.Join([]string{`&AccountServices{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Services:` + repeatedStringForServices + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ActivityEntry) String() string {
-	if this == nil {
-		return "nil"
+	if this.Errors != nil {
+		s = append(s, "Errors: "+fmt.Sprintf("%#v", this.Errors)+",\n")
 	}
-	s := strings.Join([]string{`&ActivityEntry{`,
-		`RouteAdded:` + strings.Replace(this.RouteAdded.String(), "AccountServices", "AccountServices", 1) + `,`,
-		`RouteRemoved:` + strings.Replace(fmt.Sprintf("%v", this.RouteRemoved), "ULID", "ULID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ConfigRequest) String() string {
+func (this *Service) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForLocations := "[]*NetworkLocation{"
-	for _, f := range this.Locations {
-		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.Service{")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	repeatedStringForLocations += "}"
-	s := strings.Join([]string{`&ConfigRequest{`,
-		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
-		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
-		`Locations:` + repeatedStringForLocations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ConfigResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Hub != nil {
+		s = append(s, "Hub: "+fmt.Sprintf("%#v", this.Hub)+",\n")
 	}
-	s := strings.Join([]string{`&ConfigResponse{`,
-		`TlsKey:` + fmt.Sprintf("%v", this.TlsKey) + `,`,
-		`TlsCert:` + fmt.Sprintf("%v", this.TlsCert) + `,`,
-		`TokenPub:` + fmt.Sprintf("%v", this.TokenPub) + `,`,
-		`S3AccessKey:` + fmt.Sprintf("%v", this.S3AccessKey) + `,`,
-		`S3SecretKey:` + fmt.Sprintf("%v", this.S3SecretKey) + `,`,
-		`S3Bucket:` + fmt.Sprintf("%v", this.S3Bucket) + `,`,
-		`ImageTag:` + fmt.Sprintf("%v", this.ImageTag) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HubChange) String() string {
-	if this == nil {
-		return "nil"
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	s := strings.Join([]string{`&HubChange{`,
-		`OldId:` + strings.Replace(fmt.Sprintf("%v", this.OldId), "ULID", "ULID", 1) + `,`,
-		`NewId:` + strings.Replace(fmt.Sprintf("%v", this.NewId), "ULID", "ULID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *CentralActivity) String() string {
+func (this *AddAccountRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForAccountServices := "[]*AccountServices{"
-	for _, f := range this.AccountServices {
-		repeatedStringForAccountServices += strings.Replace(f.String(), "AccountServices", "AccountServices", 1) + ","
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.AddAccountRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	repeatedStringForAccountServices += "}"
-	s := strings.Join([]string{`&CentralActivity{`,
-		`AccountServices:` + repeatedStringForAccountServices + `,`,
-		`RequestStats:` + fmt.Sprintf("%v", this.RequestStats) + `,`,
-		`NewLabelLinks:` + strings.Replace(this.NewLabelLinks.String(), "LabelLinks", "LabelLinks", 1) + `,`,
-		`HubChange:` + strings.Replace(this.HubChange.String(), "HubChange", "HubChange", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Limits != nil {
+		s = append(s, "Limits: "+fmt.Sprintf("%#v", this.Limits)+",\n")
+	}
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubActivity) String() string {
+func (this *AddAccountResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForFlow := "[]*FlowRecord{"
-	for _, f := range this.Flow {
-		repeatedStringForFlow += strings.Replace(fmt.Sprintf("%v", f), "FlowRecord", "FlowRecord", 1) + ","
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.AddAccountResponse{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	repeatedStringForFlow += "}"
-	s := strings.Join([]string{`&HubActivity{`,
-		`HubReg:` + strings.Replace(fmt.Sprintf("%v", this.HubReg), "HubActivity_HubRegistration", "HubActivity_HubRegistration", 1) + `,`,
-		`SentAt:` + strings.Replace(fmt.Sprintf("%v", this.SentAt), "Timestamp", "Timestamp", 1) + `,`,
-		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "HubActivity_HubStats", "HubActivity_HubStats", 1) + `,`,
-		`Flow:` + repeatedStringForFlow + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubActivity_HubRegistration) String() string {
+func (this *AddLabelLinkRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForLocations := "[]*NetworkLocation{"
-	for _, f := range this.Locations {
-		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	s := make([]string, 0, 11)
+	s = append(s, "&pb.AddLabelLinkRequest{")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	repeatedStringForLocations += "}"
-	s := strings.Join([]string{`&HubActivity_HubRegistration{`,
-		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
-		`StableHub:` + strings.Replace(fmt.Sprintf("%v", this.StableHub), "ULID", "ULID", 1) + `,`,
-		`Locations:` + repeatedStringForLocations + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.Target != nil {
+		s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	}
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	s = append(s, "OnConflict: "+fmt.Sprintf("%#v", this.OnConflict)+",\n")
+	s = append(s, "Weighted: "+fmt.Sprintf("%#v", this.Weighted)+",\n")
+	s = append(s, "Weight: "+fmt.Sprintf("%#v", this.Weight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubActivity_HubStats) String() string {
+func (this *Noop) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&HubActivity_HubStats{`,
-		`AgentConnections:` + fmt.Sprintf("%v", this.AgentConnections) + `,`,
-		`AccountsSeen:` + fmt.Sprintf("%v", this.AccountsSeen) + `,`,
-		`AccountsCached:` + fmt.Sprintf("%v", this.AccountsCached) + `,`,
-		`CachedBytes:` + fmt.Sprintf("%v", this.CachedBytes) + `,`,
-		`LastSuccessfulUpdate:` + strings.Replace(fmt.Sprintf("%v", this.LastSuccessfulUpdate), "Timestamp", "Timestamp", 1) + `,`,
-		`LastAttemptedUpdate:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptedUpdate), "Timestamp", "Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 4)
+	s = append(s, "&pb.Noop{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubInfo) String() string {
+func (this *RemoveLabelLinkRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForLocations := "[]*NetworkLocation{"
-	for _, f := range this.Locations {
-		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.RemoveLabelLinkRequest{")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
 	}
-	repeatedStringForLocations += "}"
-	s := strings.Join([]string{`&HubInfo{`,
-		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
-		`Locations:` + repeatedStringForLocations + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	if this.Target != nil {
+		s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ListOfHubs) String() string {
+func (this *CreateTokenRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForHubs := "[]*HubInfo{"
-	for _, f := range this.Hubs {
-		repeatedStringForHubs += strings.Replace(f.String(), "HubInfo", "HubInfo", 1) + ","
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.CreateTokenRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	repeatedStringForHubs += "}"
-	s := strings.Join([]string{`&ListOfHubs{`,
-		`Hubs:` + repeatedStringForHubs + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Capabilities != nil {
+		vs := make([]TokenCapability, len(this.Capabilities))
+		for i := range vs {
+			vs[i] = this.Capabilities[i]
+		}
+		s = append(s, "Capabilities: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.ValidDuration != nil {
+		s = append(s, "ValidDuration: "+fmt.Sprintf("%#v", this.ValidDuration)+",\n")
+	}
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubSync) String() string {
+func (this *CreateTokenResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForServices := "[]*ServiceRequest{"
-	for _, f := range this.Services {
-		repeatedStringForServices += strings.Replace(f.String(), "ServiceRequest", "ServiceRequest", 1) + ","
-	}
-	repeatedStringForServices += "}"
-	s := strings.Join([]string{`&HubSync{`,
-		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
-		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
-		`Services:` + repeatedStringForServices + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.CreateTokenResponse{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubSyncResponse) String() string {
+func (this *ControlRegister) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&HubSyncResponse{`,
-		`ServiceCount:` + fmt.Sprintf("%v", this.ServiceCount) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ControlRegister{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubRegisterRequest) String() string {
+func (this *ControlToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForLocations := "[]*NetworkLocation{"
-	for _, f := range this.Locations {
-		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
-	}
-	repeatedStringForLocations += "}"
-	s := strings.Join([]string{`&HubRegisterRequest{`,
-		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
-		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
-		`Locations:` + repeatedStringForLocations + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ControlToken{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubRegisterResponse) String() string {
+func (this *TokenInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&HubRegisterResponse{`,
-		`ControlTime:` + strings.Replace(fmt.Sprintf("%v", this.ControlTime), "Timestamp", "Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.TokenInfo{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Algorithm: "+fmt.Sprintf("%#v", this.Algorithm)+",\n")
+	s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *HubDisconnectRequest) String() string {
+func (this *TokenKey) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&HubDisconnectRequest{`,
-		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
-		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.TokenKey{")
+	s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Signing: "+fmt.Sprintf("%#v", this.Signing)+",\n")
+	s = append(s, "Algorithm: "+fmt.Sprintf("%#v", this.Algorithm)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ServiceTokenRequest) String() string {
+func (this *ListTokenKeysResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ServiceTokenRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ListTokenKeysResponse{")
+	if this.Keys != nil {
+		s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ServiceTokenResponse) String() string {
+func (this *SetHubMaxFlowsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ServiceTokenResponse{`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.SetHubMaxFlowsRequest{")
+	if this.StableId != nil {
+		s = append(s, "StableId: "+fmt.Sprintf("%#v", this.StableId)+",\n")
+	}
+	s = append(s, "MaxFlows: "+fmt.Sprintf("%#v", this.MaxFlows)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ListServicesRequest) String() string {
+func (this *ListActiveFlowsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListServicesRequest{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ListActiveFlowsRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.HubId != nil {
+		s = append(s, "HubId: "+fmt.Sprintf("%#v", this.HubId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ListServicesResponse) String() string {
+func (this *ListActiveFlowsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForServices := "[]*Service{"
-	for _, f := range this.Services {
-		repeatedStringForServices += strings.Replace(f.String(), "Service", "Service", 1) + ","
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ListActiveFlowsResponse{")
+	if this.Flows != nil {
+		s = append(s, "Flows: "+fmt.Sprintf("%#v", this.Flows)+",\n")
 	}
-	repeatedStringForServices += "}"
-	s := strings.Join([]string{`&ListServicesResponse{`,
-		`Services:` + repeatedStringForServices + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Service) String() string {
+func (this *KillFlowRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForMetadata := "[]*KVPair{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(fmt.Sprintf("%v", f), "KVPair", "KVPair", 1) + ","
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.KillFlowRequest{")
+	if this.FlowId != nil {
+		s = append(s, "FlowId: "+fmt.Sprintf("%#v", this.FlowId)+",\n")
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&Service{`,
-		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
-		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *AddAccountRequest) String() string {
+func (this *LookupAccountRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AddAccountRequest{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "Account_Limits", "Account_Limits", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LookupAccountRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *AddLabelLinkRequest) String() string {
+func (this *LookupAccountResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AddLabelLinkRequest{`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Target:` + strings.Replace(fmt.Sprintf("%v", this.Target), "LabelSet", "LabelSet", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LookupAccountResponse{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "ExternalId: "+fmt.Sprintf("%#v", this.ExternalId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Noop) String() string {
+func (this *AddAccountAliasRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Noop{`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.AddAccountAliasRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.Alias != nil {
+		s = append(s, "Alias: "+fmt.Sprintf("%#v", this.Alias)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *RemoveLabelLinkRequest) String() string {
+func (this *RemoveAccountAliasRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveLabelLinkRequest{`,
-		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.RemoveAccountAliasRequest{")
+	if this.Alias != nil {
+		s = append(s, "Alias: "+fmt.Sprintf("%#v", this.Alias)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *CreateTokenRequest) String() string {
+func (this *SetAccountFeatureRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForCapabilities := "[]TokenCapability{"
-	for _, f := range this.Capabilities {
-		repeatedStringForCapabilities += fmt.Sprintf("%v", f) + ","
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.SetAccountFeatureRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
 	}
-	repeatedStringForCapabilities += "}"
-	s := strings.Join([]string{`&CreateTokenRequest{`,
-		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
-		`Capabilities:` + repeatedStringForCapabilities + `,`,
-		`ValidDuration:` + strings.Replace(fmt.Sprintf("%v", this.ValidDuration), "Timestamp", "Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *CreateTokenResponse) String() string {
+func (this *GetAccountFeaturesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&CreateTokenResponse{`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.GetAccountFeaturesRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ControlRegister) String() string {
+func (this *AccountFeature) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ControlRegister{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.AccountFeature{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ControlToken) String() string {
+func (this *GetAccountFeaturesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ControlToken{`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.GetAccountFeaturesResponse{")
+	if this.Features != nil {
+		s = append(s, "Features: "+fmt.Sprintf("%#v", this.Features)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *TokenInfo) String() string {
+func (this *SetAccountDefaultLabelsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TokenInfo{`,
-		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.SetAccountDefaultLabelsRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ListAccountsRequest) String() string {
+func (this *GetAccountDefaultLabelsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListAccountsRequest{`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Marker:` + fmt.Sprintf("%v", this.Marker) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.GetAccountDefaultLabelsRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "ConsistentRead: "+fmt.Sprintf("%#v", this.ConsistentRead)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ListAccountsResponse) String() string {
+func (this *GetAccountDefaultLabelsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.GetAccountDefaultLabelsResponse{")
+	if this.Labels != nil {
+		s = append(s, "Labels: "+fmt.Sprintf("%#v", this.Labels)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetAccountTLSPolicyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.SetAccountTLSPolicyRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetAccountTLSPolicyRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForAccounts := "[]*Account{"
-	for _, f := range this.Accounts {
-		repeatedStringForAccounts += strings.Replace(fmt.Sprintf("%v", f), "Account", "Account", 1) + ","
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.GetAccountTLSPolicyRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "ConsistentRead: "+fmt.Sprintf("%#v", this.ConsistentRead)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetAccountTLSPolicyResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.GetAccountTLSPolicyResponse{")
+	if this.Policy != nil {
+		s = append(s, "Policy: "+fmt.Sprintf("%#v", this.Policy)+",\n")
+	}
+	if this.Effective != nil {
+		s = append(s, "Effective: "+fmt.Sprintf("%#v", this.Effective)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PeriodicJobStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&pb.PeriodicJobStatus{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "JobType: "+fmt.Sprintf("%#v", this.JobType)+",\n")
+	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
+	if this.LastRunAt != nil {
+		s = append(s, "LastRunAt: "+fmt.Sprintf("%#v", this.LastRunAt)+",\n")
+	}
+	s = append(s, "LastStatus: "+fmt.Sprintf("%#v", this.LastStatus)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	if this.NextRun != nil {
+		s = append(s, "NextRun: "+fmt.Sprintf("%#v", this.NextRun)+",\n")
+	}
+	s = append(s, "PendingAttempts: "+fmt.Sprintf("%#v", this.PendingAttempts)+",\n")
+	if this.RetryAt != nil {
+		s = append(s, "RetryAt: "+fmt.Sprintf("%#v", this.RetryAt)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MaintenanceStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.MaintenanceStatus{")
+	if this.Jobs != nil {
+		s = append(s, "Jobs: "+fmt.Sprintf("%#v", this.Jobs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EnqueueJobRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.EnqueueJobRequest{")
+	s = append(s, "JobType: "+fmt.Sprintf("%#v", this.JobType)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "Queue: "+fmt.Sprintf("%#v", this.Queue)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EnqueueJobResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.EnqueueJobResponse{")
+	if this.JobId != nil {
+		s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetMaintenanceModeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.SetMaintenanceModeRequest{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MaintenanceMode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.MaintenanceMode{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	if this.Since != nil {
+		s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FlushASNCacheResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.FlushASNCacheResponse{")
+	s = append(s, "Flushed: "+fmt.Sprintf("%#v", this.Flushed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RebuildRoutingStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.RebuildRoutingStateRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RebuildRoutingStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&pb.RebuildRoutingStateResponse{")
+	if this.RoutesAdded != nil {
+		s = append(s, "RoutesAdded: "+fmt.Sprintf("%#v", this.RoutesAdded)+",\n")
+	}
+	if this.RoutesRemoved != nil {
+		s = append(s, "RoutesRemoved: "+fmt.Sprintf("%#v", this.RoutesRemoved)+",\n")
+	}
+	s = append(s, "SettingsChanged: "+fmt.Sprintf("%#v", this.SettingsChanged)+",\n")
+	if this.LabelLinksAdded != nil {
+		s = append(s, "LabelLinksAdded: "+fmt.Sprintf("%#v", this.LabelLinksAdded)+",\n")
+	}
+	if this.LabelLinksRemoved != nil {
+		s = append(s, "LabelLinksRemoved: "+fmt.Sprintf("%#v", this.LabelLinksRemoved)+",\n")
+	}
+	s = append(s, "Changed: "+fmt.Sprintf("%#v", this.Changed)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.WatchEventsRequest{")
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "Types: "+fmt.Sprintf("%#v", this.Types)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ControlEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.ControlEvent{")
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Time != nil {
+		s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	}
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CleanupOrphanedObjectsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.CleanupOrphanedObjectsRequest{")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CleanupOrphanedObjectsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.CleanupOrphanedObjectsResponse{")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "LiveAccounts: "+fmt.Sprintf("%#v", this.LiveAccounts)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.AccountKey{")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	}
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.CreatedAt != nil {
+		s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	}
+	if this.LastUsedAt != nil {
+		s = append(s, "LastUsedAt: "+fmt.Sprintf("%#v", this.LastUsedAt)+",\n")
+	}
+	if this.RevokedAt != nil {
+		s = append(s, "RevokedAt: "+fmt.Sprintf("%#v", this.RevokedAt)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateAccountKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.CreateAccountKeyRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateAccountKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.CreateAccountKeyResponse{")
+	if this.Key != nil {
+		s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	}
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAccountKeysRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.ListAccountKeysRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	s = append(s, "BestEffort: "+fmt.Sprintf("%#v", this.BestEffort)+",\n")
+	s = append(s, "ConsistentRead: "+fmt.Sprintf("%#v", this.ConsistentRead)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAccountKeysResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.ListAccountKeysResponse{")
+	if this.Keys != nil {
+		s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	}
+	if this.Errors != nil {
+		s = append(s, "Errors: "+fmt.Sprintf("%#v", this.Errors)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevokeAccountKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.RevokeAccountKeyRequest{")
+	if this.Account != nil {
+		s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	}
+	if this.KeyId != nil {
+		s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HubCredential) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.HubCredential{")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	}
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.CreatedAt != nil {
+		s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	}
+	if this.LastUsedAt != nil {
+		s = append(s, "LastUsedAt: "+fmt.Sprintf("%#v", this.LastUsedAt)+",\n")
+	}
+	if this.RevokedAt != nil {
+		s = append(s, "RevokedAt: "+fmt.Sprintf("%#v", this.RevokedAt)+",\n")
+	}
+	if this.HubId != nil {
+		s = append(s, "HubId: "+fmt.Sprintf("%#v", this.HubId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IssueHubCredentialRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.IssueHubCredentialRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.HubId != nil {
+		s = append(s, "HubId: "+fmt.Sprintf("%#v", this.HubId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IssueHubCredentialResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.IssueHubCredentialResponse{")
+	if this.Credential != nil {
+		s = append(s, "Credential: "+fmt.Sprintf("%#v", this.Credential)+",\n")
+	}
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHubCredentialsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ListHubCredentialsResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevokeHubCredentialRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.RevokeHubCredentialRequest{")
+	if this.CredentialId != nil {
+		s = append(s, "CredentialId: "+fmt.Sprintf("%#v", this.CredentialId)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAccountsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.ListAccountsRequest{")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "Marker: "+fmt.Sprintf("%#v", this.Marker)+",\n")
+	s = append(s, "BestEffort: "+fmt.Sprintf("%#v", this.BestEffort)+",\n")
+	s = append(s, "ConsistentRead: "+fmt.Sprintf("%#v", this.ConsistentRead)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAccountsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.ListAccountsResponse{")
+	if this.Accounts != nil {
+		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	}
+	s = append(s, "NextMarker: "+fmt.Sprintf("%#v", this.NextMarker)+",\n")
+	if this.Errors != nil {
+		s = append(s, "Errors: "+fmt.Sprintf("%#v", this.Errors)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringControl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ControlServicesClient is the client API for ControlServices service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControlServicesClient interface {
+	AddService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
+	RemoveService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	FetchConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	StreamActivity(ctx context.Context, opts ...grpc.CallOption) (ControlServices_StreamActivityClient, error)
+	SyncHub(ctx context.Context, in *HubSync, opts ...grpc.CallOption) (*HubSyncResponse, error)
+	HubDisconnect(ctx context.Context, in *HubDisconnectRequest, opts ...grpc.CallOption) (*Noop, error)
+	AllHubs(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListOfHubs, error)
+	RequestServiceToken(ctx context.Context, in *ServiceTokenRequest, opts ...grpc.CallOption) (*ServiceTokenResponse, error)
+}
+
+type controlServicesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewControlServicesClient(cc *grpc.ClientConn) ControlServicesClient {
+	return &controlServicesClient{cc}
+}
+
+func (c *controlServicesClient) AddService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
+	out := new(ServiceResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/AddService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) RemoveService(ctx context.Context, in *ServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
+	out := new(ServiceResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/RemoveService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/ListServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) FetchConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/FetchConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) StreamActivity(ctx context.Context, opts ...grpc.CallOption) (ControlServices_StreamActivityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ControlServices_serviceDesc.Streams[0], "/pb.ControlServices/StreamActivity", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServicesStreamActivityClient{stream}
+	return x, nil
+}
+
+type ControlServices_StreamActivityClient interface {
+	Send(*HubActivity) error
+	Recv() (*CentralActivity, error)
+	grpc.ClientStream
+}
+
+type controlServicesStreamActivityClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServicesStreamActivityClient) Send(m *HubActivity) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *controlServicesStreamActivityClient) Recv() (*CentralActivity, error) {
+	m := new(CentralActivity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServicesClient) SyncHub(ctx context.Context, in *HubSync, opts ...grpc.CallOption) (*HubSyncResponse, error) {
+	out := new(HubSyncResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/SyncHub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) HubDisconnect(ctx context.Context, in *HubDisconnectRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/HubDisconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) AllHubs(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListOfHubs, error) {
+	out := new(ListOfHubs)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/AllHubs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServicesClient) RequestServiceToken(ctx context.Context, in *ServiceTokenRequest, opts ...grpc.CallOption) (*ServiceTokenResponse, error) {
+	out := new(ServiceTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlServices/RequestServiceToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControlServicesServer is the server API for ControlServices service.
+type ControlServicesServer interface {
+	AddService(context.Context, *ServiceRequest) (*ServiceResponse, error)
+	RemoveService(context.Context, *ServiceRequest) (*ServiceResponse, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	FetchConfig(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	StreamActivity(ControlServices_StreamActivityServer) error
+	SyncHub(context.Context, *HubSync) (*HubSyncResponse, error)
+	HubDisconnect(context.Context, *HubDisconnectRequest) (*Noop, error)
+	AllHubs(context.Context, *Noop) (*ListOfHubs, error)
+	RequestServiceToken(context.Context, *ServiceTokenRequest) (*ServiceTokenResponse, error)
+}
+
+// UnimplementedControlServicesServer can be embedded to have forward compatible implementations.
+type UnimplementedControlServicesServer struct {
+}
+
+func (*UnimplementedControlServicesServer) AddService(ctx context.Context, req *ServiceRequest) (*ServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
+}
+func (*UnimplementedControlServicesServer) RemoveService(ctx context.Context, req *ServiceRequest) (*ServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+}
+func (*UnimplementedControlServicesServer) ListServices(ctx context.Context, req *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (*UnimplementedControlServicesServer) FetchConfig(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchConfig not implemented")
+}
+func (*UnimplementedControlServicesServer) StreamActivity(srv ControlServices_StreamActivityServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamActivity not implemented")
+}
+func (*UnimplementedControlServicesServer) SyncHub(ctx context.Context, req *HubSync) (*HubSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncHub not implemented")
+}
+func (*UnimplementedControlServicesServer) HubDisconnect(ctx context.Context, req *HubDisconnectRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HubDisconnect not implemented")
+}
+func (*UnimplementedControlServicesServer) AllHubs(ctx context.Context, req *Noop) (*ListOfHubs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllHubs not implemented")
+}
+func (*UnimplementedControlServicesServer) RequestServiceToken(ctx context.Context, req *ServiceTokenRequest) (*ServiceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestServiceToken not implemented")
+}
+
+func RegisterControlServicesServer(s *grpc.Server, srv ControlServicesServer) {
+	s.RegisterService(&_ControlServices_serviceDesc, srv)
+}
+
+func _ControlServices_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).AddService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/AddService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).AddService(ctx, req.(*ServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).RemoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/RemoveService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).RemoveService(ctx, req.(*ServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/ListServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_FetchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).FetchConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/FetchConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).FetchConfig(ctx, req.(*ConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_StreamActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ControlServicesServer).StreamActivity(&controlServicesStreamActivityServer{stream})
+}
+
+type ControlServices_StreamActivityServer interface {
+	Send(*CentralActivity) error
+	Recv() (*HubActivity, error)
+	grpc.ServerStream
+}
+
+type controlServicesStreamActivityServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServicesStreamActivityServer) Send(m *CentralActivity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *controlServicesStreamActivityServer) Recv() (*HubActivity, error) {
+	m := new(HubActivity)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ControlServices_SyncHub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HubSync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).SyncHub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/SyncHub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).SyncHub(ctx, req.(*HubSync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_HubDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HubDisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).HubDisconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/HubDisconnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).HubDisconnect(ctx, req.(*HubDisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_AllHubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).AllHubs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/AllHubs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).AllHubs(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlServices_RequestServiceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServicesServer).RequestServiceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlServices/RequestServiceToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServicesServer).RequestServiceToken(ctx, req.(*ServiceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ControlServices_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ControlServices",
+	HandlerType: (*ControlServicesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddService",
+			Handler:    _ControlServices_AddService_Handler,
+		},
+		{
+			MethodName: "RemoveService",
+			Handler:    _ControlServices_RemoveService_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _ControlServices_ListServices_Handler,
+		},
+		{
+			MethodName: "FetchConfig",
+			Handler:    _ControlServices_FetchConfig_Handler,
+		},
+		{
+			MethodName: "SyncHub",
+			Handler:    _ControlServices_SyncHub_Handler,
+		},
+		{
+			MethodName: "HubDisconnect",
+			Handler:    _ControlServices_HubDisconnect_Handler,
+		},
+		{
+			MethodName: "AllHubs",
+			Handler:    _ControlServices_AllHubs_Handler,
+		},
+		{
+			MethodName: "RequestServiceToken",
+			Handler:    _ControlServices_RequestServiceToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamActivity",
+			Handler:       _ControlServices_StreamActivity_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "control.proto",
+}
+
+// ControlManagementClient is the client API for ControlManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControlManagementClient interface {
+	Register(ctx context.Context, in *ControlRegister, opts ...grpc.CallOption) (*ControlToken, error)
+	AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*AddAccountResponse, error)
+	AddLabelLink(ctx context.Context, in *AddLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error)
+	RemoveLabelLink(ctx context.Context, in *RemoveLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error)
+	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	IssueHubToken(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	GetTokenPublicKey(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*TokenInfo, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	ListTokenKeys(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListTokenKeysResponse, error)
+	SetHubMaxFlows(ctx context.Context, in *SetHubMaxFlowsRequest, opts ...grpc.CallOption) (*Noop, error)
+	ListActiveFlows(ctx context.Context, in *ListActiveFlowsRequest, opts ...grpc.CallOption) (*ListActiveFlowsResponse, error)
+	KillFlow(ctx context.Context, in *KillFlowRequest, opts ...grpc.CallOption) (*Noop, error)
+	LookupAccount(ctx context.Context, in *LookupAccountRequest, opts ...grpc.CallOption) (*LookupAccountResponse, error)
+	AddAccountAlias(ctx context.Context, in *AddAccountAliasRequest, opts ...grpc.CallOption) (*Noop, error)
+	RemoveAccountAlias(ctx context.Context, in *RemoveAccountAliasRequest, opts ...grpc.CallOption) (*Noop, error)
+	SetAccountFeature(ctx context.Context, in *SetAccountFeatureRequest, opts ...grpc.CallOption) (*Noop, error)
+	GetAccountFeatures(ctx context.Context, in *GetAccountFeaturesRequest, opts ...grpc.CallOption) (*GetAccountFeaturesResponse, error)
+	GetMaintenanceStatus(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*MaintenanceStatus, error)
+	CreateAccountKey(ctx context.Context, in *CreateAccountKeyRequest, opts ...grpc.CallOption) (*CreateAccountKeyResponse, error)
+	ListAccountKeys(ctx context.Context, in *ListAccountKeysRequest, opts ...grpc.CallOption) (*ListAccountKeysResponse, error)
+	RevokeAccountKey(ctx context.Context, in *RevokeAccountKeyRequest, opts ...grpc.CallOption) (*Noop, error)
+	IssueHubCredential(ctx context.Context, in *IssueHubCredentialRequest, opts ...grpc.CallOption) (*IssueHubCredentialResponse, error)
+	ListHubCredentials(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListHubCredentialsResponse, error)
+	RevokeHubCredential(ctx context.Context, in *RevokeHubCredentialRequest, opts ...grpc.CallOption) (*Noop, error)
+	EnqueueJob(ctx context.Context, in *EnqueueJobRequest, opts ...grpc.CallOption) (*EnqueueJobResponse, error)
+	FlushASNCache(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*FlushASNCacheResponse, error)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	SetAccountDefaultLabels(ctx context.Context, in *SetAccountDefaultLabelsRequest, opts ...grpc.CallOption) (*Noop, error)
+	GetAccountDefaultLabels(ctx context.Context, in *GetAccountDefaultLabelsRequest, opts ...grpc.CallOption) (*GetAccountDefaultLabelsResponse, error)
+	SetAccountTLSPolicy(ctx context.Context, in *SetAccountTLSPolicyRequest, opts ...grpc.CallOption) (*Noop, error)
+	GetAccountTLSPolicy(ctx context.Context, in *GetAccountTLSPolicyRequest, opts ...grpc.CallOption) (*GetAccountTLSPolicyResponse, error)
+	RebuildRoutingState(ctx context.Context, in *RebuildRoutingStateRequest, opts ...grpc.CallOption) (*RebuildRoutingStateResponse, error)
+	CleanupOrphanedObjects(ctx context.Context, in *CleanupOrphanedObjectsRequest, opts ...grpc.CallOption) (*CleanupOrphanedObjectsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ControlManagement_WatchEventsClient, error)
+}
+
+type controlManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewControlManagementClient(cc *grpc.ClientConn) ControlManagementClient {
+	return &controlManagementClient{cc}
+}
+
+func (c *controlManagementClient) Register(ctx context.Context, in *ControlRegister, opts ...grpc.CallOption) (*ControlToken, error) {
+	out := new(ControlToken)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*AddAccountResponse, error) {
+	out := new(AddAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/AddAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) AddLabelLink(ctx context.Context, in *AddLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/AddLabelLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) RemoveLabelLink(ctx context.Context, in *RemoveLabelLinkRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RemoveLabelLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/CreateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) IssueHubToken(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/IssueHubToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) GetTokenPublicKey(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*TokenInfo, error) {
+	out := new(TokenInfo)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetTokenPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) ListTokenKeys(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListTokenKeysResponse, error) {
+	out := new(ListTokenKeysResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListTokenKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) SetHubMaxFlows(ctx context.Context, in *SetHubMaxFlowsRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/SetHubMaxFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) ListActiveFlows(ctx context.Context, in *ListActiveFlowsRequest, opts ...grpc.CallOption) (*ListActiveFlowsResponse, error) {
+	out := new(ListActiveFlowsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListActiveFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) KillFlow(ctx context.Context, in *KillFlowRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/KillFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) LookupAccount(ctx context.Context, in *LookupAccountRequest, opts ...grpc.CallOption) (*LookupAccountResponse, error) {
+	out := new(LookupAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/LookupAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) AddAccountAlias(ctx context.Context, in *AddAccountAliasRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/AddAccountAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) RemoveAccountAlias(ctx context.Context, in *RemoveAccountAliasRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RemoveAccountAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) SetAccountFeature(ctx context.Context, in *SetAccountFeatureRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/SetAccountFeature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) GetAccountFeatures(ctx context.Context, in *GetAccountFeaturesRequest, opts ...grpc.CallOption) (*GetAccountFeaturesResponse, error) {
+	out := new(GetAccountFeaturesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetAccountFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) GetMaintenanceStatus(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*MaintenanceStatus, error) {
+	out := new(MaintenanceStatus)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetMaintenanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) CreateAccountKey(ctx context.Context, in *CreateAccountKeyRequest, opts ...grpc.CallOption) (*CreateAccountKeyResponse, error) {
+	out := new(CreateAccountKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/CreateAccountKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) ListAccountKeys(ctx context.Context, in *ListAccountKeysRequest, opts ...grpc.CallOption) (*ListAccountKeysResponse, error) {
+	out := new(ListAccountKeysResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListAccountKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) RevokeAccountKey(ctx context.Context, in *RevokeAccountKeyRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RevokeAccountKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) IssueHubCredential(ctx context.Context, in *IssueHubCredentialRequest, opts ...grpc.CallOption) (*IssueHubCredentialResponse, error) {
+	out := new(IssueHubCredentialResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/IssueHubCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) ListHubCredentials(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*ListHubCredentialsResponse, error) {
+	out := new(ListHubCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/ListHubCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) RevokeHubCredential(ctx context.Context, in *RevokeHubCredentialRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RevokeHubCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) EnqueueJob(ctx context.Context, in *EnqueueJobRequest, opts ...grpc.CallOption) (*EnqueueJobResponse, error) {
+	out := new(EnqueueJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/EnqueueJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) FlushASNCache(ctx context.Context, in *Noop, opts ...grpc.CallOption) (*FlushASNCacheResponse, error) {
+	out := new(FlushASNCacheResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/FlushASNCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) SetAccountDefaultLabels(ctx context.Context, in *SetAccountDefaultLabelsRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/SetAccountDefaultLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) GetAccountDefaultLabels(ctx context.Context, in *GetAccountDefaultLabelsRequest, opts ...grpc.CallOption) (*GetAccountDefaultLabelsResponse, error) {
+	out := new(GetAccountDefaultLabelsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetAccountDefaultLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) SetAccountTLSPolicy(ctx context.Context, in *SetAccountTLSPolicyRequest, opts ...grpc.CallOption) (*Noop, error) {
+	out := new(Noop)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/SetAccountTLSPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) GetAccountTLSPolicy(ctx context.Context, in *GetAccountTLSPolicyRequest, opts ...grpc.CallOption) (*GetAccountTLSPolicyResponse, error) {
+	out := new(GetAccountTLSPolicyResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/GetAccountTLSPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) RebuildRoutingState(ctx context.Context, in *RebuildRoutingStateRequest, opts ...grpc.CallOption) (*RebuildRoutingStateResponse, error) {
+	out := new(RebuildRoutingStateResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/RebuildRoutingState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) CleanupOrphanedObjects(ctx context.Context, in *CleanupOrphanedObjectsRequest, opts ...grpc.CallOption) (*CleanupOrphanedObjectsResponse, error) {
+	out := new(CleanupOrphanedObjectsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ControlManagement/CleanupOrphanedObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlManagementClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (ControlManagement_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ControlManagement_serviceDesc.Streams[0], "/pb.ControlManagement/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlManagementWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControlManagement_WatchEventsClient interface {
+	Recv() (*ControlEvent, error)
+	grpc.ClientStream
+}
+
+type controlManagementWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlManagementWatchEventsClient) Recv() (*ControlEvent, error) {
+	m := new(ControlEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ControlManagementServer is the server API for ControlManagement service.
+type ControlManagementServer interface {
+	Register(context.Context, *ControlRegister) (*ControlToken, error)
+	AddAccount(context.Context, *AddAccountRequest) (*AddAccountResponse, error)
+	AddLabelLink(context.Context, *AddLabelLinkRequest) (*Noop, error)
+	RemoveLabelLink(context.Context, *RemoveLabelLinkRequest) (*Noop, error)
+	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	IssueHubToken(context.Context, *Noop) (*CreateTokenResponse, error)
+	GetTokenPublicKey(context.Context, *Noop) (*TokenInfo, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	ListTokenKeys(context.Context, *Noop) (*ListTokenKeysResponse, error)
+	SetHubMaxFlows(context.Context, *SetHubMaxFlowsRequest) (*Noop, error)
+	ListActiveFlows(context.Context, *ListActiveFlowsRequest) (*ListActiveFlowsResponse, error)
+	KillFlow(context.Context, *KillFlowRequest) (*Noop, error)
+	LookupAccount(context.Context, *LookupAccountRequest) (*LookupAccountResponse, error)
+	AddAccountAlias(context.Context, *AddAccountAliasRequest) (*Noop, error)
+	RemoveAccountAlias(context.Context, *RemoveAccountAliasRequest) (*Noop, error)
+	SetAccountFeature(context.Context, *SetAccountFeatureRequest) (*Noop, error)
+	GetAccountFeatures(context.Context, *GetAccountFeaturesRequest) (*GetAccountFeaturesResponse, error)
+	GetMaintenanceStatus(context.Context, *Noop) (*MaintenanceStatus, error)
+	CreateAccountKey(context.Context, *CreateAccountKeyRequest) (*CreateAccountKeyResponse, error)
+	ListAccountKeys(context.Context, *ListAccountKeysRequest) (*ListAccountKeysResponse, error)
+	RevokeAccountKey(context.Context, *RevokeAccountKeyRequest) (*Noop, error)
+	IssueHubCredential(context.Context, *IssueHubCredentialRequest) (*IssueHubCredentialResponse, error)
+	ListHubCredentials(context.Context, *Noop) (*ListHubCredentialsResponse, error)
+	RevokeHubCredential(context.Context, *RevokeHubCredentialRequest) (*Noop, error)
+	EnqueueJob(context.Context, *EnqueueJobRequest) (*EnqueueJobResponse, error)
+	FlushASNCache(context.Context, *Noop) (*FlushASNCacheResponse, error)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error)
+	SetAccountDefaultLabels(context.Context, *SetAccountDefaultLabelsRequest) (*Noop, error)
+	GetAccountDefaultLabels(context.Context, *GetAccountDefaultLabelsRequest) (*GetAccountDefaultLabelsResponse, error)
+	SetAccountTLSPolicy(context.Context, *SetAccountTLSPolicyRequest) (*Noop, error)
+	GetAccountTLSPolicy(context.Context, *GetAccountTLSPolicyRequest) (*GetAccountTLSPolicyResponse, error)
+	RebuildRoutingState(context.Context, *RebuildRoutingStateRequest) (*RebuildRoutingStateResponse, error)
+	CleanupOrphanedObjects(context.Context, *CleanupOrphanedObjectsRequest) (*CleanupOrphanedObjectsResponse, error)
+	WatchEvents(*WatchEventsRequest, ControlManagement_WatchEventsServer) error
+}
+
+// UnimplementedControlManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedControlManagementServer struct {
+}
+
+func (*UnimplementedControlManagementServer) Register(ctx context.Context, req *ControlRegister) (*ControlToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedControlManagementServer) AddAccount(ctx context.Context, req *AddAccountRequest) (*AddAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccount not implemented")
+}
+func (*UnimplementedControlManagementServer) AddLabelLink(ctx context.Context, req *AddLabelLinkRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLabelLink not implemented")
+}
+func (*UnimplementedControlManagementServer) RemoveLabelLink(ctx context.Context, req *RemoveLabelLinkRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLabelLink not implemented")
+}
+func (*UnimplementedControlManagementServer) CreateToken(ctx context.Context, req *CreateTokenRequest) (*CreateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
+}
+func (*UnimplementedControlManagementServer) IssueHubToken(ctx context.Context, req *Noop) (*CreateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueHubToken not implemented")
+}
+func (*UnimplementedControlManagementServer) GetTokenPublicKey(ctx context.Context, req *Noop) (*TokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenPublicKey not implemented")
+}
+func (*UnimplementedControlManagementServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (*UnimplementedControlManagementServer) ListTokenKeys(ctx context.Context, req *Noop) (*ListTokenKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenKeys not implemented")
+}
+func (*UnimplementedControlManagementServer) SetHubMaxFlows(ctx context.Context, req *SetHubMaxFlowsRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHubMaxFlows not implemented")
+}
+func (*UnimplementedControlManagementServer) ListActiveFlows(ctx context.Context, req *ListActiveFlowsRequest) (*ListActiveFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActiveFlows not implemented")
+}
+func (*UnimplementedControlManagementServer) KillFlow(ctx context.Context, req *KillFlowRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillFlow not implemented")
+}
+func (*UnimplementedControlManagementServer) LookupAccount(ctx context.Context, req *LookupAccountRequest) (*LookupAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupAccount not implemented")
+}
+func (*UnimplementedControlManagementServer) AddAccountAlias(ctx context.Context, req *AddAccountAliasRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccountAlias not implemented")
+}
+func (*UnimplementedControlManagementServer) RemoveAccountAlias(ctx context.Context, req *RemoveAccountAliasRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountAlias not implemented")
+}
+func (*UnimplementedControlManagementServer) SetAccountFeature(ctx context.Context, req *SetAccountFeatureRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountFeature not implemented")
+}
+func (*UnimplementedControlManagementServer) GetAccountFeatures(ctx context.Context, req *GetAccountFeaturesRequest) (*GetAccountFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountFeatures not implemented")
+}
+func (*UnimplementedControlManagementServer) GetMaintenanceStatus(ctx context.Context, req *Noop) (*MaintenanceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceStatus not implemented")
+}
+func (*UnimplementedControlManagementServer) CreateAccountKey(ctx context.Context, req *CreateAccountKeyRequest) (*CreateAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountKey not implemented")
+}
+func (*UnimplementedControlManagementServer) ListAccountKeys(ctx context.Context, req *ListAccountKeysRequest) (*ListAccountKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountKeys not implemented")
+}
+func (*UnimplementedControlManagementServer) RevokeAccountKey(ctx context.Context, req *RevokeAccountKeyRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccountKey not implemented")
+}
+func (*UnimplementedControlManagementServer) IssueHubCredential(ctx context.Context, req *IssueHubCredentialRequest) (*IssueHubCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueHubCredential not implemented")
+}
+func (*UnimplementedControlManagementServer) ListHubCredentials(ctx context.Context, req *Noop) (*ListHubCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHubCredentials not implemented")
+}
+func (*UnimplementedControlManagementServer) RevokeHubCredential(ctx context.Context, req *RevokeHubCredentialRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeHubCredential not implemented")
+}
+func (*UnimplementedControlManagementServer) EnqueueJob(ctx context.Context, req *EnqueueJobRequest) (*EnqueueJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueueJob not implemented")
+}
+func (*UnimplementedControlManagementServer) FlushASNCache(ctx context.Context, req *Noop) (*FlushASNCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushASNCache not implemented")
+}
+func (*UnimplementedControlManagementServer) SetMaintenanceMode(ctx context.Context, req *SetMaintenanceModeRequest) (*MaintenanceMode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (*UnimplementedControlManagementServer) SetAccountDefaultLabels(ctx context.Context, req *SetAccountDefaultLabelsRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountDefaultLabels not implemented")
+}
+func (*UnimplementedControlManagementServer) GetAccountDefaultLabels(ctx context.Context, req *GetAccountDefaultLabelsRequest) (*GetAccountDefaultLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDefaultLabels not implemented")
+}
+func (*UnimplementedControlManagementServer) SetAccountTLSPolicy(ctx context.Context, req *SetAccountTLSPolicyRequest) (*Noop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountTLSPolicy not implemented")
+}
+func (*UnimplementedControlManagementServer) GetAccountTLSPolicy(ctx context.Context, req *GetAccountTLSPolicyRequest) (*GetAccountTLSPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTLSPolicy not implemented")
+}
+func (*UnimplementedControlManagementServer) RebuildRoutingState(ctx context.Context, req *RebuildRoutingStateRequest) (*RebuildRoutingStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildRoutingState not implemented")
+}
+func (*UnimplementedControlManagementServer) CleanupOrphanedObjects(ctx context.Context, req *CleanupOrphanedObjectsRequest) (*CleanupOrphanedObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupOrphanedObjects not implemented")
+}
+func (*UnimplementedControlManagementServer) WatchEvents(req *WatchEventsRequest, srv ControlManagement_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+
+func RegisterControlManagementServer(s *grpc.Server, srv ControlManagementServer) {
+	s.RegisterService(&_ControlManagement_serviceDesc, srv)
+}
+
+func _ControlManagement_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlRegister)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).Register(ctx, req.(*ControlRegister))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_AddAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).AddAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/AddAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).AddAccount(ctx, req.(*AddAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_AddLabelLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLabelLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).AddLabelLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/AddLabelLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).AddLabelLink(ctx, req.(*AddLabelLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_RemoveLabelLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLabelLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).RemoveLabelLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/RemoveLabelLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).RemoveLabelLink(ctx, req.(*RemoveLabelLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/CreateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_IssueHubToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).IssueHubToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/IssueHubToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).IssueHubToken(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_GetTokenPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).GetTokenPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/GetTokenPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).GetTokenPublicKey(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_ListTokenKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).ListTokenKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/ListTokenKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).ListTokenKeys(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_SetHubMaxFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHubMaxFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).SetHubMaxFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/SetHubMaxFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).SetHubMaxFlows(ctx, req.(*SetHubMaxFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_ListActiveFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActiveFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).ListActiveFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/ListActiveFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).ListActiveFlows(ctx, req.(*ListActiveFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_KillFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).KillFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/KillFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).KillFlow(ctx, req.(*KillFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_LookupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).LookupAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/LookupAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).LookupAccount(ctx, req.(*LookupAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_AddAccountAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAccountAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).AddAccountAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/AddAccountAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).AddAccountAlias(ctx, req.(*AddAccountAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_RemoveAccountAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAccountAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).RemoveAccountAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/RemoveAccountAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).RemoveAccountAlias(ctx, req.(*RemoveAccountAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_SetAccountFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).SetAccountFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/SetAccountFeature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).SetAccountFeature(ctx, req.(*SetAccountFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_GetAccountFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).GetAccountFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/GetAccountFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).GetAccountFeatures(ctx, req.(*GetAccountFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_GetMaintenanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).GetMaintenanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/GetMaintenanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).GetMaintenanceStatus(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_CreateAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).CreateAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/CreateAccountKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).CreateAccountKey(ctx, req.(*CreateAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_ListAccountKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).ListAccountKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/ListAccountKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).ListAccountKeys(ctx, req.(*ListAccountKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_RevokeAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).RevokeAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/RevokeAccountKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).RevokeAccountKey(ctx, req.(*RevokeAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_IssueHubCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueHubCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).IssueHubCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/IssueHubCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).IssueHubCredential(ctx, req.(*IssueHubCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_ListHubCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).ListHubCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/ListHubCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).ListHubCredentials(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_RevokeHubCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeHubCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).RevokeHubCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/RevokeHubCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).RevokeHubCredential(ctx, req.(*RevokeHubCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_EnqueueJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).EnqueueJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/EnqueueJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).EnqueueJob(ctx, req.(*EnqueueJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_FlushASNCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Noop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).FlushASNCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/FlushASNCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).FlushASNCache(ctx, req.(*Noop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_SetAccountDefaultLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountDefaultLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).SetAccountDefaultLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/SetAccountDefaultLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).SetAccountDefaultLabels(ctx, req.(*SetAccountDefaultLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_GetAccountDefaultLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDefaultLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).GetAccountDefaultLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/GetAccountDefaultLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).GetAccountDefaultLabels(ctx, req.(*GetAccountDefaultLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_SetAccountTLSPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountTLSPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).SetAccountTLSPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/SetAccountTLSPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).SetAccountTLSPolicy(ctx, req.(*SetAccountTLSPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_GetAccountTLSPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTLSPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).GetAccountTLSPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/GetAccountTLSPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).GetAccountTLSPolicy(ctx, req.(*GetAccountTLSPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_RebuildRoutingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildRoutingStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).RebuildRoutingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/RebuildRoutingState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).RebuildRoutingState(ctx, req.(*RebuildRoutingStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_CleanupOrphanedObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupOrphanedObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlManagementServer).CleanupOrphanedObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ControlManagement/CleanupOrphanedObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlManagementServer).CleanupOrphanedObjects(ctx, req.(*CleanupOrphanedObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlManagement_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlManagementServer).WatchEvents(m, &controlManagementWatchEventsServer{stream})
+}
+
+type ControlManagement_WatchEventsServer interface {
+	Send(*ControlEvent) error
+	grpc.ServerStream
+}
+
+type controlManagementWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlManagementWatchEventsServer) Send(m *ControlEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ControlManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ControlManagement",
+	HandlerType: (*ControlManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _ControlManagement_Register_Handler,
+		},
+		{
+			MethodName: "AddAccount",
+			Handler:    _ControlManagement_AddAccount_Handler,
+		},
+		{
+			MethodName: "AddLabelLink",
+			Handler:    _ControlManagement_AddLabelLink_Handler,
+		},
+		{
+			MethodName: "RemoveLabelLink",
+			Handler:    _ControlManagement_RemoveLabelLink_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _ControlManagement_CreateToken_Handler,
+		},
+		{
+			MethodName: "IssueHubToken",
+			Handler:    _ControlManagement_IssueHubToken_Handler,
+		},
+		{
+			MethodName: "GetTokenPublicKey",
+			Handler:    _ControlManagement_GetTokenPublicKey_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _ControlManagement_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ListTokenKeys",
+			Handler:    _ControlManagement_ListTokenKeys_Handler,
+		},
+		{
+			MethodName: "SetHubMaxFlows",
+			Handler:    _ControlManagement_SetHubMaxFlows_Handler,
+		},
+		{
+			MethodName: "ListActiveFlows",
+			Handler:    _ControlManagement_ListActiveFlows_Handler,
+		},
+		{
+			MethodName: "KillFlow",
+			Handler:    _ControlManagement_KillFlow_Handler,
+		},
+		{
+			MethodName: "LookupAccount",
+			Handler:    _ControlManagement_LookupAccount_Handler,
+		},
+		{
+			MethodName: "AddAccountAlias",
+			Handler:    _ControlManagement_AddAccountAlias_Handler,
+		},
+		{
+			MethodName: "RemoveAccountAlias",
+			Handler:    _ControlManagement_RemoveAccountAlias_Handler,
+		},
+		{
+			MethodName: "SetAccountFeature",
+			Handler:    _ControlManagement_SetAccountFeature_Handler,
+		},
+		{
+			MethodName: "GetAccountFeatures",
+			Handler:    _ControlManagement_GetAccountFeatures_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceStatus",
+			Handler:    _ControlManagement_GetMaintenanceStatus_Handler,
+		},
+		{
+			MethodName: "CreateAccountKey",
+			Handler:    _ControlManagement_CreateAccountKey_Handler,
+		},
+		{
+			MethodName: "ListAccountKeys",
+			Handler:    _ControlManagement_ListAccountKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAccountKey",
+			Handler:    _ControlManagement_RevokeAccountKey_Handler,
+		},
+		{
+			MethodName: "IssueHubCredential",
+			Handler:    _ControlManagement_IssueHubCredential_Handler,
+		},
+		{
+			MethodName: "ListHubCredentials",
+			Handler:    _ControlManagement_ListHubCredentials_Handler,
+		},
+		{
+			MethodName: "RevokeHubCredential",
+			Handler:    _ControlManagement_RevokeHubCredential_Handler,
+		},
+		{
+			MethodName: "EnqueueJob",
+			Handler:    _ControlManagement_EnqueueJob_Handler,
+		},
+		{
+			MethodName: "FlushASNCache",
+			Handler:    _ControlManagement_FlushASNCache_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _ControlManagement_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetAccountDefaultLabels",
+			Handler:    _ControlManagement_SetAccountDefaultLabels_Handler,
+		},
+		{
+			MethodName: "GetAccountDefaultLabels",
+			Handler:    _ControlManagement_GetAccountDefaultLabels_Handler,
+		},
+		{
+			MethodName: "SetAccountTLSPolicy",
+			Handler:    _ControlManagement_SetAccountTLSPolicy_Handler,
+		},
+		{
+			MethodName: "GetAccountTLSPolicy",
+			Handler:    _ControlManagement_GetAccountTLSPolicy_Handler,
+		},
+		{
+			MethodName: "RebuildRoutingState",
+			Handler:    _ControlManagement_RebuildRoutingState_Handler,
+		},
+		{
+			MethodName: "CleanupOrphanedObjects",
+			Handler:    _ControlManagement_CleanupOrphanedObjects_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ControlManagement_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "control.proto",
+}
+
+func (m *ServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Hub != nil {
+		{
+			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LabelLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabelLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Weighted {
+		i--
+		if m.Weighted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LabelLinks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelLinks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabelLinks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LabelLinks) > 0 {
+		for iNdEx := len(m.LabelLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hub != nil {
+		{
+			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountServices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountServices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TlsPolicy != nil {
+		{
+			size, err := m.TlsPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FlowIdleTimeout != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.FlowIdleTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireClientCert {
+		i--
+		if m.RequireClientCert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinVersion != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.MinVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RouteRemoved != nil {
+		{
+			size, err := m.RouteRemoved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RouteAdded != nil {
+		{
+			size, err := m.RouteAdded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintControl(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.InstanceId != nil {
+		{
+			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S3Region) > 0 {
+		i -= len(m.S3Region)
+		copy(dAtA[i:], m.S3Region)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.S3Region)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.AgentClientCa) > 0 {
+		i -= len(m.AgentClientCa)
+		copy(dAtA[i:], m.AgentClientCa)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.AgentClientCa)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TlsPolicy != nil {
+		{
+			size, err := m.TlsPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.FlowIdleTimeout != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.FlowIdleTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Reconnect != nil {
+		{
+			size, err := m.Reconnect.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TokenKeys) > 0 {
+		for iNdEx := len(m.TokenKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ImageTag) > 0 {
+		i -= len(m.ImageTag)
+		copy(dAtA[i:], m.ImageTag)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ImageTag)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.S3Bucket) > 0 {
+		i -= len(m.S3Bucket)
+		copy(dAtA[i:], m.S3Bucket)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.S3Bucket)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.S3SecretKey) > 0 {
+		i -= len(m.S3SecretKey)
+		copy(dAtA[i:], m.S3SecretKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.S3SecretKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.S3AccessKey) > 0 {
+		i -= len(m.S3AccessKey)
+		copy(dAtA[i:], m.S3AccessKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.S3AccessKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenPub) > 0 {
+		i -= len(m.TokenPub)
+		copy(dAtA[i:], m.TokenPub)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.TokenPub)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TlsCert) > 0 {
+		i -= len(m.TlsCert)
+		copy(dAtA[i:], m.TlsCert)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.TlsCert)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TlsKey) > 0 {
+		i -= len(m.TlsKey)
+		copy(dAtA[i:], m.TlsKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.TlsKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconnectPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconnectPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconnectPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jitter != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Jitter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBackoff != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.MaxBackoff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InitialBackoff != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.InitialBackoff))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewId != nil {
+		{
+			size, err := m.NewId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldId != nil {
+		{
+			size, err := m.OldId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Within != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Within))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubTLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubTLS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubTLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cert) > 0 {
+		i -= len(m.Cert)
+		copy(dAtA[i:], m.Cert)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Cert)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CentralActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CentralActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CentralActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HubTls != nil {
+		{
+			size, err := m.HubTls.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MigrateStream != nil {
+		{
+			size, err := m.MigrateStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.KillFlows) > 0 {
+		for iNdEx := len(m.KillFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KillFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HubChange != nil {
+		{
+			size, err := m.HubChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewLabelLinks != nil {
+		{
+			size, err := m.NewLabelLinks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequestStats {
+		i--
+		if m.RequestStats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccountServices) > 0 {
+		for iNdEx := len(m.AccountServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flow) > 0 {
+		for iNdEx := len(m.Flow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SentAt != nil {
+		{
+			size, err := m.SentAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HubReg != nil {
+		{
+			size, err := m.HubReg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubActivity_HubRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubActivity_HubRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubActivity_HubRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StableHub != nil {
+		{
+			size, err := m.StableHub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hub != nil {
+		{
+			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubActivity_HubStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubActivity_HubStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubActivity_HubStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAttemptedUpdate != nil {
+		{
+			size, err := m.LastAttemptedUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastSuccessfulUpdate != nil {
+		{
+			size, err := m.LastSuccessfulUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CachedBytes != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.CachedBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountsCached != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.AccountsCached))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountsSeen != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.AccountsSeen))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AgentConnections != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.AgentConnections))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintControl(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxFlows != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.MaxFlows))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActiveFlows != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.ActiveFlows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOfHubs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOfHubs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOfHubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolVersions) > 0 {
+		for iNdEx := len(m.ProtocolVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hubs) > 0 {
+		for iNdEx := len(m.Hubs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolVersionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolVersionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolVersionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hubs != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Hubs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubSync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubSyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubSyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceCount != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.ServiceCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubRegisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.InstanceId != nil {
+		{
+			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubRegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ControlTime != nil {
+		{
+			size, err := m.ControlTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubDisconnectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubDisconnectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubDisconnectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InstanceId != nil {
+		{
+			size, err := m.InstanceId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRead {
+		i--
+		if m.ConsistentRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Hub != nil {
+		{
+			size, err := m.Hub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLabelLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLabelLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLabelLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weighted {
+		i--
+		if m.Weighted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OnConflict != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.OnConflict))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Noop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Noop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Noop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLabelLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLabelLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLabelLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ValidDuration != nil {
+		{
+			size, err := m.ValidDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControlRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControlToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Signing {
+		i--
+		if m.Signing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTokenKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTokenKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTokenKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetHubMaxFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetHubMaxFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetHubMaxFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFlows != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.MaxFlows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StableId != nil {
+		{
+			size, err := m.StableId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListActiveFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListActiveFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListActiveFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HubId != nil {
+		{
+			size, err := m.HubId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListActiveFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListActiveFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListActiveFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KillFlowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KillFlowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KillFlowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowId != nil {
+		{
+			size, err := m.FlowId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddAccountAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddAccountAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddAccountAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alias != nil {
+		{
+			size, err := m.Alias.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveAccountAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveAccountAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveAccountAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alias != nil {
+		{
+			size, err := m.Alias.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAccountFeatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAccountFeatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAccountFeatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountFeaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountFeaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountFeaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountFeature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountFeaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountFeaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountFeaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Features[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAccountDefaultLabelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAccountDefaultLabelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAccountDefaultLabelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountDefaultLabelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountDefaultLabelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountDefaultLabelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRead {
+		i--
+		if m.ConsistentRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountDefaultLabelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountDefaultLabelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountDefaultLabelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Labels != nil {
+		{
+			size, err := m.Labels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAccountTLSPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAccountTLSPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAccountTLSPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountTLSPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountTLSPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountTLSPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRead {
+		i--
+		if m.ConsistentRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountTLSPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountTLSPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountTLSPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Effective != nil {
+		{
+			size, err := m.Effective.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicJobStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicJobStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicJobStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryAt != nil {
+		{
+			size, err := m.RetryAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PendingAttempts != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.PendingAttempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextRun != nil {
+		{
+			size, err := m.NextRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastStatus) > 0 {
+		i -= len(m.LastStatus)
+		copy(dAtA[i:], m.LastStatus)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.LastStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastRunAt != nil {
+		{
+			size, err := m.LastRunAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobType) > 0 {
+		i -= len(m.JobType)
+		copy(dAtA[i:], m.JobType)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.JobType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnqueueJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnqueueJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnqueueJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobType) > 0 {
+		i -= len(m.JobType)
+		copy(dAtA[i:], m.JobType)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.JobType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnqueueJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnqueueJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnqueueJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMaintenanceModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMaintenanceModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMaintenanceModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushASNCacheResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushASNCacheResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushASNCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Flushed != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Flushed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildRoutingStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildRoutingStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildRoutingStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildRoutingStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildRoutingStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildRoutingStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Changed {
+		i--
+		if m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LabelLinksRemoved) > 0 {
+		for iNdEx := len(m.LabelLinksRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelLinksRemoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LabelLinksAdded) > 0 {
+		for iNdEx := len(m.LabelLinksAdded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelLinksAdded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SettingsChanged {
+		i--
+		if m.SettingsChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RoutesRemoved) > 0 {
+		for iNdEx := len(m.RoutesRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoutesRemoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RoutesAdded) > 0 {
+		for iNdEx := len(m.RoutesAdded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoutesAdded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintControl(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControlEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanupOrphanedObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupOrphanedObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupOrphanedObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanupOrphanedObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupOrphanedObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupOrphanedObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LiveAccounts != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.LiveAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintControl(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevokedAt != nil {
+		{
+			size, err := m.RevokedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastUsedAt != nil {
+		{
+			size, err := m.LastUsedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAccountKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccountKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccountKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAccountKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccountKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccountKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRead {
+		i--
+		if m.ConsistentRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAccountKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAccountKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAccountKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyId != nil {
+		{
+			size, err := m.KeyId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HubId != nil {
+		{
+			size, err := m.HubId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RevokedAt != nil {
+		{
+			size, err := m.RevokedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastUsedAt != nil {
+		{
+			size, err := m.LastUsedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueHubCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueHubCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueHubCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HubId != nil {
+		{
+			size, err := m.HubId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueHubCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueHubCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueHubCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListHubCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHubCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHubCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeHubCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeHubCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeHubCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialId != nil {
+		{
+			size, err := m.CredentialId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintControl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsistentRead {
+		i--
+		if m.ConsistentRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Marker) > 0 {
+		i -= len(m.Marker)
+		copy(dAtA[i:], m.Marker)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Marker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintControl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NextMarker) > 0 {
+		i -= len(m.NextMarker)
+		copy(dAtA[i:], m.NextMarker)
+		i = encodeVarintControl(dAtA, i, uint64(len(m.NextMarker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintControl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovControl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Hub != nil {
+		l = m.Hub.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LabelLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Weighted {
+		n += 2
+	}
+	if m.Weight != 0 {
+		n += 1 + sovControl(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *LabelLinks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LabelLinks) > 0 {
+		for _, e := range m.LabelLinks {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hub != nil {
+		l = m.Hub.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountServices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.FlowIdleTimeout != 0 {
+		n += 1 + sovControl(uint64(m.FlowIdleTimeout))
+	}
+	if m.TlsPolicy != nil {
+		l = m.TlsPolicy.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *TLSPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinVersion != 0 {
+		n += 1 + sovControl(uint64(m.MinVersion))
+	}
+	if m.RequireClientCert {
+		n += 2
+	}
+	return n
+}
+
+func (m *ActivityEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RouteAdded != nil {
+		l = m.RouteAdded.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.RouteRemoved != nil {
+		l = m.RouteRemoved.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.InstanceId != nil {
+		l = m.InstanceId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TlsKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.TlsCert)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.TokenPub)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.S3AccessKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.S3SecretKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.S3Bucket)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ImageTag)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.TokenKeys) > 0 {
+		for _, e := range m.TokenKeys {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.Reconnect != nil {
+		l = m.Reconnect.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.FlowIdleTimeout != 0 {
+		n += 1 + sovControl(uint64(m.FlowIdleTimeout))
+	}
+	if m.TlsPolicy != nil {
+		l = m.TlsPolicy.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.AgentClientCa)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.S3Region)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ReconnectPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialBackoff != 0 {
+		n += 1 + sovControl(uint64(m.InitialBackoff))
+	}
+	if m.MaxBackoff != 0 {
+		n += 1 + sovControl(uint64(m.MaxBackoff))
+	}
+	if m.Jitter != 0 {
+		n += 1 + sovControl(uint64(m.Jitter))
+	}
+	return n
+}
+
+func (m *HubChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldId != nil {
+		l = m.OldId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.NewId != nil {
+		l = m.NewId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Within != 0 {
+		n += 1 + sovControl(uint64(m.Within))
+	}
+	return n
+}
+
+func (m *HubTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cert)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CentralActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountServices) > 0 {
+		for _, e := range m.AccountServices {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.RequestStats {
+		n += 2
+	}
+	if m.NewLabelLinks != nil {
+		l = m.NewLabelLinks.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.HubChange != nil {
+		l = m.HubChange.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.KillFlows) > 0 {
+		for _, e := range m.KillFlows {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.MigrateStream != nil {
+		l = m.MigrateStream.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.HubTls != nil {
+		l = m.HubTls.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *HubActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HubReg != nil {
+		l = m.HubReg.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.SentAt != nil {
+		l = m.SentAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Flow) > 0 {
+		for _, e := range m.Flow {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HubActivity_HubRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hub != nil {
+		l = m.Hub.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.StableHub != nil {
+		l = m.StableHub.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovControl(uint64(m.ProtocolVersion))
+	}
+	return n
+}
+
+func (m *HubActivity_HubStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgentConnections != 0 {
+		n += 1 + sovControl(uint64(m.AgentConnections))
+	}
+	if m.AccountsSeen != 0 {
+		n += 1 + sovControl(uint64(m.AccountsSeen))
+	}
+	if m.AccountsCached != 0 {
+		n += 1 + sovControl(uint64(m.AccountsCached))
+	}
+	if m.CachedBytes != 0 {
+		n += 1 + sovControl(uint64(m.CachedBytes))
+	}
+	if m.LastSuccessfulUpdate != nil {
+		l = m.LastSuccessfulUpdate.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.LastAttemptedUpdate != nil {
+		l = m.LastAttemptedUpdate.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *HubInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.ActiveFlows != 0 {
+		n += 1 + sovControl(uint64(m.ActiveFlows))
+	}
+	if m.MaxFlows != 0 {
+		n += 1 + sovControl(uint64(m.MaxFlows))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovControl(uint64(m.ProtocolVersion))
+	}
+	return n
+}
+
+func (m *ListOfHubs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hubs) > 0 {
+		for _, e := range m.Hubs {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.ProtocolVersions) > 0 {
+		for _, e := range m.ProtocolVersions {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtocolVersionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovControl(uint64(m.Version))
+	}
+	if m.Hubs != 0 {
+		n += 1 + sovControl(uint64(m.Hubs))
+	}
+	return n
+}
+
+func (m *HubSync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HubSyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceCount != 0 {
+		n += 1 + sovControl(uint64(m.ServiceCount))
+	}
+	return n
+}
+
+func (m *HubRegisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.InstanceId != nil {
+		l = m.InstanceId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HubRegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControlTime != nil {
+		l = m.ControlTime.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *HubDisconnectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.InstanceId != nil {
+		l = m.InstanceId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ItemError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListServicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	if m.ConsistentRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListServicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Hub != nil {
+		l = m.Hub.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *AddAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Created {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddLabelLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.OnConflict != 0 {
+		n += 1 + sovControl(uint64(m.OnConflict))
+	}
+	if m.Weighted {
+		n += 2
+	}
+	if m.Weight != 0 {
+		n += 1 + sovControl(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *Noop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveLabelLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, e := range m.Capabilities {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.ValidDuration != nil {
+		l = m.ValidDuration.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ControlRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ControlToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Signing {
+		n += 2
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTokenKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetHubMaxFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableId != nil {
+		l = m.StableId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.MaxFlows != 0 {
+		n += 1 + sovControl(uint64(m.MaxFlows))
+	}
+	return n
+}
+
+func (m *ListActiveFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.HubId != nil {
+		l = m.HubId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListActiveFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KillFlowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FlowId != nil {
+		l = m.FlowId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *LookupAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *LookupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *AddAccountAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Alias != nil {
+		l = m.Alias.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveAccountAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Alias != nil {
+		l = m.Alias.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAccountFeatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetAccountFeaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountFeature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetAccountFeaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for _, e := range m.Features {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetAccountDefaultLabelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAccountDefaultLabelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.ConsistentRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetAccountDefaultLabelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Labels != nil {
+		l = m.Labels.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAccountTLSPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAccountTLSPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.ConsistentRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetAccountTLSPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Effective != nil {
+		l = m.Effective.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicJobStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.JobType)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.LastRunAt != nil {
+		l = m.LastRunAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.LastStatus)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.NextRun != nil {
+		l = m.NextRun.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.PendingAttempts != 0 {
+		n += 1 + sovControl(uint64(m.PendingAttempts))
+	}
+	if m.RetryAt != nil {
+		l = m.RetryAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EnqueueJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobType)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovControl(uint64(m.Priority))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *EnqueueJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *SetMaintenanceModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *FlushASNCacheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flushed != 0 {
+		n += 1 + sovControl(uint64(m.Flushed))
+	}
+	return n
+}
+
+func (m *RebuildRoutingStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *RebuildRoutingStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoutesAdded) > 0 {
+		for _, e := range m.RoutesAdded {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.RoutesRemoved) > 0 {
+		for _, e := range m.RoutesRemoved {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.SettingsChanged {
+		n += 2
+	}
+	if len(m.LabelLinksAdded) > 0 {
+		for _, e := range m.LabelLinksAdded {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.LabelLinksRemoved) > 0 {
+		for _, e := range m.LabelLinksRemoved {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.Changed {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ControlEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CleanupOrphanedObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *CleanupOrphanedObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.LiveAccounts != 0 {
+		n += 1 + sovControl(uint64(m.LiveAccounts))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *AccountKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.LastUsedAt != nil {
+		l = m.LastUsedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.RevokedAt != nil {
+		l = m.RevokedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateAccountKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateAccountKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	if m.ConsistentRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListAccountKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevokeAccountKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.KeyId != nil {
+		l = m.KeyId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *HubCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.LastUsedAt != nil {
+		l = m.LastUsedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.RevokedAt != nil {
+		l = m.RevokedAt.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.HubId != nil {
+		l = m.HubId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *IssueHubCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.HubId != nil {
+		l = m.HubId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *IssueHubCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHubCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevokeHubCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialId != nil {
+		l = m.CredentialId.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovControl(uint64(m.Limit))
+	}
+	l = len(m.Marker)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	if m.ConsistentRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	l = len(m.NextMarker)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovControl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozControl(x uint64) (n int) {
+	return sovControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ServiceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetadata := "[]*KVPair{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(fmt.Sprintf("%v", f), "KVPair", "KVPair", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&ServiceRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LabelLink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LabelLink{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`Target:` + strings.Replace(fmt.Sprintf("%v", this.Target), "LabelSet", "LabelSet", 1) + `,`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "Account_Limits", "Account_Limits", 1) + `,`,
+		`Weighted:` + fmt.Sprintf("%v", this.Weighted) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LabelLinks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLabelLinks := "[]*LabelLink{"
+	for _, f := range this.LabelLinks {
+		repeatedStringForLabelLinks += strings.Replace(f.String(), "LabelLink", "LabelLink", 1) + ","
+	}
+	repeatedStringForLabelLinks += "}"
+	s := strings.Join([]string{`&LabelLinks{`,
+		`LabelLinks:` + repeatedStringForLabelLinks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceRoute) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceRoute{`,
+		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountServices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForServices := "[]*ServiceRoute{"
+	for _, f := range this.Services {
+		repeatedStringForServices += strings.Replace(f.String(), "ServiceRoute", "ServiceRoute", 1) + ","
+	}
+	repeatedStringForServices += "}"
+	s := strings.Join([]string{`&AccountServices{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Services:` + repeatedStringForServices + `,`,
+		`FlowIdleTimeout:` + fmt.Sprintf("%v", this.FlowIdleTimeout) + `,`,
+		`TlsPolicy:` + strings.Replace(this.TlsPolicy.String(), "TLSPolicy", "TLSPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLSPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLSPolicy{`,
+		`MinVersion:` + fmt.Sprintf("%v", this.MinVersion) + `,`,
+		`RequireClientCert:` + fmt.Sprintf("%v", this.RequireClientCert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActivityEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActivityEntry{`,
+		`RouteAdded:` + strings.Replace(this.RouteAdded.String(), "AccountServices", "AccountServices", 1) + `,`,
+		`RouteRemoved:` + strings.Replace(fmt.Sprintf("%v", this.RouteRemoved), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLocations := "[]*NetworkLocation{"
+	for _, f := range this.Locations {
+		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	}
+	repeatedStringForLocations += "}"
+	s := strings.Join([]string{`&ConfigRequest{`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
+		`Locations:` + repeatedStringForLocations + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokenKeys := "[]*TokenKey{"
+	for _, f := range this.TokenKeys {
+		repeatedStringForTokenKeys += strings.Replace(f.String(), "TokenKey", "TokenKey", 1) + ","
+	}
+	repeatedStringForTokenKeys += "}"
+	s := strings.Join([]string{`&ConfigResponse{`,
+		`TlsKey:` + fmt.Sprintf("%v", this.TlsKey) + `,`,
+		`TlsCert:` + fmt.Sprintf("%v", this.TlsCert) + `,`,
+		`TokenPub:` + fmt.Sprintf("%v", this.TokenPub) + `,`,
+		`S3AccessKey:` + fmt.Sprintf("%v", this.S3AccessKey) + `,`,
+		`S3SecretKey:` + fmt.Sprintf("%v", this.S3SecretKey) + `,`,
+		`S3Bucket:` + fmt.Sprintf("%v", this.S3Bucket) + `,`,
+		`ImageTag:` + fmt.Sprintf("%v", this.ImageTag) + `,`,
+		`TokenKeys:` + repeatedStringForTokenKeys + `,`,
+		`Reconnect:` + strings.Replace(this.Reconnect.String(), "ReconnectPolicy", "ReconnectPolicy", 1) + `,`,
+		`FlowIdleTimeout:` + fmt.Sprintf("%v", this.FlowIdleTimeout) + `,`,
+		`TlsPolicy:` + strings.Replace(this.TlsPolicy.String(), "TLSPolicy", "TLSPolicy", 1) + `,`,
+		`AgentClientCa:` + fmt.Sprintf("%v", this.AgentClientCa) + `,`,
+		`S3Region:` + fmt.Sprintf("%v", this.S3Region) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReconnectPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReconnectPolicy{`,
+		`InitialBackoff:` + fmt.Sprintf("%v", this.InitialBackoff) + `,`,
+		`MaxBackoff:` + fmt.Sprintf("%v", this.MaxBackoff) + `,`,
+		`Jitter:` + fmt.Sprintf("%v", this.Jitter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubChange{`,
+		`OldId:` + strings.Replace(fmt.Sprintf("%v", this.OldId), "ULID", "ULID", 1) + `,`,
+		`NewId:` + strings.Replace(fmt.Sprintf("%v", this.NewId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MigrateStream) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MigrateStream{`,
+		`Within:` + fmt.Sprintf("%v", this.Within) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubTLS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubTLS{`,
+		`Cert:` + fmt.Sprintf("%v", this.Cert) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CentralActivity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccountServices := "[]*AccountServices{"
+	for _, f := range this.AccountServices {
+		repeatedStringForAccountServices += strings.Replace(f.String(), "AccountServices", "AccountServices", 1) + ","
+	}
+	repeatedStringForAccountServices += "}"
+	repeatedStringForKillFlows := "[]*ULID{"
+	for _, f := range this.KillFlows {
+		repeatedStringForKillFlows += strings.Replace(fmt.Sprintf("%v", f), "ULID", "ULID", 1) + ","
+	}
+	repeatedStringForKillFlows += "}"
+	s := strings.Join([]string{`&CentralActivity{`,
+		`AccountServices:` + repeatedStringForAccountServices + `,`,
+		`RequestStats:` + fmt.Sprintf("%v", this.RequestStats) + `,`,
+		`NewLabelLinks:` + strings.Replace(this.NewLabelLinks.String(), "LabelLinks", "LabelLinks", 1) + `,`,
+		`HubChange:` + strings.Replace(this.HubChange.String(), "HubChange", "HubChange", 1) + `,`,
+		`KillFlows:` + repeatedStringForKillFlows + `,`,
+		`MigrateStream:` + strings.Replace(this.MigrateStream.String(), "MigrateStream", "MigrateStream", 1) + `,`,
+		`HubTls:` + strings.Replace(this.HubTls.String(), "HubTLS", "HubTLS", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubActivity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFlow := "[]*FlowRecord{"
+	for _, f := range this.Flow {
+		repeatedStringForFlow += strings.Replace(fmt.Sprintf("%v", f), "FlowRecord", "FlowRecord", 1) + ","
+	}
+	repeatedStringForFlow += "}"
+	s := strings.Join([]string{`&HubActivity{`,
+		`HubReg:` + strings.Replace(fmt.Sprintf("%v", this.HubReg), "HubActivity_HubRegistration", "HubActivity_HubRegistration", 1) + `,`,
+		`SentAt:` + strings.Replace(fmt.Sprintf("%v", this.SentAt), "Timestamp", "Timestamp", 1) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "HubActivity_HubStats", "HubActivity_HubStats", 1) + `,`,
+		`Flow:` + repeatedStringForFlow + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubActivity_HubRegistration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLocations := "[]*NetworkLocation{"
+	for _, f := range this.Locations {
+		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	}
+	repeatedStringForLocations += "}"
+	s := strings.Join([]string{`&HubActivity_HubRegistration{`,
+		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
+		`StableHub:` + strings.Replace(fmt.Sprintf("%v", this.StableHub), "ULID", "ULID", 1) + `,`,
+		`Locations:` + repeatedStringForLocations + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubActivity_HubStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubActivity_HubStats{`,
+		`AgentConnections:` + fmt.Sprintf("%v", this.AgentConnections) + `,`,
+		`AccountsSeen:` + fmt.Sprintf("%v", this.AccountsSeen) + `,`,
+		`AccountsCached:` + fmt.Sprintf("%v", this.AccountsCached) + `,`,
+		`CachedBytes:` + fmt.Sprintf("%v", this.CachedBytes) + `,`,
+		`LastSuccessfulUpdate:` + strings.Replace(fmt.Sprintf("%v", this.LastSuccessfulUpdate), "Timestamp", "Timestamp", 1) + `,`,
+		`LastAttemptedUpdate:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptedUpdate), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLocations := "[]*NetworkLocation{"
+	for _, f := range this.Locations {
+		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	}
+	repeatedStringForLocations += "}"
+	s := strings.Join([]string{`&HubInfo{`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Locations:` + repeatedStringForLocations + `,`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`ActiveFlows:` + fmt.Sprintf("%v", this.ActiveFlows) + `,`,
+		`MaxFlows:` + fmt.Sprintf("%v", this.MaxFlows) + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListOfHubs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHubs := "[]*HubInfo{"
+	for _, f := range this.Hubs {
+		repeatedStringForHubs += strings.Replace(f.String(), "HubInfo", "HubInfo", 1) + ","
+	}
+	repeatedStringForHubs += "}"
+	repeatedStringForProtocolVersions := "[]*ProtocolVersionCount{"
+	for _, f := range this.ProtocolVersions {
+		repeatedStringForProtocolVersions += strings.Replace(f.String(), "ProtocolVersionCount", "ProtocolVersionCount", 1) + ","
+	}
+	repeatedStringForProtocolVersions += "}"
+	s := strings.Join([]string{`&ListOfHubs{`,
+		`Hubs:` + repeatedStringForHubs + `,`,
+		`ProtocolVersions:` + repeatedStringForProtocolVersions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProtocolVersionCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProtocolVersionCount{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Hubs:` + fmt.Sprintf("%v", this.Hubs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubSync) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForServices := "[]*ServiceRequest{"
+	for _, f := range this.Services {
+		repeatedStringForServices += strings.Replace(f.String(), "ServiceRequest", "ServiceRequest", 1) + ","
+	}
+	repeatedStringForServices += "}"
+	s := strings.Join([]string{`&HubSync{`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`Services:` + repeatedStringForServices + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubSyncResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubSyncResponse{`,
+		`ServiceCount:` + fmt.Sprintf("%v", this.ServiceCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubRegisterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLocations := "[]*NetworkLocation{"
+	for _, f := range this.Locations {
+		repeatedStringForLocations += strings.Replace(fmt.Sprintf("%v", f), "NetworkLocation", "NetworkLocation", 1) + ","
+	}
+	repeatedStringForLocations += "}"
+	s := strings.Join([]string{`&HubRegisterRequest{`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
+		`Locations:` + repeatedStringForLocations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubRegisterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubRegisterResponse{`,
+		`ControlTime:` + strings.Replace(fmt.Sprintf("%v", this.ControlTime), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubDisconnectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubDisconnectRequest{`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`InstanceId:` + strings.Replace(fmt.Sprintf("%v", this.InstanceId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceTokenRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceTokenRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceTokenResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceTokenResponse{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ItemError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ItemError{`,
+		`Item:` + fmt.Sprintf("%v", this.Item) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListServicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListServicesRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`BestEffort:` + fmt.Sprintf("%v", this.BestEffort) + `,`,
+		`ConsistentRead:` + fmt.Sprintf("%v", this.ConsistentRead) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListServicesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForServices := "[]*Service{"
+	for _, f := range this.Services {
+		repeatedStringForServices += strings.Replace(f.String(), "Service", "Service", 1) + ","
+	}
+	repeatedStringForServices += "}"
+	repeatedStringForErrors := "[]*ItemError{"
+	for _, f := range this.Errors {
+		repeatedStringForErrors += strings.Replace(f.String(), "ItemError", "ItemError", 1) + ","
+	}
+	repeatedStringForErrors += "}"
+	s := strings.Join([]string{`&ListServicesResponse{`,
+		`Services:` + repeatedStringForServices + `,`,
+		`Errors:` + repeatedStringForErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Service) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetadata := "[]*KVPair{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(fmt.Sprintf("%v", f), "KVPair", "KVPair", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&Service{`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Hub:` + strings.Replace(fmt.Sprintf("%v", this.Hub), "ULID", "ULID", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddAccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddAccountRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "Account_Limits", "Account_Limits", 1) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddAccountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddAccountResponse{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddLabelLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddLabelLinkRequest{`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Target:` + strings.Replace(fmt.Sprintf("%v", this.Target), "LabelSet", "LabelSet", 1) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`OnConflict:` + fmt.Sprintf("%v", this.OnConflict) + `,`,
+		`Weighted:` + fmt.Sprintf("%v", this.Weighted) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Noop) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Noop{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveLabelLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveLabelLinkRequest{`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`Target:` + strings.Replace(fmt.Sprintf("%v", this.Target), "LabelSet", "LabelSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTokenRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCapabilities := "[]TokenCapability{"
+	for _, f := range this.Capabilities {
+		repeatedStringForCapabilities += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForCapabilities += "}"
+	s := strings.Join([]string{`&CreateTokenRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Capabilities:` + repeatedStringForCapabilities + `,`,
+		`ValidDuration:` + strings.Replace(fmt.Sprintf("%v", this.ValidDuration), "Timestamp", "Timestamp", 1) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTokenResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTokenResponse{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ControlRegister) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControlRegister{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ControlToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControlToken{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenInfo{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`KeyId:` + fmt.Sprintf("%v", this.KeyId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenKey{`,
+		`KeyId:` + fmt.Sprintf("%v", this.KeyId) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Signing:` + fmt.Sprintf("%v", this.Signing) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTokenKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKeys := "[]*TokenKey{"
+	for _, f := range this.Keys {
+		repeatedStringForKeys += strings.Replace(f.String(), "TokenKey", "TokenKey", 1) + ","
+	}
+	repeatedStringForKeys += "}"
+	s := strings.Join([]string{`&ListTokenKeysResponse{`,
+		`Keys:` + repeatedStringForKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetHubMaxFlowsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetHubMaxFlowsRequest{`,
+		`StableId:` + strings.Replace(fmt.Sprintf("%v", this.StableId), "ULID", "ULID", 1) + `,`,
+		`MaxFlows:` + fmt.Sprintf("%v", this.MaxFlows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListActiveFlowsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListActiveFlowsRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`HubId:` + strings.Replace(fmt.Sprintf("%v", this.HubId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListActiveFlowsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFlows := "[]*FlowStream{"
+	for _, f := range this.Flows {
+		repeatedStringForFlows += strings.Replace(fmt.Sprintf("%v", f), "FlowStream", "FlowStream", 1) + ","
+	}
+	repeatedStringForFlows += "}"
+	s := strings.Join([]string{`&ListActiveFlowsResponse{`,
+		`Flows:` + repeatedStringForFlows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KillFlowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KillFlowRequest{`,
+		`FlowId:` + strings.Replace(fmt.Sprintf("%v", this.FlowId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LookupAccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LookupAccountRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LookupAccountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LookupAccountResponse{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`ExternalId:` + fmt.Sprintf("%v", this.ExternalId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddAccountAliasRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddAccountAliasRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Alias:` + strings.Replace(fmt.Sprintf("%v", this.Alias), "Account", "Account", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveAccountAliasRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveAccountAliasRequest{`,
+		`Alias:` + strings.Replace(fmt.Sprintf("%v", this.Alias), "Account", "Account", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetAccountFeatureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetAccountFeatureRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountFeaturesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAccountFeaturesRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountFeature) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountFeature{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountFeaturesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFeatures := "[]*AccountFeature{"
+	for _, f := range this.Features {
+		repeatedStringForFeatures += strings.Replace(f.String(), "AccountFeature", "AccountFeature", 1) + ","
+	}
+	repeatedStringForFeatures += "}"
+	s := strings.Join([]string{`&GetAccountFeaturesResponse{`,
+		`Features:` + repeatedStringForFeatures + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetAccountDefaultLabelsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetAccountDefaultLabelsRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountDefaultLabelsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAccountDefaultLabelsRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`ConsistentRead:` + fmt.Sprintf("%v", this.ConsistentRead) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountDefaultLabelsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAccountDefaultLabelsResponse{`,
+		`Labels:` + strings.Replace(fmt.Sprintf("%v", this.Labels), "LabelSet", "LabelSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetAccountTLSPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetAccountTLSPolicyRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Policy:` + strings.Replace(this.Policy.String(), "TLSPolicy", "TLSPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountTLSPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAccountTLSPolicyRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`ConsistentRead:` + fmt.Sprintf("%v", this.ConsistentRead) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAccountTLSPolicyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAccountTLSPolicyResponse{`,
+		`Policy:` + strings.Replace(this.Policy.String(), "TLSPolicy", "TLSPolicy", 1) + `,`,
+		`Effective:` + strings.Replace(this.Effective.String(), "TLSPolicy", "TLSPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PeriodicJobStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PeriodicJobStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JobType:` + fmt.Sprintf("%v", this.JobType) + `,`,
+		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
+		`LastRunAt:` + strings.Replace(fmt.Sprintf("%v", this.LastRunAt), "Timestamp", "Timestamp", 1) + `,`,
+		`LastStatus:` + fmt.Sprintf("%v", this.LastStatus) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`NextRun:` + strings.Replace(fmt.Sprintf("%v", this.NextRun), "Timestamp", "Timestamp", 1) + `,`,
+		`PendingAttempts:` + fmt.Sprintf("%v", this.PendingAttempts) + `,`,
+		`RetryAt:` + strings.Replace(fmt.Sprintf("%v", this.RetryAt), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobs := "[]*PeriodicJobStatus{"
+	for _, f := range this.Jobs {
+		repeatedStringForJobs += strings.Replace(f.String(), "PeriodicJobStatus", "PeriodicJobStatus", 1) + ","
+	}
+	repeatedStringForJobs += "}"
+	s := strings.Join([]string{`&MaintenanceStatus{`,
+		`Jobs:` + repeatedStringForJobs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnqueueJobRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnqueueJobRequest{`,
+		`JobType:` + fmt.Sprintf("%v", this.JobType) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnqueueJobResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnqueueJobResponse{`,
+		`JobId:` + strings.Replace(fmt.Sprintf("%v", this.JobId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetMaintenanceModeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetMaintenanceModeRequest{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceMode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceMode{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FlushASNCacheResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FlushASNCacheResponse{`,
+		`Flushed:` + fmt.Sprintf("%v", this.Flushed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RebuildRoutingStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RebuildRoutingStateRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RebuildRoutingStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoutesAdded := "[]*ServiceRoute{"
+	for _, f := range this.RoutesAdded {
+		repeatedStringForRoutesAdded += strings.Replace(f.String(), "ServiceRoute", "ServiceRoute", 1) + ","
+	}
+	repeatedStringForRoutesAdded += "}"
+	repeatedStringForRoutesRemoved := "[]*ServiceRoute{"
+	for _, f := range this.RoutesRemoved {
+		repeatedStringForRoutesRemoved += strings.Replace(f.String(), "ServiceRoute", "ServiceRoute", 1) + ","
+	}
+	repeatedStringForRoutesRemoved += "}"
+	repeatedStringForLabelLinksAdded := "[]*LabelLink{"
+	for _, f := range this.LabelLinksAdded {
+		repeatedStringForLabelLinksAdded += strings.Replace(f.String(), "LabelLink", "LabelLink", 1) + ","
+	}
+	repeatedStringForLabelLinksAdded += "}"
+	repeatedStringForLabelLinksRemoved := "[]*LabelLink{"
+	for _, f := range this.LabelLinksRemoved {
+		repeatedStringForLabelLinksRemoved += strings.Replace(f.String(), "LabelLink", "LabelLink", 1) + ","
+	}
+	repeatedStringForLabelLinksRemoved += "}"
+	s := strings.Join([]string{`&RebuildRoutingStateResponse{`,
+		`RoutesAdded:` + repeatedStringForRoutesAdded + `,`,
+		`RoutesRemoved:` + repeatedStringForRoutesRemoved + `,`,
+		`SettingsChanged:` + fmt.Sprintf("%v", this.SettingsChanged) + `,`,
+		`LabelLinksAdded:` + repeatedStringForLabelLinksAdded + `,`,
+		`LabelLinksRemoved:` + repeatedStringForLabelLinksRemoved + `,`,
+		`Changed:` + fmt.Sprintf("%v", this.Changed) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchEventsRequest{`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Types:` + fmt.Sprintf("%v", this.Types) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ControlEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControlEvent{`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "Timestamp", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CleanupOrphanedObjectsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CleanupOrphanedObjectsRequest{`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CleanupOrphanedObjectsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CleanupOrphanedObjectsResponse{`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`LiveAccounts:` + fmt.Sprintf("%v", this.LiveAccounts) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountKey{`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`RevokedAt:` + strings.Replace(fmt.Sprintf("%v", this.RevokedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateAccountKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateAccountKeyRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateAccountKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateAccountKeyResponse{`,
+		`Key:` + strings.Replace(this.Key.String(), "AccountKey", "AccountKey", 1) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAccountKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListAccountKeysRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`BestEffort:` + fmt.Sprintf("%v", this.BestEffort) + `,`,
+		`ConsistentRead:` + fmt.Sprintf("%v", this.ConsistentRead) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAccountKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKeys := "[]*AccountKey{"
+	for _, f := range this.Keys {
+		repeatedStringForKeys += strings.Replace(f.String(), "AccountKey", "AccountKey", 1) + ","
+	}
+	repeatedStringForKeys += "}"
+	repeatedStringForErrors := "[]*ItemError{"
+	for _, f := range this.Errors {
+		repeatedStringForErrors += strings.Replace(f.String(), "ItemError", "ItemError", 1) + ","
+	}
+	repeatedStringForErrors += "}"
+	s := strings.Join([]string{`&ListAccountKeysResponse{`,
+		`Keys:` + repeatedStringForKeys + `,`,
+		`Errors:` + repeatedStringForErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevokeAccountKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevokeAccountKeyRequest{`,
+		`Account:` + strings.Replace(fmt.Sprintf("%v", this.Account), "Account", "Account", 1) + `,`,
+		`KeyId:` + strings.Replace(fmt.Sprintf("%v", this.KeyId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HubCredential) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HubCredential{`,
+		`Id:` + strings.Replace(fmt.Sprintf("%v", this.Id), "ULID", "ULID", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`RevokedAt:` + strings.Replace(fmt.Sprintf("%v", this.RevokedAt), "Timestamp", "Timestamp", 1) + `,`,
+		`HubId:` + strings.Replace(fmt.Sprintf("%v", this.HubId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IssueHubCredentialRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IssueHubCredentialRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HubId:` + strings.Replace(fmt.Sprintf("%v", this.HubId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IssueHubCredentialResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IssueHubCredentialResponse{`,
+		`Credential:` + strings.Replace(this.Credential.String(), "HubCredential", "HubCredential", 1) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListHubCredentialsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCredentials := "[]*HubCredential{"
+	for _, f := range this.Credentials {
+		repeatedStringForCredentials += strings.Replace(f.String(), "HubCredential", "HubCredential", 1) + ","
+	}
+	repeatedStringForCredentials += "}"
+	s := strings.Join([]string{`&ListHubCredentialsResponse{`,
+		`Credentials:` + repeatedStringForCredentials + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevokeHubCredentialRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevokeHubCredentialRequest{`,
+		`CredentialId:` + strings.Replace(fmt.Sprintf("%v", this.CredentialId), "ULID", "ULID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAccountsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListAccountsRequest{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Marker:` + fmt.Sprintf("%v", this.Marker) + `,`,
+		`BestEffort:` + fmt.Sprintf("%v", this.BestEffort) + `,`,
+		`ConsistentRead:` + fmt.Sprintf("%v", this.ConsistentRead) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAccountsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccounts := "[]*Account{"
+	for _, f := range this.Accounts {
+		repeatedStringForAccounts += strings.Replace(fmt.Sprintf("%v", f), "Account", "Account", 1) + ","
+	}
+	repeatedStringForAccounts += "}"
+	repeatedStringForErrors := "[]*ItemError{"
+	for _, f := range this.Errors {
+		repeatedStringForErrors += strings.Replace(f.String(), "ItemError", "ItemError", 1) + ","
+	}
+	repeatedStringForErrors += "}"
+	s := strings.Join([]string{`&ListAccountsResponse{`,
+		`Accounts:` + repeatedStringForAccounts + `,`,
+		`NextMarker:` + fmt.Sprintf("%v", this.NextMarker) + `,`,
+		`Errors:` + repeatedStringForErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringControl(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hub == nil {
+				m.Hub = &ULID{}
+			}
+			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &KVPair{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &LabelSet{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &Account_Limits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weighted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelLinks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelLinks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelLinks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelLinks = append(m.LabelLinks, &LabelLink{})
+			if err := m.LabelLinks[len(m.LabelLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hub == nil {
+				m.Hub = &ULID{}
+			}
+			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceRoute{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowIdleTimeout", wireType)
+			}
+			m.FlowIdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowIdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TlsPolicy == nil {
+				m.TlsPolicy = &TLSPolicy{}
+			}
+			if err := m.TlsPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
+			}
+			m.MinVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireClientCert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireClientCert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteAdded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RouteAdded == nil {
+				m.RouteAdded = &AccountServices{}
+			}
+			if err := m.RouteAdded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RouteRemoved == nil {
+				m.RouteRemoved = &ULID{}
+			}
+			if err := m.RouteRemoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstanceId == nil {
+				m.InstanceId = &ULID{}
+			}
+			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locations = append(m.Locations, &NetworkLocation{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsKey = append(m.TlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TlsKey == nil {
+				m.TlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsCert = append(m.TlsCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TlsCert == nil {
+				m.TlsCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPub", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPub = append(m.TokenPub[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenPub == nil {
+				m.TokenPub = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3AccessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S3AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3SecretKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S3SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S3Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenKeys = append(m.TokenKeys, &TokenKey{})
+			if err := m.TokenKeys[len(m.TokenKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconnect", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reconnect == nil {
+				m.Reconnect = &ReconnectPolicy{}
+			}
+			if err := m.Reconnect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowIdleTimeout", wireType)
+			}
+			m.FlowIdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowIdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TlsPolicy == nil {
+				m.TlsPolicy = &TLSPolicy{}
+			}
+			if err := m.TlsPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentClientCa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentClientCa = append(m.AgentClientCa[:0], dAtA[iNdEx:postIndex]...)
+			if m.AgentClientCa == nil {
+				m.AgentClientCa = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S3Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconnectPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconnectPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconnectPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
+			}
+			m.InitialBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBackoff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
+			}
+			m.MaxBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			m.Jitter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jitter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldId == nil {
+				m.OldId = &ULID{}
+			}
+			if err := m.OldId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewId == nil {
+				m.NewId = &ULID{}
+			}
+			if err := m.NewId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrateStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			m.Within = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Within |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubTLS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubTLS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubTLS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cert = append(m.Cert[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cert == nil {
+				m.Cert = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CentralActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CentralActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CentralActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountServices = append(m.AccountServices, &AccountServices{})
+			if err := m.AccountServices[len(m.AccountServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestStats = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLabelLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewLabelLinks == nil {
+				m.NewLabelLinks = &LabelLinks{}
+			}
+			if err := m.NewLabelLinks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HubChange == nil {
+				m.HubChange = &HubChange{}
+			}
+			if err := m.HubChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KillFlows = append(m.KillFlows, &ULID{})
+			if err := m.KillFlows[len(m.KillFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrateStream == nil {
+				m.MigrateStream = &MigrateStream{}
+			}
+			if err := m.MigrateStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubTls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HubTls == nil {
+				m.HubTls = &HubTLS{}
+			}
+			if err := m.HubTls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubReg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HubReg == nil {
+				m.HubReg = &HubActivity_HubRegistration{}
+			}
+			if err := m.HubReg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAt == nil {
+				m.SentAt = &Timestamp{}
+			}
+			if err := m.SentAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &HubActivity_HubStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flow = append(m.Flow, &FlowRecord{})
+			if err := m.Flow[len(m.Flow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hub == nil {
+				m.Hub = &ULID{}
+			}
+			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableHub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableHub == nil {
+				m.StableHub = &ULID{}
+			}
+			if err := m.StableHub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locations = append(m.Locations, &NetworkLocation{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentConnections", wireType)
+			}
+			m.AgentConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentConnections |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsSeen", wireType)
+			}
+			m.AccountsSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountsSeen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsCached", wireType)
+			}
+			m.AccountsCached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountsCached |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedBytes", wireType)
+			}
+			m.CachedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CachedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccessfulUpdate == nil {
+				m.LastSuccessfulUpdate = &Timestamp{}
+			}
+			if err := m.LastSuccessfulUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptedUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptedUpdate == nil {
+				m.LastAttemptedUpdate = &Timestamp{}
+			}
+			if err := m.LastAttemptedUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locations = append(m.Locations, &NetworkLocation{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFlows", wireType)
+			}
+			m.ActiveFlows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveFlows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlows", wireType)
+			}
+			m.MaxFlows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOfHubs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOfHubs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOfHubs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hubs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hubs = append(m.Hubs, &HubInfo{})
+			if err := m.Hubs[len(m.Hubs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolVersions = append(m.ProtocolVersions, &ProtocolVersionCount{})
+			if err := m.ProtocolVersions[len(m.ProtocolVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolVersionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolVersionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolVersionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hubs", wireType)
+			}
+			m.Hubs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hubs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubSync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubSync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubSync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceRequest{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubSyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubSyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceCount", wireType)
+			}
+			m.ServiceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubRegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstanceId == nil {
+				m.InstanceId = &ULID{}
+			}
+			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locations = append(m.Locations, &NetworkLocation{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubRegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubRegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControlTime == nil {
+				m.ControlTime = &Timestamp{}
+			}
+			if err := m.ControlTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubDisconnectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubDisconnectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubDisconnectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstanceId == nil {
+				m.InstanceId = &ULID{}
+			}
+			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConsistentRead = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ItemError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hub == nil {
+				m.Hub = &ULID{}
+			}
+			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &KVPair{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &Account_Limits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLabelLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLabelLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &LabelSet{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnConflict", wireType)
+			}
+			m.OnConflict = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnConflict |= AddLabelLinkRequest_ConflictMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weighted = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Noop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Noop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Noop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveLabelLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveLabelLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveLabelLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &LabelSet{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, TokenCapability{})
+			if err := m.Capabilities[len(m.Capabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidDuration == nil {
+				m.ValidDuration = &Timestamp{}
+			}
+			if err := m.ValidDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControlRegister) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControlRegister: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControlRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControlToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControlToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControlToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Signing = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTokenKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTokenKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTokenKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &TokenKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHubMaxFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHubMaxFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetHubMaxFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableId == nil {
+				m.StableId = &ULID{}
+			}
+			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlows", wireType)
+			}
+			m.MaxFlows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListActiveFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListActiveFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListActiveFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HubId == nil {
+				m.HubId = &ULID{}
+			}
+			if err := m.HubId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListActiveFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListActiveFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListActiveFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, &FlowStream{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KillFlowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KillFlowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KillFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FlowId == nil {
+				m.FlowId = &ULID{}
+			}
+			if err := m.FlowId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	repeatedStringForAccounts += "}"
-	s := strings.Join([]string{`&ListAccountsResponse{`,
-		`Accounts:` + repeatedStringForAccounts + `,`,
-		`NextMarker:` + fmt.Sprintf("%v", this.NextMarker) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func valueToStringControl(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *LookupAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *AddAccountAliasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7649,10 +26120,10 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddAccountAliasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddAccountAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7693,7 +26164,7 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7720,16 +26191,69 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hub == nil {
-				m.Hub = &ULID{}
+			if m.Alias == nil {
+				m.Alias = &Account{}
 			}
-			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Alias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAccountAliasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAccountAliasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAccountAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7756,18 +26280,71 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ULID{}
+			if m.Alias == nil {
+				m.Alias = &Account{}
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Alias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAccountFeatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAccountFeatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAccountFeatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -7777,29 +26354,33 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -7809,33 +26390,29 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = &LabelSet{}
-			}
-			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -7845,26 +26422,12 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = append(m.Metadata, &KVPair{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -7889,7 +26452,7 @@ func (m *ServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAccountFeaturesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7912,12 +26475,48 @@ func (m *ServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountFeaturesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountFeaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -7942,7 +26541,7 @@ func (m *ServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelLink) Unmarshal(dAtA []byte) error {
+func (m *AccountFeature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7965,17 +26564,17 @@ func (m *LabelLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountFeature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountFeature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -7985,33 +26584,29 @@ func (m *LabelLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &Account{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8021,67 +26616,68 @@ func (m *LabelLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = &LabelSet{}
-			}
-			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthControl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &LabelSet{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAccountFeaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
 			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAccountFeaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAccountFeaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8108,10 +26704,8 @@ func (m *LabelLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &Account_Limits{}
-			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Features = append(m.Features, &AccountFeature{})
+			if err := m.Features[len(m.Features)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8139,7 +26733,7 @@ func (m *LabelLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelLinks) Unmarshal(dAtA []byte) error {
+func (m *SetAccountDefaultLabelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8162,15 +26756,15 @@ func (m *LabelLinks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelLinks: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAccountDefaultLabelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelLinks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAccountDefaultLabelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelLinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8197,8 +26791,46 @@ func (m *LabelLinks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelLinks = append(m.LabelLinks, &LabelLink{})
-			if err := m.LabelLinks[len(m.LabelLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = &LabelSet{}
+			}
+			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8226,7 +26858,7 @@ func (m *LabelLinks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
+func (m *GetAccountDefaultLabelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8249,15 +26881,15 @@ func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountDefaultLabelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountDefaultLabelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8284,18 +26916,18 @@ func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hub == nil {
-				m.Hub = &ULID{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRead", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8305,61 +26937,66 @@ func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.ConsistentRead = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthControl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ULID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAccountDefaultLabelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAccountDefaultLabelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAccountDefaultLabelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
@@ -8419,7 +27056,7 @@ func (m *ServiceRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountServices) Unmarshal(dAtA []byte) error {
+func (m *SetAccountTLSPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8442,10 +27079,10 @@ func (m *AccountServices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountServices: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAccountTLSPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountServices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAccountTLSPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8486,7 +27123,7 @@ func (m *AccountServices) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8513,8 +27150,10 @@ func (m *AccountServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceRoute{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &TLSPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8542,7 +27181,7 @@ func (m *AccountServices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
+func (m *GetAccountTLSPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8565,15 +27204,15 @@ func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivityEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountTLSPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountTLSPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteAdded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8600,18 +27239,18 @@ func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RouteAdded == nil {
-				m.RouteAdded = &AccountServices{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.RouteAdded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemoved", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRead", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8621,28 +27260,12 @@ func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RouteRemoved == nil {
-				m.RouteRemoved = &ULID{}
-			}
-			if err := m.RouteRemoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ConsistentRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -8667,7 +27290,7 @@ func (m *ActivityEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAccountTLSPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8690,15 +27313,15 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountTLSPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountTLSPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8725,16 +27348,16 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableId == nil {
-				m.StableId = &ULID{}
+			if m.Policy == nil {
+				m.Policy = &TLSPolicy{}
 			}
-			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8761,44 +27384,10 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InstanceId == nil {
-				m.InstanceId = &ULID{}
-			}
-			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Effective == nil {
+				m.Effective = &TLSPolicy{}
 			}
-			m.Locations = append(m.Locations, &NetworkLocation{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Effective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8826,7 +27415,7 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *PeriodicJobStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8849,17 +27438,81 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodicJobStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodicJobStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TlsKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8869,31 +27522,29 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TlsKey = append(m.TlsKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.TlsKey == nil {
-				m.TlsKey = []byte{}
-			}
+			m.Period = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TlsCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8903,31 +27554,33 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TlsCert = append(m.TlsCert[:0], dAtA[iNdEx:postIndex]...)
-			if m.TlsCert == nil {
-				m.TlsCert = []byte{}
+			if m.LastRunAt == nil {
+				m.LastRunAt = &Timestamp{}
+			}
+			if err := m.LastRunAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatus", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -8937,29 +27590,27 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPub = append(m.TokenPub[:0], dAtA[iNdEx:postIndex]...)
-			if m.TokenPub == nil {
-				m.TokenPub = []byte{}
-			}
+			m.LastStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8987,13 +27638,13 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S3AccessKey = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRun", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9003,29 +27654,52 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S3SecretKey = string(dAtA[iNdEx:postIndex])
+			if m.NextRun == nil {
+				m.NextRun = &Timestamp{}
+			}
+			if err := m.NextRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAttempts", wireType)
+			}
+			m.PendingAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9035,29 +27709,86 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S3Bucket = string(dAtA[iNdEx:postIndex])
+			if m.RetryAt == nil {
+				m.RetryAt = &Timestamp{}
+			}
+			if err := m.RetryAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9067,23 +27798,25 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageTag = string(dAtA[iNdEx:postIndex])
+			m.Jobs = append(m.Jobs, &PeriodicJobStatus{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9109,7 +27842,7 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubChange) Unmarshal(dAtA []byte) error {
+func (m *EnqueueJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9132,17 +27865,17 @@ func (m *HubChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnqueueJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnqueueJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9152,31 +27885,112 @@ func (m *HubChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldId == nil {
-				m.OldId = &ULID{}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			if err := m.OldId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9203,10 +28017,10 @@ func (m *HubChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewId == nil {
-				m.NewId = &ULID{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.NewId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9234,7 +28048,7 @@ func (m *HubChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CentralActivity) Unmarshal(dAtA []byte) error {
+func (m *EnqueueJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9257,15 +28071,15 @@ func (m *CentralActivity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CentralActivity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnqueueJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CentralActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnqueueJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9292,14 +28106,69 @@ func (m *CentralActivity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountServices = append(m.AccountServices, &AccountServices{})
-			if err := m.AccountServices[len(m.AccountServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JobId == nil {
+				m.JobId = &ULID{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMaintenanceModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMaintenanceModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMaintenanceModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9316,48 +28185,12 @@ func (m *CentralActivity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RequestStats = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewLabelLinks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NewLabelLinks == nil {
-				m.NewLabelLinks = &LabelLinks{}
-			}
-			if err := m.NewLabelLinks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9367,27 +28200,23 @@ func (m *CentralActivity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HubChange == nil {
-				m.HubChange = &HubChange{}
-			}
-			if err := m.HubChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9413,7 +28242,7 @@ func (m *CentralActivity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubActivity) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9436,17 +28265,17 @@ func (m *HubActivity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubActivity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubReg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9456,33 +28285,17 @@ func (m *HubActivity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HubReg == nil {
-				m.HubReg = &HubActivity_HubRegistration{}
-			}
-			if err := m.HubReg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9492,31 +28305,27 @@ func (m *HubActivity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentAt == nil {
-				m.SentAt = &Timestamp{}
-			}
-			if err := m.SentAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9543,44 +28352,10 @@ func (m *HubActivity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &HubActivity_HubStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Since == nil {
+				m.Since = &Timestamp{}
 			}
-			m.Flow = append(m.Flow, &FlowRecord{})
-			if err := m.Flow[len(m.Flow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9608,7 +28383,7 @@ func (m *HubActivity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
+func (m *FlushASNCacheResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9631,17 +28406,17 @@ func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubRegistration: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushASNCacheResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushASNCacheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flushed", wireType)
 			}
-			var msglen int
+			m.Flushed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9651,31 +28426,67 @@ func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Flushed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthControl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hub == nil {
-				m.Hub = &ULID{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebuildRoutingStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
 			}
-			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildRoutingStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildRoutingStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableHub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9702,18 +28513,18 @@ func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableHub == nil {
-				m.StableHub = &ULID{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.StableHub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9723,26 +28534,12 @@ func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locations = append(m.Locations, &NetworkLocation{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -9767,7 +28564,7 @@ func (m *HubActivity_HubRegistration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
+func (m *RebuildRoutingStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9790,17 +28587,17 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildRoutingStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildRoutingStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentConnections", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesAdded", wireType)
 			}
-			m.AgentConnections = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9810,16 +28607,31 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentConnections |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesAdded = append(m.RoutesAdded, &ServiceRoute{})
+			if err := m.RoutesAdded[len(m.RoutesAdded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountsSeen", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesRemoved", wireType)
 			}
-			m.AccountsSeen = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9829,16 +28641,31 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsSeen |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesRemoved = append(m.RoutesRemoved, &ServiceRoute{})
+			if err := m.RoutesRemoved[len(m.RoutesRemoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountsCached", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettingsChanged", wireType)
 			}
-			m.AccountsCached = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9848,16 +28675,17 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsCached |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SettingsChanged = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CachedBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelLinksAdded", wireType)
 			}
-			m.CachedBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9867,14 +28695,29 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CachedBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelLinksAdded = append(m.LabelLinksAdded, &LabelLink{})
+			if err := m.LabelLinksAdded[len(m.LabelLinksAdded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelLinksRemoved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9901,18 +28744,16 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSuccessfulUpdate == nil {
-				m.LastSuccessfulUpdate = &Timestamp{}
-			}
-			if err := m.LastSuccessfulUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LabelLinksRemoved = append(m.LabelLinksRemoved, &LabelLink{})
+			if err := m.LabelLinksRemoved[len(m.LabelLinksRemoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptedUpdate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -9922,28 +28763,32 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastAttemptedUpdate == nil {
-				m.LastAttemptedUpdate = &Timestamp{}
+			m.Changed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if err := m.LastAttemptedUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -9968,7 +28813,7 @@ func (m *HubActivity_HubStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubInfo) Unmarshal(dAtA []byte) error {
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9991,17 +28836,17 @@ func (m *HubInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10011,33 +28856,29 @@ func (m *HubInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ULID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10047,25 +28888,23 @@ func (m *HubInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locations = append(m.Locations, &NetworkLocation{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10091,7 +28930,7 @@ func (m *HubInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListOfHubs) Unmarshal(dAtA []byte) error {
+func (m *ControlEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10114,17 +28953,17 @@ func (m *ListOfHubs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListOfHubs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListOfHubs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hubs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10134,82 +28973,59 @@ func (m *ListOfHubs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hubs = append(m.Hubs, &HubInfo{})
-			if err := m.Hubs[len(m.Hubs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HubSync) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HubSync: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubSync: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10236,18 +29052,18 @@ func (m *HubSync) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ULID{}
+			if m.Time == nil {
+				m.Time = &Timestamp{}
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10257,33 +29073,61 @@ func (m *HubSync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableId == nil {
-				m.StableId = &ULID{}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10293,24 +29137,24 @@ func (m *HubSync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceRequest{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10337,7 +29181,7 @@ func (m *HubSync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubSyncResponse) Unmarshal(dAtA []byte) error {
+func (m *CleanupOrphanedObjectsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10360,17 +29204,17 @@ func (m *HubSyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubSyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupOrphanedObjectsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupOrphanedObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.ServiceCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10380,11 +29224,12 @@ func (m *HubSyncResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceCount |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -10409,7 +29254,7 @@ func (m *HubSyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanupOrphanedObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10432,17 +29277,17 @@ func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubRegisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupOrphanedObjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupOrphanedObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10452,33 +29297,29 @@ func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableId == nil {
-				m.StableId = &ULID{}
-			}
-			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveAccounts", wireType)
 			}
-			var msglen int
+			m.LiveAccounts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10488,33 +29329,16 @@ func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LiveAccounts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InstanceId == nil {
-				m.InstanceId = &ULID{}
-			}
-			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10524,26 +29348,12 @@ func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locations = append(m.Locations, &NetworkLocation{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -10568,7 +29378,7 @@ func (m *HubRegisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubRegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10591,15 +29401,15 @@ func (m *HubRegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubRegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10626,69 +29436,16 @@ func (m *HubRegisterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ControlTime == nil {
-				m.ControlTime = &Timestamp{}
+			if m.Id == nil {
+				m.Id = &ULID{}
 			}
-			if err := m.ControlTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HubDisconnectRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HubDisconnectRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubDisconnectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10715,16 +29472,48 @@ func (m *HubDisconnectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableId == nil {
-				m.StableId = &ULID{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.StableId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10751,71 +29540,18 @@ func (m *HubDisconnectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InstanceId == nil {
-				m.InstanceId = &ULID{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &Timestamp{}
 			}
-			if err := m.InstanceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10825,82 +29561,33 @@ func (m *ServiceTokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceTokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = &Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastUsedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceTokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -10910,23 +29597,27 @@ func (m *ServiceTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if m.RevokedAt == nil {
+				m.RevokedAt = &Timestamp{}
+			}
+			if err := m.RevokedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10952,7 +29643,7 @@ func (m *ServiceTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListServicesRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateAccountKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10975,10 +29666,10 @@ func (m *ListServicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListServicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateAccountKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateAccountKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11017,64 +29708,11 @@ func (m *ListServicesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListServicesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListServicesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -11084,25 +29722,23 @@ func (m *ListServicesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11128,7 +29764,7 @@ func (m *ListServicesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *CreateAccountKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11151,51 +29787,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateAccountKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateAccountKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &ULID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11222,16 +29822,16 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hub == nil {
-				m.Hub = &ULID{}
+			if m.Key == nil {
+				m.Key = &AccountKey{}
 			}
-			if err := m.Hub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11259,11 +29859,64 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11290,18 +29943,18 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = &LabelSet{}
+			if m.Account == nil {
+				m.Account = &Account{}
 			}
-			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -11311,26 +29964,32 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BestEffort = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRead", wireType)
 			}
-			m.Metadata = append(m.Metadata, &KVPair{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ConsistentRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -11355,7 +30014,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAccountKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11378,15 +30037,15 @@ func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAccountKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAccountKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11413,16 +30072,14 @@ func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &Account{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, &AccountKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11449,10 +30106,8 @@ func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &Account_Limits{}
-			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, &ItemError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11480,7 +30135,7 @@ func (m *AddAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeAccountKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11503,49 +30158,13 @@ func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLabelLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAccountKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLabelLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAccountKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthControl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = &LabelSet{}
-			}
-			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -11581,9 +30200,9 @@ func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11610,10 +30229,10 @@ func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &LabelSet{}
+			if m.KeyId == nil {
+				m.KeyId = &ULID{}
 			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11641,60 +30260,7 @@ func (m *AddLabelLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Noop) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Noop: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Noop: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveLabelLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *HubCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11717,15 +30283,15 @@ func (m *RemoveLabelLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLabelLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HubCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLabelLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HubCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11752,16 +30318,48 @@ func (m *RemoveLabelLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = &LabelSet{}
+			if m.Id == nil {
+				m.Id = &ULID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
 			}
-			if err := m.Labels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11788,69 +30386,16 @@ func (m *RemoveLabelLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &Account{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &Timestamp{}
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthControl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11877,16 +30422,16 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &Account{}
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = &Timestamp{}
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUsedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11913,14 +30458,16 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Capabilities = append(m.Capabilities, TokenCapability{})
-			if err := m.Capabilities[len(m.Capabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RevokedAt == nil {
+				m.RevokedAt = &Timestamp{}
+			}
+			if err := m.RevokedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HubId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11947,10 +30494,10 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidDuration == nil {
-				m.ValidDuration = &Timestamp{}
+			if m.HubId == nil {
+				m.HubId = &ULID{}
 			}
-			if err := m.ValidDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HubId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11978,7 +30525,7 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *IssueHubCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12001,15 +30548,15 @@ func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueHubCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueHubCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12037,7 +30584,43 @@ func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HubId == nil {
+				m.HubId = &ULID{}
+			}
+			if err := m.HubId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12063,7 +30646,7 @@ func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControlRegister) Unmarshal(dAtA []byte) error {
+func (m *IssueHubCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12086,15 +30669,51 @@ func (m *ControlRegister) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControlRegister: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueHubCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueHubCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credential == nil {
+				m.Credential = &HubCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12122,7 +30741,7 @@ func (m *ControlRegister) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12148,7 +30767,7 @@ func (m *ControlRegister) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControlToken) Unmarshal(dAtA []byte) error {
+func (m *ListHubCredentialsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12171,17 +30790,17 @@ func (m *ControlToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControlToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListHubCredentialsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListHubCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -12191,23 +30810,25 @@ func (m *ControlToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Credentials = append(m.Credentials, &HubCredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12233,7 +30854,7 @@ func (m *ControlToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+func (m *RevokeHubCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12256,17 +30877,17 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeHubCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeHubCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -12276,24 +30897,26 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthControl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			if m.CredentialId == nil {
+				m.CredentialId = &ULID{}
+			}
+			if err := m.CredentialId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -12402,6 +31025,46 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 				m.Marker = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConsistentRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -12523,6 +31186,40 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				m.NextMarker = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ItemError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
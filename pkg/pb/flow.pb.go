@@ -14,6 +14,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -28,18 +29,55 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type FlowStream_EndReason int32
+
+const (
+	END_UNKNOWN   FlowStream_EndReason = 0
+	END_NORMAL    FlowStream_EndReason = 1
+	END_ERROR     FlowStream_EndReason = 2
+	END_HUB_DRAIN FlowStream_EndReason = 3
+	END_TIMEOUT   FlowStream_EndReason = 4
+	END_KILLED    FlowStream_EndReason = 5
+	END_IDLE      FlowStream_EndReason = 6
+)
+
+var FlowStream_EndReason_name = map[int32]string{
+	0: "END_UNKNOWN",
+	1: "END_NORMAL",
+	2: "END_ERROR",
+	3: "END_HUB_DRAIN",
+	4: "END_TIMEOUT",
+	5: "END_KILLED",
+	6: "END_IDLE",
+}
+
+var FlowStream_EndReason_value = map[string]int32{
+	"END_UNKNOWN":   0,
+	"END_NORMAL":    1,
+	"END_ERROR":     2,
+	"END_HUB_DRAIN": 3,
+	"END_TIMEOUT":   4,
+	"END_KILLED":    5,
+	"END_IDLE":      6,
+}
+
+func (FlowStream_EndReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb3fc33c49933823, []int{0, 0}
+}
+
 type FlowStream struct {
-	FlowId      *ULID      `protobuf:"bytes,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
-	HubId       *ULID      `protobuf:"bytes,2,opt,name=hub_id,json=hubId,proto3" json:"hub_id,omitempty"`
-	AgentId     *ULID      `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	ServiceId   *ULID      `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Account     *Account   `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
-	Labels      *LabelSet  `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
-	StartedAt   *Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt     *Timestamp `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	NumMessages int64      `protobuf:"varint,12,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
-	NumBytes    int64      `protobuf:"varint,13,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
-	Duration    int64      `protobuf:"varint,14,opt,name=duration,proto3" json:"duration,omitempty"`
+	FlowId      *ULID                `protobuf:"bytes,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	HubId       *ULID                `protobuf:"bytes,2,opt,name=hub_id,json=hubId,proto3" json:"hub_id,omitempty"`
+	AgentId     *ULID                `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	ServiceId   *ULID                `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Account     *Account             `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Labels      *LabelSet            `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
+	StartedAt   *Timestamp           `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt     *Timestamp           `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	NumMessages int64                `protobuf:"varint,12,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+	NumBytes    int64                `protobuf:"varint,13,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+	Duration    int64                `protobuf:"varint,14,opt,name=duration,proto3" json:"duration,omitempty"`
+	EndReason   FlowStream_EndReason `protobuf:"varint,15,opt,name=end_reason,json=endReason,proto3,enum=pb.FlowStream_EndReason" json:"end_reason,omitempty"`
 }
 
 func (m *FlowStream) Reset()      { *m = FlowStream{} }
@@ -151,6 +189,13 @@ func (m *FlowStream) GetDuration() int64 {
 	return 0
 }
 
+func (m *FlowStream) GetEndReason() FlowStream_EndReason {
+	if m != nil {
+		return m.EndReason
+	}
+	return END_UNKNOWN
+}
+
 type FlowRecord struct {
 	Agent    *FlowRecord_AgentConnection `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
 	Stream   *FlowStream                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
@@ -306,6 +351,7 @@ type FlowRecord_HubStats struct {
 	ActiveAgents int64 `protobuf:"varint,2,opt,name=active_agents,json=activeAgents,proto3" json:"active_agents,omitempty"`
 	TotalAgents  int64 `protobuf:"varint,3,opt,name=total_agents,json=totalAgents,proto3" json:"total_agents,omitempty"`
 	Services     int64 `protobuf:"varint,4,opt,name=services,proto3" json:"services,omitempty"`
+	ActiveFlows  int64 `protobuf:"varint,5,opt,name=active_flows,json=activeFlows,proto3" json:"active_flows,omitempty"`
 }
 
 func (m *FlowRecord_HubStats) Reset()      { *m = FlowRecord_HubStats{} }
@@ -368,8 +414,17 @@ func (m *FlowRecord_HubStats) GetServices() int64 {
 	return 0
 }
 
+func (m *FlowRecord_HubStats) GetActiveFlows() int64 {
+	if m != nil {
+		return m.ActiveFlows
+	}
+	return 0
+}
+
 type FlowTopSnapshot struct {
-	Records []*FlowStream `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Records     []*FlowStream `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Window      string        `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	BucketStart *Timestamp    `protobuf:"bytes,3,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
 }
 
 func (m *FlowTopSnapshot) Reset()      { *m = FlowTopSnapshot{} }
@@ -411,8 +466,23 @@ func (m *FlowTopSnapshot) GetRecords() []*FlowStream {
 	return nil
 }
 
+func (m *FlowTopSnapshot) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+func (m *FlowTopSnapshot) GetBucketStart() *Timestamp {
+	if m != nil {
+		return m.BucketStart
+	}
+	return nil
+}
+
 type FlowTopRequest struct {
-	MaxRecords int32 `protobuf:"varint,1,opt,name=max_records,json=maxRecords,proto3" json:"max_records,omitempty"`
+	MaxRecords int32  `protobuf:"varint,1,opt,name=max_records,json=maxRecords,proto3" json:"max_records,omitempty"`
+	Window     string `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
 func (m *FlowTopRequest) Reset()      { *m = FlowTopRequest{} }
@@ -454,7 +524,15 @@ func (m *FlowTopRequest) GetMaxRecords() int32 {
 	return 0
 }
 
+func (m *FlowTopRequest) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("pb.FlowStream_EndReason", FlowStream_EndReason_name, FlowStream_EndReason_value)
 	proto.RegisterType((*FlowStream)(nil), "pb.FlowStream")
 	proto.RegisterType((*FlowRecord)(nil), "pb.FlowRecord")
 	proto.RegisterType((*FlowRecord_AgentConnection)(nil), "pb.FlowRecord.AgentConnection")
@@ -466,50 +544,67 @@ func init() {
 func init() { proto.RegisterFile("flow.proto", fileDescriptor_bb3fc33c49933823) }
 
 var fileDescriptor_bb3fc33c49933823 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcb, 0x6e, 0xd3, 0x4e,
-	0x14, 0xc6, 0x3d, 0x71, 0x93, 0x38, 0x27, 0x97, 0x4a, 0xf3, 0x5f, 0xfc, 0x2d, 0x23, 0x4d, 0xdb,
-	0x40, 0xa1, 0x0b, 0x14, 0x89, 0xd2, 0x5d, 0x57, 0x69, 0x11, 0x22, 0x52, 0x61, 0xe1, 0x94, 0x75,
-	0x34, 0x8e, 0x87, 0x26, 0x52, 0xec, 0x31, 0x9e, 0x71, 0x5b, 0x76, 0xbc, 0x01, 0xbc, 0x01, 0x2c,
-	0x79, 0x14, 0x96, 0xdd, 0x20, 0x75, 0x49, 0xdd, 0x0d, 0xcb, 0x3e, 0x02, 0x9a, 0x8b, 0xdb, 0x2a,
-	0x2a, 0x97, 0x0d, 0xbb, 0x9e, 0xf3, 0xfd, 0x8e, 0xe7, 0xf4, 0xfb, 0x8e, 0x02, 0xf0, 0x66, 0xc1,
-	0x4f, 0x06, 0x59, 0xce, 0x25, 0xc7, 0xb5, 0x2c, 0x0a, 0xa0, 0x58, 0xcc, 0x63, 0x53, 0x07, 0xab,
-	0x72, 0x9e, 0x30, 0x21, 0x69, 0x92, 0xd9, 0x46, 0x7b, 0x41, 0x23, 0xb6, 0xb0, 0x45, 0x97, 0x4e,
-	0xa7, 0xbc, 0x48, 0xa5, 0x29, 0xfb, 0x9f, 0x5c, 0x80, 0xe7, 0x0b, 0x7e, 0x32, 0x96, 0x39, 0xa3,
-	0x09, 0xde, 0x80, 0xa6, 0xfa, 0xf2, 0x64, 0x1e, 0xfb, 0x68, 0x1d, 0x6d, 0xb5, 0xb7, 0xbd, 0x41,
-	0x16, 0x0d, 0x5e, 0x1f, 0x8c, 0x9e, 0x85, 0x0d, 0x25, 0x8c, 0x62, 0xbc, 0x06, 0x8d, 0x59, 0x11,
-	0x29, 0xa2, 0xb6, 0x44, 0xd4, 0x67, 0x45, 0x34, 0x8a, 0xf1, 0x7d, 0xf0, 0xe8, 0x11, 0x4b, 0xa5,
-	0x42, 0xdc, 0x25, 0xa4, 0xa9, 0x95, 0x51, 0x8c, 0x1f, 0x01, 0x08, 0x96, 0x1f, 0xcf, 0xa7, 0x4c,
-	0x61, 0x2b, 0x4b, 0x58, 0xcb, 0x6a, 0xa3, 0x18, 0x6f, 0x42, 0xd3, 0x6e, 0xec, 0xd7, 0x35, 0xd5,
-	0x56, 0xd4, 0xd0, 0xb4, 0xc2, 0x4a, 0xc3, 0x0f, 0xa0, 0xa1, 0xff, 0x4b, 0xe1, 0x37, 0x34, 0xd5,
-	0x51, 0xd4, 0x81, 0xea, 0x8c, 0x99, 0x0c, 0xad, 0x86, 0x1f, 0x03, 0x08, 0x49, 0x73, 0xc9, 0xe2,
-	0x09, 0x95, 0x3e, 0x68, 0xb2, 0xab, 0xc8, 0xc3, 0xca, 0xb2, 0xb0, 0x65, 0x81, 0xa1, 0xc4, 0x5b,
-	0xe0, 0xb1, 0x34, 0x36, 0x6c, 0xfb, 0x2e, 0xb6, 0xa9, 0xe5, 0xa1, 0xc4, 0x1b, 0xd0, 0x49, 0x8b,
-	0x64, 0x92, 0x30, 0x21, 0xe8, 0x11, 0x13, 0x7e, 0x67, 0x1d, 0x6d, 0xb9, 0x61, 0x3b, 0x2d, 0x92,
-	0x97, 0xb6, 0x85, 0xef, 0x41, 0x4b, 0x21, 0xd1, 0x3b, 0xc9, 0x84, 0xdf, 0xd5, 0xba, 0x97, 0x16,
-	0xc9, 0x9e, 0xaa, 0x71, 0x00, 0x5e, 0x5c, 0xe4, 0x54, 0xce, 0x79, 0xea, 0xf7, 0x8c, 0x56, 0xd5,
-	0xfd, 0x6f, 0x2b, 0x26, 0xa1, 0x90, 0x4d, 0x79, 0x1e, 0xe3, 0x1d, 0xa8, 0x6b, 0x0f, 0x6d, 0x3e,
-	0x44, 0x6d, 0x74, 0x23, 0x0f, 0x86, 0x4a, 0xdb, 0xe7, 0x69, 0xca, 0xa6, 0x6a, 0x3a, 0x34, 0x30,
-	0x7e, 0x08, 0x0d, 0xa1, 0x13, 0xb6, 0xa1, 0xf5, 0xaa, 0x31, 0x93, 0x7b, 0x68, 0x55, 0xbc, 0x03,
-	0x2d, 0x15, 0xae, 0x90, 0x54, 0x0a, 0x1b, 0xde, 0xff, 0x4b, 0x2f, 0xbc, 0x28, 0xa2, 0xb1, 0x92,
-	0x43, 0x6f, 0x66, 0xff, 0x0a, 0x3e, 0xd7, 0x60, 0x75, 0xe9, 0xe1, 0x5b, 0x67, 0x82, 0xfe, 0x7c,
-	0x26, 0xb5, 0x5f, 0x9d, 0xc9, 0xad, 0xf4, 0xdd, 0xdf, 0xa4, 0xff, 0x8f, 0x73, 0xb5, 0xd7, 0x68,
-	0x72, 0xad, 0xeb, 0x5c, 0xc7, 0xb6, 0x85, 0x37, 0xa1, 0x47, 0xa7, 0x72, 0x7e, 0xcc, 0x26, 0xc6,
-	0xc2, 0x2a, 0xdc, 0xae, 0xe9, 0x1a, 0x7f, 0x45, 0xf0, 0x01, 0x81, 0x57, 0x39, 0xf7, 0x37, 0xde,
-	0xd8, 0xf1, 0x89, 0x36, 0x42, 0x68, 0x83, 0xdc, 0xb0, 0x63, 0x9a, 0xda, 0x6a, 0xa1, 0x96, 0x93,
-	0x5c, 0xd2, 0x45, 0xc5, 0xb8, 0xe6, 0xe8, 0x74, 0xcf, 0x22, 0x01, 0x78, 0xd7, 0xbb, 0xaf, 0x98,
-	0xbb, 0xaa, 0xea, 0xfe, 0x2e, 0xac, 0xaa, 0x54, 0x0f, 0x79, 0x36, 0x4e, 0x69, 0x26, 0x66, 0x5c,
-	0x19, 0xd3, 0xcc, 0x75, 0xc8, 0xc2, 0x47, 0xeb, 0xee, 0x1d, 0x67, 0x52, 0xc9, 0xfd, 0x27, 0xd0,
-	0xb3, 0xc3, 0x21, 0x7b, 0x5b, 0x30, 0x21, 0xf1, 0x1a, 0xb4, 0x13, 0x7a, 0x3a, 0xb9, 0x99, 0x57,
-	0x4e, 0x41, 0x42, 0x4f, 0xcd, 0xd9, 0x88, 0xed, 0x57, 0xd7, 0xef, 0x85, 0x2c, 0xe3, 0xb9, 0x64,
-	0x39, 0xde, 0x85, 0xde, 0x7e, 0x91, 0xe7, 0x2c, 0x95, 0x56, 0xc1, 0xb8, 0x7a, 0xf0, 0xe6, 0xcb,
-	0xc1, 0x7f, 0xb7, 0x7a, 0xd5, 0xaa, 0x7d, 0x67, 0x6f, 0xe7, 0xec, 0x82, 0x38, 0xe7, 0x17, 0xc4,
-	0xb9, 0xba, 0x20, 0xe8, 0x7d, 0x49, 0xd0, 0x97, 0x92, 0xa0, 0xaf, 0x25, 0x41, 0x67, 0x25, 0x41,
-	0xdf, 0x4b, 0x82, 0x7e, 0x94, 0xc4, 0xb9, 0x2a, 0x09, 0xfa, 0x78, 0x49, 0x9c, 0xb3, 0x4b, 0xe2,
-	0x9c, 0x5f, 0x12, 0x27, 0x6a, 0xe8, 0x9f, 0xbd, 0xa7, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x49,
-	0xf4, 0xca, 0xc4, 0x41, 0x05, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3d, 0x6f, 0x23, 0x45,
+	0x18, 0xf6, 0x78, 0xe3, 0xf5, 0xee, 0xbb, 0xfe, 0x62, 0x90, 0x60, 0x65, 0xa4, 0xbd, 0x9c, 0xe1,
+	0x20, 0x05, 0xb2, 0x50, 0x88, 0x44, 0x41, 0xe5, 0x9c, 0x8d, 0x6e, 0x75, 0x8e, 0x23, 0x8d, 0x13,
+	0x51, 0xae, 0x66, 0xbd, 0xc3, 0xc5, 0xc2, 0xbb, 0x6b, 0x76, 0x66, 0xcf, 0x47, 0x81, 0x44, 0x43,
+	0xcf, 0x4f, 0xa0, 0xa4, 0xe6, 0x57, 0x50, 0xa6, 0xe3, 0x4a, 0xe2, 0x34, 0x94, 0x57, 0x52, 0xa2,
+	0xf9, 0xd8, 0x24, 0x58, 0x21, 0xd0, 0xd0, 0xcd, 0xfb, 0x3c, 0x8f, 0xe7, 0xfd, 0x7a, 0x66, 0x0d,
+	0xf0, 0xd5, 0x2a, 0xdf, 0x0c, 0xd7, 0x45, 0x2e, 0x72, 0x5c, 0x5f, 0xc7, 0x7d, 0x28, 0x57, 0xcb,
+	0x44, 0xc7, 0xfd, 0xae, 0x58, 0xa6, 0x8c, 0x0b, 0x9a, 0xae, 0x0d, 0xe0, 0xad, 0x68, 0xcc, 0x56,
+	0x26, 0x68, 0xd3, 0xc5, 0x22, 0x2f, 0x33, 0xa1, 0xc3, 0xc1, 0x6f, 0x7b, 0x00, 0x5f, 0xac, 0xf2,
+	0xcd, 0x5c, 0x14, 0x8c, 0xa6, 0xf8, 0x31, 0x34, 0xe5, 0xcd, 0xd1, 0x32, 0xf1, 0xd1, 0x3e, 0x3a,
+	0xf0, 0x0e, 0x9d, 0xe1, 0x3a, 0x1e, 0x9e, 0x4f, 0xc3, 0x31, 0xb1, 0x25, 0x11, 0x26, 0xf8, 0x11,
+	0xd8, 0x17, 0x65, 0x2c, 0x15, 0xf5, 0x1d, 0x45, 0xe3, 0xa2, 0x8c, 0xc3, 0x04, 0xbf, 0x0f, 0x0e,
+	0x7d, 0xc1, 0x32, 0x21, 0x25, 0xd6, 0x8e, 0xa4, 0xa9, 0x98, 0x30, 0xc1, 0x1f, 0x01, 0x70, 0x56,
+	0xbc, 0x5c, 0x2e, 0x98, 0x94, 0xed, 0xed, 0xc8, 0x5c, 0xc3, 0x85, 0x09, 0x7e, 0x02, 0x4d, 0x53,
+	0xb1, 0xdf, 0x50, 0x2a, 0x4f, 0xaa, 0x46, 0x1a, 0x22, 0x15, 0x87, 0x3f, 0x00, 0x5b, 0x75, 0xc9,
+	0x7d, 0x5b, 0xa9, 0x5a, 0x52, 0x35, 0x95, 0xc8, 0x9c, 0x09, 0x62, 0x38, 0xfc, 0x31, 0x00, 0x17,
+	0xb4, 0x10, 0x2c, 0x89, 0xa8, 0xf0, 0x41, 0x29, 0xdb, 0x52, 0x79, 0x56, 0x8d, 0x8c, 0xb8, 0x46,
+	0x30, 0x12, 0xf8, 0x00, 0x1c, 0x96, 0x25, 0x5a, 0xeb, 0xdd, 0xa7, 0x6d, 0x2a, 0x7a, 0x24, 0xf0,
+	0x63, 0x68, 0x65, 0x65, 0x1a, 0xa5, 0x8c, 0x73, 0xfa, 0x82, 0x71, 0xbf, 0xb5, 0x8f, 0x0e, 0x2c,
+	0xe2, 0x65, 0x65, 0x7a, 0x62, 0x20, 0xfc, 0x1e, 0xb8, 0x52, 0x12, 0x7f, 0x2b, 0x18, 0xf7, 0xdb,
+	0x8a, 0x77, 0xb2, 0x32, 0x3d, 0x96, 0x31, 0xee, 0x83, 0x93, 0x94, 0x05, 0x15, 0xcb, 0x3c, 0xf3,
+	0x3b, 0x9a, 0xab, 0x62, 0xfc, 0x19, 0x00, 0xcb, 0x92, 0xa8, 0x60, 0x94, 0xe7, 0x99, 0xdf, 0xdd,
+	0x47, 0x07, 0x9d, 0x43, 0x5f, 0xd6, 0x71, 0xbb, 0xb6, 0xe1, 0x24, 0x4b, 0x88, 0xe2, 0x89, 0xcb,
+	0xaa, 0xe3, 0xe0, 0x3b, 0x70, 0x6f, 0x70, 0xdc, 0x05, 0x6f, 0x32, 0x1b, 0x47, 0xe7, 0xb3, 0xe7,
+	0xb3, 0xd3, 0x2f, 0x67, 0xbd, 0x1a, 0xee, 0x00, 0x48, 0x60, 0x76, 0x4a, 0x4e, 0x46, 0xd3, 0x1e,
+	0xc2, 0x6d, 0x70, 0x65, 0x3c, 0x21, 0xe4, 0x94, 0xf4, 0xea, 0xf8, 0x2d, 0x68, 0xcb, 0xf0, 0xd9,
+	0xf9, 0x71, 0x34, 0x26, 0xa3, 0x70, 0xd6, 0xb3, 0xaa, 0x2b, 0xce, 0xc2, 0x93, 0xc9, 0xe9, 0xf9,
+	0x59, 0x6f, 0xaf, 0xba, 0xe2, 0x79, 0x38, 0x9d, 0x4e, 0xc6, 0xbd, 0x06, 0x6e, 0x81, 0x23, 0xe3,
+	0x70, 0x3c, 0x9d, 0xf4, 0xec, 0xc1, 0x9f, 0xc6, 0x59, 0x84, 0x2d, 0xf2, 0x22, 0xc1, 0x47, 0xd0,
+	0x50, 0xbb, 0x37, 0xbe, 0x0a, 0xaa, 0x0e, 0x34, 0x3d, 0x1c, 0x49, 0xee, 0x69, 0x9e, 0x65, 0x6c,
+	0x21, 0xbb, 0x26, 0x5a, 0x8c, 0x3f, 0x04, 0x9b, 0xab, 0x16, 0x8d, 0xd9, 0x3a, 0x7f, 0x6f, 0x9c,
+	0x18, 0x16, 0x1f, 0x81, 0x2b, 0x4d, 0xc9, 0x05, 0x15, 0xdc, 0x98, 0xee, 0xdd, 0x9d, 0x0c, 0xcf,
+	0xca, 0x78, 0x2e, 0x69, 0xe2, 0x5c, 0x98, 0x53, 0xff, 0xa7, 0x3a, 0x74, 0x77, 0x12, 0xdf, 0xb1,
+	0x37, 0xfa, 0x77, 0x7b, 0xd7, 0xff, 0xc9, 0xde, 0x77, 0x5c, 0x6b, 0x3d, 0xe0, 0xda, 0xff, 0xd9,
+	0x8f, 0xe6, 0x15, 0x69, 0x3f, 0x36, 0x94, 0x1f, 0xe7, 0x06, 0xc2, 0x4f, 0xa0, 0x43, 0x17, 0x62,
+	0xf9, 0x92, 0x45, 0x7a, 0x84, 0x95, 0x29, 0xdb, 0x1a, 0xd5, 0xf3, 0xe5, 0xfd, 0x5f, 0x10, 0x38,
+	0xd5, 0xe4, 0xfe, 0xcb, 0x6c, 0xcc, 0xcf, 0x23, 0x35, 0x08, 0xae, 0x06, 0x64, 0x91, 0x96, 0x06,
+	0xd5, 0xa8, 0xb9, 0x2c, 0x4e, 0xe4, 0x82, 0xae, 0x2a, 0x8d, 0xa5, 0x1f, 0x8b, 0xc2, 0x8c, 0xa4,
+	0x0f, 0xce, 0x4d, 0xed, 0x7b, 0xfa, 0x3d, 0x54, 0xb1, 0xfc, 0xb9, 0xc9, 0x21, 0x3f, 0x48, 0x5c,
+	0x7d, 0x15, 0x2c, 0xe2, 0x69, 0x4c, 0xee, 0x9b, 0x0f, 0x7e, 0x40, 0xd0, 0x95, 0xa7, 0xb3, 0x7c,
+	0x3d, 0xcf, 0xe8, 0x9a, 0x5f, 0xe4, 0x72, 0x78, 0xcd, 0x42, 0x19, 0x81, 0xfb, 0x68, 0xdf, 0xba,
+	0xc7, 0x4a, 0x15, 0x8d, 0xdf, 0x01, 0x7b, 0xb3, 0xcc, 0x92, 0x7c, 0xa3, 0xaa, 0x77, 0x89, 0x89,
+	0xf0, 0x27, 0xd0, 0x8a, 0xcb, 0xc5, 0xd7, 0x4c, 0x44, 0x6a, 0x25, 0xbe, 0x75, 0xdf, 0x0a, 0x3c,
+	0x2d, 0x99, 0x4b, 0xc5, 0x20, 0x84, 0x8e, 0x29, 0x83, 0xb0, 0x6f, 0x4a, 0xc6, 0x05, 0x7e, 0x04,
+	0x5e, 0x4a, 0x5f, 0x45, 0xb7, 0x95, 0xc8, 0xbd, 0x40, 0x4a, 0x5f, 0x91, 0x87, 0x93, 0x1f, 0xce,
+	0x6e, 0x3a, 0x22, 0x6c, 0x9d, 0x17, 0x82, 0x15, 0xf8, 0x73, 0xe8, 0x3c, 0x2d, 0x8b, 0x82, 0x65,
+	0xc2, 0x30, 0x18, 0x57, 0x2d, 0xdd, 0x66, 0xec, 0xbf, 0x7d, 0x07, 0xab, 0x86, 0x31, 0xa8, 0x1d,
+	0x1f, 0x5d, 0x5e, 0x05, 0xb5, 0xd7, 0x57, 0x41, 0xed, 0xcd, 0x55, 0x80, 0xbe, 0xdf, 0x06, 0xe8,
+	0xe7, 0x6d, 0x80, 0x7e, 0xdd, 0x06, 0xe8, 0x72, 0x1b, 0xa0, 0xdf, 0xb7, 0x01, 0xfa, 0x63, 0x1b,
+	0xd4, 0xde, 0x6c, 0x03, 0xf4, 0xe3, 0x75, 0x50, 0xbb, 0xbc, 0x0e, 0x6a, 0xaf, 0xaf, 0x83, 0x5a,
+	0x6c, 0xab, 0x3f, 0x8d, 0x4f, 0xff, 0x1a, 0x00, 0x75, 0xe8, 0xd9, 0x08, 0x7f, 0x06, 0x00, 0x00,
+}
+
+func (x FlowStream_EndReason) String() string {
+	s, ok := FlowStream_EndReason_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (this *FlowStream) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -562,6 +657,9 @@ func (this *FlowStream) Equal(that interface{}) bool {
 	if this.Duration != that1.Duration {
 		return false
 	}
+	if this.EndReason != that1.EndReason {
+		return false
+	}
 	return true
 }
 func (this *FlowRecord) Equal(that interface{}) bool {
@@ -667,6 +765,9 @@ func (this *FlowRecord_HubStats) Equal(that interface{}) bool {
 	if this.Services != that1.Services {
 		return false
 	}
+	if this.ActiveFlows != that1.ActiveFlows {
+		return false
+	}
 	return true
 }
 func (this *FlowTopSnapshot) Equal(that interface{}) bool {
@@ -696,6 +797,12 @@ func (this *FlowTopSnapshot) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if !this.BucketStart.Equal(that1.BucketStart) {
+		return false
+	}
 	return true
 }
 func (this *FlowTopRequest) Equal(that interface{}) bool {
@@ -720,13 +827,16 @@ func (this *FlowTopRequest) Equal(that interface{}) bool {
 	if this.MaxRecords != that1.MaxRecords {
 		return false
 	}
+	if this.Window != that1.Window {
+		return false
+	}
 	return true
 }
 func (this *FlowStream) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&pb.FlowStream{")
 	if this.FlowId != nil {
 		s = append(s, "FlowId: "+fmt.Sprintf("%#v", this.FlowId)+",\n")
@@ -755,6 +865,7 @@ func (this *FlowStream) GoString() string {
 	s = append(s, "NumMessages: "+fmt.Sprintf("%#v", this.NumMessages)+",\n")
 	s = append(s, "NumBytes: "+fmt.Sprintf("%#v", this.NumBytes)+",\n")
 	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "EndReason: "+fmt.Sprintf("%#v", this.EndReason)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -806,7 +917,7 @@ func (this *FlowRecord_HubStats) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.FlowRecord_HubStats{")
 	if this.HubId != nil {
 		s = append(s, "HubId: "+fmt.Sprintf("%#v", this.HubId)+",\n")
@@ -814,6 +925,7 @@ func (this *FlowRecord_HubStats) GoString() string {
 	s = append(s, "ActiveAgents: "+fmt.Sprintf("%#v", this.ActiveAgents)+",\n")
 	s = append(s, "TotalAgents: "+fmt.Sprintf("%#v", this.TotalAgents)+",\n")
 	s = append(s, "Services: "+fmt.Sprintf("%#v", this.Services)+",\n")
+	s = append(s, "ActiveFlows: "+fmt.Sprintf("%#v", this.ActiveFlows)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -821,11 +933,15 @@ func (this *FlowTopSnapshot) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 7)
 	s = append(s, "&pb.FlowTopSnapshot{")
 	if this.Records != nil {
 		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
 	}
+	s = append(s, "Window: "+fmt.Sprintf("%#v", this.Window)+",\n")
+	if this.BucketStart != nil {
+		s = append(s, "BucketStart: "+fmt.Sprintf("%#v", this.BucketStart)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -833,9 +949,10 @@ func (this *FlowTopRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&pb.FlowTopRequest{")
 	s = append(s, "MaxRecords: "+fmt.Sprintf("%#v", this.MaxRecords)+",\n")
+	s = append(s, "Window: "+fmt.Sprintf("%#v", this.Window)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -948,6 +1065,11 @@ func (m *FlowStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndReason != 0 {
+		i = encodeVarintFlow(dAtA, i, uint64(m.EndReason))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Duration != 0 {
 		i = encodeVarintFlow(dAtA, i, uint64(m.Duration))
 		i--
@@ -1234,6 +1356,11 @@ func (m *FlowRecord_HubStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActiveFlows != 0 {
+		i = encodeVarintFlow(dAtA, i, uint64(m.ActiveFlows))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Services != 0 {
 		i = encodeVarintFlow(dAtA, i, uint64(m.Services))
 		i--
@@ -1284,6 +1411,25 @@ func (m *FlowTopSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BucketStart != nil {
+		{
+			size, err := m.BucketStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFlow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintFlow(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Records) > 0 {
 		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1321,6 +1467,13 @@ func (m *FlowTopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintFlow(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MaxRecords != 0 {
 		i = encodeVarintFlow(dAtA, i, uint64(m.MaxRecords))
 		i--
@@ -1387,6 +1540,9 @@ func (m *FlowStream) Size() (n int) {
 	if m.Duration != 0 {
 		n += 1 + sovFlow(uint64(m.Duration))
 	}
+	if m.EndReason != 0 {
+		n += 1 + sovFlow(uint64(m.EndReason))
+	}
 	return n
 }
 
@@ -1465,6 +1621,9 @@ func (m *FlowRecord_HubStats) Size() (n int) {
 	if m.Services != 0 {
 		n += 1 + sovFlow(uint64(m.Services))
 	}
+	if m.ActiveFlows != 0 {
+		n += 1 + sovFlow(uint64(m.ActiveFlows))
+	}
 	return n
 }
 
@@ -1480,6 +1639,14 @@ func (m *FlowTopSnapshot) Size() (n int) {
 			n += 1 + l + sovFlow(uint64(l))
 		}
 	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovFlow(uint64(l))
+	}
+	if m.BucketStart != nil {
+		l = m.BucketStart.Size()
+		n += 1 + l + sovFlow(uint64(l))
+	}
 	return n
 }
 
@@ -1492,6 +1659,10 @@ func (m *FlowTopRequest) Size() (n int) {
 	if m.MaxRecords != 0 {
 		n += 1 + sovFlow(uint64(m.MaxRecords))
 	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovFlow(uint64(l))
+	}
 	return n
 }
 
@@ -1517,6 +1688,7 @@ func (this *FlowStream) String() string {
 		`NumMessages:` + fmt.Sprintf("%v", this.NumMessages) + `,`,
 		`NumBytes:` + fmt.Sprintf("%v", this.NumBytes) + `,`,
 		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`EndReason:` + fmt.Sprintf("%v", this.EndReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1558,6 +1730,7 @@ func (this *FlowRecord_HubStats) String() string {
 		`ActiveAgents:` + fmt.Sprintf("%v", this.ActiveAgents) + `,`,
 		`TotalAgents:` + fmt.Sprintf("%v", this.TotalAgents) + `,`,
 		`Services:` + fmt.Sprintf("%v", this.Services) + `,`,
+		`ActiveFlows:` + fmt.Sprintf("%v", this.ActiveFlows) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1573,6 +1746,8 @@ func (this *FlowTopSnapshot) String() string {
 	repeatedStringForRecords += "}"
 	s := strings.Join([]string{`&FlowTopSnapshot{`,
 		`Records:` + repeatedStringForRecords + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`BucketStart:` + strings.Replace(fmt.Sprintf("%v", this.BucketStart), "Timestamp", "Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1583,6 +1758,7 @@ func (this *FlowTopRequest) String() string {
 	}
 	s := strings.Join([]string{`&FlowTopRequest{`,
 		`MaxRecords:` + fmt.Sprintf("%v", this.MaxRecords) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1969,6 +2145,25 @@ func (m *FlowStream) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndReason", wireType)
+			}
+			m.EndReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndReason |= FlowStream_EndReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlow(dAtA[iNdEx:])
@@ -2547,6 +2742,25 @@ func (m *FlowRecord_HubStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFlows", wireType)
+			}
+			m.ActiveFlows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveFlows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlow(dAtA[iNdEx:])
@@ -2634,6 +2848,74 @@ func (m *FlowTopSnapshot) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketStart == nil {
+				m.BucketStart = &Timestamp{}
+			}
+			if err := m.BucketStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlow(dAtA[iNdEx:])
@@ -2706,6 +2988,38 @@ func (m *FlowTopRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlow(dAtA[iNdEx:])
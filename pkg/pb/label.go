@@ -11,6 +11,103 @@ import (
 	"github.com/lib/pq"
 )
 
+const (
+	// MaxLabelNameLength is the longest label name that Validate accepts.
+	MaxLabelNameLength = 63
+
+	// MaxLabelValueLength is the longest label value that Validate accepts,
+	// long enough to hold a full DNS name.
+	MaxLabelValueLength = 253
+)
+
+// Validate checks that every label in the set is well formed:
+//
+// Names are 1 to MaxLabelNameLength bytes of lowercase ASCII letters,
+// digits, '.', '_' and '-', and may be prefixed with ':' for labels the
+// system assigns, such as :hostname.
+//
+// Values may be empty, are at most MaxLabelValueLength bytes and are made
+// of printable lowercase ASCII. They can't contain spaces or ',', which
+// separates labels when a set is flattened.
+//
+// The returned error identifies the offending label.
+func (ls *LabelSet) Validate() error {
+	if ls == nil || len(ls.Labels) == 0 {
+		return fmt.Errorf("no labels given")
+	}
+
+	for _, lbl := range ls.Labels {
+		if err := validateLabelName(lbl.Name); err != nil {
+			return fmt.Errorf("label name %q: %s", lbl.Name, err)
+		}
+
+		if err := validateLabelValue(lbl.Value); err != nil {
+			return fmt.Errorf("label %q value %q: %s", lbl.Name, lbl.Value, err)
+		}
+	}
+
+	return nil
+}
+
+// Normalize lowercases the names and values in the set and trims the
+// spaces around them. Labels compare without regard to case, so this
+// doesn't change what the set matches, but lets sets written before
+// Validate existed pass it.
+func (ls *LabelSet) Normalize() {
+	if ls == nil {
+		return
+	}
+
+	for _, lbl := range ls.Labels {
+		lbl.Name = strings.ToLower(strings.TrimSpace(lbl.Name))
+		lbl.Value = strings.ToLower(strings.TrimSpace(lbl.Value))
+	}
+}
+
+func validateLabelName(name string) error {
+	name = strings.TrimPrefix(name, ":")
+
+	if name == "" {
+		return fmt.Errorf("must not be empty")
+	}
+
+	if len(name) > MaxLabelNameLength {
+		return fmt.Errorf("longer than %d bytes", MaxLabelNameLength)
+	}
+
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			// ok
+		case r >= 'A' && r <= 'Z':
+			return fmt.Errorf("must be lowercase")
+		default:
+			return fmt.Errorf("invalid character %q", r)
+		}
+	}
+
+	return nil
+}
+
+func validateLabelValue(val string) error {
+	if len(val) > MaxLabelValueLength {
+		return fmt.Errorf("longer than %d bytes", MaxLabelValueLength)
+	}
+
+	for _, r := range val {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			return fmt.Errorf("must be lowercase")
+		case r == ',':
+			return fmt.Errorf("must not contain ','")
+		case r <= ' ' || r >= 0x7f:
+			return fmt.Errorf("invalid character %q", r)
+		}
+	}
+
+	return nil
+}
+
 func ParseLabelSet(s string) *LabelSet {
 	var ls LabelSet
 
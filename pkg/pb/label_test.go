@@ -1,6 +1,7 @@
 package pb
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,4 +67,41 @@ func TestLabelSet(t *testing.T) {
 		assert.Equal(t, "env", ls.Labels[0].Name)
 	})
 
+	t.Run("validates label names and values", func(t *testing.T) {
+		require.NoError(t, ParseLabelSet(":hostname=foo.com,env=test,canary").Validate())
+
+		bad := []*LabelSet{
+			nil,
+			{},
+			{Labels: []*Label{{Name: "", Value: "x"}}},
+			{Labels: []*Label{{Name: ":", Value: "x"}}},
+			{Labels: []*Label{{Name: "Service", Value: "www"}}},
+			{Labels: []*Label{{Name: "service ", Value: "www"}}},
+			{Labels: []*Label{{Name: "service", Value: "www "}}},
+			{Labels: []*Label{{Name: "service", Value: "WWW"}}},
+			{Labels: []*Label{{Name: "service", Value: "a,b"}}},
+			{Labels: []*Label{{Name: "service", Value: "a\nb"}}},
+			{Labels: []*Label{{Name: "service", Value: "ü"}}},
+			{Labels: []*Label{{Name: strings.Repeat("a", MaxLabelNameLength+1)}}},
+			{Labels: []*Label{{Name: "host", Value: strings.Repeat("a", MaxLabelValueLength+1)}}},
+		}
+
+		for _, ls := range bad {
+			assert.Error(t, ls.Validate(), "%#v", ls)
+		}
+
+		err := (&LabelSet{Labels: []*Label{{Name: "service", Value: "www "}}}).Validate()
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), `"service"`)
+	})
+
+	t.Run("normalizes case and surrounding spaces", func(t *testing.T) {
+		ls := &LabelSet{Labels: []*Label{{Name: " Service", Value: "WWW "}, {Name: "canary"}}}
+		ls.Normalize()
+
+		require.NoError(t, ls.Validate())
+		assert.Equal(t, "service", ls.Labels[0].Name)
+		assert.Equal(t, "www", ls.Labels[0].Value)
+	})
 }
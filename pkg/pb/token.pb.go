@@ -87,18 +87,21 @@ const (
 	BLAKE2HMAC Signature_SigType = 0
 	ED25519    Signature_SigType = 1
 	EXTERNAL   Signature_SigType = 2
+	ECDSA_P256 Signature_SigType = 3
 )
 
 var Signature_SigType_name = map[int32]string{
 	0: "BLAKE2HMAC",
 	1: "ED25519",
 	2: "EXTERNAL",
+	3: "ECDSA_P256",
 }
 
 var Signature_SigType_value = map[string]int32{
 	"BLAKE2HMAC": 0,
 	"ED25519":    1,
 	"EXTERNAL":   2,
+	"ECDSA_P256": 3,
 }
 
 func (Signature_SigType) EnumDescriptor() ([]byte, []int) {
@@ -422,47 +425,48 @@ func init() {
 func init() { proto.RegisterFile("token.proto", fileDescriptor_3aff0bcd502840ab) }
 
 var fileDescriptor_3aff0bcd502840ab = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0xf6, 0x3a, 0x09, 0x4e, 0xc6, 0x10, 0xac, 0xfd, 0xfd, 0x90, 0x2c, 0x54, 0xb9, 0x69, 0xd4,
-	0xaa, 0x11, 0xa8, 0xa1, 0x4d, 0xe1, 0xd0, 0x43, 0x0f, 0x8e, 0x31, 0x01, 0x41, 0x42, 0xb5, 0x09,
-	0xb4, 0x37, 0xb4, 0xc1, 0xdb, 0x74, 0x15, 0x13, 0x5b, 0xb1, 0x83, 0x94, 0x5b, 0x4f, 0x3d, 0xf7,
-	0x31, 0xfa, 0x28, 0xdc, 0xca, 0x91, 0x53, 0x55, 0xcc, 0xa5, 0x47, 0x1e, 0xa0, 0x87, 0xca, 0x6b,
-	0xc7, 0x09, 0xed, 0x6d, 0xfe, 0x7c, 0x33, 0xf3, 0xcd, 0x37, 0xbb, 0xa0, 0x86, 0xde, 0x90, 0x8d,
-	0xea, 0xfe, 0xd8, 0x0b, 0x3d, 0x2c, 0xfb, 0xfd, 0xf5, 0x55, 0x87, 0x7d, 0x0c, 0xb6, 0x06, 0xde,
-	0xc0, 0x4b, 0x82, 0xeb, 0xab, 0x21, 0xbf, 0x60, 0x41, 0x48, 0x2f, 0xfc, 0x34, 0x50, 0x1c, 0x5e,
-	0xa6, 0x16, 0x4c, 0x5c, 0xee, 0xa4, 0xf6, 0x0a, 0x3d, 0x3f, 0xf7, 0x26, 0xa3, 0x30, 0x71, 0xab,
-	0x5b, 0xa0, 0xec, 0x33, 0xea, 0xb0, 0x71, 0x80, 0x9f, 0x82, 0xf2, 0x29, 0x31, 0x75, 0x54, 0xc9,
-	0xd5, 0xd4, 0x06, 0xd4, 0xfd, 0x7e, 0xfd, 0xf0, 0xf4, 0x1d, 0xe5, 0x63, 0x32, 0x4b, 0x55, 0xbf,
-	0x23, 0x28, 0x75, 0xf9, 0x60, 0x44, 0xc3, 0xc9, 0x98, 0xe1, 0x47, 0x50, 0x0a, 0x66, 0x8e, 0x8e,
-	0x2a, 0xa8, 0xb6, 0x4c, 0xe6, 0x01, 0xfc, 0x12, 0x8a, 0x01, 0x1f, 0x9c, 0x85, 0x53, 0x9f, 0xe9,
-	0x72, 0x05, 0xd5, 0xca, 0x8d, 0xb5, 0xb8, 0x65, 0x56, 0x1e, 0x5b, 0xbd, 0xa9, 0xcf, 0x88, 0x12,
-	0x24, 0x06, 0x5e, 0x83, 0xa5, 0x21, 0x9b, 0x9e, 0x71, 0x47, 0xcf, 0x55, 0x50, 0xad, 0x44, 0x0a,
-	0x43, 0x36, 0x3d, 0x70, 0xf0, 0xb3, 0x39, 0xb5, 0x7c, 0x05, 0xd5, 0xd4, 0x86, 0x1a, 0xf7, 0x49,
-	0x89, 0xcf, 0xb9, 0x6d, 0x83, 0x92, 0x76, 0xc4, 0x65, 0x80, 0xe6, 0x91, 0x79, 0x68, 0x37, 0xf6,
-	0xdb, 0xa6, 0xa5, 0x49, 0x58, 0x05, 0xc5, 0xde, 0x6d, 0xec, 0xec, 0xbc, 0x7a, 0xa3, 0x21, 0xbc,
-	0x0c, 0x45, 0xfb, 0x43, 0xcf, 0x26, 0x1d, 0xf3, 0x48, 0x93, 0xab, 0xef, 0x61, 0xb5, 0x17, 0x8b,
-	0x6b, 0x51, 0x9f, 0xf6, 0xb9, 0xcb, 0xc3, 0x29, 0xae, 0x03, 0x9c, 0x67, 0x9e, 0xd8, 0xab, 0xdc,
-	0x28, 0xc7, 0x23, 0xe7, 0x18, 0xb2, 0x80, 0xc0, 0xff, 0x43, 0xe1, 0x92, 0xba, 0x93, 0x64, 0xcb,
-	0x12, 0x49, 0x9c, 0xea, 0x6f, 0x19, 0x0a, 0xa2, 0x33, 0xc6, 0x90, 0xef, 0x7b, 0xce, 0x34, 0x55,
-	0x48, 0xd8, 0xf8, 0x39, 0x14, 0x2f, 0x58, 0x48, 0x1d, 0x1a, 0x52, 0x51, 0xf6, 0xd7, 0x52, 0x59,
-	0x12, 0xbf, 0x00, 0xc8, 0x24, 0x0d, 0xf4, 0x9c, 0x38, 0xcd, 0xca, 0x03, 0x1d, 0xc9, 0x02, 0x60,
-	0xfd, 0x8b, 0x0c, 0xf9, 0x66, 0x3c, 0xe0, 0x09, 0xe4, 0xc7, 0x9e, 0xcb, 0x52, 0xfa, 0xa2, 0x42,
-	0xb0, 0x21, 0x9e, 0xcb, 0x88, 0x48, 0x61, 0x1d, 0x64, 0xee, 0xa4, 0xd3, 0x8b, 0x31, 0xe0, 0xe4,
-	0xe8, 0x60, 0x97, 0xc8, 0x5c, 0x28, 0x9e, 0x3e, 0x14, 0x71, 0x89, 0x94, 0x9c, 0x99, 0x84, 0xc8,
-	0x2c, 0x87, 0xeb, 0xa0, 0x5e, 0x52, 0x97, 0x3b, 0x67, 0x93, 0x51, 0xc8, 0xdd, 0xf4, 0x38, 0xc9,
-	0xa8, 0xd9, 0x6b, 0x24, 0x20, 0x10, 0x27, 0x31, 0x00, 0xbf, 0x85, 0xe5, 0x4c, 0x36, 0xce, 0x02,
-	0xbd, 0x20, 0xb6, 0xf9, 0x2f, 0xe3, 0x36, 0xd7, 0xb7, 0x99, 0xbf, 0xfa, 0xf1, 0x58, 0x22, 0x0f,
-	0xe0, 0x78, 0x13, 0x80, 0x3a, 0x0e, 0x0f, 0xb9, 0x37, 0xa2, 0xae, 0x0e, 0xff, 0xaa, 0xb6, 0x90,
-	0xde, 0xd8, 0x03, 0x58, 0x38, 0xa9, 0x0a, 0x8a, 0x75, 0xdc, 0xe9, 0xd8, 0x56, 0x4f, 0x93, 0x70,
-	0x09, 0x0a, 0x5d, 0x9b, 0x9c, 0xda, 0x1a, 0xc2, 0x00, 0x4b, 0xa6, 0x65, 0xd9, 0xdd, 0xae, 0x26,
-	0xe3, 0x22, 0xe4, 0xdb, 0xad, 0x76, 0x4f, 0xcb, 0xc5, 0x51, 0xeb, 0xb8, 0xb3, 0x77, 0xd0, 0xd2,
-	0xf2, 0x1b, 0x9b, 0x50, 0xca, 0x74, 0x8b, 0x2b, 0xcd, 0x96, 0xdd, 0x89, 0x9b, 0x28, 0x90, 0xdb,
-	0x3f, 0x69, 0x26, 0x2d, 0xda, 0x66, 0xc7, 0x6c, 0xd9, 0x9a, 0xdc, 0xdc, 0xbe, 0xbe, 0x35, 0xa4,
-	0x9b, 0x5b, 0x43, 0xba, 0xbf, 0x35, 0xd0, 0xe7, 0xc8, 0x40, 0xdf, 0x22, 0x03, 0x5d, 0x45, 0x06,
-	0xba, 0x8e, 0x0c, 0xf4, 0x33, 0x32, 0xd0, 0xaf, 0xc8, 0x90, 0xee, 0x23, 0x03, 0x7d, 0xbd, 0x33,
-	0xa4, 0xeb, 0x3b, 0x43, 0xba, 0xb9, 0x33, 0xa4, 0xfe, 0x92, 0xf8, 0x8c, 0xaf, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xab, 0xc7, 0xc3, 0x51, 0xe6, 0x03, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0xf5, 0x38, 0x09, 0x4e, 0xae, 0x21, 0x58, 0xf3, 0x1e, 0x92, 0x85, 0x9e, 0xfc, 0xd2, 0xa8,
+	0x55, 0x23, 0x50, 0x43, 0xeb, 0x96, 0x4a, 0x5d, 0x74, 0xe1, 0x38, 0x26, 0x20, 0x48, 0x40, 0x93,
+	0x40, 0xbb, 0x8b, 0x26, 0x78, 0x9a, 0x8e, 0x62, 0x62, 0x2b, 0x76, 0x90, 0xb2, 0xeb, 0xaa, 0xeb,
+	0xfe, 0x8c, 0xfe, 0x14, 0x96, 0x2c, 0x59, 0xb5, 0xc5, 0x6c, 0xba, 0xe4, 0x07, 0x74, 0x51, 0xf9,
+	0x23, 0x4e, 0x10, 0xbb, 0xfb, 0x71, 0xe6, 0xdc, 0x73, 0xcf, 0xb5, 0x41, 0x0e, 0xdc, 0x11, 0x1b,
+	0xd7, 0xbd, 0x89, 0x1b, 0xb8, 0x58, 0xf4, 0x06, 0x9b, 0xeb, 0x36, 0xfb, 0xe4, 0xef, 0x0c, 0xdd,
+	0xa1, 0x9b, 0x14, 0x37, 0xd7, 0x03, 0x7e, 0xc1, 0xfc, 0x80, 0x5e, 0x78, 0x69, 0xa1, 0x38, 0xba,
+	0x4c, 0x23, 0x98, 0x3a, 0xdc, 0x4e, 0xe3, 0x35, 0x7a, 0x7e, 0xee, 0x4e, 0xc7, 0x41, 0x92, 0x56,
+	0x77, 0x40, 0xda, 0x67, 0xd4, 0x66, 0x13, 0x1f, 0x3f, 0x05, 0xe9, 0x73, 0x12, 0xaa, 0xa8, 0x92,
+	0xab, 0xc9, 0x3a, 0xd4, 0xbd, 0x41, 0xfd, 0xf0, 0xec, 0x84, 0xf2, 0x09, 0x99, 0xb7, 0xaa, 0x3f,
+	0x11, 0x94, 0xba, 0x7c, 0x38, 0xa6, 0xc1, 0x74, 0xc2, 0xf0, 0x7f, 0x50, 0xf2, 0xe7, 0x89, 0x8a,
+	0x2a, 0xa8, 0xb6, 0x4a, 0x16, 0x05, 0xfc, 0x12, 0x8a, 0x3e, 0x1f, 0xf6, 0x83, 0x99, 0xc7, 0x54,
+	0xb1, 0x82, 0x6a, 0x65, 0x7d, 0x23, 0xa2, 0xcc, 0x9e, 0x47, 0x51, 0x6f, 0xe6, 0x31, 0x22, 0xf9,
+	0x49, 0x80, 0x37, 0x60, 0x65, 0xc4, 0x66, 0x7d, 0x6e, 0xab, 0xb9, 0x0a, 0xaa, 0x95, 0x48, 0x61,
+	0xc4, 0x66, 0x07, 0x36, 0x7e, 0xb6, 0x90, 0x96, 0xaf, 0xa0, 0x9a, 0xac, 0xcb, 0x11, 0x4f, 0x2a,
+	0x7c, 0xa1, 0xad, 0x09, 0x52, 0xca, 0x88, 0xcb, 0x00, 0x8d, 0x23, 0xe3, 0xd0, 0xd2, 0xf7, 0xdb,
+	0x86, 0xa9, 0x08, 0x58, 0x06, 0xc9, 0x6a, 0xea, 0xbb, 0xbb, 0xaf, 0xde, 0x29, 0x08, 0xaf, 0x42,
+	0xd1, 0xfa, 0xd8, 0xb3, 0x48, 0xc7, 0x38, 0x52, 0xc4, 0x08, 0x6a, 0x99, 0xcd, 0xae, 0xd1, 0x3f,
+	0xd1, 0x77, 0xdf, 0x2a, 0xb9, 0xea, 0x07, 0x58, 0xef, 0x45, 0x66, 0x9b, 0xd4, 0xa3, 0x03, 0xee,
+	0xf0, 0x60, 0x86, 0xeb, 0x00, 0xe7, 0x59, 0x16, 0xef, 0x59, 0xd6, 0xcb, 0x91, 0x84, 0x05, 0x86,
+	0x2c, 0x21, 0xf0, 0xbf, 0x50, 0xb8, 0xa4, 0xce, 0x34, 0xd9, 0xba, 0x44, 0x92, 0xa4, 0xfa, 0x47,
+	0x84, 0x42, 0xcc, 0x8c, 0x31, 0xe4, 0x07, 0xae, 0x3d, 0x4b, 0x1d, 0x8b, 0x63, 0xfc, 0x1c, 0x8a,
+	0x17, 0x2c, 0xa0, 0x36, 0x0d, 0xa8, 0x2a, 0x3e, 0x5e, 0x32, 0x6b, 0xe2, 0x17, 0x00, 0x99, 0xc5,
+	0xbe, 0x9a, 0x8b, 0x4f, 0xb5, 0xf6, 0xc0, 0x57, 0xb2, 0x04, 0xd8, 0xfc, 0x2a, 0x42, 0xbe, 0x11,
+	0x0d, 0x78, 0x02, 0xf9, 0x89, 0xeb, 0xb0, 0x54, 0x7e, 0xfc, 0x22, 0x56, 0x43, 0x5c, 0x87, 0x91,
+	0xb8, 0x85, 0x55, 0x10, 0xb9, 0x9d, 0x4e, 0x2f, 0x46, 0x80, 0xd3, 0xa3, 0x83, 0x26, 0x11, 0x79,
+	0x7c, 0x81, 0xf4, 0xc3, 0x51, 0x73, 0x0b, 0x71, 0x46, 0x52, 0x22, 0xf3, 0x1e, 0xae, 0x83, 0x7c,
+	0x49, 0x1d, 0x6e, 0xf7, 0xa7, 0xe3, 0x80, 0x3b, 0xe9, 0xb1, 0x92, 0x51, 0xf3, 0xaf, 0x93, 0x40,
+	0x8c, 0x38, 0x8d, 0x00, 0xf8, 0x3d, 0xac, 0x66, 0xb6, 0x71, 0xe6, 0xab, 0x85, 0x78, 0x9b, 0x7f,
+	0x32, 0x6d, 0x0b, 0x7f, 0x1b, 0xf9, 0xab, 0x1f, 0xff, 0x0b, 0xe4, 0x01, 0x1c, 0x6f, 0x03, 0x50,
+	0xdb, 0xe6, 0x01, 0x77, 0xc7, 0xd4, 0x51, 0xe1, 0xb1, 0x6b, 0x4b, 0xed, 0xad, 0x3d, 0x80, 0xa5,
+	0x93, 0xca, 0x20, 0x99, 0xc7, 0x9d, 0x8e, 0x65, 0xf6, 0x14, 0x01, 0x97, 0xa0, 0xd0, 0xb5, 0xc8,
+	0x99, 0xa5, 0x20, 0x0c, 0xb0, 0x62, 0x98, 0xa6, 0xd5, 0xed, 0x2a, 0x22, 0x2e, 0x42, 0xbe, 0xdd,
+	0x6a, 0xf7, 0x94, 0x5c, 0x54, 0x35, 0x8f, 0x3b, 0x7b, 0x07, 0x2d, 0x25, 0xbf, 0xb5, 0x0d, 0xa5,
+	0xcc, 0xb7, 0xe8, 0xa5, 0xd1, 0xb2, 0x3a, 0x11, 0x89, 0x04, 0xb9, 0xfd, 0xd3, 0x46, 0x42, 0xd1,
+	0x36, 0x3a, 0x46, 0xcb, 0x52, 0xc4, 0xc6, 0x9b, 0xeb, 0x5b, 0x4d, 0xb8, 0xb9, 0xd5, 0x84, 0xfb,
+	0x5b, 0x0d, 0x7d, 0x09, 0x35, 0xf4, 0x3d, 0xd4, 0xd0, 0x55, 0xa8, 0xa1, 0xeb, 0x50, 0x43, 0xbf,
+	0x42, 0x0d, 0xfd, 0x0e, 0x35, 0xe1, 0x3e, 0xd4, 0xd0, 0xb7, 0x3b, 0x4d, 0xb8, 0xbe, 0xd3, 0x84,
+	0x9b, 0x3b, 0x4d, 0x18, 0xac, 0xc4, 0x3f, 0xe7, 0xeb, 0xbf, 0x03, 0x00, 0xc9, 0x7b, 0xc2, 0x8c,
+	0xf6, 0x03, 0x00, 0x00,
 }
 
 func (x Capability) String() string {
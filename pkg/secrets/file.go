@@ -0,0 +1,274 @@
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/pkg/errors"
+)
+
+var fileNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// File keeps signing keys and material as files in a directory, readable
+// only by the user running the server. It's meant for evaluations and
+// small deployments where running vault isn't worth it: anyone able to
+// read the directory can sign tokens.
+type File struct {
+	dir string
+
+	mu   sync.Mutex
+	keys map[string]crypto.Signer
+}
+
+// NewFile returns a backend storing its files under dir, which is created
+// if needed.
+func NewFile(dir string) (*File, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("the file secrets backend needs a directory")
+	}
+
+	for _, sub := range []string{"keys", "material"} {
+		err := os.MkdirAll(filepath.Join(dir, sub), 0700)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &File{
+		dir:  dir,
+		keys: make(map[string]crypto.Signer),
+	}, nil
+}
+
+func (f *File) path(kind, name, ext string) (string, error) {
+	if !fileNameRegexp.MatchString(name) {
+		return "", fmt.Errorf("invalid secret name: %s", name)
+	}
+
+	return filepath.Join(f.dir, kind, name+ext), nil
+}
+
+// Write data to path so that readers never see a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// The algorithm of a signing key.
+func keyAlgorithm(key crypto.Signer) (token.Algorithm, error) {
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return token.AlgorithmEd25519, nil
+	case *ecdsa.PrivateKey:
+		if k.Curve == elliptic.P256() {
+			return token.AlgorithmECDSAP256, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported signing key type: %T", key)
+}
+
+func generateKey(alg token.Algorithm) (crypto.Signer, error) {
+	switch alg {
+	case "", token.AlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	case token.AlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported token signing algorithm: %s", alg)
+	}
+}
+
+// The named key, created with alg if it doesn't exist, and used as is
+// otherwise.
+func (f *File) privateKey(name string, alg token.Algorithm) (crypto.Signer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if key, ok := f.keys[name]; ok {
+		return key, nil
+	}
+
+	path, err := f.path("keys", name, ".pem")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("signing key %s is not PEM encoded", name)
+		}
+
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing signing key %s", name)
+		}
+
+		key, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("signing key %s is not a signing key", name)
+		}
+
+		if _, err := keyAlgorithm(key); err != nil {
+			return nil, errors.Wrapf(err, "signing key %s", name)
+		}
+
+		f.keys[name] = key
+
+		return key, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := generateKey(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeFileAtomic(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		return nil, err
+	}
+
+	f.keys[name] = key
+
+	return key, nil
+}
+
+func (f *File) SigningKey(name string, alg token.Algorithm) (token.PublicKey, error) {
+	key, err := f.privateKey(name, alg)
+	if err != nil {
+		return token.PublicKey{}, err
+	}
+
+	keyAlg, err := keyAlgorithm(key)
+	if err != nil {
+		return token.PublicKey{}, err
+	}
+
+	if alg != "" && keyAlg != alg {
+		return token.PublicKey{}, fmt.Errorf("signing key %s is an %s key, not %s", name, keyAlg, alg)
+	}
+
+	if keyAlg == token.AlgorithmECDSAP256 {
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		if err != nil {
+			return token.PublicKey{}, err
+		}
+
+		return token.PublicKey{Algorithm: keyAlg, Key: der}, nil
+	}
+
+	return token.Ed25519Key(key.Public().(ed25519.PublicKey)), nil
+}
+
+func (f *File) Sign(name string, data []byte) ([]byte, error) {
+	key, err := f.privateKey(name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, data), nil
+	case *ecdsa.PrivateKey:
+		return token.SignECDSAP256(k, data)
+	default:
+		return nil, fmt.Errorf("unsupported signing key type: %T", key)
+	}
+}
+
+func (f *File) Read(name string) (map[string][]byte, error) {
+	path, err := f.path("material", name, ".json")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	var out map[string][]byte
+
+	err = json.Unmarshal(data, &out)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding %s", name)
+	}
+
+	return out, nil
+}
+
+func (f *File) Write(name string, data map[string][]byte) error {
+	path, err := f.path("material", name, ".json")
+	if err != nil {
+		return err
+	}
+
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, enc)
+}
+
+func (f *File) Delete(name string) error {
+	path, err := f.path("material", name, ".json")
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Package secrets abstracts where the token signing keys and the stored
+// TLS material are kept, so that a deployment can use vault or, for small
+// setups, a local directory.
+package secrets
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound is returned by Read when nothing is stored under the name.
+var ErrNotFound = errors.New("no secret stored under that name")
+
+// A Backend holds named token signing keys, which never leave it, and
+// named material such as certificates and their keys.
+type Backend interface {
+	// SigningKey returns the public half of the named key, creating the
+	// key first with alg if it doesn't exist. An existing key must be of
+	// alg, unless alg is empty, which accepts a key of any algorithm and
+	// creates ed25519 keys.
+	SigningKey(name string, alg token.Algorithm) (token.PublicKey, error)
+
+	// Sign returns the signature of data by the named key, in the form
+	// tokens carry for the key's algorithm.
+	Sign(name string, data []byte) ([]byte, error)
+
+	// Read returns the material stored under name, or ErrNotFound.
+	Read(name string) (map[string][]byte, error)
+
+	// Write replaces the material stored under name.
+	Write(name string, data map[string][]byte) error
+
+	// Delete removes the material stored under name. Deleting a name with
+	// nothing stored under it is not an error.
+	Delete(name string) error
+}
+
+// The backends that New knows by name.
+const (
+	BackendVault = "vault"
+	BackendFile  = "file"
+)
+
+// New returns the backend called kind. An empty kind selects vault, which
+// needs vc, while file needs dir.
+func New(kind string, vc *api.Client, dir string) (Backend, error) {
+	switch kind {
+	case "", BackendVault:
+		if vc == nil {
+			return nil, fmt.Errorf("the vault secrets backend needs a vault client")
+		}
+
+		return NewVault(vc), nil
+	case BackendFile:
+		return NewFile(dir)
+	default:
+		return nil, fmt.Errorf("unknown secrets backend: %s", kind)
+	}
+}
@@ -0,0 +1,134 @@
+package secrets
+
+import (
+	"crypto/ed25519"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackend(t *testing.T, b Backend) {
+	name := pb.NewULID().SpecString()
+
+	pub, err := b.SigningKey(name, token.AlgorithmEd25519)
+	require.NoError(t, err)
+	assert.Equal(t, token.AlgorithmEd25519, pub.Algorithm)
+
+	again, err := b.SigningKey(name, "")
+	require.NoError(t, err)
+	assert.Equal(t, pub, again)
+
+	sig, err := b.Sign(name, []byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pub.Key, []byte("hello"), sig))
+
+	_, err = b.SigningKey(name, token.AlgorithmECDSAP256)
+	assert.Error(t, err)
+
+	ecName := pb.NewULID().SpecString()
+
+	ecPub, err := b.SigningKey(ecName, token.AlgorithmECDSAP256)
+	require.NoError(t, err)
+	assert.Equal(t, token.AlgorithmECDSAP256, ecPub.Algorithm)
+
+	sig, err = b.Sign(ecName, []byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, ecPub.Verify([]byte("hello"), sig))
+	assert.False(t, ecPub.Verify([]byte("goodbye"), sig))
+
+	_, err = b.Read(name)
+	assert.Equal(t, ErrNotFound, err)
+
+	err = b.Write(name, map[string][]byte{
+		"certificate": []byte("cert"),
+		"key":         []byte("key"),
+	})
+	require.NoError(t, err)
+
+	data, err := b.Read(name)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cert"), data["certificate"])
+	assert.Equal(t, []byte("key"), data["key"])
+
+	require.NoError(t, b.Delete(name))
+
+	_, err = b.Read(name)
+	assert.Equal(t, ErrNotFound, err)
+
+	require.NoError(t, b.Delete(name))
+}
+
+func TestBackends(t *testing.T) {
+	t.Run("vault", func(t *testing.T) {
+		testBackend(t, NewVault(testutils.SetupVault()))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		f, err := NewFile(dir)
+		require.NoError(t, err)
+
+		testBackend(t, f)
+	})
+
+	t.Run("file keys persist across instances", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		f, err := NewFile(dir)
+		require.NoError(t, err)
+
+		pub, err := f.SigningKey("hzn-k1", "")
+		require.NoError(t, err)
+
+		f2, err := NewFile(dir)
+		require.NoError(t, err)
+
+		sig, err := f2.Sign("hzn-k1", []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(pub.Key, []byte("hello"), sig))
+
+		fi, err := os.Stat(dir + "/keys/hzn-k1.pem")
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	})
+
+	t.Run("file rejects names outside the directory", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		f, err := NewFile(dir)
+		require.NoError(t, err)
+
+		_, err = f.Read("../escape")
+		assert.Error(t, err)
+
+		_, err = f.SigningKey("a/b", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("selects backends by name", func(t *testing.T) {
+		_, err := New("vault", nil, "")
+		assert.Error(t, err)
+
+		_, err = New("kms", nil, "")
+		assert.Error(t, err)
+
+		_, err = New("file", nil, "")
+		assert.Error(t, err)
+	})
+}
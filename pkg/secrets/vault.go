@@ -0,0 +1,88 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/horizon/pkg/token"
+	"github.com/hashicorp/vault/api"
+)
+
+// Vault keeps signing keys in the transit secrets engine, mounted at
+// transit, and material in the version 2 kv engine, mounted at kv.
+type Vault struct {
+	vc *api.Client
+}
+
+func NewVault(vc *api.Client) *Vault {
+	return &Vault{vc: vc}
+}
+
+func (v *Vault) SigningKey(name string, alg token.Algorithm) (token.PublicKey, error) {
+	return token.SetupVaultKey(v.vc, name, alg)
+}
+
+func (v *Vault) Sign(name string, data []byte) ([]byte, error) {
+	return token.VaultSign(v.vc, name, data)
+}
+
+func (v *Vault) kvPath(name string) string {
+	return filepath.Join("/kv/data", name)
+}
+
+func (v *Vault) Read(name string) (map[string][]byte, error) {
+	sec, err := v.vc.Logical().Read(v.kvPath(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if sec == nil {
+		return nil, ErrNotFound
+	}
+
+	data, ok := sec.Data["data"].(map[string]interface{})
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	out := make(map[string][]byte, len(data))
+
+	// Byte values are written as base64 by the vault client.
+	for k, val := range data {
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("value %s of %s is not a string", k, name)
+		}
+
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+
+		out[k] = b
+	}
+
+	return out, nil
+}
+
+func (v *Vault) Write(name string, data map[string][]byte) error {
+	values := make(map[string]interface{}, len(data))
+
+	for k, val := range data {
+		values[k] = val
+	}
+
+	_, err := v.vc.Logical().Write(v.kvPath(name), map[string]interface{}{
+		"data": values,
+	})
+
+	return err
+}
+
+// Delete removes every version of the material, not just the latest, so
+// that nothing is left behind under name.
+func (v *Vault) Delete(name string) error {
+	_, err := v.vc.Logical().Delete(filepath.Join("/kv/metadata", name))
+	return err
+}
@@ -0,0 +1,133 @@
+package tlsmanage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CertInfo identifies a certificate, so that refreshed material can be
+// told apart from what was loaded before.
+type CertInfo struct {
+	// The serial number, as colon separated hex bytes.
+	Serial string
+
+	// The SHA-256 of the DER encoded certificate, as lowercase hex.
+	Fingerprint string
+
+	NotAfter time.Time
+
+	// Whether the certificate is signed by its own key, as the snake-oil
+	// certificates used in development are. Clients won't trust it.
+	SelfSigned bool
+}
+
+// ParseCertInfo returns the identity of the first certificate in the PEM
+// encoded data.
+func ParseCertInfo(data []byte) (*CertInfo, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrNoCertificate
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, ErrNoCertificate
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+
+	return &CertInfo{
+		Serial:      formatSerial(cert.SerialNumber.Bytes()),
+		Fingerprint: hex.EncodeToString(sum[:]),
+		NotAfter:    cert.NotAfter,
+		SelfSigned:  isSelfSigned(cert),
+	}, nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+		return false
+	}
+
+	return cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
+
+var ErrSelfSignedCertificate = errors.New("certificate is self-signed")
+
+// CheckRealCertificate returns an error unless the first certificate in
+// the PEM encoded data is one clients can be expected to trust, rather
+// than snake-oil: parsable, unexpired and not self-signed.
+func CheckRealCertificate(data []byte) error {
+	info, err := ParseCertInfo(data)
+	if err != nil {
+		return err
+	}
+
+	if info.SelfSigned {
+		return ErrSelfSignedCertificate
+	}
+
+	if time.Now().After(info.NotAfter) {
+		return fmt.Errorf("certificate expired at %s", info.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func formatSerial(b []byte) string {
+	if len(b) == 0 {
+		return "00"
+	}
+
+	parts := make([]string, len(b))
+	for i, c := range b {
+		parts[i] = hex.EncodeToString([]byte{c})
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// CertInfo returns the identity of the currently loaded hub certificate.
+func (m *Manager) CertInfo() (*CertInfo, error) {
+	return ParseCertInfo(m.hubCert)
+}
+
+// Log when the hub certificate differs from the one previously loaded.
+// Refreshes that return the same material are silent.
+func (m *Manager) logRotation(prev, cur []byte) {
+	info, err := ParseCertInfo(cur)
+	if err != nil {
+		m.cfg.L.Warn("refreshed hub certificate could not be parsed", "error", err)
+		return
+	}
+
+	old, err := ParseCertInfo(prev)
+	if err != nil {
+		m.cfg.L.Info("hub certificate loaded",
+			"serial", info.Serial,
+			"fingerprint", info.Fingerprint,
+			"expires", info.NotAfter,
+		)
+		return
+	}
+
+	if old.Fingerprint == info.Fingerprint {
+		return
+	}
+
+	m.cfg.L.Info("hub certificate rotated",
+		"serial", info.Serial,
+		"fingerprint", info.Fingerprint,
+		"expires", info.NotAfter,
+		"previous-serial", old.Serial,
+		"previous-fingerprint", old.Fingerprint,
+	)
+}
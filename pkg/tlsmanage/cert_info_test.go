@@ -0,0 +1,114 @@
+package tlsmanage
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertInfo(t *testing.T) {
+	cert, _, err := testutils.SelfSignedCert()
+	require.NoError(t, err)
+
+	other, _, err := testutils.SelfSignedCert()
+	require.NoError(t, err)
+
+	t.Run("reports the same identity for the same certificate", func(t *testing.T) {
+		a, err := ParseCertInfo(cert)
+		require.NoError(t, err)
+
+		b, err := ParseCertInfo(cert)
+		require.NoError(t, err)
+
+		assert.Equal(t, a, b)
+		assert.Len(t, a.Fingerprint, 64)
+		assert.NotEmpty(t, a.Serial)
+
+		c, err := ParseCertInfo(other)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, a.Fingerprint, c.Fingerprint)
+	})
+
+	t.Run("rejects data without a certificate", func(t *testing.T) {
+		_, err := ParseCertInfo([]byte("not a cert"))
+		assert.Equal(t, ErrNoCertificate, err)
+	})
+
+	t.Run("only logs when the certificate changes", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		m := &Manager{
+			cfg: ManagerConfig{
+				L: hclog.New(&hclog.LoggerOptions{Output: &buf}),
+			},
+		}
+
+		m.logRotation(cert, cert)
+		assert.Empty(t, buf.String())
+
+		m.logRotation(cert, other)
+		assert.Equal(t, 1, strings.Count(buf.String(), "hub certificate rotated"))
+
+		info, err := ParseCertInfo(other)
+		require.NoError(t, err)
+
+		assert.Contains(t, buf.String(), info.Fingerprint)
+	})
+
+	t.Run("tells snake-oil apart from issued certificates", func(t *testing.T) {
+		info, err := ParseCertInfo(cert)
+		require.NoError(t, err)
+		assert.True(t, info.SelfSigned)
+
+		assert.Equal(t, ErrSelfSignedCertificate, CheckRealCertificate(cert))
+
+		caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		ca := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "test ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+
+		leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		leaf := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      pkix.Name{CommonName: "hub.test"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			DNSNames:     []string{"hub.test"},
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, leaf, ca, &leafKey.PublicKey, caKey)
+		require.NoError(t, err)
+
+		issued := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+		info, err = ParseCertInfo(issued)
+		require.NoError(t, err)
+		assert.False(t, info.SelfSigned)
+
+		assert.NoError(t, CheckRealCertificate(issued))
+	})
+}
@@ -0,0 +1,80 @@
+package tlsmanage
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A ChainError is returned by ImportMaterial for a certificate that doesn't
+// chain to one of ManagerConfig.ImportRoots.
+type ChainError struct {
+	// The subjects of the certificates given, leaf first.
+	Chain []string
+
+	// Why the chain was rejected.
+	Err error
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("certificate chain [%s] is not trusted: %s", strings.Join(e.Chain, " <- "), e.Err)
+}
+
+// Verify that pair chains from its leaf, through the intermediates given
+// with it, to one of ImportRoots, in no more than ImportMaxChainDepth
+// certificates. Nothing is checked when ImportRoots isn't set.
+func (m *Manager) verifyImportChain(pair *tls.Certificate, leaf *x509.Certificate, now time.Time) error {
+	if m.cfg.ImportRoots == nil {
+		return nil
+	}
+
+	chain := []string{leaf.Subject.String()}
+
+	intermediates := x509.NewCertPool()
+
+	for _, der := range pair.Certificate[1:] {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return &ChainError{Chain: chain, Err: fmt.Errorf("parsing intermediate certificate: %s", err)}
+		}
+
+		chain = append(chain, cert.Subject.String())
+		intermediates.AddCert(cert)
+	}
+
+	// The names are checked separately, as a wildcard domain isn't a name
+	// Verify can check for.
+	verified, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         m.cfg.ImportRoots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return &ChainError{Chain: chain, Err: err}
+	}
+
+	max := m.cfg.ImportMaxChainDepth
+	if max <= 0 {
+		return nil
+	}
+
+	shortest := len(verified[0])
+
+	for _, v := range verified[1:] {
+		if len(v) < shortest {
+			shortest = len(v)
+		}
+	}
+
+	if shortest > max {
+		return &ChainError{
+			Chain: chain,
+			Err:   fmt.Errorf("the chain to a trusted root is %d certificates long, more than the maximum of %d", shortest, max),
+		}
+	}
+
+	return nil
+}
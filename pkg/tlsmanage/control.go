@@ -0,0 +1,133 @@
+package tlsmanage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/workq"
+)
+
+// How often the renew job checks whether the control cert is within
+// Control.RenewBefore of expiring.
+var ControlCertRenewPeriod = time.Hour * 24
+
+// SetupControlCert obtains a new control cert from the ACME server.
+func (m *Manager) SetupControlCert(ctx context.Context) error {
+	cert, err := m.obtain(ctx, m.cfg.Control.Domain, m.cfg.Control.KeyType)
+	if err != nil {
+		return err
+	}
+
+	m.controlCert = cert.Certificate
+	m.controlIssuer = cert.IssuerCertificate
+	m.controlKey = cert.PrivateKey
+
+	return nil
+}
+
+func (m *Manager) FetchControlFromVault() ([]byte, []byte, error) {
+	return m.readVaultMaterial(controlVaultPath)
+}
+
+func (m *Manager) StoreControlInVault() error {
+	return m.writeVaultMaterial(controlVaultPath, m.controlCert, m.controlKey)
+}
+
+// RefreshControlFromVault loads the latest control material from vault,
+// as stored by whichever instance last renewed it.
+func (m *Manager) RefreshControlFromVault() ([]byte, []byte, error) {
+	cert, key, err := m.FetchControlFromVault()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.controlCert = cert
+	m.controlKey = key
+
+	return cert, key, nil
+}
+
+// ControlMaterial is HubMaterial for the control cert: it returns the
+// loaded material, or reads it from vault, or obtains a new cert when
+// vault has none yet.
+func (m *Manager) ControlMaterial(ctx context.Context) ([]byte, []byte, error) {
+	if len(m.controlCert) > 0 {
+		return m.controlCert, m.controlKey, nil
+	}
+
+	cert, key, err := m.FetchControlFromVault()
+	if err == nil {
+		m.controlCert = cert
+		m.controlKey = key
+		return cert, key, nil
+	}
+
+	err = m.SetupControlCert(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.StoreControlInVault()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return m.controlCert, m.controlKey, nil
+}
+
+// ControlCertInfo returns the identity of the currently loaded control
+// certificate.
+func (m *Manager) ControlCertInfo() (*CertInfo, error) {
+	return ParseCertInfo(m.controlCert)
+}
+
+// Indicates if the current control cert expires within
+// Control.RenewBefore of now, or if there is no usable cert at all.
+func (m *Manager) controlNeedsRenewal(now time.Time) bool {
+	info, err := m.ControlCertInfo()
+	if err != nil {
+		return true
+	}
+
+	return !now.Add(m.cfg.Control.RenewBefore).Before(info.NotAfter)
+}
+
+// The renewal of the control cert runs as its own job, so a failure to
+// renew one cert never holds up the other.
+func (m *Manager) registerControlRenewHandler(reg *workq.Registry) {
+	reg.Register("renew-control-cert", func(ctx context.Context, jobType string, _ *struct{}) error {
+		L := hclog.FromContext(ctx)
+
+		if m.cfg.Secrets != nil {
+			_, _, err := m.RefreshControlFromVault()
+			if err != nil && err != ErrNoTLSMaterial {
+				L.Error("error refreshing control cert/key from vault", "error", err)
+				return err
+			}
+		}
+
+		if !m.controlNeedsRenewal(time.Now()) {
+			L.Debug("control cert not yet due for renewal", "renew-before", m.cfg.Control.RenewBefore)
+			return nil
+		}
+
+		L.Info("renewing control cert", "domain", m.cfg.Control.Domain, "renew-before", m.cfg.Control.RenewBefore)
+
+		err := m.SetupControlCert(ctx)
+		if err != nil {
+			L.Error("error retrieving updated cert/key for control", "error", err)
+			return err
+		}
+
+		err = m.StoreControlInVault()
+		if err != nil {
+			L.Error("error storing new control cert/key in vault", "error", err)
+			return err
+		}
+
+		return nil
+	})
+
+	workq.RegisterPeriodicJob("renew-control-cert", "default", "renew-control-cert", nil, ControlCertRenewPeriod)
+}
@@ -0,0 +1,125 @@
+package tlsmanage
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v3/certcrypto"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControlCert(t *testing.T) {
+	now := time.Now()
+
+	t.Run("parses key types", func(t *testing.T) {
+		kt, err := ParseKeyType("P256")
+		require.NoError(t, err)
+		assert.Equal(t, certcrypto.EC256, kt)
+
+		kt, err = ParseKeyType("4096")
+		require.NoError(t, err)
+		assert.Equal(t, certcrypto.RSA4096, kt)
+
+		_, err = ParseKeyType("ed25519")
+		assert.Error(t, err)
+	})
+
+	t.Run("serves the hub cert unless a control domain is configured", func(t *testing.T) {
+		hubCert, hubKey := importTestCert(t, []string{"*.hub.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+		ctlCert, ctlKey := importTestCert(t, []string{"control.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		mgr, err := NewManager(ManagerConfig{Domain: "*.hub.test"})
+		require.NoError(t, err)
+
+		mgr.hubCert, mgr.hubKey = hubCert, hubKey
+		mgr.controlCert, mgr.controlKey = ctlCert, ctlKey
+
+		leaf := func() *x509.Certificate {
+			cert, err := mgr.Certificate()
+			require.NoError(t, err)
+
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			require.NoError(t, err)
+
+			return leaf
+		}
+
+		assert.NoError(t, leaf().VerifyHostname("a.hub.test"))
+
+		mgr.cfg.Control.Domain = "control.test"
+
+		assert.NoError(t, leaf().VerifyHostname("control.test"))
+	})
+
+	t.Run("renews on its own window", func(t *testing.T) {
+		mgr, err := NewManager(ManagerConfig{
+			RenewBefore: time.Minute,
+			Control: ControlCertConfig{
+				Domain:      "control.test",
+				RenewBefore: 2 * time.Hour,
+			},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, mgr.controlNeedsRenewal(now), "no cert should need renewal")
+
+		mgr.hubCert, mgr.hubKey = importTestCert(t, []string{"*.hub.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+		mgr.controlCert, mgr.controlKey = importTestCert(t, []string{"control.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		assert.False(t, mgr.needsRenewal(now))
+		assert.True(t, mgr.controlNeedsRenewal(now))
+	})
+
+	t.Run("defaults the control renew window", func(t *testing.T) {
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultRenewBefore, mgr.cfg.Control.RenewBefore)
+	})
+
+	t.Run("keeps the control material apart from the hub material in vault", func(t *testing.T) {
+		vc := testutils.SetupVault()
+
+		defer vc.Logical().Delete("/kv/metadata/hub-tls")
+		defer vc.Logical().Delete("/kv/metadata/control-tls")
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:      "*.hub.test",
+			VaultClient: vc,
+			Control: ControlCertConfig{
+				Domain: "control.test",
+			},
+		})
+		require.NoError(t, err)
+
+		mgr.hubCert, mgr.hubKey = importTestCert(t, []string{"*.hub.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, mgr.StoreInVault())
+
+		mgr.controlCert, mgr.controlKey = importTestCert(t, []string{"control.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, mgr.StoreControlInVault())
+
+		mgr2, err := NewManager(ManagerConfig{
+			Domain:      "*.hub.test",
+			VaultClient: vc,
+			Control: ControlCertConfig{
+				Domain: "control.test",
+			},
+		})
+		require.NoError(t, err)
+
+		cert, key, err := mgr2.ControlMaterial(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, mgr.controlCert, cert)
+		assert.Equal(t, mgr.controlKey, key)
+
+		hubCert, _, err := mgr2.HubMaterial(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, mgr.hubCert, hubCert)
+	})
+}
@@ -0,0 +1,144 @@
+package tlsmanage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v3/challenge"
+	"github.com/go-acme/lego/v3/challenge/dns01"
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+)
+
+// SetDNSProvider sets the provider used to present the DNS challenge
+// records, replacing one configured by SetupRoute53.
+func (m *Manager) SetDNSProvider(prov challenge.Provider) {
+	m.challengeProvider = prov
+	m.dnsCheck = nil
+}
+
+// SetFallbackDNSProvider sets a provider that is used to present and clean
+// up challenge records whenever the primary provider fails to.
+func (m *Manager) SetFallbackDNSProvider(prov challenge.Provider) {
+	m.fallbackProvider = prov
+}
+
+// The provider to give to lego, wrapping the primary and fallback
+// providers when a fallback is configured.
+func (m *Manager) dnsProvider() challenge.Provider {
+	if m.fallbackProvider == nil {
+		return m.challengeProvider
+	}
+
+	return &fallbackProvider{
+		L:         m.cfg.L,
+		primary:   m.challengeProvider,
+		fallback:  m.fallbackProvider,
+		presented: make(map[string]challenge.Provider),
+	}
+}
+
+// fallbackProvider presents challenges with the primary provider, falling
+// back to the secondary one when the primary returns an error.
+type fallbackProvider struct {
+	L hclog.Logger
+
+	primary, fallback challenge.Provider
+
+	mu sync.Mutex
+
+	// Which provider presented each challenge, so it can be cleaned up with
+	// the same one.
+	presented map[string]challenge.Provider
+}
+
+func (f *fallbackProvider) name(p challenge.Provider) string {
+	if p == f.primary {
+		return "primary"
+	}
+
+	return "fallback"
+}
+
+func (f *fallbackProvider) Present(domain, token, keyAuth string) error {
+	key := domain + "/" + token
+
+	perr := f.primary.Present(domain, token, keyAuth)
+	if perr == nil {
+		f.record(key, f.primary)
+		return nil
+	}
+
+	f.L.Warn("primary dns provider failed to present challenge, trying fallback",
+		"domain", domain, "error", perr)
+
+	err := f.fallback.Present(domain, token, keyAuth)
+	if err != nil {
+		return errors.Wrapf(err, "fallback dns provider failed after primary error: %s", perr)
+	}
+
+	f.record(key, f.fallback)
+
+	return nil
+}
+
+func (f *fallbackProvider) record(key string, p challenge.Provider) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.presented[key] = p
+
+	f.L.Info("dns challenge presented", "challenge", key, "provider", f.name(p))
+}
+
+func (f *fallbackProvider) CleanUp(domain, token, keyAuth string) error {
+	key := domain + "/" + token
+
+	f.mu.Lock()
+	p, ok := f.presented[key]
+	delete(f.presented, key)
+	f.mu.Unlock()
+
+	if !ok {
+		p = f.primary
+	}
+
+	err := p.CleanUp(domain, token, keyAuth)
+	if err == nil {
+		return nil
+	}
+
+	other := f.fallback
+	if p == f.fallback {
+		other = f.primary
+	}
+
+	f.L.Warn("dns provider failed to clean up challenge, trying the other provider",
+		"domain", domain, "provider", f.name(p), "error", err)
+
+	return other.CleanUp(domain, token, keyAuth)
+}
+
+// Timeout returns the longest timeout of the two providers, since either
+// may end up satisfying the challenge.
+func (f *fallbackProvider) Timeout() (time.Duration, time.Duration) {
+	timeout, interval := dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+
+	for _, p := range []challenge.Provider{f.primary, f.fallback} {
+		pt, ok := p.(challenge.ProviderTimeout)
+		if !ok {
+			continue
+		}
+
+		t, i := pt.Timeout()
+		if t > timeout {
+			timeout = t
+		}
+
+		if i > interval {
+			interval = i
+		}
+	}
+
+	return timeout, interval
+}
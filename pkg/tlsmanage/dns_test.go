@@ -0,0 +1,84 @@
+package tlsmanage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingDNSProvider struct {
+	presents, cleanups int
+}
+
+func (f *failingDNSProvider) Present(domain, token, keyAuth string) error {
+	f.presents++
+	return errors.New("provider api unavailable")
+}
+
+func (f *failingDNSProvider) CleanUp(domain, token, keyAuth string) error {
+	f.cleanups++
+	return errors.New("provider api unavailable")
+}
+
+func TestFallbackDNSProvider(t *testing.T) {
+	t.Run("uses the primary provider when it works", func(t *testing.T) {
+		var primary, fallback mockDNSProvider
+
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		mgr.SetDNSProvider(&primary)
+		mgr.SetFallbackDNSProvider(&fallback)
+
+		prov := mgr.dnsProvider()
+
+		require.NoError(t, prov.Present("test.cloud", "tok", "auth"))
+		require.NoError(t, prov.CleanUp("test.cloud", "tok", "auth"))
+
+		assert.Equal(t, "test.cloud", primary.present.domain)
+		assert.Equal(t, "test.cloud", primary.cleanup.domain)
+		assert.Equal(t, "", fallback.present.domain)
+	})
+
+	t.Run("falls back when the primary fails", func(t *testing.T) {
+		var (
+			primary  failingDNSProvider
+			fallback mockDNSProvider
+		)
+
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		mgr.SetDNSProvider(&primary)
+		mgr.SetFallbackDNSProvider(&fallback)
+
+		prov := mgr.dnsProvider()
+
+		require.NoError(t, prov.Present("test.cloud", "tok", "auth"))
+		assert.Equal(t, 1, primary.presents)
+		assert.Equal(t, "test.cloud", fallback.present.domain)
+
+		// Cleaned up by the provider that presented it
+		require.NoError(t, prov.CleanUp("test.cloud", "tok", "auth"))
+		assert.Equal(t, 0, primary.cleanups)
+		assert.Equal(t, "test.cloud", fallback.cleanup.domain)
+	})
+
+	t.Run("errors when both providers fail", func(t *testing.T) {
+		var primary, fallback failingDNSProvider
+
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		mgr.SetDNSProvider(&primary)
+		mgr.SetFallbackDNSProvider(&fallback)
+
+		err = mgr.dnsProvider().Present("test.cloud", "tok", "auth")
+		require.Error(t, err)
+
+		assert.Equal(t, 1, primary.presents)
+		assert.Equal(t, 1, fallback.presents)
+	})
+}
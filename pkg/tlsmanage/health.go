@@ -0,0 +1,201 @@
+package tlsmanage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A DNSHealthChecker is a DNS provider that can verify that its credentials
+// work without presenting a challenge. Providers set with SetDNSProvider
+// that don't implement it aren't checked by CheckHealth.
+type DNSHealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// ComponentHealth is the state of one dependency of the manager.
+type ComponentHealth struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+
+	// Set when the dependency couldn't be checked, and so is assumed to
+	// be healthy.
+	Unchecked bool `json:"unchecked,omitempty"`
+}
+
+// HealthStatus is the result of CheckHealth.
+type HealthStatus struct {
+	ACME  ComponentHealth `json:"acme"`
+	DNS   ComponentHealth `json:"dns"`
+	Vault ComponentHealth `json:"vault"`
+}
+
+// Err returns an error naming each unhealthy component, or nil when they're
+// all healthy.
+func (h *HealthStatus) Err() error {
+	var failures []string
+
+	for _, c := range []struct {
+		name string
+		ComponentHealth
+	}{{"acme", h.ACME}, {"dns", h.DNS}, {"vault", h.Vault}} {
+		if !c.OK {
+			failures = append(failures, c.name+": "+c.Error)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(failures, "; "))
+}
+
+func componentHealth(err error) ComponentHealth {
+	if err != nil {
+		return ComponentHealth{Error: err.Error()}
+	}
+
+	return ComponentHealth{OK: true}
+}
+
+// CheckHealth verifies that what the manager needs to renew certificates
+// works: the ACME directory is reachable, the DNS provider accepts its
+// credentials, and the stored material can be read. It catches breakage
+// that would otherwise only show as a failed renewal.
+func (m *Manager) CheckHealth(ctx context.Context) *HealthStatus {
+	var h HealthStatus
+
+	h.ACME = componentHealth(m.checkACME(ctx))
+	h.DNS = m.checkDNS(ctx)
+	h.Vault = componentHealth(m.checkMaterial())
+
+	return &h
+}
+
+func (m *Manager) checkACME(ctx context.Context) error {
+	req, err := http.NewRequest("GET", m.lcfg.CADirURL, nil)
+	if err != nil {
+		return err
+	}
+
+	client := m.lcfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "fetching acme directory")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("acme directory returned %s", resp.Status)
+	}
+
+	var dir struct {
+		NewNonce string `json:"newNonce"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&dir)
+	if err != nil {
+		return errors.Wrapf(err, "decoding acme directory")
+	}
+
+	if dir.NewNonce == "" {
+		return fmt.Errorf("acme directory at %s is missing newNonce", m.lcfg.CADirURL)
+	}
+
+	return nil
+}
+
+func (m *Manager) checkDNS(ctx context.Context) ComponentHealth {
+	if m.challengeProvider == nil {
+		return ComponentHealth{Error: "no dns provider configured"}
+	}
+
+	if m.dnsCheck != nil {
+		return componentHealth(m.dnsCheck(ctx))
+	}
+
+	if hc, ok := m.challengeProvider.(DNSHealthChecker); ok {
+		return componentHealth(hc.CheckHealth(ctx))
+	}
+
+	return ComponentHealth{OK: true, Unchecked: true}
+}
+
+func (m *Manager) checkMaterial() error {
+	if m.cfg.Secrets == nil {
+		return nil
+	}
+
+	_, _, err := m.readVaultMaterial(hubVaultPath)
+	if err != nil {
+		return errors.Wrapf(err, "reading hub material")
+	}
+
+	if m.cfg.Control.Domain != "" {
+		_, _, err = m.readVaultMaterial(controlVaultPath)
+		if err != nil {
+			return errors.Wrapf(err, "reading control material")
+		}
+	}
+
+	return nil
+}
+
+// The time HealthCheck caches the result of CheckHealth for by default.
+var DefaultHealthCacheTime = time.Minute
+
+// HealthCheck returns a function suited to a readiness check, returning the
+// error of CheckHealth. Results are cached for cacheFor, defaulting to
+// DefaultHealthCacheTime, so frequent probes don't load the ACME server.
+func (m *Manager) HealthCheck(cacheFor time.Duration) func(ctx context.Context) error {
+	if cacheFor == 0 {
+		cacheFor = DefaultHealthCacheTime
+	}
+
+	var (
+		mu      sync.Mutex
+		checked time.Time
+		lastErr error
+	)
+
+	return func(ctx context.Context) error {
+		mu.Lock()
+		if !checked.IsZero() && time.Since(checked) < cacheFor {
+			err := lastErr
+			mu.Unlock()
+			return err
+		}
+		mu.Unlock()
+
+		// The checks make network calls, so they run without mu held.
+		err := m.CheckHealth(ctx).Err()
+
+		// A probe that gave up says nothing about the dependencies.
+		if ctx.Err() != nil {
+			return err
+		}
+
+		if err != nil {
+			m.cfg.L.Warn("tls management is unhealthy", "error", err)
+		}
+
+		mu.Lock()
+		checked = time.Now()
+		lastErr = err
+		mu.Unlock()
+
+		return err
+	}
+}
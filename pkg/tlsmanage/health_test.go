@@ -0,0 +1,106 @@
+package tlsmanage
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/horizon/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type checkedDNSProvider struct {
+	mockDNSProvider
+	err error
+}
+
+func (c *checkedDNSProvider) CheckHealth(ctx context.Context) error {
+	return c.err
+}
+
+func TestCheckHealth(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsmanage")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	store, err := secrets.NewFile(dir)
+	require.NoError(t, err)
+
+	acme := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"newNonce": "https://acme.test/new-nonce"}`))
+	}))
+	defer acme.Close()
+
+	mgr, err := NewManager(ManagerConfig{
+		Domain:  "*.test.cloud",
+		Secrets: store,
+	})
+	require.NoError(t, err)
+
+	mgr.lcfg.CADirURL = acme.URL
+
+	t.Run("reports each unhealthy component", func(t *testing.T) {
+		h := mgr.CheckHealth(context.Background())
+
+		assert.True(t, h.ACME.OK)
+		assert.False(t, h.DNS.OK)
+		assert.False(t, h.Vault.OK)
+
+		err := h.Err()
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "dns: no dns provider configured")
+		assert.Contains(t, err.Error(), "vault: reading hub material")
+	})
+
+	t.Run("is healthy once every component works", func(t *testing.T) {
+		prov := &checkedDNSProvider{}
+		mgr.SetDNSProvider(prov)
+
+		require.NoError(t, store.Write(hubVaultPath, map[string][]byte{
+			"certificate": []byte("cert"),
+			"key":         []byte("key"),
+		}))
+
+		h := mgr.CheckHealth(context.Background())
+		assert.NoError(t, h.Err())
+
+		prov.err = errors.New("bad credentials")
+
+		h = mgr.CheckHealth(context.Background())
+		assert.EqualError(t, h.Err(), "dns: bad credentials")
+	})
+
+	t.Run("doesn't check providers that can't be", func(t *testing.T) {
+		mgr.SetDNSProvider(&mockDNSProvider{})
+
+		h := mgr.CheckHealth(context.Background())
+		assert.NoError(t, h.Err())
+		assert.True(t, h.DNS.Unchecked)
+	})
+
+	t.Run("fails on an unreachable acme directory", func(t *testing.T) {
+		down := httptest.NewServer(http.NotFoundHandler())
+		down.Close()
+
+		mgr.lcfg.CADirURL = down.URL
+		defer func() { mgr.lcfg.CADirURL = acme.URL }()
+
+		check := mgr.HealthCheck(0)
+
+		err := check(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "acme: fetching acme directory")
+
+		// The failure is cached.
+		mgr.lcfg.CADirURL = acme.URL
+
+		assert.Equal(t, err, check(context.Background()))
+	})
+}
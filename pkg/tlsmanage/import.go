@@ -0,0 +1,90 @@
+package tlsmanage
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ImportMaterial seeds the manager with an existing certificate and key,
+// such as one issued outside of Horizon, and stores them in vault where
+// RefreshFromVault reads them. Once imported, HubMaterial returns them
+// without contacting the ACME server, and they're renewed through ACME
+// like any other certificate as they near expiry.
+//
+// The pair must match, the certificate must currently be valid, and its
+// SANs must cover the configured domain. With ImportRoots configured, it
+// must also chain to one of them, or a *ChainError is returned.
+func (m *Manager) ImportMaterial(ctx context.Context, certPEM, keyPEM []byte) error {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrapf(err, "certificate and key do not form a valid pair")
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return errors.Wrapf(err, "parsing certificate")
+	}
+
+	now := time.Now()
+
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("certificate expired at %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf("certificate is not valid until %s", leaf.NotBefore.Format(time.RFC3339))
+	}
+
+	if !certCoversDomain(leaf, m.cfg.Domain) {
+		return fmt.Errorf("certificate does not cover %s, it's valid for %s",
+			m.cfg.Domain, strings.Join(leaf.DNSNames, ", "))
+	}
+
+	err = m.verifyImportChain(&pair, leaf, now)
+	if err != nil {
+		return err
+	}
+
+	// The manager keeps serving its current material unless the import
+	// is stored, so that what it serves is always what it restarts with.
+	err = m.writeVaultMaterial(hubVaultPath, certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrapf(err, "storing imported material in vault")
+	}
+
+	m.logRotation(m.hubCert, certPEM)
+
+	m.hubCert = certPEM
+	m.hubKey = keyPEM
+	m.hubIssuer = nil
+
+	m.cfg.L.Info("imported hub certificate",
+		"domain", m.cfg.Domain,
+		"expires", leaf.NotAfter,
+	)
+
+	return nil
+}
+
+// Whether cert is valid for domain. A wildcard domain, as used for the
+// hubs, must be present as is, as a certificate for a single name below it
+// doesn't cover the rest.
+func certCoversDomain(cert *x509.Certificate, domain string) bool {
+	if strings.HasPrefix(domain, "*.") {
+		for _, name := range cert.DNSNames {
+			if strings.EqualFold(name, domain) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return cert.VerifyHostname(domain) == nil
+}
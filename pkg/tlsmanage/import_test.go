@@ -0,0 +1,267 @@
+package tlsmanage
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/secrets"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func importTestCert(t *testing.T, names []string, notBefore, notAfter time.Time) ([]byte, []byte) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: names[0]},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		DNSNames:     names,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+
+	return certPEM, keyPEM
+}
+
+// A backend whose writes fail, as they do while vault is unavailable.
+type failingWrites struct {
+	secrets.Backend
+}
+
+func (failingWrites) Write(name string, data map[string][]byte) error {
+	return errors.New("backend unavailable")
+}
+
+func TestImportMaterial(t *testing.T) {
+	vc := testutils.SetupVault()
+
+	ctx := context.Background()
+
+	now := time.Now()
+
+	t.Run("stores a valid pair where the hub material is read from", func(t *testing.T) {
+		defer vc.Logical().Delete("/kv/metadata/hub-tls")
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:      "*.test.cloud",
+			VaultClient: vc,
+		})
+		require.NoError(t, err)
+
+		cert, key := importTestCert(t, []string{"*.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		err = mgr.ImportMaterial(ctx, cert, key)
+		require.NoError(t, err)
+
+		vcert, vkey, err := mgr.FetchFromVault()
+		require.NoError(t, err)
+
+		assert.Equal(t, cert, vcert)
+		assert.Equal(t, key, vkey)
+
+		mgr2, err := NewManager(ManagerConfig{
+			Domain:      "*.test.cloud",
+			VaultClient: vc,
+		})
+		require.NoError(t, err)
+
+		bcert, bkey, err := mgr2.HubMaterial(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, cert, bcert)
+		assert.Equal(t, key, bkey)
+	})
+
+	t.Run("rejects material that isn't usable", func(t *testing.T) {
+		defer vc.Logical().Delete("/kv/metadata/hub-tls")
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:      "*.test.cloud",
+			VaultClient: vc,
+		})
+		require.NoError(t, err)
+
+		cert, _ := importTestCert(t, []string{"*.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+		_, otherKey := importTestCert(t, []string{"*.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		err = mgr.ImportMaterial(ctx, cert, otherKey)
+		assert.Error(t, err)
+
+		expired, expiredKey := importTestCert(t, []string{"*.test.cloud"}, now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+		err = mgr.ImportMaterial(ctx, expired, expiredKey)
+		assert.Error(t, err)
+
+		single, singleKey := importTestCert(t, []string{"hub.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		err = mgr.ImportMaterial(ctx, single, singleKey)
+		assert.Error(t, err)
+
+		other, otherKey := importTestCert(t, []string{"*.other.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		err = mgr.ImportMaterial(ctx, other, otherKey)
+		assert.Error(t, err)
+
+		_, _, err = mgr.FetchFromVault()
+		assert.Error(t, err)
+
+		assert.Nil(t, mgr.hubCert)
+	})
+
+	t.Run("keeps the current material when storing the import fails", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		store, err := secrets.NewFile(dir)
+		require.NoError(t, err)
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:  "*.test.cloud",
+			Secrets: failingWrites{store},
+		})
+		require.NoError(t, err)
+
+		cur, curKey := importTestCert(t, []string{"*.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		mgr.hubCert = cur
+		mgr.hubKey = curKey
+
+		cert, key := importTestCert(t, []string{"*.test.cloud"}, now.Add(-time.Hour), now.Add(time.Hour))
+
+		err = mgr.ImportMaterial(ctx, cert, key)
+		require.Error(t, err)
+
+		assert.Equal(t, cur, mgr.hubCert)
+		assert.Equal(t, curKey, mgr.hubKey)
+	})
+
+	t.Run("verifies the chain against the trusted roots", func(t *testing.T) {
+		defer vc.Logical().Delete("/kv/metadata/hub-tls")
+
+		root, rootKey := chainTestCert(t, "root", nil, nil)
+		inter, interKey := chainTestCert(t, "intermediate", root, rootKey)
+		leaf, leafKey := chainTestCert(t, "*.test.cloud", inter, interKey)
+
+		other, _ := chainTestCert(t, "other root", nil, nil)
+
+		encode := func(certs ...*x509.Certificate) []byte {
+			var out []byte
+			for _, c := range certs {
+				out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+			}
+			return out
+		}
+
+		keyDer, err := x509.MarshalPKCS8PrivateKey(leafKey)
+		require.NoError(t, err)
+
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+
+		newManager := func(roots []*x509.Certificate, depth int) *Manager {
+			pool := x509.NewCertPool()
+			for _, r := range roots {
+				pool.AddCert(r)
+			}
+
+			mgr, err := NewManager(ManagerConfig{
+				Domain:              "*.test.cloud",
+				VaultClient:         vc,
+				ImportRoots:         pool,
+				ImportMaxChainDepth: depth,
+			})
+			require.NoError(t, err)
+
+			return mgr
+		}
+
+		err = newManager([]*x509.Certificate{other}, 0).ImportMaterial(ctx, encode(leaf, inter), keyPEM)
+		require.Error(t, err)
+
+		cerr, ok := err.(*ChainError)
+		require.True(t, ok, "error: %s", err)
+
+		assert.Equal(t, []string{"CN=*.test.cloud", "CN=intermediate"}, cerr.Chain)
+		assert.Contains(t, err.Error(), "unknown authority")
+
+		// The intermediate has to be given along with the leaf.
+		err = newManager([]*x509.Certificate{root}, 0).ImportMaterial(ctx, encode(leaf), keyPEM)
+		assert.IsType(t, &ChainError{}, err)
+
+		err = newManager([]*x509.Certificate{root}, 2).ImportMaterial(ctx, encode(leaf, inter), keyPEM)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "more than the maximum of 2")
+
+		mgr := newManager([]*x509.Certificate{root}, 3)
+
+		err = mgr.ImportMaterial(ctx, encode(leaf, inter), keyPEM)
+		require.NoError(t, err)
+
+		vcert, _, err := mgr.FetchFromVault()
+		require.NoError(t, err)
+
+		assert.Equal(t, encode(leaf, inter), vcert)
+	})
+}
+
+// Issue a certificate for name, signed by parent or self-signed when parent
+// is nil. Wildcard names get a leaf certificate, the rest a CA.
+func chainTestCert(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	if !strings.HasPrefix(name, "*.") {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		template.DNSNames = []string{name}
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+	}
+
+	if parent == nil {
+		parent, parentKey = template, priv
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &priv.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, priv
+}
@@ -8,14 +8,16 @@ import (
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
-	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/go-acme/lego/v3/certcrypto"
 	"github.com/go-acme/lego/v3/certificate"
 	"github.com/go-acme/lego/v3/challenge"
 	"github.com/go-acme/lego/v3/challenge/dns01"
@@ -24,6 +26,7 @@ import (
 	lego53 "github.com/go-acme/lego/v3/providers/dns/route53"
 	"github.com/go-acme/lego/v3/registration"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/secrets"
 	"github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
 )
@@ -41,8 +44,24 @@ type Manager struct {
 	hubIssuer []byte
 	hubKey    []byte
 
+	controlCert   []byte
+	controlIssuer []byte
+	controlKey    []byte
+
 	challengeProvider challenge.Provider
+	fallbackProvider  challenge.Provider
 	dnsOptions        []dns01.ChallengeOption
+
+	// Verifies the credentials of challengeProvider, when it was set up
+	// by SetupRoute53.
+	dnsCheck func(ctx context.Context) error
+
+	// The subdomains that are issued certs of their own, when set by
+	// SetSubdomainSource.
+	subdomainSource func(ctx context.Context) ([]string, error)
+
+	// Accessed atomically
+	vaultFailures int64
 }
 
 func (m *Manager) GetEmail() string {
@@ -63,6 +82,79 @@ type ManagerConfig struct {
 	KeyPath     string
 	VaultClient *api.Client
 	Staging     bool
+
+	// AccountKey is the PEM encoded private key of an already registered
+	// ACME account. When set along with AccountURL, the manager uses that
+	// account rather than generating or registering its own, which lets
+	// multiple services share a single account.
+	AccountKey []byte
+
+	// AccountURL is the URL the ACME server assigned to the account
+	// identified by AccountKey.
+	AccountURL string
+
+	// Email is the contact registered with the ACME account, which is
+	// where the CA sends expiry and policy notices. Accounts registered
+	// without one are updated to use it.
+	Email string
+
+	// AcceptTOS agrees to the ACME server's terms of service when
+	// registering an account. Registration fails if the server has terms
+	// and they weren't accepted.
+	AcceptTOS bool
+
+	// How long before the hub cert expires to renew it. Defaults to
+	// DefaultRenewBefore.
+	RenewBefore time.Duration
+
+	// The type of key generated for the hub cert. Defaults to lego's
+	// default, RSA 2048.
+	KeyType certcrypto.KeyType
+
+	// The control server's own certificate. Domain, KeyType and RenewBefore
+	// above apply only to the hub cert.
+	Control ControlCertConfig
+
+	// Where the lego key and the issued material are kept. Defaults to
+	// the kv engine of VaultClient, when that's set.
+	Secrets secrets.Backend
+
+	// The roots that certificates given to ImportMaterial must chain to,
+	// through the intermediates included with them. When nil the chain
+	// isn't checked.
+	ImportRoots *x509.CertPool
+
+	// The most certificates, leaf and root included, an imported chain may
+	// have. Zero means no limit. Only applies with ImportRoots.
+	ImportMaxChainDepth int
+}
+
+// ControlCertConfig configures a certificate for the control server's own
+// endpoint, issued and renewed independently of the hub cert so the
+// endpoint can be served on a name outside of the wildcard hub domain.
+type ControlCertConfig struct {
+	// The name to issue the control cert for. When empty, no separate cert
+	// is managed and the control server uses the hub cert.
+	Domain string
+
+	// The type of key generated for the control cert. Defaults to lego's
+	// default, RSA 2048.
+	KeyType certcrypto.KeyType
+
+	// How long before the control cert expires to renew it. Defaults to
+	// DefaultRenewBefore.
+	RenewBefore time.Duration
+}
+
+// ParseKeyType validates the name of a certificate key type, as accepted
+// by lego: P256, P384, 2048, 4096 or 8192.
+func ParseKeyType(str string) (certcrypto.KeyType, error) {
+	switch kt := certcrypto.KeyType(str); kt {
+	case certcrypto.EC256, certcrypto.EC384, certcrypto.RSA2048, certcrypto.RSA4096, certcrypto.RSA8192:
+		return kt, nil
+	default:
+		return "", fmt.Errorf("unknown key type: %s", str)
+	}
 }
 
 func NewManager(cfg ManagerConfig) (*Manager, error) {
@@ -76,9 +168,37 @@ func NewManager(cfg ManagerConfig) (*Manager, error) {
 		cfg.L = hclog.L()
 	}
 
+	if cfg.RenewBefore == 0 {
+		cfg.RenewBefore = DefaultRenewBefore
+	}
+
+	if cfg.Control.RenewBefore == 0 {
+		cfg.Control.RenewBefore = DefaultRenewBefore
+	}
+
+	if cfg.Secrets == nil && cfg.VaultClient != nil {
+		cfg.Secrets = secrets.NewVault(cfg.VaultClient)
+	}
+
 	m.cfg = cfg
+	m.email = cfg.Email
+
+	if len(cfg.AccountKey) > 0 || cfg.AccountURL != "" {
+		if len(cfg.AccountKey) == 0 || cfg.AccountURL == "" {
+			return nil, fmt.Errorf("both an account key and account url must be provided")
+		}
+
+		pkey, err = certcrypto.ParsePEMPrivateKey(cfg.AccountKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing acme account key")
+		}
 
-	if cfg.KeyPath != "" {
+		m.registration = &registration.Resource{
+			URI: cfg.AccountURL,
+		}
+
+		cfg.L.Debug("using existing acme account", "url", cfg.AccountURL)
+	} else if cfg.KeyPath != "" {
 		f, err := os.Open(cfg.KeyPath)
 		if err == nil {
 			data, err := ioutil.ReadAll(f)
@@ -91,32 +211,25 @@ func NewManager(cfg ManagerConfig) (*Manager, error) {
 
 			cfg.L.Debug("read lego key from path", "path", cfg.KeyPath)
 		}
-	} else if cfg.VaultClient != nil {
-		sec, err := cfg.VaultClient.Logical().Read("/kv/data/lego-key")
-		if err != nil {
+	} else if cfg.Secrets != nil {
+		data, err := cfg.Secrets.Read("lego-key")
+		if err != nil && err != secrets.ErrNotFound {
 			return nil, err
 		}
 
-		if sec != nil {
-			data := sec.Data["data"].(map[string]interface{})
-
-			derkey, err := base64.StdEncoding.DecodeString(data["key"].(string))
-			if err != nil {
-				return nil, err
-			}
-
-			key, err := x509.ParsePKCS8PrivateKey(derkey)
+		if err == nil {
+			key, err := x509.ParsePKCS8PrivateKey(data["key"])
 			if err != nil {
 				return nil, err
 			}
 
 			eckey, ok := key.(*ecdsa.PrivateKey)
 			if !ok {
-				return nil, fmt.Errorf("value in vault was not an ecdsa key")
+				return nil, fmt.Errorf("stored lego key was not an ecdsa key")
 			}
 
 			pkey = eckey
-			cfg.L.Debug("read lego key from vault")
+			cfg.L.Debug("read lego key from secrets backend")
 		} else {
 			ecpkey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
 			if err != nil {
@@ -128,17 +241,15 @@ func NewManager(cfg ManagerConfig) (*Manager, error) {
 				return nil, err
 			}
 
-			_, err = cfg.VaultClient.Logical().Write("/kv/data/lego-key", map[string]interface{}{
-				"data": map[string]interface{}{
-					"key": keyBytes,
-				},
+			err = cfg.Secrets.Write("lego-key", map[string][]byte{
+				"key": keyBytes,
 			})
 			if err != nil {
 				return nil, err
 			}
 
 			pkey = ecpkey
-			cfg.L.Debug("generated and wrote lego key to vault")
+			cfg.L.Debug("generated and wrote lego key to secrets backend")
 		}
 	} else {
 		pkey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
@@ -161,6 +272,35 @@ func NewManager(cfg ManagerConfig) (*Manager, error) {
 	return &m, nil
 }
 
+// ValidateAccount checks that the configured existing ACME account can be
+// reached with the configured key and is still valid. It's a noop when
+// the manager is managing its own account.
+func (m *Manager) ValidateAccount(ctx context.Context) error {
+	if m.cfg.AccountURL == "" {
+		return nil
+	}
+
+	client, err := lego.NewClient(m.lcfg)
+	if err != nil {
+		return err
+	}
+
+	reg, err := client.Registration.QueryRegistration()
+	if err != nil {
+		return errors.Wrapf(err, "querying acme account %s", m.cfg.AccountURL)
+	}
+
+	if reg.Body.Status != "valid" {
+		return fmt.Errorf("acme account %s is not valid: %s", m.cfg.AccountURL, reg.Body.Status)
+	}
+
+	m.registration = reg
+
+	hclog.FromContext(ctx).Info("validated existing acme account", "url", reg.URI)
+
+	return nil
+}
+
 func (m *Manager) SetupRoute53(sess *session.Session, zoneId string) error {
 	awsConfig := lego53.NewDefaultConfig()
 	awsConfig.HostedZoneID = zoneId
@@ -172,32 +312,73 @@ func (m *Manager) SetupRoute53(sess *session.Session, zoneId string) error {
 	}
 
 	m.challengeProvider = prov
+
+	m.dnsCheck = func(ctx context.Context) error {
+		_, err := awsConfig.Client.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{
+			Id: aws.String(zoneId),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "looking up route53 zone %s", zoneId)
+		}
+
+		return nil
+	}
+
 	return nil
 }
 
 func (m *Manager) SetupHubCert(ctx context.Context) error {
-	domain := m.cfg.Domain
+	cert, err := m.obtain(ctx, m.cfg.Domain, m.cfg.KeyType)
+	if err != nil {
+		return err
+	}
+
+	m.hubCert = cert.Certificate
+	m.hubIssuer = cert.IssuerCertificate
+	m.hubKey = cert.PrivateKey
+
+	return nil
+}
 
+// Obtain a certificate for domain from the ACME server, registering the
+// account first if needed. An empty keyType uses the one in lcfg.
+func (m *Manager) obtain(ctx context.Context, domain string, keyType certcrypto.KeyType) (*certificate.Resource, error) {
 	log.Logger = hclog.FromContext(ctx).StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true})
 
+	// The hub and control certs may use different key types, so each
+	// request gets its own copy of the config.
+	lcfg := *m.lcfg
+	if keyType != "" {
+		lcfg.Certificate.KeyType = keyType
+	}
+
 	// A client facilitates communication with the CA server.
-	client, err := lego.NewClient(m.lcfg)
+	client, err := lego.NewClient(&lcfg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	client.Challenge.SetDNS01Provider(m.challengeProvider, m.dnsOptions...)
-	reg, err := client.Registration.ResolveAccountByKey()
-	if err != nil {
-		reg, err = client.Registration.Register(registration.RegisterOptions{
-			TermsOfServiceAgreed: true,
-		})
+	client.Challenge.SetDNS01Provider(m.dnsProvider(), m.dnsOptions...)
+
+	// An existing account was provided, so there is nothing to register.
+	if m.cfg.AccountURL == "" {
+		reg, err := client.Registration.ResolveAccountByKey()
 		if err != nil {
-			return errors.Wrapf(err, "attempting to register")
+			reg, err = m.register(client)
+			if err != nil {
+				return nil, err
+			}
+		} else if m.email != "" && !hasContact(reg, m.email) {
+			// Lego resolves the account by key alone, so the contact it
+			// was registered with has to be checked separately.
+			reg, err = m.updateContact(ctx, client, reg)
+			if err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	m.registration = reg
+		m.registration = reg
+	}
 
 	request := certificate.ObtainRequest{
 		Domains: []string{domain},
@@ -206,14 +387,54 @@ func (m *Manager) SetupHubCert(ctx context.Context) error {
 
 	cert, err := client.Certificate.Obtain(request)
 	if err != nil {
-		return errors.Wrapf(err, "attempting to obtain certificate")
+		return nil, errors.Wrapf(err, "attempting to obtain certificate")
 	}
 
-	m.hubCert = cert.Certificate
-	m.hubIssuer = cert.IssuerCertificate
-	m.hubKey = cert.PrivateKey
+	return cert, nil
+}
 
-	return nil
+// Register a new account with the ACME server, using the configured email
+// as its contact.
+func (m *Manager) register(client *lego.Client) (*registration.Resource, error) {
+	if tos := client.GetToSURL(); tos != "" && !m.cfg.AcceptTOS {
+		return nil, fmt.Errorf("the ACME server requires accepting its terms of service (%s), set ACME_ACCEPT_TOS to agree", tos)
+	}
+
+	reg, err := client.Registration.Register(registration.RegisterOptions{
+		TermsOfServiceAgreed: m.cfg.AcceptTOS,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "attempting to register")
+	}
+
+	return reg, nil
+}
+
+// Replace the contact of the account reg with the configured email.
+func (m *Manager) updateContact(ctx context.Context, client *lego.Client, reg *registration.Resource) (*registration.Resource, error) {
+	// Lego finds the account to update through the user's registration.
+	m.registration = reg
+
+	reg, err := client.Registration.UpdateRegistration(registration.RegisterOptions{
+		TermsOfServiceAgreed: m.cfg.AcceptTOS,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "updating acme account contact")
+	}
+
+	hclog.FromContext(ctx).Info("updated acme account contact", "email", m.email)
+
+	return reg, nil
+}
+
+func hasContact(reg *registration.Resource, email string) bool {
+	for _, c := range reg.Body.Contact {
+		if c == "mailto:"+email {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (m *Manager) RefreshFromVault() ([]byte, []byte, error) {
@@ -222,6 +443,8 @@ func (m *Manager) RefreshFromVault() ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
+	m.logRotation(m.hubCert, cert)
+
 	m.hubCert = cert
 	m.hubKey = key
 
@@ -256,6 +479,13 @@ func (m *Manager) HubMaterial(ctx context.Context) ([]byte, []byte, error) {
 	return m.hubCert, m.hubKey, nil
 }
 
+// Certificate returns the material the control server should serve for its
+// own endpoint: the control cert when one is configured, otherwise the hub
+// cert.
 func (m *Manager) Certificate() (tls.Certificate, error) {
+	if m.cfg.Control.Domain != "" {
+		return tls.X509KeyPair(m.controlCert, m.controlKey)
+	}
+
 	return tls.X509KeyPair(m.hubCert, m.hubKey)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/go-acme/lego/v3/certcrypto"
 	"github.com/go-acme/lego/v3/challenge/dns01"
 	"github.com/go-acme/lego/v3/lego"
+	"github.com/go-acme/lego/v3/registration"
 	"github.com/hashicorp/horizon/pkg/testutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,7 +55,8 @@ func TestManager(t *testing.T) {
 		var mdp mockDNSProvider
 
 		mgr, err := NewManager(ManagerConfig{
-			Domain: "*.test.cloud",
+			Domain:    "*.test.cloud",
+			AcceptTOS: true,
 		})
 		require.NoError(t, err)
 
@@ -104,6 +106,69 @@ func TestManager(t *testing.T) {
 		assert.Equal(t, "_acme-challenge.test.cloud.", dnsCheckFqdn)
 	})
 
+	t.Run("can use an existing acme account", func(t *testing.T) {
+		pebbleClient := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		}
+
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		owner, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		owner.key = priv
+		owner.lcfg = lego.NewConfig(owner)
+		owner.lcfg.CADirURL = "https://127.0.0.1:14000/dir"
+		owner.lcfg.HTTPClient = pebbleClient
+
+		client, err := lego.NewClient(owner.lcfg)
+		require.NoError(t, err)
+
+		reg, err := client.Registration.Register(registration.RegisterOptions{
+			TermsOfServiceAgreed: true,
+		})
+		require.NoError(t, err)
+
+		_, err = NewManager(ManagerConfig{
+			AccountKey: certcrypto.PEMEncode(priv),
+		})
+		require.Error(t, err)
+
+		mgr, err := NewManager(ManagerConfig{
+			AccountKey: certcrypto.PEMEncode(priv),
+			AccountURL: reg.URI,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, reg.URI, mgr.GetRegistration().URI)
+
+		mgr.lcfg.CADirURL = "https://127.0.0.1:14000/dir"
+		mgr.lcfg.HTTPClient = pebbleClient
+
+		err = mgr.ValidateAccount(context.Background())
+		require.NoError(t, err)
+
+		other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		bad, err := NewManager(ManagerConfig{
+			AccountKey: certcrypto.PEMEncode(other),
+			AccountURL: reg.URI,
+		})
+		require.NoError(t, err)
+
+		bad.lcfg.CADirURL = "https://127.0.0.1:14000/dir"
+		bad.lcfg.HTTPClient = pebbleClient
+
+		err = bad.ValidateAccount(context.Background())
+		require.Error(t, err)
+	})
+
 	t.Run("can fetch the hub material from vault", func(t *testing.T) {
 		defer vc.Logical().Delete("/kv/metadata/hub-tls")
 
@@ -167,6 +232,7 @@ func TestManager(t *testing.T) {
 		mgr, err := NewManager(ManagerConfig{
 			Domain:      "*.test.cloud",
 			VaultClient: vc,
+			AcceptTOS:   true,
 		})
 		require.NoError(t, err)
 
@@ -231,4 +297,65 @@ func TestManager(t *testing.T) {
 		assert.Equal(t, bkey, bkey2)
 	})
 
+	t.Run("registers with the configured email", func(t *testing.T) {
+		var mdp mockDNSProvider
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:    "*.test.cloud",
+			Email:     "ops@test.cloud",
+			AcceptTOS: true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "ops@test.cloud", mgr.GetEmail())
+
+		mgr.challengeProvider = &mdp
+		mgr.dnsOptions = append(mgr.dnsOptions,
+			dns01.WrapPreCheck(
+				func(domain, fqdn, value string, check dns01.PreCheckFunc) (bool, error) {
+					return true, nil
+				}),
+		)
+
+		mgr.lcfg.CADirURL = "https://127.0.0.1:14000/dir"
+		mgr.lcfg.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		}
+		mgr.lcfg.Certificate.KeyType = certcrypto.EC256
+
+		err = mgr.SetupHubCert(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"mailto:ops@test.cloud"}, mgr.GetRegistration().Body.Contact)
+	})
+
+	t.Run("refuses to register without accepting the terms of service", func(t *testing.T) {
+		var mdp mockDNSProvider
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain: "*.test.cloud",
+		})
+		require.NoError(t, err)
+
+		mgr.challengeProvider = &mdp
+
+		mgr.lcfg.CADirURL = "https://127.0.0.1:14000/dir"
+		mgr.lcfg.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		}
+
+		err = mgr.SetupHubCert(context.Background())
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "terms of service")
+		assert.Nil(t, mgr.GetRegistration())
+	})
 }
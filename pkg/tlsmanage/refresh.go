@@ -0,0 +1,144 @@
+package tlsmanage
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// How long RefreshFromVaultWithBackoff keeps retrying while vault is
+	// unavailable, and the bounds of the delay between attempts.
+	VaultRetryMaxElapsed = 10 * time.Minute
+	VaultRetryMinBackoff = time.Second
+	VaultRetryMaxBackoff = time.Minute
+
+	// When vault stays unavailable and the served cert expires within this
+	// window, the failure is escalated rather than just logged.
+	CertExpiryWarning = 7 * 24 * time.Hour
+)
+
+var vaultFailures = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "tlsmanage_vault_consecutive_failures",
+	Help: "The number of consecutive failed attempts to read the hub TLS material from vault.",
+})
+
+func init() {
+	prometheus.MustRegister(vaultFailures)
+}
+
+// ErrNoCertificate is returned by CertExpiry when the manager has no
+// certificate loaded or it can not be parsed.
+var ErrNoCertificate = errors.New("no parsable certificate loaded")
+
+// The vault client only reports the response status as part of the error
+// message, ie "Code: 503. Errors:".
+var vaultStatusCode = regexp.MustCompile(`Code: (\d{3})`)
+
+// IsVaultUnavailable reports whether err indicates vault could not serve
+// the request at all (sealed, in standby, overloaded, or unreachable), as
+// opposed to the request being answered. Such errors are worth retrying.
+func IsVaultUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) {
+		return true
+	}
+
+	m := vaultStatusCode.FindStringSubmatch(err.Error())
+	if m == nil {
+		return false
+	}
+
+	code, _ := strconv.Atoi(m[1])
+
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// VaultFailures returns the number of consecutive failed refreshes.
+func (m *Manager) VaultFailures() int64 {
+	return atomic.LoadInt64(&m.vaultFailures)
+}
+
+// CertExpiry returns when the currently loaded hub certificate expires.
+func (m *Manager) CertExpiry() (time.Time, error) {
+	info, err := m.CertInfo()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return info.NotAfter, nil
+}
+
+// RefreshFromVaultWithBackoff is RefreshFromVault, but retries with
+// exponential backoff while vault is unavailable, up to
+// VaultRetryMaxElapsed. Missing material is returned straight away as
+// retrying won't make it appear. If vault stays down while the current
+// cert is within CertExpiryWarning of expiring, an error is logged with
+// alert=true so that it can be picked up by alerting.
+func (m *Manager) RefreshFromVaultWithBackoff(ctx context.Context) ([]byte, []byte, error) {
+	L := m.cfg.L
+
+	start := time.Now()
+	backoff := VaultRetryMinBackoff
+
+	for {
+		cert, key, err := m.RefreshFromVault()
+		if err == nil {
+			atomic.StoreInt64(&m.vaultFailures, 0)
+			vaultFailures.Set(0)
+			return cert, key, nil
+		}
+
+		failures := atomic.AddInt64(&m.vaultFailures, 1)
+		vaultFailures.Set(float64(failures))
+
+		if !IsVaultUnavailable(err) {
+			L.Error("hub TLS material could not be read from vault", "error", err, "failures", failures)
+			return nil, nil, err
+		}
+
+		if time.Since(start)+backoff > VaultRetryMaxElapsed {
+			m.escalateIfExpiring(err, failures)
+			return nil, nil, err
+		}
+
+		L.Warn("vault unavailable while refreshing hub TLS material, retrying",
+			"error", err, "failures", failures, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > VaultRetryMaxBackoff {
+			backoff = VaultRetryMaxBackoff
+		}
+	}
+}
+
+func (m *Manager) escalateIfExpiring(err error, failures int64) {
+	L := m.cfg.L
+
+	expiry, cerr := m.CertExpiry()
+	if cerr != nil || time.Until(expiry) < CertExpiryWarning {
+		L.Error("vault unavailable and served hub certificate is close to expiry",
+			"error", err, "failures", failures, "expires", expiry, "alert", true)
+		return
+	}
+
+	L.Warn("vault unavailable, continuing to serve current hub certificate",
+		"error", err, "failures", failures, "expires", expiry)
+}
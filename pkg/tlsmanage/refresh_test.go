@@ -0,0 +1,109 @@
+package tlsmanage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/secrets"
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshFromVault(t *testing.T) {
+	VaultRetryMinBackoff = time.Millisecond
+	VaultRetryMaxBackoff = 10 * time.Millisecond
+
+	cert, key, err := testutils.SelfSignedCert()
+	require.NoError(t, err)
+
+	vaultServer := func(t *testing.T, unavailable int64, status int) (*api.Client, *int64, func()) {
+		var calls int64
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt64(&calls, 1) <= unavailable {
+				w.WriteHeader(status)
+				return
+			}
+
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"data": map[string]interface{}{
+						"key":         base64.StdEncoding.EncodeToString(key),
+						"certificate": base64.StdEncoding.EncodeToString(cert),
+					},
+				},
+			})
+		}))
+
+		vc, err := api.NewClient(&api.Config{Address: srv.URL})
+		require.NoError(t, err)
+
+		vc.SetToken("test")
+
+		return vc, &calls, srv.Close
+	}
+
+	t.Run("retries while vault is sealed", func(t *testing.T) {
+		vc, calls, closer := vaultServer(t, 3, http.StatusServiceUnavailable)
+		defer closer()
+
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		mgr.cfg.Secrets = secrets.NewVault(vc)
+
+		certOut, keyOut, err := mgr.RefreshFromVaultWithBackoff(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, cert, certOut)
+		assert.Equal(t, key, keyOut)
+		assert.Equal(t, int64(4), atomic.LoadInt64(calls))
+		assert.Equal(t, int64(0), mgr.VaultFailures())
+
+		expiry, err := mgr.CertExpiry()
+		require.NoError(t, err)
+
+		assert.True(t, expiry.After(time.Now()))
+	})
+
+	t.Run("does not retry when vault answered", func(t *testing.T) {
+		vc, calls, closer := vaultServer(t, 1, http.StatusForbidden)
+		defer closer()
+
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		mgr.cfg.Secrets = secrets.NewVault(vc)
+
+		_, _, err = mgr.RefreshFromVaultWithBackoff(context.Background())
+		require.Error(t, err)
+
+		assert.False(t, IsVaultUnavailable(err))
+		assert.Equal(t, int64(1), atomic.LoadInt64(calls))
+		assert.Equal(t, int64(1), mgr.VaultFailures())
+	})
+
+	t.Run("classifies unavailability errors", func(t *testing.T) {
+		vaultErr := func(code int) error {
+			return fmt.Errorf("Error making API request.\n\nURL: GET http://vault/v1/kv/data/hub-tls\nCode: %d. Errors:\n\n* error", code)
+		}
+
+		assert.True(t, IsVaultUnavailable(vaultErr(503)))
+		assert.True(t, IsVaultUnavailable(errors.Wrapf(vaultErr(500), "reading")))
+		assert.True(t, IsVaultUnavailable(vaultErr(429)))
+
+		assert.False(t, IsVaultUnavailable(vaultErr(403)))
+		assert.False(t, IsVaultUnavailable(ErrNoTLSMaterial))
+		assert.False(t, IsVaultUnavailable(nil))
+	})
+}
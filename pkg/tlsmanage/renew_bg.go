@@ -9,15 +9,53 @@ import (
 )
 
 var (
-	HubCertRenewPeriod = time.Hour * 24 * 30 // every 30 days
+	// How often the renew job checks whether the hub cert is within
+	// RenewBefore of expiring.
+	HubCertRenewPeriod = time.Hour * 24 // every day
+
+	// The default ManagerConfig.RenewBefore. Let's Encrypt certs are valid
+	// for 90 days, so this renews once a third of the lifetime is left.
+	DefaultRenewBefore = time.Hour * 24 * 30
 )
 
 func init() {
 	workq.RegisterPeriodicJob("renew-hub-cert", "default", "renew-hub-cert", nil, HubCertRenewPeriod)
 }
 
-func (m *Manager) RegisterRenewHandler(L hclog.Logger, reg *workq.Registry) {
+// Indicates if the current hub cert expires within RenewBefore of now, or
+// if there is no usable cert at all.
+func (m *Manager) needsRenewal(now time.Time) bool {
+	expiry, err := m.CertExpiry()
+	if err != nil {
+		return true
+	}
+
+	return !now.Add(m.cfg.RenewBefore).Before(expiry)
+}
+
+func (m *Manager) RegisterRenewHandler(reg *workq.Registry) {
 	reg.Register("renew-hub-cert", func(ctx context.Context, jobType string, _ *struct{}) error {
+		// The worker tags this logger with the job id and attempt.
+		L := hclog.FromContext(ctx)
+
+		// Another instance may have already renewed, so check against the
+		// latest material.
+		if m.cfg.Secrets != nil {
+			_, _, err := m.RefreshFromVault()
+			if err != nil && err != ErrNoTLSMaterial {
+				L.Error("error refreshing cert/key from vault", "error", err)
+				return err
+			}
+		}
+
+		if !m.needsRenewal(time.Now()) {
+			expiry, _ := m.CertExpiry()
+			L.Debug("hub cert not yet due for renewal", "expires", expiry, "renew-before", m.cfg.RenewBefore)
+			return nil
+		}
+
+		L.Info("renewing hub cert", "renew-before", m.cfg.RenewBefore)
+
 		err := m.SetupHubCert(ctx)
 		if err != nil {
 			L.Error("error retrieving updated cert/key for hub", "error", err)
@@ -32,4 +70,12 @@ func (m *Manager) RegisterRenewHandler(L hclog.Logger, reg *workq.Registry) {
 
 		return nil
 	})
+
+	if m.cfg.Control.Domain != "" {
+		m.registerControlRenewHandler(reg)
+	}
+
+	if m.subdomainSource != nil {
+		m.registerSubdomainHandler(reg)
+	}
 }
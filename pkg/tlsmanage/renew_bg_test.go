@@ -0,0 +1,42 @@
+package tlsmanage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenewal(t *testing.T) {
+	t.Run("renews when within the configured lead time", func(t *testing.T) {
+		// The cert expires in 5 minutes
+		cert, key, err := testutils.SelfSignedCert()
+		require.NoError(t, err)
+
+		mgr, err := NewManager(ManagerConfig{
+			RenewBefore: time.Minute,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, mgr.needsRenewal(time.Now()), "no cert should need renewal")
+
+		mgr.hubCert = cert
+		mgr.hubKey = key
+
+		now := time.Now()
+
+		assert.False(t, mgr.needsRenewal(now))
+		assert.False(t, mgr.needsRenewal(now.Add(3*time.Minute)))
+		assert.True(t, mgr.needsRenewal(now.Add(4*time.Minute+30*time.Second)))
+		assert.True(t, mgr.needsRenewal(now.Add(10*time.Minute)))
+	})
+
+	t.Run("defaults to renewing 30 days out", func(t *testing.T) {
+		mgr, err := NewManager(ManagerConfig{})
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultRenewBefore, mgr.cfg.RenewBefore)
+	})
+}
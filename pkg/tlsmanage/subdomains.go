@@ -0,0 +1,225 @@
+package tlsmanage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/horizon/pkg/secrets"
+	"github.com/hashicorp/horizon/pkg/workq"
+	"github.com/pkg/errors"
+)
+
+// How often the subdomain certs are reconciled against the subdomains that
+// need one, which bounds how long a newly registered hub waits for its cert.
+var SubdomainCertPeriod = time.Minute
+
+// The subdomains with a stored cert are kept under this name, as the keys
+// of the material, so that certs for subdomains that have gone away can be
+// found and removed.
+const subdomainIndexPath = "hub-tls-subdomains"
+
+func subdomainVaultPath(name string) string {
+	return "hub-tls-" + name
+}
+
+// SubdomainMaterial is the certificate and key issued for one explicitly
+// named subdomain.
+type SubdomainMaterial struct {
+	Name        string
+	Certificate []byte
+	Key         []byte
+}
+
+// SetSubdomainSource has the manager issue a certificate naming each of the
+// subdomains returned by names, for environments that forbid wildcard
+// certs. The reconcile job, registered by RegisterRenewHandler, issues and
+// renews a cert for every subdomain returned and removes those of the
+// subdomains no longer returned. Each cert counts against the ACME server's
+// rate limits, so names should only return subdomains in use.
+func (m *Manager) SetSubdomainSource(names func(ctx context.Context) ([]string, error)) {
+	m.subdomainSource = names
+}
+
+func (m *Manager) subdomainIndex() (map[string]bool, error) {
+	data, err := m.cfg.Secrets.Read(subdomainIndexPath)
+	if err != nil {
+		if err == secrets.ErrNotFound {
+			return map[string]bool{}, nil
+		}
+
+		return nil, err
+	}
+
+	index := make(map[string]bool, len(data))
+
+	for name := range data {
+		index[name] = true
+	}
+
+	return index, nil
+}
+
+func (m *Manager) writeSubdomainIndex(index map[string]bool) error {
+	data := make(map[string][]byte, len(index))
+
+	for name := range index {
+		data[name] = []byte{}
+	}
+
+	return m.cfg.Secrets.Write(subdomainIndexPath, data)
+}
+
+// Indicates if cert expires within RenewBefore of now, or isn't usable.
+func (m *Manager) subdomainNeedsRenewal(cert []byte, now time.Time) bool {
+	info, err := ParseCertInfo(cert)
+	if err != nil {
+		return true
+	}
+
+	return !now.Add(m.cfg.RenewBefore).Before(info.NotAfter)
+}
+
+// ReconcileSubdomainCerts issues a cert for each subdomain from the source
+// set with SetSubdomainSource that has none or whose cert is due for
+// renewal, and removes the certs of subdomains the source no longer
+// returns. A failure to issue one cert doesn't hold up the others, the
+// failures are returned together once every subdomain has been handled.
+func (m *Manager) ReconcileSubdomainCerts(ctx context.Context) error {
+	L := hclog.FromContext(ctx)
+
+	if m.subdomainSource == nil {
+		return nil
+	}
+
+	names, err := m.subdomainSource(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "listing subdomains")
+	}
+
+	current, err := m.subdomainIndex()
+	if err != nil {
+		return errors.Wrapf(err, "reading subdomain index")
+	}
+
+	var (
+		result  error
+		want    = make(map[string]bool, len(names))
+		index   = make(map[string]bool, len(names))
+		changed bool
+		now     = time.Now()
+	)
+
+	for _, name := range names {
+		want[name] = true
+
+		cert, _, err := m.readVaultMaterial(subdomainVaultPath(name))
+		if err == nil && !m.subdomainNeedsRenewal(cert, now) {
+			index[name] = true
+			continue
+		}
+
+		if err != nil && err != ErrNoTLSMaterial {
+			result = multierror.Append(result, errors.Wrapf(err, "reading cert for %s", name))
+			if current[name] {
+				index[name] = true
+			}
+			continue
+		}
+
+		L.Info("issuing subdomain cert", "name", name, "renewal", err == nil)
+
+		res, err := m.obtain(ctx, name, m.cfg.KeyType)
+		if err == nil {
+			err = m.writeVaultMaterial(subdomainVaultPath(name), res.Certificate, res.PrivateKey)
+		}
+
+		if err != nil {
+			L.Error("error issuing subdomain cert", "name", name, "error", err)
+			result = multierror.Append(result, errors.Wrapf(err, "issuing cert for %s", name))
+			if current[name] {
+				index[name] = true
+			}
+			continue
+		}
+
+		if !current[name] {
+			changed = true
+		}
+
+		index[name] = true
+	}
+
+	for name := range current {
+		if want[name] {
+			continue
+		}
+
+		err := m.cfg.Secrets.Delete(subdomainVaultPath(name))
+		if err != nil {
+			L.Error("error removing subdomain cert", "name", name, "error", err)
+			result = multierror.Append(result, errors.Wrapf(err, "removing cert for %s", name))
+			index[name] = true
+			continue
+		}
+
+		L.Info("removed subdomain cert", "name", name)
+
+		changed = true
+	}
+
+	if changed {
+		err = m.writeSubdomainIndex(index)
+		if err != nil {
+			result = multierror.Append(result, errors.Wrapf(err, "writing subdomain index"))
+		}
+	}
+
+	return result
+}
+
+// FetchSubdomainsFromVault returns the material of every subdomain with a
+// stored cert, ordered by name, as stored by whichever instance last ran
+// the reconcile job.
+func (m *Manager) FetchSubdomainsFromVault() ([]*SubdomainMaterial, error) {
+	index, err := m.subdomainIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for name := range index {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var out []*SubdomainMaterial
+
+	for _, name := range names {
+		cert, key, err := m.readVaultMaterial(subdomainVaultPath(name))
+		if err != nil {
+			// Removed since the index was read.
+			if err == ErrNoTLSMaterial {
+				continue
+			}
+
+			return nil, errors.Wrapf(err, "reading cert for %s", name)
+		}
+
+		out = append(out, &SubdomainMaterial{Name: name, Certificate: cert, Key: key})
+	}
+
+	return out, nil
+}
+
+func (m *Manager) registerSubdomainHandler(reg *workq.Registry) {
+	reg.Register("reconcile-subdomain-certs", func(ctx context.Context, jobType string, _ *struct{}) error {
+		return m.ReconcileSubdomainCerts(ctx)
+	})
+
+	workq.RegisterPeriodicJob("reconcile-subdomain-certs", "default", "reconcile-subdomain-certs", nil, SubdomainCertPeriod)
+}
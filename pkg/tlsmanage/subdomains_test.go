@@ -0,0 +1,109 @@
+package tlsmanage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubdomainCerts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	var dirs []string
+
+	defer func() {
+		for _, dir := range dirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	setup := func(t *testing.T) (*Manager, secrets.Backend) {
+		dir, err := ioutil.TempDir("", "hzn")
+		require.NoError(t, err)
+
+		dirs = append(dirs, dir)
+
+		store, err := secrets.NewFile(dir)
+		require.NoError(t, err)
+
+		mgr, err := NewManager(ManagerConfig{
+			Domain:  "hub.test",
+			Secrets: store,
+		})
+		require.NoError(t, err)
+
+		// Nothing listens here, so any attempt to issue a cert fails fast.
+		mgr.lcfg.CADirURL = "http://127.0.0.1:1/dir"
+
+		return mgr, store
+	}
+
+	store := func(t *testing.T, mgr *Manager, names ...string) {
+		index, err := mgr.subdomainIndex()
+		require.NoError(t, err)
+
+		for _, name := range names {
+			cert, key := importTestCert(t, []string{name}, now.Add(-time.Hour), now.Add(90*24*time.Hour))
+			require.NoError(t, mgr.writeVaultMaterial(subdomainVaultPath(name), cert, key))
+
+			index[name] = true
+		}
+
+		require.NoError(t, mgr.writeSubdomainIndex(index))
+	}
+
+	t.Run("removes the certs of subdomains no longer in use", func(t *testing.T) {
+		mgr, backend := setup(t)
+
+		store(t, mgr, "a.hub.test", "b.hub.test")
+
+		mgr.SetSubdomainSource(func(ctx context.Context) ([]string, error) {
+			return []string{"a.hub.test"}, nil
+		})
+
+		require.NoError(t, mgr.ReconcileSubdomainCerts(ctx))
+
+		material, err := mgr.FetchSubdomainsFromVault()
+		require.NoError(t, err)
+		require.Len(t, material, 1)
+		assert.Equal(t, "a.hub.test", material[0].Name)
+
+		_, err = backend.Read(subdomainVaultPath("b.hub.test"))
+		assert.Equal(t, secrets.ErrNotFound, err)
+	})
+
+	t.Run("keeps reconciling the rest when a cert can't be issued", func(t *testing.T) {
+		mgr, _ := setup(t)
+
+		store(t, mgr, "a.hub.test", "b.hub.test")
+
+		mgr.SetSubdomainSource(func(ctx context.Context) ([]string, error) {
+			return []string{"new.hub.test", "a.hub.test"}, nil
+		})
+
+		err := mgr.ReconcileSubdomainCerts(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "new.hub.test")
+
+		index, err := mgr.subdomainIndex()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"a.hub.test": true}, index)
+	})
+
+	t.Run("renews certs within the renewal window", func(t *testing.T) {
+		mgr, _ := setup(t)
+
+		cert, _ := importTestCert(t, []string{"a.hub.test"}, now.Add(-time.Hour), now.Add(time.Hour))
+		assert.True(t, mgr.subdomainNeedsRenewal(cert, now))
+
+		cert, _ = importTestCert(t, []string{"a.hub.test"}, now.Add(-time.Hour), now.Add(90*24*time.Hour))
+		assert.False(t, mgr.subdomainNeedsRenewal(cert, now))
+	})
+}
@@ -1,48 +1,48 @@
 package tlsmanage
 
 import (
-	"encoding/base64"
-
+	"github.com/hashicorp/horizon/pkg/secrets"
 	"github.com/pkg/errors"
 )
 
 var ErrNoTLSMaterial = errors.New("no tls material available")
 
-func (m *Manager) FetchFromVault() ([]byte, []byte, error) {
-	sec, err := m.cfg.VaultClient.Logical().Read("/kv/data/hub-tls")
-	if err != nil {
-		return nil, nil, err
-	}
+// The names the hub and control material are kept under in the secrets
+// backend.
+const (
+	hubVaultPath     = "hub-tls"
+	controlVaultPath = "control-tls"
+)
 
-	if sec == nil {
-		return nil, nil, ErrNoTLSMaterial
-	}
+func (m *Manager) FetchFromVault() ([]byte, []byte, error) {
+	return m.readVaultMaterial(hubVaultPath)
+}
 
-	data, ok := sec.Data["data"].(map[string]interface{})
-	if !ok {
-		return nil, nil, ErrNoTLSMaterial
-	}
+func (m *Manager) StoreInVault() error {
+	return m.writeVaultMaterial(hubVaultPath, m.hubCert, m.hubKey)
+}
 
-	key, err := base64.StdEncoding.DecodeString(data["key"].(string))
+func (m *Manager) readVaultMaterial(name string) ([]byte, []byte, error) {
+	data, err := m.cfg.Secrets.Read(name)
 	if err != nil {
+		if err == secrets.ErrNotFound {
+			return nil, nil, ErrNoTLSMaterial
+		}
+
 		return nil, nil, err
 	}
 
-	cert, err := base64.StdEncoding.DecodeString(data["certificate"].(string))
-	if err != nil {
-		return nil, nil, err
+	cert, key := data["certificate"], data["key"]
+	if len(cert) == 0 || len(key) == 0 {
+		return nil, nil, ErrNoTLSMaterial
 	}
 
 	return cert, key, nil
 }
 
-func (m *Manager) StoreInVault() error {
-	_, err := m.cfg.VaultClient.Logical().Write("/kv/data/hub-tls", map[string]interface{}{
-		"data": map[string]interface{}{
-			"key":         m.hubKey,
-			"certificate": m.hubCert,
-		},
+func (m *Manager) writeVaultMaterial(name string, cert, key []byte) error {
+	return m.cfg.Secrets.Write(name, map[string][]byte{
+		"key":         key,
+		"certificate": cert,
 	})
-
-	return err
 }
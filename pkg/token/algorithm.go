@@ -0,0 +1,117 @@
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/horizon/pkg/pb"
+)
+
+// An Algorithm that tokens are signed with.
+type Algorithm string
+
+const (
+	AlgorithmEd25519   Algorithm = "ed25519"
+	AlgorithmECDSAP256 Algorithm = "ecdsa-p256"
+)
+
+// ParseAlgorithm parses the name of a signing algorithm. An empty name is
+// ed25519.
+func ParseAlgorithm(str string) (Algorithm, error) {
+	switch Algorithm(str) {
+	case "", AlgorithmEd25519:
+		return AlgorithmEd25519, nil
+	case AlgorithmECDSAP256:
+		return AlgorithmECDSAP256, nil
+	default:
+		return "", fmt.Errorf("unsupported token signing algorithm: %s", str)
+	}
+}
+
+// The signature type that tokens signed with a is marked with.
+func (a Algorithm) SigType() pb.Signature_SigType {
+	switch a {
+	case AlgorithmECDSAP256:
+		return pb.ECDSA_P256
+	default:
+		return pb.ED25519
+	}
+}
+
+// A PublicKey that token signatures are verified against. Ed25519 keys are
+// the raw key, ECDSA keys are PKIX DER encoded.
+type PublicKey struct {
+	Algorithm Algorithm
+	Key       []byte
+}
+
+// Ed25519Key returns key as a PublicKey.
+func Ed25519Key(key ed25519.PublicKey) PublicKey {
+	return PublicKey{Algorithm: AlgorithmEd25519, Key: key}
+}
+
+// Verify reports whether sig is a valid signature of data by the key.
+func (k PublicKey) Verify(data, sig []byte) bool {
+	switch k.Algorithm {
+	case "", AlgorithmEd25519:
+		if len(k.Key) != ed25519.PublicKeySize {
+			return false
+		}
+
+		return ed25519.Verify(ed25519.PublicKey(k.Key), data, sig)
+	case AlgorithmECDSAP256:
+		pub, err := parseECDSAP256(k.Key)
+		if err != nil || len(sig) != 64 {
+			return false
+		}
+
+		digest := sha256.Sum256(data)
+
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+
+		return ecdsa.Verify(pub, digest[:], r, s)
+	default:
+		return false
+	}
+}
+
+func parseECDSAP256(der []byte) (*ecdsa.PublicKey, error) {
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, ok := parsed.(*ecdsa.PublicKey)
+	if !ok || pub.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("not an ecdsa p-256 key")
+	}
+
+	return pub, nil
+}
+
+// SignECDSAP256 signs data with key in the form ECDSA_P256 signatures are
+// verified in.
+func SignECDSAP256(key *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, 64)
+
+	rb, sb := r.Bytes(), s.Bytes()
+
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):], sb)
+
+	return sig, nil
+}
@@ -3,9 +3,6 @@ package token
 import (
 	"bytes"
 	"crypto/ed25519"
-	"encoding/base64"
-	"fmt"
-	"path/filepath"
 	"time"
 
 	"github.com/hashicorp/horizon/pkg/pb"
@@ -144,6 +141,20 @@ func (c *TokenCreator) EncodeED25519(key ed25519.PrivateKey, keyId string) (stri
 }
 
 func (c *TokenCreator) EncodeED25519WithVault(vc *api.Client, path, keyId string) (string, error) {
+	return c.EncodeED25519WithSigner(func(data []byte) ([]byte, error) {
+		return VaultSign(vc, path, data)
+	}, keyId)
+}
+
+// EncodeED25519WithSigner is like EncodeED25519 but leaves producing the
+// signature to sign, for keys held outside the process.
+func (c *TokenCreator) EncodeED25519WithSigner(sign func(data []byte) ([]byte, error), keyId string) (string, error) {
+	return c.EncodeWithSigner(sign, keyId, AlgorithmEd25519)
+}
+
+// EncodeWithSigner encodes the token with the signature sign produces,
+// marked as made by the key keyId using alg.
+func (c *TokenCreator) EncodeWithSigner(sign func(data []byte) ([]byte, error), keyId string, alg Algorithm) (string, error) {
 	var t pb.Token
 
 	t.Metadata = &pb.Headers{}
@@ -160,21 +171,7 @@ func (c *TokenCreator) EncodeED25519WithVault(vc *api.Client, path, keyId string
 		return "", err
 	}
 
-	secret, err := vc.Logical().Write(filepath.Join("/transit/sign", path), map[string]interface{}{
-		"input":                base64.StdEncoding.EncodeToString(data),
-		"marshaling_algorithm": "jws",
-	})
-
-	if err != nil {
-		return "", err
-	}
-
-	ct, ok := secret.Data["signature"].(string)
-	if !ok {
-		return "", fmt.Errorf("vault response missing ciphertext")
-	}
-
-	sig, err := base64.RawURLEncoding.DecodeString(ct[9:])
+	sig, err := sign(data)
 	if err != nil {
 		return "", err
 	}
@@ -183,7 +180,7 @@ func (c *TokenCreator) EncodeED25519WithVault(vc *api.Client, path, keyId string
 
 	t.Signatures = []*pb.Signature{
 		{
-			SigType:   pb.ED25519,
+			SigType:   alg.SigType(),
 			KeyId:     keyId,
 			Signature: sig,
 		},
@@ -29,3 +29,37 @@ func Metadata(stoken string) (map[string]string, error) {
 
 	return md, nil
 }
+
+// The account stoken is for, read without checking its signatures. Only
+// suitable as a hint, such as the account an agent asks control for hubs
+// on behalf of.
+func UnverifiedAccount(stoken string) (*pb.Account, error) {
+	token, err := RemoveArmor(stoken)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(token) == 0 || token[0] != Magic {
+		return nil, ErrBadToken
+	}
+
+	var t pb.Token
+
+	err = t.Unmarshal(token[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	var body pb.Token_Body
+
+	err = body.Unmarshal(t.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if body.Account == nil {
+		return nil, ErrBadToken
+	}
+
+	return body.Account, nil
+}
@@ -1,8 +1,11 @@
 package token
 
 import (
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/x509"
 	"errors"
 	"testing"
 	"time"
@@ -41,6 +44,95 @@ func TestToken(t *testing.T) {
 		assert.Equal(t, "k1", vt.KeyId)
 	})
 
+	t.Run("validates against the key matching the key id", func(t *testing.T) {
+		var tc TokenCreator
+		tc.AccountId = pb.NewULID()
+		tc.AccuntNamespace = "/test"
+
+		pub1, key1, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		pub2, key2, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		keys := map[string]ed25519.PublicKey{
+			"k1": pub1,
+			"k2": pub2,
+		}
+
+		old, err := tc.EncodeED25519(key1, "k1")
+		require.NoError(t, err)
+
+		cur, err := tc.EncodeED25519(key2, "k2")
+		require.NoError(t, err)
+
+		vt, err := CheckTokenED25519Keys(old, keys)
+		require.NoError(t, err)
+		assert.Equal(t, "k1", vt.KeyId)
+
+		vt, err = CheckTokenED25519Keys(cur, keys)
+		require.NoError(t, err)
+		assert.Equal(t, "k2", vt.KeyId)
+
+		// Signed by k1 but claiming to be k2
+		wrong, err := tc.EncodeED25519(key1, "k2")
+		require.NoError(t, err)
+
+		_, err = CheckTokenED25519Keys(wrong, keys)
+		require.Error(t, err)
+
+		// Retired keys are rejected
+		delete(keys, "k1")
+
+		_, err = CheckTokenED25519Keys(old, keys)
+		require.Error(t, err)
+	})
+
+	t.Run("validates tokens signed with either algorithm", func(t *testing.T) {
+		var tc TokenCreator
+		tc.AccountId = pb.NewULID()
+		tc.AccuntNamespace = "/test"
+
+		edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		ecPub, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+		require.NoError(t, err)
+
+		keys := map[string]PublicKey{
+			"k1": Ed25519Key(edPub),
+			"k2": {Algorithm: AlgorithmECDSAP256, Key: ecPub},
+		}
+
+		old, err := tc.EncodeED25519(edKey, "k1")
+		require.NoError(t, err)
+
+		signEC := func(data []byte) ([]byte, error) {
+			return SignECDSAP256(ecKey, data)
+		}
+
+		cur, err := tc.EncodeWithSigner(signEC, "k2", AlgorithmECDSAP256)
+		require.NoError(t, err)
+
+		vt, err := CheckTokenKeys(old, keys)
+		require.NoError(t, err)
+		assert.Equal(t, "k1", vt.KeyId)
+
+		vt, err = CheckTokenKeys(cur, keys)
+		require.NoError(t, err)
+		assert.Equal(t, "k2", vt.KeyId)
+
+		// An ECDSA signature marked as ed25519 isn't checked against k2
+		wrong, err := tc.EncodeWithSigner(signEC, "k2", AlgorithmEd25519)
+		require.NoError(t, err)
+
+		_, err = CheckTokenKeys(wrong, keys)
+		require.Error(t, err)
+	})
+
 	t.Run("detect alterations", func(t *testing.T) {
 		var tc TokenCreator
 		tc.AccountId = pb.NewULID()
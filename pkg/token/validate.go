@@ -43,7 +43,10 @@ func checkTokenValidity(b *pb.Token_Body) error {
 	return nil
 }
 
-func CheckTokenHMAC(stoken string, key []byte) (*ValidToken, error) {
+// Parse stoken and return it if one of its signatures is accepted by
+// verify and it's currently valid. noMatch describes the failure when no
+// signature is accepted.
+func checkToken(stoken string, noMatch string, verify func(sig *pb.Signature, body []byte) bool) (*ValidToken, error) {
 	token, err := RemoveArmor(stoken)
 	if err != nil {
 		return nil, err
@@ -60,34 +63,21 @@ func CheckTokenHMAC(stoken string, key []byte) (*ValidToken, error) {
 		return nil, err
 	}
 
-	h, err := blake2b.New256(key)
-	if err != nil {
-		return nil, err
-	}
-
-	h.Write(t.Body)
-
-	computed := h.Sum(nil)
-
 	var (
 		keyId string
 		ok    bool
 	)
 
 	for _, sig := range t.Signatures {
-		if sig.SigType != pb.BLAKE2HMAC {
-			continue
-		}
-
-		if subtle.ConstantTimeCompare(computed, sig.Signature) == 1 {
-			ok = true
+		if verify(sig, t.Body) {
 			keyId = sig.KeyId
+			ok = true
 			break
 		}
 	}
 
 	if !ok {
-		return nil, errors.Wrapf(ErrBadToken, "no signatures matched (%d)", len(t.Signatures))
+		return nil, errors.Wrap(ErrBadToken, noMatch)
 	}
 
 	var body pb.Token_Body
@@ -112,62 +102,56 @@ func CheckTokenHMAC(stoken string, key []byte) (*ValidToken, error) {
 	return vt, nil
 }
 
-func CheckTokenED25519(stoken string, key ed25519.PublicKey) (*ValidToken, error) {
-	token, err := RemoveArmor(stoken)
-	if err != nil {
-		return nil, err
-	}
-
-	if token[0] != Magic {
-		return nil, ErrBadToken
-	}
-
-	var t pb.Token
-
-	err = t.Unmarshal(token[1:])
+func CheckTokenHMAC(stoken string, key []byte) (*ValidToken, error) {
+	h, err := blake2b.New256(key)
 	if err != nil {
 		return nil, err
 	}
 
-	var (
-		keyId string
-		ok    bool
-	)
-
-	for _, sig := range t.Signatures {
-		if sig.SigType != pb.ED25519 {
-			continue
+	return checkToken(stoken, "no signatures matched", func(sig *pb.Signature, body []byte) bool {
+		if sig.SigType != pb.BLAKE2HMAC {
+			return false
 		}
 
-		if ed25519.Verify(key, t.Body, sig.Signature) {
-			keyId = sig.KeyId
-			ok = true
-			break
-		}
-	}
+		h.Reset()
+		h.Write(body)
 
-	if !ok {
-		return nil, errors.Wrapf(ErrBadToken, "no signatures matched")
-	}
+		return subtle.ConstantTimeCompare(h.Sum(nil), sig.Signature) == 1
+	})
+}
 
-	var body pb.Token_Body
+func CheckTokenED25519(stoken string, key ed25519.PublicKey) (*ValidToken, error) {
+	return checkToken(stoken, "no signatures matched", func(sig *pb.Signature, body []byte) bool {
+		return sig.SigType == pb.ED25519 && ed25519.Verify(key, body, sig.Signature)
+	})
+}
 
-	err = body.Unmarshal(t.Body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "corruption in protected headers")
-	}
+// CheckTokenED25519Keys validates the token against a set of public keys
+// indexed by key id. Each signature is only checked against the key matching
+// its key id, which allows tokens signed by an old and a new key to both be
+// accepted while a key rotation is in progress.
+func CheckTokenED25519Keys(stoken string, keys map[string]ed25519.PublicKey) (*ValidToken, error) {
+	pubs := make(map[string]PublicKey, len(keys))
 
-	err = checkTokenValidity(&body)
-	if err != nil {
-		return nil, err
+	for id, key := range keys {
+		pubs[id] = Ed25519Key(key)
 	}
 
-	vt := &ValidToken{
-		Body:  &body,
-		Token: &t,
-		Raw:   token,
-		KeyId: keyId,
-	}
+	return CheckTokenKeys(stoken, pubs)
+}
 
-	return vt, nil
+// CheckTokenKeys validates the token against a set of public keys of any
+// supported algorithm, indexed by key id. Each signature is only checked
+// against the key matching its key id, and only if the key is of the
+// signature's algorithm, so tokens signed under an old and a new algorithm
+// are both accepted while moving between the two.
+func CheckTokenKeys(stoken string, keys map[string]PublicKey) (*ValidToken, error) {
+	return checkToken(stoken, "no signatures matched a known key", func(sig *pb.Signature, body []byte) bool {
+		key, known := keys[sig.KeyId]
+		if !known || key.Algorithm.SigType() != sig.SigType {
+			return false
+		}
+
+		return key.Verify(body, sig.Signature)
+	})
 }
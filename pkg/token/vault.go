@@ -3,31 +3,51 @@ package token
 import (
 	"crypto/ed25519"
 	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/mitchellh/mapstructure"
 )
 
 func SetupVault(vc *api.Client, path string) (ed25519.PublicKey, error) {
-	sec, err := vc.Logical().Read(filepath.Join("/transit/keys", path))
+	key, err := SetupVaultKey(vc, path, AlgorithmEd25519)
 	if err != nil {
 		return nil, err
 	}
 
+	return key.Key, nil
+}
+
+// SetupVaultKey returns the public half of the transit key at path, first
+// creating it with alg if it doesn't exist. An existing key must be of alg,
+// unless alg is empty, which accepts a key of any supported algorithm and
+// creates ed25519 keys.
+func SetupVaultKey(vc *api.Client, path string, alg Algorithm) (PublicKey, error) {
+	create := alg
+	if create == "" {
+		create = AlgorithmEd25519
+	}
+
+	sec, err := vc.Logical().Read(filepath.Join("/transit/keys", path))
+	if err != nil {
+		return PublicKey{}, err
+	}
+
 	if sec == nil {
 		_, err = vc.Logical().Write(filepath.Join("/transit/keys", path), map[string]interface{}{
-			"type": "ed25519",
+			"type": string(create),
 		})
 
 		sec, err = vc.Logical().Read(filepath.Join("/transit/keys", path))
 		if err != nil {
-			return nil, err
+			return PublicKey{}, err
 		}
 
 		if sec == nil {
-			return nil, fmt.Errorf("vault transit not available")
+			return PublicKey{}, fmt.Errorf("vault transit not available")
 		}
 	}
 
@@ -36,18 +56,67 @@ func SetupVault(vc *api.Client, path string) (ed25519.PublicKey, error) {
 	}
 
 	var secData struct {
+		Type string             `mapstructure:"type"`
 		Keys map[string]keyData `mapstructure:"keys"`
 	}
 
 	err = mapstructure.Decode(sec.Data, &secData)
 	if err != nil {
-		return nil, err
+		return PublicKey{}, err
 	}
 
-	key, err := base64.StdEncoding.DecodeString(secData.Keys["1"].PublicKey)
+	keyAlg, err := ParseAlgorithm(secData.Type)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	if alg != "" && keyAlg != alg {
+		return PublicKey{}, fmt.Errorf("transit key %s is an %s key, not %s", path, keyAlg, alg)
+	}
+
+	pub := secData.Keys["1"].PublicKey
+
+	// ECDSA public keys are given as PEM, ed25519 ones as base64.
+	if keyAlg == AlgorithmECDSAP256 {
+		block, _ := pem.Decode([]byte(pub))
+		if block == nil {
+			return PublicKey{}, fmt.Errorf("transit key %s has no PEM encoded public key", path)
+		}
+
+		return PublicKey{Algorithm: keyAlg, Key: block.Bytes}, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(pub)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return PublicKey{Algorithm: keyAlg, Key: key}, nil
+}
+
+// VaultSign signs data with the transit key at path.
+func VaultSign(vc *api.Client, path string, data []byte) ([]byte, error) {
+	secret, err := vc.Logical().Write(filepath.Join("/transit/sign", path), map[string]interface{}{
+		"input":                base64.StdEncoding.EncodeToString(data),
+		"marshaling_algorithm": "jws",
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return key, nil
+	if secret == nil {
+		return nil, fmt.Errorf("vault response missing signature")
+	}
+
+	sig, ok := secret.Data["signature"].(string)
+	if !ok {
+		return nil, fmt.Errorf("vault response missing signature")
+	}
+
+	// Signatures are prefixed with the key version, ie vault:v1:
+	if idx := strings.LastIndexByte(sig, ':'); idx != -1 {
+		sig = sig[idx+1:]
+	}
+
+	return base64.RawURLEncoding.DecodeString(sig)
 }
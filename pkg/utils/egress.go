@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// LoadCertPool returns the system roots along with the PEM encoded CA
+// certificates in path.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	err = addCertsFromFile(pool, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// TrustCAFile adds the PEM encoded CA certificates in path to the roots
+// cfg verifies servers against. Roots cfg already has, such as those the
+// vault client loads from VAULT_CACERT, are kept. Otherwise the system
+// roots are used along with the file's.
+func TrustCAFile(cfg *tls.Config, path string) error {
+	if cfg.RootCAs == nil {
+		pool, err := LoadCertPool(path)
+		if err != nil {
+			return err
+		}
+
+		cfg.RootCAs = pool
+
+		return nil
+	}
+
+	return addCertsFromFile(cfg.RootCAs, path)
+}
+
+func addCertsFromFile(pool *x509.CertPool, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return fmt.Errorf("no certificates found in %s", path)
+	}
+
+	return nil
+}
+
+// EgressTransport returns a pooled transport that verifies servers against
+// pool. A nil pool uses the system roots.
+func EgressTransport(pool *x509.CertPool) *http.Transport {
+	tr := cleanhttp.DefaultPooledTransport()
+
+	if pool != nil {
+		if tr.TLSClientConfig == nil {
+			tr.TLSClientConfig = &tls.Config{}
+		}
+
+		tr.TLSClientConfig.RootCAs = pool
+	}
+
+	return tr
+}
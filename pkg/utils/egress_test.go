@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgress(t *testing.T) {
+	parse := func(t *testing.T, data []byte) *x509.Certificate {
+		block, _ := pem.Decode(data)
+		require.NotNil(t, block)
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		return cert
+	}
+
+	verifies := func(cert *x509.Certificate, roots *x509.CertPool) bool {
+		_, err := cert.Verify(x509.VerifyOptions{Roots: roots})
+		return err == nil
+	}
+
+	t.Run("adds the CA file to the roots already configured", func(t *testing.T) {
+		existingPEM, _, err := SelfSignedCert()
+		require.NoError(t, err)
+
+		egressPEM, _, err := SelfSignedCert()
+		require.NoError(t, err)
+
+		dir, err := ioutil.TempDir("", "egress")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "ca.pem")
+
+		err = ioutil.WriteFile(path, egressPEM, 0600)
+		require.NoError(t, err)
+
+		existing := x509.NewCertPool()
+		require.True(t, existing.AppendCertsFromPEM(existingPEM))
+
+		cfg := &tls.Config{RootCAs: existing}
+
+		err = TrustCAFile(cfg, path)
+		require.NoError(t, err)
+
+		assert.True(t, verifies(parse(t, existingPEM), cfg.RootCAs))
+		assert.True(t, verifies(parse(t, egressPEM), cfg.RootCAs))
+
+		// Without configured roots, the file's are trusted.
+		cfg = &tls.Config{}
+
+		err = TrustCAFile(cfg, path)
+		require.NoError(t, err)
+
+		require.NotNil(t, cfg.RootCAs)
+		assert.True(t, verifies(parse(t, egressPEM), cfg.RootCAs))
+	})
+
+	t.Run("rejects a file without certificates", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "egress")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "ca.pem")
+
+		err = ioutil.WriteFile(path, []byte("not a cert"), 0600)
+		require.NoError(t, err)
+
+		err = TrustCAFile(&tls.Config{RootCAs: x509.NewCertPool()}, path)
+		assert.Error(t, err)
+	})
+}
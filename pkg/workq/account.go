@@ -0,0 +1,335 @@
+package workq
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Per-account queues are named with this prefix followed by the account's
+// key. Workers with AccountQueues set serve all of them.
+const AccountQueuePrefix = "account:"
+
+// AccountQueue returns the name of the queue for account's jobs, given as
+// its string key, so that a backlog of one account's jobs doesn't hold up
+// those of the others.
+func AccountQueue(account string) string {
+	return AccountQueuePrefix + account
+}
+
+// IsAccountQueue reports whether queue is a per-account queue.
+func IsAccountQueue(queue string) bool {
+	return strings.HasPrefix(queue, AccountQueuePrefix)
+}
+
+// QueueDepths returns the number of queued jobs ready to run now in each
+// queue that has any, as Backlog counts them.
+func QueueDepths(db *gorm.DB) (map[string]int, error) {
+	rows, err := db.Raw(`
+SELECT queue, count(*) FROM jobs
+ WHERE status = 'queued'
+   AND (cool_off_until IS NULL OR now() >= cool_off_until)
+   AND pending_parents = 0
+ GROUP BY queue`).Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	depths := make(map[string]int)
+
+	for rows.Next() {
+		var (
+			queue string
+			n     int
+		)
+
+		err = rows.Scan(&queue, &n)
+		if err != nil {
+			return nil, err
+		}
+
+		depths[queue] = n
+	}
+
+	return depths, rows.Err()
+}
+
+// The most per-account queues the queue depth gauge reports on their own.
+// The depths of the rest are summed under OtherAccountQueues, so the
+// number of series stays bounded however many accounts have work queued.
+// hzn workq depths lists every queue's depth from QueueDepths.
+const MaxAccountQueueDepthSeries = 20
+
+// The queue label the depths of the per-account queues beyond
+// MaxAccountQueueDepthSeries are reported under.
+const OtherAccountQueues = AccountQueuePrefix + "*"
+
+// Exports the last queue depths recorded. They're replaced as a whole, so a
+// scrape never sees a partly refreshed set.
+type queueDepthCollector struct {
+	desc   *prometheus.Desc
+	depths atomic.Value // map[string]int
+}
+
+func (c *queueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *queueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	depths, _ := c.depths.Load().(map[string]int)
+
+	for queue, n := range depths {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), queue)
+	}
+}
+
+var queueDepth = &queueDepthCollector{
+	desc: prometheus.NewDesc(
+		"workq_queue_depth",
+		"The number of queued jobs ready to run, by queue, as seen by workers serving per-account queues. Only the deepest per-account queues are reported on their own.",
+		[]string{"queue"}, nil,
+	),
+}
+
+func init() {
+	prometheus.MustRegister(queueDepth)
+}
+
+// Reduce depths to the named queues and the max deepest per-account queues,
+// summing the rest under OtherAccountQueues.
+func topQueueDepths(depths map[string]int, max int) map[string]int {
+	top := make(map[string]int)
+
+	var accounts []string
+
+	for queue, n := range depths {
+		if IsAccountQueue(queue) {
+			accounts = append(accounts, queue)
+		} else {
+			top[queue] = n
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		a, b := accounts[i], accounts[j]
+		if depths[a] != depths[b] {
+			return depths[a] > depths[b]
+		}
+
+		return a < b
+	})
+
+	for i, queue := range accounts {
+		if i < max {
+			top[queue] = depths[queue]
+		} else {
+			top[OtherAccountQueues] += depths[queue]
+		}
+	}
+
+	return top
+}
+
+// Refresh the queue depth gauge, dropping the queues that have drained.
+func (w *Worker) recordQueueDepths() error {
+	depths, err := QueueDepths(w.db)
+	if err != nil {
+		return err
+	}
+
+	queueDepth.depths.Store(topQueueDepths(depths, MaxAccountQueueDepthSeries))
+
+	return nil
+}
+
+// How long the set of queues with ready jobs is reused before it's read
+// again. Queues found empty are dropped from it as they're popped, and it's
+// read again as soon as none are left, so this only bounds how long a
+// queue that gains work waits behind ones that still have some.
+var readyQueuesTTL = time.Second
+
+// The queues this worker serves that have jobs ready to run, by name, and
+// whether they were read just now rather than taken from the cached set.
+func (w *Worker) readyQueues() ([]string, bool, error) {
+	w.mu.Lock()
+	if w.ready != nil && time.Since(w.readyAt) < readyQueuesTTL {
+		queues := append([]string(nil), w.ready...)
+		w.mu.Unlock()
+
+		return queues, false, nil
+	}
+	w.mu.Unlock()
+
+	queues, err := w.loadReadyQueues()
+	if err != nil {
+		return nil, false, err
+	}
+
+	w.mu.Lock()
+	w.ready = append([]string{}, queues...)
+	w.readyAt = time.Now()
+	w.mu.Unlock()
+
+	return queues, true, nil
+}
+
+func (w *Worker) loadReadyQueues() ([]string, error) {
+	var queues []string
+
+	err := dbx.Check(
+		w.db.Model(&Job{}).
+			Where("status = ?", "queued").
+			Where("queue IN (?) OR queue LIKE ?", w.queues, AccountQueuePrefix+"%").
+			Where("cool_off_until IS NULL or now() >= cool_off_until").
+			Where("pending_parents = 0").
+			Pluck("DISTINCT queue", &queues),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(queues)
+
+	return queues, nil
+}
+
+// Drop queue from the cached set of queues with ready jobs, having found
+// none in it.
+func (w *Worker) drained(queue string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i, q := range w.ready {
+		if q == queue {
+			w.ready = append(w.ready[:i:i], w.ready[i+1:]...)
+			break
+		}
+	}
+}
+
+// Order queues to start after the one last served, so each gets a turn.
+func (w *Worker) rotate(queues []string) []string {
+	w.mu.Lock()
+	last := w.lastQueue
+	w.mu.Unlock()
+
+	idx := sort.SearchStrings(queues, last)
+	if idx < len(queues) && queues[idx] == last {
+		idx++
+	}
+
+	return append(queues[idx:len(queues):len(queues)], queues[:idx]...)
+}
+
+// Whether the jobs of queue count against MaxPerAccount.
+func (w *Worker) capped(queue string) bool {
+	return w.MaxPerAccount > 0 && IsAccountQueue(queue)
+}
+
+// Reserve up to n of queue's slots, returning how many were reserved.
+func (w *Worker) reserve(queue string, n int) int {
+	if !w.capped(queue) {
+		return n
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if free := w.MaxPerAccount - w.running[queue]; n > free {
+		n = free
+	}
+
+	if n > 0 {
+		if w.running == nil {
+			w.running = make(map[string]int)
+		}
+
+		w.running[queue] += n
+	}
+
+	return n
+}
+
+// Return n of queue's slots, as its jobs finish.
+func (w *Worker) release(queue string, n int) {
+	if !w.capped(queue) || n <= 0 {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.running[queue] -= n
+	if w.running[queue] <= 0 {
+		delete(w.running, queue)
+	}
+}
+
+// Claim up to n jobs from one queue, taking the queues with work in turn
+// and skipping the per-account queues that already have MaxPerAccount
+// jobs running. Within a queue jobs are claimed by priority as usual.
+func (w *Worker) popFair(n int) ([]*RunningJob, error) {
+	for {
+		queues, fresh, err := w.readyQueues()
+		if err != nil {
+			return nil, err
+		}
+
+		jobs, err := w.popQueues(queues, n)
+		if err != gorm.ErrRecordNotFound || fresh {
+			return jobs, err
+		}
+
+		// Every cached queue has been found empty, but others may have
+		// gained work since the set was read, so read it again before
+		// giving up. Queues left in it are at MaxPerAccount.
+		w.mu.Lock()
+		empty := len(w.ready) == 0
+		if empty {
+			w.ready = nil
+		}
+		w.mu.Unlock()
+
+		if !empty {
+			return nil, err
+		}
+	}
+}
+
+func (w *Worker) popQueues(queues []string, n int) ([]*RunningJob, error) {
+	for _, queue := range w.rotate(queues) {
+		limit := w.reserve(queue, n)
+		if limit <= 0 {
+			continue
+		}
+
+		jobs, err := w.popBatch([]string{queue}, limit)
+		if err != nil {
+			w.release(queue, limit)
+
+			if err == gorm.ErrRecordNotFound {
+				w.drained(queue)
+				continue
+			}
+
+			return nil, err
+		}
+
+		w.release(queue, limit-len(jobs))
+
+		w.mu.Lock()
+		w.lastQueue = queue
+		w.mu.Unlock()
+
+		return jobs, nil
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
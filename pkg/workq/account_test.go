@@ -0,0 +1,202 @@
+package workq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountQueues(t *testing.T) {
+	L := hclog.L()
+
+	t.Run("names queues after the account", func(t *testing.T) {
+		q := AccountQueue("/!01EBCD")
+		assert.Equal(t, "account:/!01EBCD", q)
+		assert.True(t, IsAccountQueue(q))
+		assert.False(t, IsAccountQueue("default"))
+	})
+
+	t.Run("serves the queues with work in turn", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		a, b := AccountQueue("a"), AccountQueue("b")
+
+		var queued []*Job
+
+		for _, queue := range []string{a, a, a, b, "default", "other"} {
+			job := NewJob()
+			job.Queue = queue
+			job.Priority = 10
+			job.Set("test", 1)
+
+			queued = append(queued, job)
+		}
+
+		// The backlog of a is higher priority, but doesn't hold up the rest.
+		queued[3].Priority = 0
+		queued[4].Priority = 0
+
+		i := NewInjector(L, db)
+		for _, job := range queued {
+			require.NoError(t, i.Inject(job))
+		}
+
+		w := NewWorker(L, db, []string{"default"})
+		w.AccountQueues = true
+
+		var served []string
+
+		for {
+			jobs, err := w.PopBatch(1)
+			if err == gorm.ErrRecordNotFound {
+				break
+			}
+
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+
+			served = append(served, jobs[0].Queue)
+			require.NoError(t, jobs[0].Close())
+		}
+
+		// Queues the worker isn't configured for are left alone.
+		assert.Equal(t, []string{a, b, "default", a, a}, served)
+	})
+
+	t.Run("limits the jobs running from one account", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		a, b := AccountQueue("a"), AccountQueue("b")
+
+		i := NewInjector(L, db)
+
+		for _, queue := range []string{a, a, b} {
+			job := NewJob()
+			job.Queue = queue
+			job.Set("test", 1)
+
+			require.NoError(t, i.Inject(job))
+		}
+
+		w := NewWorker(L, db, nil)
+		w.AccountQueues = true
+		w.MaxPerAccount = 1
+
+		first, err := w.PopBatch(5)
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+		assert.Equal(t, a, first[0].Queue)
+
+		second, err := w.PopBatch(5)
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		assert.Equal(t, b, second[0].Queue)
+
+		// a's other job waits for the running one to finish.
+		_, err = w.PopBatch(5)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		require.NoError(t, first[0].Close())
+		w.release(a, 1)
+
+		third, err := w.PopBatch(5)
+		require.NoError(t, err)
+		require.Len(t, third, 1)
+		assert.Equal(t, a, third[0].Queue)
+
+		require.NoError(t, second[0].Close())
+		require.NoError(t, third[0].Close())
+	})
+
+	t.Run("reports the deepest account queues on their own", func(t *testing.T) {
+		depths := map[string]int{
+			"default":         4,
+			AccountQueue("a"): 1,
+			AccountQueue("b"): 7,
+			AccountQueue("c"): 3,
+			AccountQueue("d"): 3,
+		}
+
+		assert.Equal(t, map[string]int{
+			"default":          4,
+			AccountQueue("b"):  7,
+			AccountQueue("c"):  3,
+			OtherAccountQueues: 4,
+		}, topQueueDepths(depths, 2))
+	})
+
+	t.Run("picks up queues that gain work once the cached ones drain", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		i := NewInjector(L, db)
+
+		inject := func(queue string) {
+			job := NewJob()
+			job.Queue = queue
+			job.Set("test", 1)
+
+			require.NoError(t, i.Inject(job))
+		}
+
+		a, b := AccountQueue("a"), AccountQueue("b")
+
+		inject(a)
+
+		w := NewWorker(L, db, nil)
+		w.AccountQueues = true
+
+		jobs, err := w.PopBatch(1)
+		require.NoError(t, err)
+		require.NoError(t, jobs[0].Close())
+
+		inject(b)
+
+		jobs, err = w.PopBatch(1)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, b, jobs[0].Queue)
+		require.NoError(t, jobs[0].Close())
+
+		_, err = w.PopBatch(1)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+	})
+
+	t.Run("counts the jobs ready to run by queue", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		later := time.Now().Add(time.Hour)
+
+		for _, queue := range []string{"default", AccountQueue("a"), AccountQueue("a")} {
+			job := NewJob()
+			job.Queue = queue
+			job.Set("test", 1)
+
+			require.NoError(t, dbx.Check(db.Create(job)))
+		}
+
+		cooling := NewJob()
+		cooling.Queue = AccountQueue("b")
+		cooling.CoolOffUntil = &later
+		cooling.Set("test", 2)
+
+		require.NoError(t, dbx.Check(db.Create(cooling)))
+
+		depths, err := QueueDepths(db)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]int{
+			"default":         1,
+			AccountQueue("a"): 2,
+		}, depths)
+	})
+}
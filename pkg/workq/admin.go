@@ -0,0 +1,279 @@
+package workq
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// DefaultListLimit is the number of jobs returned by ListJobs when the
+// filter does not specify a limit.
+const DefaultListLimit = 100
+
+// JobFilter narrows down the jobs returned by ListJobs. Empty fields match
+// every job.
+type JobFilter struct {
+	Queue   string
+	JobType string
+	Status  string
+	Limit   int
+}
+
+// ListJobs returns the jobs matching the filter, oldest first.
+func ListJobs(db *gorm.DB, f JobFilter) ([]*Job, error) {
+	q := db.Order("created_at ASC, id ASC")
+
+	if f.Queue != "" {
+		q = q.Where("queue = ?", f.Queue)
+	}
+
+	if f.JobType != "" {
+		q = q.Where("job_type = ?", f.JobType)
+	}
+
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+
+	var jobs []*Job
+
+	err := dbx.Check(q.Limit(limit).Find(&jobs))
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// Backlog returns the number of queued jobs, across every queue, that are
+// ready to run now: those not cooling off after a failure or waiting on
+// their parents.
+func Backlog(db *gorm.DB) (int, error) {
+	var n int
+
+	err := dbx.Check(
+		db.Model(&Job{}).
+			Where("status = ?", "queued").
+			Where("cool_off_until IS NULL or now() >= cool_off_until").
+			Where("pending_parents = 0").
+			Count(&n),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// GetJob returns the job with the given id, or gorm.ErrRecordNotFound.
+func GetJob(db *gorm.DB, id []byte) (*Job, error) {
+	var job Job
+
+	err := dbx.Check(db.Where("id = ?", id).First(&job))
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// ErrNotRetryable is returned by RetryJob for a job that is running or
+// hasn't failed.
+var ErrNotRetryable = errors.New("job is neither dead nor waiting to retry")
+
+// RetryJob puts a dead job, or one waiting out its cool off after failing,
+// back into the queue, resetting its attempts and cool off so that the next
+// available worker will pick it up. Other jobs are left alone, returning
+// ErrNotRetryable, or gorm.ErrRecordNotFound if there is no such job.
+func RetryJob(db *gorm.DB, id []byte) error {
+	tx := db.Begin()
+
+	var job Job
+
+	// Running jobs are locked by their worker, so they're skipped rather
+	// than reset underneath it.
+	err := dbx.Check(
+		tx.
+			Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("id = ?", id).
+			First(&job),
+	)
+	if err != nil {
+		tx.Rollback()
+
+		if err == gorm.ErrRecordNotFound {
+			if _, gerr := GetJob(db, id); gerr == nil {
+				return ErrNotRetryable
+			}
+		}
+
+		return err
+	}
+
+	if job.Status != "dead" && (job.Status != "queued" || job.Attempts == 0) {
+		tx.Rollback()
+		return ErrNotRetryable
+	}
+
+	res := tx.Model(&Job{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":         "queued",
+			"attempts":       0,
+			"cool_off_until": gorm.Expr("NULL"),
+			"cancel_reason":  gorm.Expr("NULL"),
+
+			"first_attempt_at": gorm.Expr("NULL"),
+			"abandon_reason":   gorm.Expr("NULL"),
+		})
+
+	err = dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = dbx.Check(tx.Exec("NOTIFY " + listenChannel))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return dbx.Check(tx.Commit())
+}
+
+// DeleteJob removes the job with the given id, or returns
+// gorm.ErrRecordNotFound if there is no such job. Jobs that were waiting on
+// it are canceled, as they would otherwise wait forever.
+func DeleteJob(db *gorm.DB, id []byte) error {
+	tx := db.Begin()
+
+	res := tx.Where("id = ?", id).Delete(&Job{})
+
+	err := dbx.Check(res)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return gorm.ErrRecordNotFound
+	}
+
+	reason := fmt.Sprintf("parent job %s was deleted", pb.ULIDFromBytes(id).SpecString())
+
+	err = cancelDependents(hclog.L(), tx, id, reason)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return dbx.Check(tx.Commit())
+}
+
+// CancelJob removes the job with the given id if it's still waiting to
+// run, returning true if it was removed. Jobs that a worker is currently
+// running, or that have already finished, are left alone and false is
+// returned. Jobs that were waiting on the removed job are canceled.
+func CancelJob(db *gorm.DB, id []byte) (bool, error) {
+	tx := db.Begin()
+
+	var job Job
+
+	// Running jobs are locked by their worker, so skipping locked rows
+	// means they're treated as no longer cancelable rather than waiting
+	// for them to finish.
+	err := dbx.Check(
+		tx.
+			Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("id = ?", id).
+			Where("status = ?", "queued").
+			First(&job),
+	)
+	if err != nil {
+		tx.Rollback()
+
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	err = dbx.Check(tx.Where("id = ?", id).Delete(&Job{}))
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	reason := fmt.Sprintf("parent job %s was canceled", pb.ULIDFromBytes(id).SpecString())
+
+	err = cancelDependents(hclog.L(), tx, id, reason)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	err = dbx.Check(tx.Commit())
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PeriodicStatus describes a periodic job along with the outcome of its
+// latest run and any run that's waiting to be retried.
+type PeriodicStatus struct {
+	PeriodicJob
+
+	// The attempts made so far by a queued run of this periodic job, and
+	// when it will next be tried. Zero and nil when no run is pending.
+	PendingAttempts int
+	RetryAt         *time.Time
+}
+
+// ListPeriodicStatus returns the status of every periodic job, by name.
+func ListPeriodicStatus(db *gorm.DB) ([]*PeriodicStatus, error) {
+	var pjobs []*PeriodicJob
+
+	err := dbx.Check(db.Order("name ASC").Find(&pjobs))
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*PeriodicStatus
+
+	for _, pj := range pjobs {
+		ps := &PeriodicStatus{PeriodicJob: *pj}
+
+		var pending Job
+
+		err := dbx.Check(
+			db.Where("periodic_job = ?", pj.Name).
+				Where("status = ?", "queued").
+				Order("created_at DESC").
+				First(&pending),
+		)
+		if err == nil {
+			ps.PendingAttempts = pending.Attempts
+			ps.RetryAt = pending.CoolOffUntil
+		} else if err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+
+		out = append(out, ps)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,243 @@
+package workq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmin(t *testing.T) {
+	t.Run("lists jobs by queue, type, and status", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		j1 := NewJob()
+		j1.Queue = "a"
+		j1.Set("test", 1)
+
+		j2 := NewJob()
+		j2.Queue = "b"
+		j2.Set("other", 2)
+
+		j3 := NewJob()
+		j3.Queue = "a"
+		j3.Status = "dead"
+		j3.Set("test", 3)
+
+		for _, j := range []*Job{j1, j2, j3} {
+			require.NoError(t, dbx.Check(db.Create(j)))
+		}
+
+		jobs, err := ListJobs(db, JobFilter{})
+		require.NoError(t, err)
+		assert.Len(t, jobs, 3)
+
+		jobs, err = ListJobs(db, JobFilter{Queue: "a"})
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+
+		jobs, err = ListJobs(db, JobFilter{JobType: "other"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, j2.Id, jobs[0].Id)
+
+		jobs, err = ListJobs(db, JobFilter{Status: "dead"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, j3.Id, jobs[0].Id)
+
+		jobs, err = ListJobs(db, JobFilter{Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("retries a dead job", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		job := NewJob()
+		job.Queue = "a"
+		job.Status = "dead"
+		job.Attempts = MaximumAttempts
+		job.Set("test", 1)
+
+		require.NoError(t, dbx.Check(db.Create(job)))
+
+		err := RetryJob(db, job.Id)
+		require.NoError(t, err)
+
+		j2, err := GetJob(db, job.Id)
+		require.NoError(t, err)
+
+		assert.Equal(t, "queued", j2.Status)
+		assert.Equal(t, 0, j2.Attempts)
+		assert.Nil(t, j2.CoolOffUntil)
+
+		err = RetryJob(db, NewJob().Id)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+	})
+
+	t.Run("only retries failed jobs", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		finished := NewJob()
+		finished.Queue = "a"
+		finished.Status = "finished"
+		finished.Set("test", 1)
+
+		fresh := NewJob()
+		fresh.Queue = "a"
+		fresh.Set("test", 1)
+
+		for _, job := range []*Job{finished, fresh} {
+			require.NoError(t, dbx.Check(db.Create(job)))
+
+			err := RetryJob(db, job.Id)
+			assert.Equal(t, ErrNotRetryable, err)
+		}
+
+		failed := NewJob()
+		failed.Queue = "b"
+		failed.Attempts = 1
+		failed.Set("test", 1)
+
+		require.NoError(t, dbx.Check(db.Create(failed)))
+
+		w := NewWorker(hclog.L(), db, []string{"b"})
+
+		rj, err := w.Pop()
+		require.NoError(t, err)
+
+		// It's running.
+		err = RetryJob(db, failed.Id)
+		assert.Equal(t, ErrNotRetryable, err)
+
+		require.NoError(t, rj.Abort())
+
+		require.NoError(t, RetryJob(db, failed.Id))
+
+		j2, err := GetJob(db, failed.Id)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, j2.Attempts)
+		assert.Nil(t, j2.CoolOffUntil)
+	})
+
+	t.Run("deletes a job", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		job := NewJob()
+		job.Queue = "a"
+		job.Set("test", 1)
+
+		require.NoError(t, dbx.Check(db.Create(job)))
+
+		err := DeleteJob(db, job.Id)
+		require.NoError(t, err)
+
+		_, err = GetJob(db, job.Id)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		err = DeleteJob(db, job.Id)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+	})
+
+	t.Run("cancels a scheduled job", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		i := NewInjector(hclog.L(), db)
+
+		job := NewJob()
+		job.Queue = "a"
+		job.Set("test", 1)
+
+		err := i.EnqueueAt(job, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// Not yet due, so no worker will pick it up
+		w := NewWorker(hclog.L(), db, []string{"a"})
+		_, err = w.Pop()
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		ok, err := CancelJob(db, job.Id)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		_, err = GetJob(db, job.Id)
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		ok, err = CancelJob(db, job.Id)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("does not cancel a running job", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		job := NewJob()
+		job.Queue = "a"
+		job.Set("test", 1)
+
+		require.NoError(t, dbx.Check(db.Create(job)))
+
+		w := NewWorker(hclog.L(), db, []string{"a"})
+
+		rj, err := w.Pop()
+		require.NoError(t, err)
+
+		ok, err := CancelJob(db, job.Id)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		rj.Close()
+
+		// Finished jobs aren't cancelable either
+		ok, err = CancelJob(db, job.Id)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("counts the jobs ready to run", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		ready := NewJob()
+		ready.Queue = "a"
+		ready.Set("test", 1)
+
+		later := time.Now().Add(time.Hour)
+
+		cooling := NewJob()
+		cooling.Queue = "b"
+		cooling.CoolOffUntil = &later
+		cooling.Set("test", 2)
+
+		waiting := NewJob()
+		waiting.Queue = "a"
+		waiting.PendingParents = 1
+		waiting.Set("test", 3)
+
+		dead := NewJob()
+		dead.Queue = "a"
+		dead.Status = "dead"
+		dead.Set("test", 4)
+
+		for _, j := range []*Job{ready, cooling, waiting, dead} {
+			require.NoError(t, dbx.Check(db.Create(j)))
+		}
+
+		n, err := Backlog(db)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+}
@@ -0,0 +1,154 @@
+package workq
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// A Codec marshals the payloads of a job type to and from the value its
+// handler takes. Job types use JSONCodec unless they're registered with
+// another one. Jobs record the codec their payload was encoded with, and
+// are decoded with it.
+type Codec interface {
+	// The name of the codec, stored in the jobs whose payloads it encodes.
+	Name() string
+
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	// Encode payloads as JSON. This is the default.
+	JSONCodec Codec = jsonCodec{}
+
+	// Encode payloads with protobuf. Values must be proto messages.
+	ProtoCodec Codec = protoCodec{}
+
+	// Encode payloads with encoding/gob.
+	GobCodec Codec = gobCodec{}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Name() string {
+	return "protobuf"
+}
+
+func (protoCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("value is not a proto message: %T", v)
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (protoCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("value is not a proto message: %T", v)
+	}
+
+	return proto.Unmarshal(data, msg)
+}
+
+type gobCodec struct{}
+
+func (gobCodec) Name() string {
+	return "gob"
+}
+
+func (gobCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+var builtinCodecs = map[string]Codec{
+	JSONCodec.Name():  JSONCodec,
+	ProtoCodec.Name(): ProtoCodec,
+	GobCodec.Name():   GobCodec,
+}
+
+// Encode v as a job payload with c. Payloads are stored as jsonb, so the
+// output of codecs other than JSONCodec is stored as a base64 encoded JSON
+// string. Jobs record the name of the codec alongside the payload.
+func encodePayload(c Codec, v interface{}) ([]byte, error) {
+	data, err := c.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if c == JSONCodec {
+		return data, nil
+	}
+
+	return json.Marshal(data)
+}
+
+// Decode a payload created by encodePayload with the codec named name into
+// v. An empty name is JSONCodec. c is the job type's codec, which is used
+// when it's the one named, so that codecs other than the builtin ones can
+// be decoded.
+func decodePayload(c Codec, name string, payload []byte, v interface{}) error {
+	if name == "" || name == JSONCodec.Name() {
+		err := JSONCodec.Unmarshal(payload, v)
+		if err != nil {
+			return errors.Wrapf(err, "decoding %s payload", JSONCodec.Name())
+		}
+
+		return nil
+	}
+
+	dc := builtinCodecs[name]
+
+	if c != nil && c.Name() == name {
+		dc = c
+	}
+
+	if dc == nil {
+		return fmt.Errorf("unknown payload codec: %s", name)
+	}
+
+	var data []byte
+
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return errors.Wrapf(err, "decoding %s payload", name)
+	}
+
+	err = dc.Unmarshal(data, v)
+	if err != nil {
+		return errors.Wrapf(err, "decoding %s payload", name)
+	}
+
+	return nil
+}
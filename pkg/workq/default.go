@@ -1,7 +1,6 @@
 package workq
 
 import (
-	"encoding/json"
 	"sync"
 	"time"
 )
@@ -15,9 +14,15 @@ func RegisterHandler(jobType string, h interface{}) {
 	GlobalRegistry.Register(jobType, h)
 }
 
+// Register a job and handler with the default registry, with payloads
+// encoded by codec.
+func RegisterHandlerWithCodec(jobType string, codec Codec, h interface{}) {
+	GlobalRegistry.RegisterWithCodec(jobType, codec, h)
+}
+
 type defaultPeriodic struct {
 	name, queue, jobType string
-	payload              []byte
+	value                interface{}
 	period               time.Duration
 }
 
@@ -29,12 +34,9 @@ func RegisterPeriodicJob(name, queue, jobType string, v interface{}, period time
 	periodMu.Lock()
 	defer periodMu.Unlock()
 
-	payload, err := json.Marshal(v)
-	if err != nil {
-		panic(err)
-	}
-
+	// The payload is encoded when the worker starts, as the job type's
+	// handler, and so its codec, may be registered after this.
 	defaultPeriodics = append(defaultPeriodics, defaultPeriodic{
-		name, queue, jobType, payload, period,
+		name, queue, jobType, v, period,
 	})
 }
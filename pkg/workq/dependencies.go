@@ -0,0 +1,150 @@
+package workq
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+var ErrUnknownParent = errors.New("unknown parent job")
+
+// InjectAfter injects job such that it only becomes eligible to run once
+// every one of parents has finished successfully. If a parent fails
+// permanently, or is canceled, job is canceled too. Parents must still be
+// present, so finished parents need to be given before they're cleaned up.
+// Parents that are being run are waited on, so that their outcome is known.
+func (i *Injector) InjectAfter(job *Job, parents ...[]byte) error {
+	if len(parents) == 0 {
+		return i.Inject(job)
+	}
+
+	if job.Id == nil {
+		job.Id = pb.NewULID().Bytes()
+	}
+
+	tx := i.db.Begin()
+
+	var found []*Job
+
+	// Locking the parents keeps them from finishing between counting them
+	// and the job being visible to releaseDependents.
+	err := dbx.Check(
+		tx.Set("gorm:query_option", "FOR SHARE").
+			Where("id IN (?)", parents).
+			Find(&found),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	byId := make(map[string]*Job)
+	for _, p := range found {
+		byId[string(p.Id)] = p
+	}
+
+	job.ParentIds = nil
+	job.PendingParents = 0
+
+	seen := make(map[string]bool)
+
+	for _, id := range parents {
+		if seen[string(id)] {
+			continue
+		}
+
+		seen[string(id)] = true
+
+		p, ok := byId[string(id)]
+		if !ok {
+			tx.Rollback()
+			return errors.Wrapf(ErrUnknownParent, "%s", pb.ULIDFromBytes(id).SpecString())
+		}
+
+		switch p.Status {
+		case "finished":
+			// Nothing to wait for
+		case "dead":
+			tx.Rollback()
+			return fmt.Errorf("parent job %s has failed", pb.ULIDFromBytes(id).SpecString())
+		default:
+			job.PendingParents++
+		}
+
+		job.ParentIds = append(job.ParentIds, id)
+	}
+
+	err = dbx.Check(tx.Create(job))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tx.Exec("NOTIFY " + listenChannel)
+
+	return dbx.Check(tx.Commit())
+}
+
+// Count the successful run of parent against the jobs waiting on it, as
+// part of the transaction that finishes parent. Jobs with no remaining
+// parents become eligible to run.
+func releaseDependents(tx *gorm.DB, parent []byte) error {
+	res := tx.Exec(
+		`UPDATE jobs SET pending_parents = pending_parents - 1
+		  WHERE status = 'queued' AND pending_parents > 0 AND ? = ANY(parent_ids)`,
+		parent,
+	)
+
+	err := dbx.Check(res)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected > 0 {
+		tx.Exec("NOTIFY " + listenChannel)
+	}
+
+	return nil
+}
+
+// Cancel the jobs waiting on parent, and the jobs waiting on those in
+// turn, recording reason on the direct dependents.
+func cancelDependents(L hclog.Logger, tx *gorm.DB, parent []byte, reason string) error {
+	var deps []*Job
+
+	err := dbx.Check(
+		tx.Where("status = ?", "queued").
+			Where("pending_parents > 0").
+			Where("? = ANY(parent_ids)", parent).
+			Find(&deps),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, dep := range deps {
+		err = dbx.Check(tx.Model(dep).Updates(map[string]interface{}{
+			"status":          "dead",
+			"pending_parents": 0,
+			"cancel_reason":   reason,
+		}))
+		if err != nil {
+			return err
+		}
+
+		id := pb.ULIDFromBytes(dep.Id).SpecString()
+
+		L.Warn("canceled job waiting on parent", "id", id, "job-type", dep.JobType, "reason", reason)
+
+		err = cancelDependents(L, tx, dep.Id, fmt.Sprintf("parent job %s was canceled", id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
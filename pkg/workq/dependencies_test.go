@@ -0,0 +1,186 @@
+package workq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencies(t *testing.T) {
+	L := hclog.L()
+
+	t.Run("runs a job once its parents finish", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inj := NewInjector(L, db)
+
+		p1 := NewJob()
+		p1.Queue = "a"
+		p1.Set("export-account", 1)
+		require.NoError(t, inj.Inject(p1))
+
+		p2 := NewJob()
+		p2.Queue = "a"
+		p2.Set("export-account", 2)
+		require.NoError(t, inj.Inject(p2))
+
+		child := NewJob()
+		child.Queue = "a"
+		child.Set("delete-account-objects", 3)
+		require.NoError(t, inj.InjectAfter(child, p1.Id, p2.Id))
+
+		stored, err := GetJob(db, child.Id)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored.PendingParents)
+		assert.Equal(t, [][]byte{p1.Id, p2.Id}, [][]byte(stored.ParentIds))
+
+		w := NewWorker(L, db, []string{"a"})
+
+		for _, parent := range []*Job{p1, p2} {
+			job, err := w.Pop()
+			require.NoError(t, err)
+			assert.Equal(t, parent.Id, job.Id)
+
+			require.NoError(t, job.Close())
+		}
+
+		job, err := w.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, child.Id, job.Id)
+		require.NoError(t, job.Close())
+
+		_, err = w.Pop()
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+	})
+
+	t.Run("doesn't wait on parents that already finished", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inj := NewInjector(L, db)
+
+		parent := NewJob()
+		parent.Queue = "a"
+		parent.Status = "finished"
+		parent.Set("export-account", 1)
+		require.NoError(t, inj.Inject(parent))
+
+		child := NewJob()
+		child.Queue = "a"
+		child.Set("delete-account-objects", 2)
+		require.NoError(t, inj.InjectAfter(child, parent.Id))
+
+		w := NewWorker(L, db, []string{"a"})
+
+		job, err := w.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, child.Id, job.Id)
+		job.Close()
+	})
+
+	t.Run("rejects unknown and failed parents", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inj := NewInjector(L, db)
+
+		child := NewJob()
+		child.Queue = "a"
+		child.Set("delete-account-objects", 1)
+
+		err := inj.InjectAfter(child, pb.NewULID().Bytes())
+		require.Error(t, err)
+		assert.Equal(t, ErrUnknownParent, errors.Cause(err))
+
+		dead := NewJob()
+		dead.Queue = "a"
+		dead.Status = "dead"
+		dead.Set("export-account", 2)
+		require.NoError(t, inj.Inject(dead))
+
+		err = inj.InjectAfter(child, dead.Id)
+		require.Error(t, err)
+	})
+
+	t.Run("cancels dependents when a parent fails permanently", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inj := NewInjector(L, db)
+
+		parent := NewJob()
+		parent.Queue = "a"
+		parent.Attempts = MaximumAttempts - 1
+		parent.Set("export-account", 1)
+		require.NoError(t, inj.Inject(parent))
+
+		child := NewJob()
+		child.Queue = "a"
+		child.Set("delete-account-objects", 2)
+		require.NoError(t, inj.InjectAfter(child, parent.Id))
+
+		grandchild := NewJob()
+		grandchild.Queue = "a"
+		grandchild.Set("notify", 3)
+		require.NoError(t, inj.InjectAfter(grandchild, child.Id))
+
+		w := NewWorker(L, db, []string{"a"})
+
+		job, err := w.Pop()
+		require.NoError(t, err)
+		require.NoError(t, job.Abort())
+
+		stored, err := GetJob(db, child.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "dead", stored.Status)
+		require.NotNil(t, stored.CancelReason)
+		assert.Contains(t, *stored.CancelReason, pb.ULIDFromBytes(parent.Id).SpecString())
+		assert.Contains(t, *stored.CancelReason, "failed permanently")
+
+		stored, err = GetJob(db, grandchild.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "dead", stored.Status)
+		require.NotNil(t, stored.CancelReason)
+		assert.Contains(t, *stored.CancelReason, pb.ULIDFromBytes(child.Id).SpecString())
+
+		_, err = w.Pop()
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		var count int
+		require.NoError(t, dbx.Check(db.Model(&Job{}).Where("status = ?", "dead").Count(&count)))
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("cancels the children of a deleted parent", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inj := NewInjector(L, db)
+
+		parent := NewJob()
+		parent.Queue = "a"
+		parent.Set("export-account", 1)
+		require.NoError(t, inj.Inject(parent))
+
+		child := NewJob()
+		child.Queue = "a"
+		child.Set("delete-account-objects", 2)
+		require.NoError(t, inj.InjectAfter(child, parent.Id))
+
+		require.NoError(t, DeleteJob(db, parent.Id))
+
+		stored, err := GetJob(db, child.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "dead", stored.Status)
+		require.NotNil(t, stored.CancelReason)
+		assert.Contains(t, *stored.CancelReason, "was deleted")
+	})
+}
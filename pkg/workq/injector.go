@@ -3,8 +3,10 @@ package workq
 import (
 	"database/sql"
 	"encoding/json"
+	"reflect"
 	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/pkg/dbx"
 	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/jinzhu/gorm"
@@ -12,6 +14,11 @@ import (
 
 type Injector struct {
 	db *gorm.DB
+	L  hclog.Logger
+}
+
+func NewInjector(L hclog.Logger, db *gorm.DB) *Injector {
+	return &Injector{L: L, db: db}
 }
 
 func (i *Injector) Inject(job *Job) error {
@@ -28,34 +35,159 @@ func (i *Injector) Inject(job *Job) error {
 	return dbx.Check(tx.Commit())
 }
 
+// InjectAll injects jobs in a single transaction, waking the workers once.
+// None are injected if any of them fails to be.
+func (i *Injector) InjectAll(jobs []*Job) error {
+	tx := i.db.Begin()
+
+	for _, job := range jobs {
+		if job.Id == nil {
+			job.Id = pb.NewULID().Bytes()
+		}
+
+		err := dbx.Check(tx.Create(job))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	tx.Exec("NOTIFY " + listenChannel)
+
+	return dbx.Check(tx.Commit())
+}
+
+// EnqueueAt injects job such that it will not be run before at. The job
+// can be canceled with CancelJob up until a worker picks it up.
+func (i *Injector) EnqueueAt(job *Job, at time.Time) error {
+	job.CoolOffUntil = &at
+	return i.Inject(job)
+}
+
+// AddPeriodicJob is AddPeriodicJobRaw with v encoded with the codec jt is
+// registered with in GlobalRegistry.
 func (i *Injector) AddPeriodicJob(name, queue, jt string, v interface{}, period time.Duration) error {
-	data, err := json.Marshal(v)
+	c := GlobalRegistry.Codec(jt)
+
+	data, err := encodePayload(c, v)
 	if err != nil {
 		return err
 	}
 
-	return i.AddPeriodicJobRaw(name, queue, jt, data, period)
+	return i.addPeriodicJob(name, queue, jt, c.Name(), data, period)
 }
 
+// AddPeriodicJobRaw creates the periodic job or reconciles the stored one
+// with the given definition, payload being JSON. When the period changes,
+// the next run is rescheduled to be one new period after the previous run,
+// so the change takes effect immediately rather than after the old
+// schedule next fires.
 func (i *Injector) AddPeriodicJobRaw(name, queue, jt string, payload []byte, period time.Duration) error {
-	var pjob PeriodicJob
+	return i.addPeriodicJob(name, queue, jt, JSONCodec.Name(), payload, period)
+}
 
-	pjob.Name = name
-	pjob.Queue = queue
-	pjob.Period = period.String()
-	pjob.JobType = jt
-	pjob.NextRun = time.Now().Add(period)
-	pjob.Payload = payload
+// AddPeriodicJobRaw with payload encoded by the codec named codec.
+func (i *Injector) addPeriodicJob(name, queue, jt, codec string, payload []byte, period time.Duration) error {
+	L := i.L
+	if L == nil {
+		L = hclog.L()
+	}
+
+	tx := i.db.Begin()
+
+	var pjob PeriodicJob
 
 	err := dbx.Check(
-		i.db.Set("gorm:insert_option",
-			"ON CONFLICT (name) DO UPDATE SET queue=EXCLUDED.queue, payload=EXCLUDED.payload, period=EXCLUDED.period, next_run=LEAST(periodic_jobs.next_run, EXCLUDED.next_run)").
-			Create(&pjob),
+		tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("name = ?", name).
+			First(&pjob),
 	)
 
-	if err == sql.ErrNoRows {
+	if err == gorm.ErrRecordNotFound {
+		pjob.Name = name
+		pjob.Queue = queue
+		pjob.Period = period.String()
+		pjob.JobType = jt
+		pjob.NextRun = time.Now().Add(period)
+		pjob.Payload = payload
+		pjob.PayloadCodec = codec
+
+		// Another process registering the same job concurrently wins.
+		err = dbx.Check(
+			tx.Set("gorm:insert_option", "ON CONFLICT (name) DO NOTHING").
+				Create(&pjob),
+		)
+		if err != nil && err != sql.ErrNoRows {
+			tx.Rollback()
+			return err
+		}
+
+		return dbx.Check(tx.Commit())
+	}
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	updates := map[string]interface{}{}
+
+	if pjob.Queue != queue {
+		updates["queue"] = queue
+	}
+
+	if pjob.JobType != jt {
+		updates["job_type"] = jt
+	}
+
+	if !jsonEqual(pjob.Payload, payload) {
+		updates["payload"] = payload
+	}
+
+	if pjob.PayloadCodec != codec {
+		updates["payload_codec"] = codec
+	}
+
+	if pjob.Period != period.String() {
+		nextRun := time.Now().Add(period)
+
+		if old, err := time.ParseDuration(pjob.Period); err == nil {
+			nextRun = pjob.NextRun.Add(-old).Add(period)
+		}
+
+		updates["period"] = period.String()
+		updates["next_run"] = nextRun
+
+		L.Info("periodic job schedule changed",
+			"name", name,
+			"old-period", pjob.Period,
+			"new-period", period.String(),
+			"old-next-run", pjob.NextRun,
+			"new-next-run", nextRun,
+		)
+	}
+
+	if len(updates) == 0 {
+		tx.Rollback()
 		return nil
 	}
 
-	return err
+	err = dbx.Check(tx.Model(&pjob).Updates(updates))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return dbx.Check(tx.Commit())
+}
+
+// Compare payloads by value, as postgres doesn't keep jsonb formatting.
+func jsonEqual(a, b []byte) bool {
+	var av, bv interface{}
+
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return string(a) == string(b)
+	}
+
+	return reflect.DeepEqual(av, bv)
 }
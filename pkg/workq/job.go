@@ -1,10 +1,10 @@
 package workq
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/lib/pq"
 )
 
 type Job struct {
@@ -14,21 +14,86 @@ type Job struct {
 	JobType string
 	Payload []byte
 
+	// The name of the codec Payload is encoded with. Empty is JSONCodec.
+	PayloadCodec string
+
 	CoolOffUntil *time.Time
 	Attempts     int
 
+	// The limits on retrying the job. It's abandoned, and marked dead, once
+	// it has failed MaxAttempts times, MaximumAttempts if zero, or once
+	// MaxElapsed has passed since its first attempt, if set.
+	MaxAttempts int
+	MaxElapsed  time.Duration
+
+	FirstAttemptAt *time.Time
+
+	// Which of the limits the job hit when it was abandoned,
+	// AbandonMaxAttempts or AbandonMaxElapsed.
+	AbandonReason *string
+
+	// Queued jobs with a higher priority are popped first. Those of equal
+	// priority are popped in the order they were queued.
+	Priority int
+
+	// The name of the periodic job that queued this job, if any.
+	PeriodicJob *string
+
+	// The jobs that must finish successfully before this one may run, and
+	// the number of them that haven't yet. See Injector.InjectAfter.
+	ParentIds      pq.ByteaArray
+	PendingParents int
+
+	// Why the job was canceled rather than run, such as a parent having
+	// failed permanently. Canceled jobs are marked dead.
+	CancelReason *string
+
 	CreatedAt time.Time
 }
 
+const (
+	AbandonMaxAttempts = "max-attempts"
+	AbandonMaxElapsed  = "max-elapsed"
+)
+
+// Returns which retry limit the job has hit after failing its attempts'th
+// attempt at now, or "" if it may be retried.
+func (j *Job) abandonReason(attempts int, now time.Time) string {
+	max := j.MaxAttempts
+	if max <= 0 {
+		max = MaximumAttempts
+	}
+
+	if attempts >= max {
+		return AbandonMaxAttempts
+	}
+
+	if j.MaxElapsed > 0 && j.FirstAttemptAt != nil && now.Sub(*j.FirstAttemptAt) >= j.MaxElapsed {
+		return AbandonMaxElapsed
+	}
+
+	return ""
+}
+
+// Set the job's type to jt and its payload to v, encoded with the codec
+// jt is registered with in GlobalRegistry.
 func (j *Job) Set(jt string, v interface{}) error {
+	return j.SetWith(GlobalRegistry, jt, v)
+}
+
+// SetWith is Set, using the codec jt is registered with in r.
+func (j *Job) SetWith(r *Registry, jt string, v interface{}) error {
 	j.JobType = jt
 
-	data, err := json.Marshal(v)
+	c := r.Codec(jt)
+
+	data, err := encodePayload(c, v)
 	if err != nil {
 		return err
 	}
 
 	j.Payload = data
+	j.PayloadCodec = c.Name()
 	return nil
 }
 
@@ -36,6 +101,7 @@ func NewJob() *Job {
 	var j Job
 	j.Id = pb.NewULID().Bytes()
 	j.Status = "queued"
+	j.PayloadCodec = JSONCodec.Name()
 
 	return &j
 }
@@ -0,0 +1,68 @@
+package workq
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Handler metrics are labeled by job type, which is also the name the
+// handler was registered under, so failures of one handler can be told
+// apart from the rest of the queue.
+var (
+	jobExecutions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "workq_job_executions_total",
+			Help: "The number of times a job handler was executed, by job type.",
+		},
+		[]string{"job_type"},
+	)
+
+	jobErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "workq_job_errors_total",
+			Help: "The number of job handler executions that returned an error, by job type.",
+		},
+		[]string{"job_type"},
+	)
+
+	jobDurations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "workq_job_duration_seconds",
+			Help:    "How long job handlers took to execute, by job type and outcome.",
+			Buckets: prometheus.ExponentialBuckets(0.005, 4, 10),
+		},
+		[]string{"job_type", "outcome"},
+	)
+
+	jobsAbandoned = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "workq_jobs_abandoned_total",
+			Help: "The number of jobs marked dead after reaching a retry limit, by job type and limit.",
+		},
+		[]string{"job_type", "reason"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(jobExecutions, jobErrors, jobDurations, jobsAbandoned)
+}
+
+// Record a single execution of the handler for jobType.
+func recordJobExecution(jobType string, dur time.Duration, err error) {
+	outcome := "success"
+
+	jobExecutions.WithLabelValues(jobType).Inc()
+
+	if err != nil {
+		outcome = "error"
+		jobErrors.WithLabelValues(jobType).Inc()
+	}
+
+	jobDurations.WithLabelValues(jobType, outcome).Observe(dur.Seconds())
+}
+
+// Record a job being abandoned for reaching the retry limit reason.
+func recordJobAbandoned(jobType, reason string) {
+	jobsAbandoned.WithLabelValues(jobType, reason).Inc()
+}
@@ -0,0 +1,24 @@
+package workq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobMetrics(t *testing.T) {
+	t.Run("counts executions and errors per job type", func(t *testing.T) {
+		recordJobExecution("metrics-ok", time.Second, nil)
+		recordJobExecution("metrics-ok", time.Second, nil)
+		recordJobExecution("metrics-fail", time.Second, errors.New("nope"))
+
+		assert.Equal(t, float64(2), testutil.ToFloat64(jobExecutions.WithLabelValues("metrics-ok")))
+		assert.Equal(t, float64(0), testutil.ToFloat64(jobErrors.WithLabelValues("metrics-ok")))
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(jobExecutions.WithLabelValues("metrics-fail")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(jobErrors.WithLabelValues("metrics-fail")))
+	})
+}
@@ -16,6 +16,15 @@ type PeriodicJob struct {
 	Period  string
 	NextRun time.Time
 
+	// The name of the codec Payload is encoded with, see Job.
+	PayloadCodec string
+
+	// The outcome of the most recent run of a job queued by this periodic
+	// job. LastStatus is "success" or "error".
+	LastRunAt  *time.Time
+	LastStatus *string
+	LastError  *string
+
 	CreatedAt time.Time
 }
 
@@ -53,7 +62,9 @@ func (w *Worker) CheckPeriodic() error {
 		job := NewJob()
 		job.Queue = pjob.Queue
 		job.Payload = pjob.Payload
+		job.PayloadCodec = pjob.PayloadCodec
 		job.JobType = pjob.JobType
+		job.PeriodicJob = &pjob.Name
 
 		tx.Create(&job)
 
@@ -65,3 +76,30 @@ func (w *Worker) CheckPeriodic() error {
 		}
 	}
 }
+
+// Record the outcome of a job queued by the named periodic job. This is
+// done outside the job's transaction so that failures are recorded even
+// though the job itself is rolled back for retry.
+func (w *Worker) recordPeriodicResult(name string, jobErr error) {
+	status := "success"
+
+	var msg *string
+	if jobErr != nil {
+		status = "error"
+		str := jobErr.Error()
+		msg = &str
+	}
+
+	err := dbx.Check(
+		w.db.Model(&PeriodicJob{}).
+			Where("name = ?", name).
+			Updates(map[string]interface{}{
+				"last_run_at": time.Now(),
+				"last_status": status,
+				"last_error":  msg,
+			}),
+	)
+	if err != nil {
+		w.L.Error("error recording periodic job result", "name", name, "error", err)
+	}
+}
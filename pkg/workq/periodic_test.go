@@ -1,6 +1,8 @@
 package workq
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -25,6 +27,7 @@ func TestPeriodic(t *testing.T) {
 		pjob.Period = "30m"
 		pjob.JobType = "test"
 		pjob.Payload = []byte("1")
+		pjob.PayloadCodec = "json"
 
 		err := dbx.Check(db.Create(&pjob))
 		require.NoError(t, err)
@@ -41,6 +44,7 @@ func TestPeriodic(t *testing.T) {
 
 		assert.Equal(t, pjob.Queue, job.Queue)
 		assert.Equal(t, pjob.Payload, job.Payload)
+		assert.Equal(t, pjob.PayloadCodec, job.PayloadCodec)
 
 		var pjob2 PeriodicJob
 
@@ -89,7 +93,96 @@ func TestPeriodic(t *testing.T) {
 		err = dbx.Check(db.Last(&pjob3))
 		require.NoError(t, err)
 
-		assert.Equal(t, pjob.Id, pjob2.Id)
+		assert.Equal(t, pjob.Id, pjob3.Id)
+		assert.True(t, pjob.NextRun.Equal(pjob3.NextRun))
+	})
+
+	t.Run("reschedules relative to the previous run when the period changes", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var i Injector
+		i.db = db
+
+		err := i.AddPeriodicJob("foo", "a", "test", nil, time.Hour)
+		require.NoError(t, err)
+
+		var pjob PeriodicJob
+
+		err = dbx.Check(db.First(&pjob))
+		require.NoError(t, err)
+
+		err = i.AddPeriodicJob("foo", "b", "other", nil, 24*time.Hour)
+		require.NoError(t, err)
+
+		var pjob2 PeriodicJob
+
+		err = dbx.Check(db.First(&pjob2))
+		require.NoError(t, err)
+
+		assert.Equal(t, "24h0m0s", pjob2.Period)
+		assert.Equal(t, "b", pjob2.Queue)
+		assert.Equal(t, "other", pjob2.JobType)
+		assert.True(t, pjob.NextRun.Add(23*time.Hour).Equal(pjob2.NextRun))
+
+		// Registering the same definition again leaves the schedule alone.
+		err = i.AddPeriodicJob("foo", "b", "other", nil, 24*time.Hour)
+		require.NoError(t, err)
+
+		var pjob3 PeriodicJob
+
+		err = dbx.Check(db.First(&pjob3))
+		require.NoError(t, err)
+
 		assert.True(t, pjob2.NextRun.Equal(pjob3.NextRun))
 	})
+
+	t.Run("records the outcome of periodic runs", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var pjob PeriodicJob
+
+		pjob.Name = "cleanup"
+		pjob.NextRun = time.Now()
+		pjob.Queue = "a"
+		pjob.Period = "30m"
+		pjob.JobType = "test"
+		pjob.Payload = []byte("1")
+
+		err := dbx.Check(db.Create(&pjob))
+		require.NoError(t, err)
+
+		w := NewWorker(L, db, []string{"a"})
+
+		err = w.CheckPeriodic()
+		require.NoError(t, err)
+
+		rj, err := w.Pop()
+		require.NoError(t, err)
+
+		require.NotNil(t, rj.PeriodicJob)
+		assert.Equal(t, "cleanup", *rj.PeriodicJob)
+
+		w.runJob(context.Background(), rj, func(ctx context.Context, j *Job) error {
+			return errors.New("disk full")
+		})
+
+		status, err := ListPeriodicStatus(db)
+		require.NoError(t, err)
+
+		require.Len(t, status, 1)
+
+		st := status[0]
+		assert.Equal(t, "cleanup", st.Name)
+		require.NotNil(t, st.LastRunAt)
+		require.NotNil(t, st.LastStatus)
+		assert.Equal(t, "error", *st.LastStatus)
+		require.NotNil(t, st.LastError)
+		assert.Equal(t, "disk full", *st.LastError)
+
+		// The failed run is waiting to be retried.
+		assert.Equal(t, 1, st.PendingAttempts)
+		assert.NotNil(t, st.RetryAt)
+	})
 }
@@ -11,6 +11,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// ErrUnknownJobType is returned by CheckPayload for job types that have no
+// registered handler.
+var ErrUnknownJobType = errors.New("no handler registered for job type")
+
 type Handler interface {
 	PerformJob(jobType string, data []byte) error
 }
@@ -18,6 +22,7 @@ type Handler interface {
 type registeredHandler struct {
 	argType reflect.Type
 	f       reflect.Value
+	codec   Codec
 }
 
 type Registry struct {
@@ -36,7 +41,16 @@ func (r *Registry) PrintHandlers(L hclog.Logger) {
 	}
 }
 
+// Register h as the handler for jobType, with payloads encoded as JSON.
 func (r *Registry) Register(jobType string, h interface{}) {
+	r.RegisterWithCodec(jobType, JSONCodec, h)
+}
+
+// RegisterWithCodec registers h as the handler for jobType, with payloads
+// marshaled and unmarshaled by codec. h must be a
+// func(context.Context, string, *T) error, and is passed the payload
+// unmarshaled into a new T.
+func (r *Registry) RegisterWithCodec(jobType string, codec Codec, h interface{}) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -76,6 +90,7 @@ func (r *Registry) Register(jobType string, h interface{}) {
 	r.types[jobType] = registeredHandler{
 		argType: argt,
 		f:       v,
+		codec:   codec,
 	}
 }
 
@@ -92,9 +107,9 @@ func (r *Registry) Handle(ctx context.Context, job *Job) error {
 
 	arg := reflect.New(rh.argType.Elem())
 
-	err := json.Unmarshal(job.Payload, arg.Interface())
+	err := decodePayload(rh.codec, job.PayloadCodec, job.Payload, arg.Interface())
 	if err != nil {
-		return errors.Wrapf(err, "wrong json for job type: %s", job.JobType)
+		return errors.Wrapf(err, "wrong payload for job type: %s", job.JobType)
 	}
 
 	out := rh.f.Call([]reflect.Value{
@@ -110,6 +125,95 @@ func (r *Registry) Handle(ctx context.Context, job *Job) error {
 	return v.Interface().(error)
 }
 
+// Has reports whether a handler is registered for jobType.
+func (r *Registry) Has(jobType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.types[jobType]
+	return ok
+}
+
+// CheckPayload reports whether a job of jobType with payload could be
+// handled, without running it: a handler must be registered and payload,
+// encoded with the job type's codec, must decode into its argument.
+func (r *Registry) CheckPayload(jobType string, payload []byte) error {
+	r.mu.RLock()
+
+	rh, ok := r.types[jobType]
+
+	r.mu.RUnlock()
+
+	if !ok {
+		return errors.Wrapf(ErrUnknownJobType, "job type: %s", jobType)
+	}
+
+	arg := reflect.New(rh.argType.Elem())
+
+	err := decodePayload(rh.codec, rh.codec.Name(), payload, arg.Interface())
+	if err != nil {
+		return errors.Wrapf(err, "wrong payload for job type: %s", jobType)
+	}
+
+	return nil
+}
+
+// Codec returns the codec payloads of jobType are encoded with, JSONCodec
+// if it has no registered handler. Jobs record the codec their payload
+// was encoded with, so one queued before its job type was registered is
+// still decoded correctly.
+func (r *Registry) Codec(jobType string) Codec {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rh, ok := r.types[jobType]
+	if !ok {
+		return JSONCodec
+	}
+
+	return rh.codec
+}
+
+// EncodePayload encodes v as the payload of a job of jobType, using the
+// job type's codec.
+func (r *Registry) EncodePayload(jobType string, v interface{}) ([]byte, error) {
+	return encodePayload(r.Codec(jobType), v)
+}
+
+// PayloadFromJSON converts a JSON payload for jobType, such as one given to
+// the EnqueueJob RPC, to the encoding of the job type's codec, returning it
+// along with the codec's name. It fails if the JSON doesn't decode into the
+// handler's argument.
+func (r *Registry) PayloadFromJSON(jobType string, data []byte) ([]byte, string, error) {
+	r.mu.RLock()
+
+	rh, ok := r.types[jobType]
+
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, "", errors.Wrapf(ErrUnknownJobType, "job type: %s", jobType)
+	}
+
+	arg := reflect.New(rh.argType.Elem())
+
+	err := json.Unmarshal(data, arg.Interface())
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "wrong json for job type: %s", jobType)
+	}
+
+	if rh.codec == JSONCodec {
+		return data, JSONCodec.Name(), nil
+	}
+
+	payload, err := encodePayload(rh.codec, arg.Interface())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return payload, rh.codec.Name(), nil
+}
+
 func (r *Registry) Size() int {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
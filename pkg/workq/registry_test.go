@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -60,4 +62,103 @@ func TestRegistry(t *testing.T) {
 
 		require.NoError(t, err)
 	})
+
+	t.Run("checks payloads without running the handler", func(t *testing.T) {
+		type foo struct {
+			Name string
+		}
+
+		var called bool
+
+		var r Registry
+
+		r.Register("foo_happened", func(ctx context.Context, jt string, f *foo) error {
+			called = true
+			return nil
+		})
+
+		require.NoError(t, r.CheckPayload("foo_happened", []byte(`{"Name": "boo"}`)))
+		assert.False(t, called)
+
+		assert.Error(t, r.CheckPayload("foo_happened", []byte(`{"Name": 1}`)))
+
+		err := r.CheckPayload("bar_happened", []byte(`null`))
+		assert.Equal(t, ErrUnknownJobType, errors.Cause(err))
+	})
+
+	t.Run("encodes payloads with the job type's codec", func(t *testing.T) {
+		type foo struct {
+			Name string
+			Age  int
+		}
+
+		var (
+			r       Registry
+			gotFoo  *foo
+			gotAcct *pb.Account
+		)
+
+		r.RegisterWithCodec("foo_happened", GobCodec, func(ctx context.Context, jt string, f *foo) error {
+			gotFoo = f
+			return nil
+		})
+
+		r.RegisterWithCodec("account_happened", ProtoCodec, func(ctx context.Context, jt string, a *pb.Account) error {
+			gotAcct = a
+			return nil
+		})
+
+		assert.Equal(t, GobCodec, r.Codec("foo_happened"))
+		assert.Equal(t, JSONCodec, r.Codec("bar_happened"))
+
+		var job Job
+
+		err := job.SetWith(&r, "foo_happened", &foo{Name: "boo", Age: 42})
+		require.NoError(t, err)
+
+		// Still valid for the jsonb column.
+		assert.True(t, json.Valid(job.Payload))
+		assert.Equal(t, "gob", job.PayloadCodec)
+
+		require.NoError(t, r.Handle(context.TODO(), &job))
+		assert.Equal(t, &foo{Name: "boo", Age: 42}, gotFoo)
+
+		acct := &pb.Account{Namespace: "/", AccountId: pb.NewULID()}
+
+		err = job.SetWith(&r, "account_happened", acct)
+		require.NoError(t, err)
+
+		require.NoError(t, r.Handle(context.TODO(), &job))
+		assert.True(t, acct.Equal(gotAcct))
+
+		// A payload is decoded with the codec its job records, such as one
+		// queued before its job type was registered with a codec.
+		require.NoError(t, r.Handle(context.TODO(), &Job{JobType: "foo_happened", Payload: []byte(`{"Name": "early"}`)}))
+		assert.Equal(t, &foo{Name: "early"}, gotFoo)
+
+		// However the JSON looks.
+		require.NoError(t, r.Handle(context.TODO(), &Job{
+			JobType:      "foo_happened",
+			PayloadCodec: "json",
+			Payload:      []byte(`{"Name": "marked", "$codec": "nope"}`),
+		}))
+		assert.Equal(t, &foo{Name: "marked"}, gotFoo)
+
+		assert.Error(t, r.Handle(context.TODO(), &Job{JobType: "foo_happened", PayloadCodec: "nope", Payload: []byte(`""`)}))
+
+		assert.Error(t, r.CheckPayload("foo_happened", []byte(`{"Name": "boo"}`)))
+
+		payload, codec, err := r.PayloadFromJSON("foo_happened", []byte(`{"Name": "zed"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "gob", codec)
+
+		require.NoError(t, r.CheckPayload("foo_happened", payload))
+
+		require.NoError(t, r.Handle(context.TODO(), &Job{JobType: "foo_happened", PayloadCodec: codec, Payload: payload}))
+		assert.Equal(t, &foo{Name: "zed"}, gotFoo)
+
+		_, _, err = r.PayloadFromJSON("foo_happened", []byte(`{"Name": 1}`))
+		assert.Error(t, err)
+	})
 }
@@ -0,0 +1,73 @@
+package workq
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
+	"github.com/jinzhu/gorm"
+)
+
+// The queue that jobs run with RunOnce are recorded in.
+const RunOnceQueue = "default"
+
+// RunOnce runs the GlobalRegistry handler for jobType once, synchronously,
+// with payload as its argument. See Registry.RunOnce.
+func RunOnce(ctx context.Context, db *gorm.DB, jobType string, payload interface{}) error {
+	return GlobalRegistry.RunOnce(ctx, db, jobType, payload)
+}
+
+// RunOnce creates a job of jobType with payload and runs it to completion
+// in the calling goroutine rather than waiting for a worker to pick it up,
+// returning the handler's error.
+//
+// The job is inserted as running, which no worker ever claims, before the
+// handler runs, so that no transaction is held open while it does. On
+// success it's marked finished, like a job run by a worker. On failure it's
+// deleted, leaving nothing queued for a retry; the caller decides whether to
+// run it again.
+func (r *Registry) RunOnce(ctx context.Context, db *gorm.DB, jobType string, payload interface{}) error {
+	if !r.Has(jobType) {
+		return fmt.Errorf("no handler registered for job type: %s", jobType)
+	}
+
+	job := NewJob()
+	job.Queue = RunOnceQueue
+	job.Status = "running"
+
+	err := job.SetWith(r, jobType, payload)
+	if err != nil {
+		return err
+	}
+
+	err = dbx.Check(db.Create(job))
+	if err != nil {
+		return err
+	}
+
+	L := hclog.FromContext(ctx).With(
+		"job-id", pb.ULIDFromBytes(job.Id).SpecString(),
+		"job-type", job.JobType,
+	)
+
+	ctx = hclog.WithContext(ctx, L)
+
+	L.Debug("running job once")
+
+	start := time.Now()
+	err = r.Handle(ctx, job)
+	recordJobExecution(job.JobType, time.Since(start), err)
+
+	if err != nil {
+		if derr := dbx.Check(db.Where("id = ?", job.Id).Delete(&Job{})); derr != nil {
+			L.Error("error removing failed job", "error", derr)
+		}
+
+		return err
+	}
+
+	return dbx.Check(db.Model(&Job{}).Where("id = ?", job.Id).Update("status", "finished"))
+}
@@ -0,0 +1,106 @@
+package workq
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/horizon/internal/testsql"
+	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOnce(t *testing.T) {
+	type payload struct {
+		Name string
+	}
+
+	t.Run("runs the handler and records the job as finished", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var r Registry
+
+		var seen string
+
+		r.Register("greet", func(ctx context.Context, jt string, p *payload) error {
+			seen = p.Name
+			return nil
+		})
+
+		err := r.RunOnce(context.Background(), db, "greet", &payload{Name: "boo"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "boo", seen)
+
+		var jobs []*Job
+
+		err = dbx.Check(db.Find(&jobs))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(jobs))
+
+		assert.Equal(t, "greet", jobs[0].JobType)
+		assert.Equal(t, "finished", jobs[0].Status)
+	})
+
+	t.Run("returns the handler's error and leaves nothing queued", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var r Registry
+
+		boom := errors.New("boom")
+
+		r.Register("fail", func(ctx context.Context, jt string, p *payload) error {
+			return boom
+		})
+
+		err := r.RunOnce(context.Background(), db, "fail", nil)
+		assert.Equal(t, boom, err)
+
+		var count int
+
+		err = dbx.Check(db.Model(&Job{}).Count(&count))
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("holds no transaction open while the handler runs", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var r Registry
+
+		var status string
+
+		r.Register("peek", func(ctx context.Context, jt string, p *payload) error {
+			var job Job
+
+			err := dbx.Check(db.Where("job_type = ?", "peek").First(&job))
+			if err != nil {
+				return err
+			}
+
+			status = job.Status
+			return nil
+		})
+
+		err := r.RunOnce(context.Background(), db, "peek", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "running", status)
+	})
+
+	t.Run("rejects job types without a handler", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var r Registry
+
+		err := r.RunOnce(context.Background(), db, "missing", nil)
+		require.Error(t, err)
+	})
+}
@@ -2,7 +2,9 @@ package workq
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
@@ -15,7 +17,9 @@ import (
 const (
 	DefaultPopInterval     = time.Minute
 	DefaultConcurrency     = 5
+	DefaultBatchSize       = 1
 	DefaultCleanupInterval = time.Hour
+	DefaultPeriodicCheck   = time.Minute
 	MaximumAttempts        = 100
 )
 
@@ -27,8 +31,28 @@ type Worker struct {
 
 	Validate func(job *Job) (bool, error)
 
+	// Also serve every per-account queue, see AccountQueue. The queues
+	// with work are then served in turn, one claim from each, rather than
+	// strictly by priority, so one account's backlog can't hold up the
+	// other accounts' jobs, or those of the named queues.
+	AccountQueues bool
+
+	// The most jobs of a single per-account queue this worker runs at
+	// once, when AccountQueues is set. The limit is per worker, not across
+	// processes. Zero means no limit.
+	MaxPerAccount int
+
+	mu        sync.Mutex
+	running   map[string]int
+	lastQueue string
+
+	// The cached queues with ready jobs, see readyQueues.
+	ready   []string
+	readyAt time.Time
+
 	Stats struct {
 		ListenWakeups int64
+		PollWakeups   int64
 	}
 }
 
@@ -40,6 +64,95 @@ type RunningJob struct {
 	Job
 	L  hclog.Logger
 	tx *gorm.DB
+
+	// Set when the job was claimed as part of a batch, in which case tx is
+	// shared with the other jobs in it.
+	batch *jobBatch
+}
+
+// A set of jobs claimed with a single query. They share the transaction
+// holding their row locks, which is committed once every job has been
+// closed or aborted. Each job's changes are made in a savepoint of their
+// own, so a failed statement only undoes that job's changes rather than
+// aborting the transaction for the whole batch. Finished jobs aren't
+// durable until the batch commits, so a crash, or a job whose changes
+// can't be made, runs them again along with the jobs that hadn't finished.
+type jobBatch struct {
+	tx      *gorm.DB
+	pending int
+
+	// Set when a job's changes couldn't be made, in which case the batch is
+	// rolled back rather than committed, so that job isn't left claimed.
+	failed bool
+}
+
+func (b *jobBatch) finish() error {
+	b.pending--
+	if b.pending > 0 {
+		return nil
+	}
+
+	if b.failed {
+		return dbx.Check(b.tx.Rollback())
+	}
+
+	return dbx.Check(b.tx.Commit())
+}
+
+// Commit the job's changes, or hand them to its batch to be committed with
+// the rest of it.
+func (r *RunningJob) commit() error {
+	tx, batch := r.tx, r.batch
+	r.tx = nil
+
+	if batch != nil {
+		return batch.finish()
+	}
+
+	return dbx.Check(tx.Commit())
+}
+
+// Make the job's changes with f. Within a batch they're made in a
+// savepoint, rolled back to if f fails so the batch's transaction stays
+// usable.
+func (r *RunningJob) change(f func(tx *gorm.DB) error) error {
+	if r.batch == nil {
+		return f(r.tx)
+	}
+
+	sp := "job_" + hex.EncodeToString(r.Id)
+
+	err := dbx.Check(r.tx.Exec("SAVEPOINT " + sp))
+	if err != nil {
+		return err
+	}
+
+	err = f(r.tx)
+	if err != nil {
+		r.tx.Exec("ROLLBACK TO SAVEPOINT " + sp)
+		return err
+	}
+
+	return dbx.Check(r.tx.Exec("RELEASE SAVEPOINT " + sp))
+}
+
+// Give up the job's hold on its transaction after its changes failed,
+// rolling back its claim. A batch is rolled back once its other jobs are
+// done, so they run again too.
+func (r *RunningJob) drop() {
+	if r.batch != nil {
+		r.batch.failed = true
+
+		err := r.commit()
+		if err != nil {
+			r.L.Error("error committing job batch", "error", err)
+		}
+
+		return
+	}
+
+	r.tx.Rollback()
+	r.tx = nil
 }
 
 var MaxCoolOffDuration = 240 * time.Second
@@ -51,17 +164,43 @@ func (r *RunningJob) Abort() error {
 
 	attempts := r.Job.Attempts + 1
 
-	if attempts >= MaximumAttempts {
-		r.L.Error("maximum attempts reached, dropping job",
+	if reason := r.Job.abandonReason(attempts, time.Now()); reason != "" {
+		r.L.Error("retry limit reached, marking job as dead",
 			"id", pb.ULIDFromBytes(r.Id).SpecString(),
 			"queue", r.Queue,
 			"job-type", r.JobType,
 			"created-at", r.CreatedAt.String(),
+			"reason", reason,
 		)
 
-		r.tx.Delete(&r.Job)
+		recordJobAbandoned(r.JobType, reason)
+
+		// Dead jobs are kept around rather than deleted so that they can
+		// be inspected and retried by an operator.
+		err := r.change(func(tx *gorm.DB) error {
+			err := dbx.Check(tx.Model(&r.Job).
+				Updates(map[string]interface{}{
+					"status":         "dead",
+					"attempts":       attempts,
+					"abandon_reason": reason,
+				}),
+			)
+			if err != nil {
+				return err
+			}
+
+			reason := fmt.Sprintf("parent job %s failed permanently", pb.ULIDFromBytes(r.Id).SpecString())
 
-		return dbx.Check(r.tx.Commit())
+			return cancelDependents(r.L, tx, r.Id, reason)
+		})
+
+		if err != nil {
+			r.L.Error("error marking job as dead", "error", err)
+			r.drop()
+			return err
+		}
+
+		return r.commit()
 	}
 
 	dur := time.Duration(attempts*10) * time.Second
@@ -72,21 +211,22 @@ func (r *RunningJob) Abort() error {
 
 	cool := time.Now().Add(dur)
 
-	err := dbx.Check(r.tx.Model(&r.Job).
-		Updates(map[string]interface{}{
-			"status":         "queued",
-			"attempts":       attempts,
-			"cool_off_until": &cool,
-		}),
-	)
+	err := r.change(func(tx *gorm.DB) error {
+		return dbx.Check(tx.Model(&r.Job).
+			Updates(map[string]interface{}{
+				"status":         "queued",
+				"attempts":       attempts,
+				"cool_off_until": &cool,
+			}),
+		)
+	})
 
 	if err != nil {
+		r.drop()
 		return err
 	}
 
-	err = dbx.Check(r.tx.Commit())
-	r.tx = nil
-	return err
+	return r.commit()
 }
 
 func (r *RunningJob) AbortAndRequeue() error {
@@ -94,6 +234,20 @@ func (r *RunningJob) AbortAndRequeue() error {
 		return nil
 	}
 
+	// The other jobs in the batch still need the transaction, so undo
+	// claiming this one rather than rolling back.
+	if r.batch != nil {
+		err := r.change(func(tx *gorm.DB) error {
+			return dbx.Check(tx.Model(&r.Job).Update("status", "queued"))
+		})
+		if err != nil {
+			r.drop()
+			return err
+		}
+
+		return r.commit()
+	}
+
 	err := dbx.Check(r.tx.Rollback())
 	r.tx = nil
 	return err
@@ -104,25 +258,38 @@ func (r *RunningJob) Close() error {
 		return nil
 	}
 
-	err := dbx.Check(r.tx.Commit())
-	r.tx = nil
-	return err
+	err := r.change(func(tx *gorm.DB) error {
+		return releaseDependents(tx, r.Id)
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.commit()
 }
 
+// Pop claims the highest priority job ready to run in the worker's named
+// queues.
 func (w *Worker) Pop() (*RunningJob, error) {
+	return w.pop(w.queues)
+}
+
+func (w *Worker) pop(queues []string) (*RunningJob, error) {
 	tx := w.db.Begin()
 
 	var job RunningJob
 	job.L = w.L
 
-	w.L.Debug("attempting to pop job from database", "queues", w.queues)
+	w.L.Debug("attempting to pop job from database", "queues", queues)
 
 	err := dbx.Check(
 		tx.
 			Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
 			Where("status = ?", "queued").
-			Where("queue IN (?)", w.queues).
+			Where("queue IN (?)", queues).
 			Where("cool_off_until IS NULL or now() >= cool_off_until").
+			Where("pending_parents = 0").
+			Order("priority DESC").
 			First(&job.Job),
 	)
 
@@ -133,6 +300,8 @@ func (w *Worker) Pop() (*RunningJob, error) {
 
 	w.L.Debug("job found", "job-type", job.JobType)
 
+	job.L = w.jobLogger(&job.Job)
+
 	if w.Validate != nil {
 		ok, err := w.Validate(&job.Job)
 		if err != nil {
@@ -146,16 +315,205 @@ func (w *Worker) Pop() (*RunningJob, error) {
 		}
 	}
 
-	err = dbx.Check(tx.Model(&job.Job).Update("status", "finished"))
+	err = dbx.Check(tx.Model(&job.Job).Updates(claimUpdates()))
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
+	job.Job.startAttempt()
+
 	job.tx = tx
 
 	return &job, nil
 }
 
+// The changes made to a job's row when it's claimed by a worker. The time
+// of the first attempt is kept, as MaxElapsed is measured from it.
+func claimUpdates() map[string]interface{} {
+	return map[string]interface{}{
+		"status":           "finished",
+		"first_attempt_at": gorm.Expr("COALESCE(first_attempt_at, now())"),
+	}
+}
+
+// Match claimUpdates in the claimed copy of the job.
+func (j *Job) startAttempt() {
+	if j.FirstAttemptAt == nil {
+		now := time.Now()
+		j.FirstAttemptAt = &now
+	}
+}
+
+// Tag all logging done on behalf of job so that it can be correlated
+// across retries.
+func (w *Worker) jobLogger(job *Job) hclog.Logger {
+	return w.L.With(
+		"job-id", pb.ULIDFromBytes(job.Id).SpecString(),
+		"job-type", job.JobType,
+		"attempt", job.Attempts+1,
+	)
+}
+
+// PopBatch claims up to n jobs with a single query. The jobs share one
+// transaction, so their row locks are held until all of them have been
+// closed or aborted, and the finished ones are only durable once the batch
+// commits; they're expected to be run one after another by the caller.
+// Jobs rejected by Validate aren't held. With n of 1 or less this is the same as Pop. When AccountQueues
+// is set, the jobs are all claimed from one queue, taking the queues in
+// turn.
+func (w *Worker) PopBatch(n int) ([]*RunningJob, error) {
+	if w.AccountQueues {
+		return w.popFair(n)
+	}
+
+	return w.popBatch(w.queues, n)
+}
+
+func (w *Worker) popBatch(queues []string, n int) ([]*RunningJob, error) {
+	if n <= 1 {
+		job, err := w.pop(queues)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*RunningJob{job}, nil
+	}
+
+	tx := w.db.Begin()
+
+	// Rolled back to if Validate rejects any of the jobs found, releasing
+	// their row locks.
+	err := dbx.Check(tx.Exec("SAVEPOINT candidates"))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var found []*Job
+
+	err = dbx.Check(
+		tx.
+			Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("status = ?", "queued").
+			Where("queue IN (?)", queues).
+			Where("cool_off_until IS NULL or now() >= cool_off_until").
+			Where("pending_parents = 0").
+			Order("priority DESC, id ASC").
+			Limit(n).
+			Find(&found),
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	batch := &jobBatch{tx: tx}
+
+	var (
+		jobs []*RunningJob
+		ids  [][]byte
+	)
+
+	for _, j := range found {
+		j.startAttempt()
+
+		if w.Validate != nil {
+			ok, err := w.Validate(j)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		jobs = append(jobs, &RunningJob{
+			Job:   *j,
+			L:     w.jobLogger(j),
+			tx:    tx,
+			batch: batch,
+		})
+
+		ids = append(ids, j.Id)
+	}
+
+	if len(jobs) == 0 {
+		tx.Rollback()
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	// Lock only the jobs that passed, skipping any another worker took
+	// in between.
+	if len(jobs) < len(found) {
+		jobs, ids, err = w.relockBatch(tx, jobs, ids)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if len(jobs) == 0 {
+			tx.Rollback()
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	w.L.Debug("claimed batch of jobs", "count", len(jobs))
+
+	err = dbx.Check(tx.Model(&Job{}).Where("id IN (?)", ids).Updates(claimUpdates()))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	batch.pending = len(jobs)
+
+	return jobs, nil
+}
+
+// Release the row locks of the jobs found for a batch and lock only those
+// of jobs again, returning the ones still available and their ids.
+func (w *Worker) relockBatch(tx *gorm.DB, jobs []*RunningJob, ids [][]byte) ([]*RunningJob, [][]byte, error) {
+	err := dbx.Check(tx.Exec("ROLLBACK TO SAVEPOINT candidates"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locked [][]byte
+
+	err = dbx.Check(
+		tx.Model(&Job{}).
+			Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("id IN (?)", ids).
+			Where("status = ?", "queued").
+			Pluck("id", &locked),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	held := make(map[string]bool, len(locked))
+	for _, id := range locked {
+		held[string(id)] = true
+	}
+
+	var (
+		kept    []*RunningJob
+		keptIds [][]byte
+	)
+
+	for _, job := range jobs {
+		if held[string(job.Id)] {
+			kept = append(kept, job)
+			keptIds = append(keptIds, job.Id)
+		}
+	}
+
+	return kept, keptIds, nil
+}
+
 // Cleanup all the finished jobs
 func (w *Worker) CleanupFinished(lag bool) error {
 	var query string
@@ -170,9 +528,38 @@ func (w *Worker) CleanupFinished(lag bool) error {
 }
 
 type RunConfig struct {
-	ConnInfo     string
-	PopInterval  time.Duration
-	Concurrency  int
+	ConnInfo string
+
+	// How often the queues are polled for jobs. Enqueued jobs wake the
+	// workers through LISTEN right away, so unless DisableListen is set
+	// polling only picks up jobs whose cool off has passed and any missed
+	// notifications, and the interval can be long. Defaults to
+	// DefaultPopInterval.
+	PopInterval time.Duration
+
+	// Rely on polling alone, for connections that can't LISTEN, such as
+	// through a pooler in transaction mode.
+	DisableListen bool
+
+	// How often periodic jobs are checked for being due. Defaults to
+	// DefaultPeriodicCheck.
+	PeriodicCheck time.Duration
+
+	// The number of worker goroutines that pop and execute jobs in parallel.
+	// Each one claims its own job via Pop, which uses FOR UPDATE SKIP LOCKED,
+	// so no job is ever handed to two workers. There are no per-handler
+	// limits, which means a single job type can occupy every worker at once.
+	// Handlers that must not run concurrently with themselves (across workers
+	// or processes) need to serialize on their own, for instance with a lock.
+	Concurrency int
+
+	// The number of jobs each worker goroutine claims per query. The jobs in
+	// a batch are run one after another by the goroutine that claimed them,
+	// so Concurrency still bounds how many run at once. A crash reruns the
+	// jobs of a batch that had already finished, see PopBatch. Defaults to
+	// DefaultBatchSize.
+	BatchSize int
+
 	CleanupCheck time.Duration
 	Handler      func(ctx context.Context, j *Job) error
 }
@@ -188,6 +575,7 @@ func (w *Worker) Run(ctx context.Context, cfg RunConfig) error {
 
 	var inj Injector
 	inj.db = w.db
+	inj.L = L
 
 	for _, pe := range defaultPeriodics {
 		L.Info("added periodic job",
@@ -197,7 +585,10 @@ func (w *Worker) Run(ctx context.Context, cfg RunConfig) error {
 			"period", pe.period,
 		)
 
-		inj.AddPeriodicJobRaw(pe.name, pe.queue, pe.jobType, pe.payload, pe.period)
+		err := inj.AddPeriodicJob(pe.name, pe.queue, pe.jobType, pe.value, pe.period)
+		if err != nil {
+			L.Error("error adding periodic job", "name", pe.name, "error", err)
+		}
 	}
 
 	periodMu.Unlock()
@@ -216,10 +607,18 @@ func (w *Worker) Run(ctx context.Context, cfg RunConfig) error {
 		cfg.Concurrency = DefaultConcurrency
 	}
 
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+
 	if cfg.CleanupCheck == 0 {
 		cfg.CleanupCheck = DefaultCleanupInterval
 	}
 
+	if cfg.PeriodicCheck == 0 {
+		cfg.PeriodicCheck = DefaultPeriodicCheck
+	}
+
 	if cfg.Handler == nil {
 		if GlobalRegistry.Size() == 0 {
 			return fmt.Errorf("no handler and default registry is empty")
@@ -229,26 +628,35 @@ func (w *Worker) Run(ctx context.Context, cfg RunConfig) error {
 		cfg.Handler = GlobalRegistry.Handle
 	}
 
-	minReconn := 10 * time.Second
-	maxReconn := time.Minute
-	listener := pq.NewListener(cfg.ConnInfo, minReconn, maxReconn, reportProblem)
-	defer listener.Close()
+	// Without a listener notify stays nil and never fires.
+	var notify <-chan *pq.Notification
 
-	err := listener.Listen(listenChannel)
-	if err != nil {
-		return err
+	if cfg.DisableListen {
+		L.Info("LISTEN disabled, polling for jobs", "interval", cfg.PopInterval)
+	} else {
+		minReconn := 10 * time.Second
+		maxReconn := time.Minute
+		listener := pq.NewListener(cfg.ConnInfo, minReconn, maxReconn, reportProblem)
+		defer listener.Close()
+
+		err := listener.Listen(listenChannel)
+		if err != nil {
+			return err
+		}
+
+		notify = listener.Notify
 	}
 
 	ticker := time.NewTicker(cfg.PopInterval)
 	defer ticker.Stop()
 
-	workChan := make(chan *RunningJob)
+	wakeup := make(chan struct{}, cfg.Concurrency)
 
 	for i := 0; i < cfg.Concurrency; i++ {
-		go w.processJobs(ctx, workChan, cfg.Handler)
+		go w.processJobs(ctx, wakeup, cfg.BatchSize, cfg.Handler)
 	}
 
-	pticker := time.NewTicker(time.Minute)
+	pticker := time.NewTicker(cfg.PeriodicCheck)
 	defer pticker.Stop()
 
 	cticker := time.NewTicker(cfg.CleanupCheck)
@@ -275,51 +683,96 @@ func (w *Worker) Run(ctx context.Context, cfg RunConfig) error {
 			}
 
 			continue
-		case <-listener.Notify:
+		case <-notify:
 			w.Stats.ListenWakeups++
 			// got event
 		case <-ticker.C:
+			w.Stats.PollWakeups++
 			// timed out, try to pop
-		}
 
-		job, err := w.Pop()
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				continue
+			if w.AccountQueues {
+				err := w.recordQueueDepths()
+				if err != nil {
+					L.Error("error recording queue depths", "error", err)
+				}
 			}
-
-			return err
 		}
 
-		L.Debug("running job", "job-type", job.JobType)
-
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case workChan <- job:
-			// ok
+		// Wake up any idle workers. Busy workers will pick up
+		// remaining jobs on their own once they finish their current one.
+		for i := 0; i < cfg.Concurrency; i++ {
+			select {
+			case wakeup <- struct{}{}:
+			default:
+			}
 		}
 	}
 }
 
-func (w *Worker) processJobs(ctx context.Context, wc chan *RunningJob, f func(context.Context, *Job) error) {
+func (w *Worker) processJobs(ctx context.Context, wakeup chan struct{}, batchSize int, f func(context.Context, *Job) error) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case job := <-wc:
-			func() {
-				defer job.Abort()
-
-				w.L.Debug("executing job handler", "job-type", job.JobType)
-				err := f(ctx, &job.Job)
-				if err == nil {
-					w.L.Debug("job finished")
-					job.Close()
-				} else {
-					w.L.Error("error executing job function", "error", err, "job-type", job.JobType)
+		case <-wakeup:
+			// ok
+		}
+
+		// Drain the queue before waiting for the next wakeup.
+		for ctx.Err() == nil {
+			jobs, err := w.PopBatch(batchSize)
+			if err != nil {
+				if err != gorm.ErrRecordNotFound {
+					w.L.Error("error popping job", "error", err)
 				}
-			}()
+
+				break
+			}
+
+			for i, job := range jobs {
+				// Release the rest of the batch for another worker to pick
+				// up rather than running them with a canceled context.
+				if ctx.Err() != nil {
+					for _, rest := range jobs[i:] {
+						rest.AbortAndRequeue()
+					}
+
+					w.release(job.Queue, len(jobs)-i)
+
+					break
+				}
+
+				w.L.Debug("running job", "job-type", job.JobType)
+
+				w.runJob(ctx, job, f)
+				w.release(job.Queue, 1)
+			}
 		}
 	}
 }
+
+func (w *Worker) runJob(ctx context.Context, job *RunningJob, f func(context.Context, *Job) error) {
+	defer job.Abort()
+
+	L := job.L
+
+	// Handlers pick up the job tagged logger via hclog.FromContext.
+	ctx = hclog.WithContext(ctx, L)
+
+	L.Debug("executing job handler")
+
+	start := time.Now()
+	err := f(ctx, &job.Job)
+	recordJobExecution(job.JobType, time.Since(start), err)
+
+	if job.PeriodicJob != nil {
+		w.recordPeriodicResult(*job.PeriodicJob, err)
+	}
+
+	if err == nil {
+		L.Debug("job finished")
+		job.Close()
+	} else {
+		L.Error("error executing job function", "error", err)
+	}
+}
@@ -1,7 +1,10 @@
 package workq
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -9,6 +12,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/horizon/internal/testsql"
 	"github.com/hashicorp/horizon/pkg/dbx"
+	"github.com/hashicorp/horizon/pkg/pb"
 	"github.com/jinzhu/gorm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -409,6 +413,105 @@ func TestWorker(t *testing.T) {
 		assert.Equal(t, int64(1), w.Stats.ListenWakeups)
 	})
 
+	t.Run("provides handlers a logger tagged with the job", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var buf bytes.Buffer
+
+		jL := hclog.New(&hclog.LoggerOptions{
+			Output:     &buf,
+			JSONFormat: true,
+		})
+
+		job := NewJob()
+		job.Queue = "a"
+
+		job.Set("test", 1)
+
+		err := dbx.Check(db.Create(&job))
+		require.NoError(t, err)
+
+		w := NewWorker(jL, db, []string{"a"})
+
+		rj, err := w.Pop()
+		require.NoError(t, err)
+
+		w.runJob(context.Background(), rj, func(ctx context.Context, j *Job) error {
+			hclog.FromContext(ctx).Info("from the handler")
+			return nil
+		})
+
+		var line map[string]interface{}
+
+		for _, l := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(l), &entry))
+
+			if entry["@message"] == "from the handler" {
+				line = entry
+			}
+		}
+
+		require.NotNil(t, line)
+
+		assert.Equal(t, pb.ULIDFromBytes(job.Id).SpecString(), line["job-id"])
+		assert.Equal(t, "test", line["job-type"])
+		assert.Equal(t, float64(1), line["attempt"])
+	})
+
+	t.Run("runs jobs on multiple workers in parallel", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		w := NewWorker(L, db, []string{"a"})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			started = make(chan struct{}, 2)
+			release = make(chan struct{})
+		)
+
+		go w.Run(ctx, RunConfig{
+			ConnInfo:    testsql.TestPostgresDBString(t, "periodic"),
+			PopInterval: time.Minute,
+			Concurrency: 2,
+			Handler: func(ctx context.Context, j *Job) error {
+				started <- struct{}{}
+				<-release
+				return nil
+			},
+		})
+
+		time.Sleep(time.Second)
+
+		var i Injector
+		i.db = db
+
+		for x := 0; x < 2; x++ {
+			job := NewJob()
+			job.Queue = "a"
+
+			job.Set("test", x)
+
+			err := i.Inject(job)
+			require.NoError(t, err)
+		}
+
+		// Both handlers must be running at the same time for this to pass.
+		for x := 0; x < 2; x++ {
+			select {
+			case <-started:
+			case <-time.After(5 * time.Second):
+				t.Fatal("jobs were not processed in parallel")
+			}
+		}
+
+		close(release)
+	})
+
 	t.Run("skips a job in cooloff", func(t *testing.T) {
 		db := testsql.TestPostgresDB(t, "periodic")
 		defer db.Close()
@@ -526,7 +629,249 @@ func TestWorker(t *testing.T) {
 		require.NoError(t, err)
 
 		var job3 Job
-		err = dbx.Check(db.First(&job3))
+		err = dbx.Check(db.Where("status = ?", "queued").First(&job3))
 		require.Error(t, err)
+
+		err = dbx.Check(db.Where("status = ?", "dead").First(&job3))
+		require.NoError(t, err)
+
+		assert.Equal(t, job.Id, job3.Id)
+		assert.Equal(t, MaximumAttempts, job3.Attempts)
+	})
+
+	t.Run("claims a batch of jobs in one query", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		for i := 0; i < 3; i++ {
+			job := NewJob()
+			job.Queue = "a"
+
+			job.Set("test", i)
+
+			err := dbx.Check(db.Create(&job))
+			require.NoError(t, err)
+		}
+
+		w1 := NewWorker(L, db, []string{"a"})
+
+		batch, err := w1.PopBatch(2)
+		require.NoError(t, err)
+
+		require.Len(t, batch, 2)
+		assert.NotEqual(t, batch[0].Id, batch[1].Id)
+
+		// The batch holds its jobs, so another worker only sees the rest.
+		w2 := NewWorker(L, db, []string{"a"})
+
+		other, err := w2.PopBatch(2)
+		require.NoError(t, err)
+
+		require.Len(t, other, 1)
+		require.NoError(t, other[0].Close())
+
+		require.NoError(t, batch[0].Close())
+		require.NoError(t, batch[1].Abort())
+
+		var finished, queued int
+
+		err = dbx.Check(db.Model(&Job{}).Where("status = ?", "finished").Count(&finished))
+		require.NoError(t, err)
+
+		err = dbx.Check(db.Model(&Job{}).Where("status = ?", "queued").Count(&queued))
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, finished)
+		assert.Equal(t, 1, queued)
+
+		var aborted Job
+
+		err = dbx.Check(db.Where("id = ?", batch[1].Id).First(&aborted))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, aborted.Attempts)
+	})
+
+	t.Run("doesn't hold the jobs of a batch rejected by Validate", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		var rejected []byte
+
+		for i := 0; i < 2; i++ {
+			job := NewJob()
+			job.Queue = "a"
+
+			job.Set("test", i)
+
+			err := dbx.Check(db.Create(&job))
+			require.NoError(t, err)
+
+			rejected = job.Id
+		}
+
+		w1 := NewWorker(L, db, []string{"a"})
+		w1.Validate = func(job *Job) (bool, error) {
+			return string(job.Id) != string(rejected), nil
+		}
+
+		batch, err := w1.PopBatch(2)
+		require.NoError(t, err)
+		require.Len(t, batch, 1)
+
+		w2 := NewWorker(L, db, []string{"a"})
+
+		other, err := w2.PopBatch(2)
+		require.NoError(t, err)
+		require.Len(t, other, 1)
+		assert.Equal(t, rejected, other[0].Id)
+
+		require.NoError(t, other[0].Close())
+		require.NoError(t, batch[0].Close())
+	})
+
+	t.Run("pops higher priority jobs first", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		low := NewJob()
+		low.Queue = "a"
+		low.Set("test", 1)
+
+		high := NewJob()
+		high.Queue = "a"
+		high.Priority = 10
+		high.Set("test", 2)
+
+		i := NewInjector(L, db)
+		require.NoError(t, i.Inject(low))
+		require.NoError(t, i.Inject(high))
+
+		w := NewWorker(L, db, []string{"a"})
+
+		j, err := w.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, high.Id, j.Id)
+		require.NoError(t, j.Close())
+
+		j, err = w.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, low.Id, j.Id)
+		require.NoError(t, j.Close())
+	})
+
+	t.Run("polls for jobs when LISTEN is disabled", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		w := NewWorker(L, db, []string{"a"})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ran := make(chan *Job, 1)
+
+		go w.Run(ctx, RunConfig{
+			ConnInfo:      testsql.TestPostgresDBString(t, "periodic"),
+			PopInterval:   500 * time.Millisecond,
+			DisableListen: true,
+			Concurrency:   1,
+			Handler: func(ctx context.Context, j *Job) error {
+				ran <- j
+				return nil
+			},
+		})
+
+		var i Injector
+		i.db = db
+
+		job := NewJob()
+		job.Queue = "a"
+
+		job.Set("test", 1)
+
+		err := i.Inject(job)
+		require.NoError(t, err)
+
+		select {
+		case j := <-ran:
+			assert.Equal(t, job.Id, j.Id)
+		case <-time.After(5 * time.Second):
+			t.Fatal("job was not picked up by polling")
+		}
+
+		assert.Equal(t, int64(0), w.Stats.ListenWakeups)
+	})
+
+	t.Run("gives up on a job once its retry budget is spent", func(t *testing.T) {
+		db := testsql.TestPostgresDB(t, "periodic")
+		defer db.Close()
+
+		inject := func(f func(job *Job)) *Job {
+			job := NewJob()
+			job.Queue = "a"
+
+			job.Set("test", 1)
+
+			f(job)
+
+			err := dbx.Check(db.Create(&job))
+			require.NoError(t, err)
+
+			return job
+		}
+
+		load := func(id []byte) *Job {
+			var stored Job
+			err := dbx.Check(db.Where("id = ?", id).First(&stored))
+			require.NoError(t, err)
+
+			return &stored
+		}
+
+		w := NewWorker(L, db, []string{"a"})
+
+		job := inject(func(job *Job) { job.MaxElapsed = time.Hour })
+
+		j2, err := w.Pop()
+		require.NoError(t, err)
+
+		require.NotNil(t, j2.FirstAttemptAt)
+
+		// Within the budget the job is retried.
+		require.NoError(t, j2.Abort())
+
+		stored := load(job.Id)
+
+		assert.Equal(t, "queued", stored.Status)
+		require.NotNil(t, stored.FirstAttemptAt)
+
+		_, err = db.DB().Exec("UPDATE jobs SET cool_off_until = NULL, first_attempt_at = now() - interval '2 hours'")
+		require.NoError(t, err)
+
+		j2, err = w.Pop()
+		require.NoError(t, err)
+
+		require.NoError(t, j2.Abort())
+
+		stored = load(job.Id)
+
+		assert.Equal(t, "dead", stored.Status)
+		assert.Equal(t, 2, stored.Attempts)
+		require.NotNil(t, stored.AbandonReason)
+		assert.Equal(t, AbandonMaxElapsed, *stored.AbandonReason)
+
+		job = inject(func(job *Job) { job.MaxAttempts = 1 })
+
+		j2, err = w.Pop()
+		require.NoError(t, err)
+
+		require.NoError(t, j2.Abort())
+
+		stored = load(job.Id)
+
+		assert.Equal(t, "dead", stored.Status)
+		require.NotNil(t, stored.AbandonReason)
+		assert.Equal(t, AbandonMaxAttempts, *stored.AbandonReason)
 	})
 }